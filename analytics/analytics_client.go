@@ -103,10 +103,12 @@ func (client AnalyticsClient) ChangeAnalyticsInstanceCompartment(ctx context.Con
 				response = ChangeAnalyticsInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAnalyticsInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAnalyticsInstanceCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client AnalyticsClient) ChangeAnalyticsInstanceNetworkEndpoint(ctx context
 				response = ChangeAnalyticsInstanceNetworkEndpointResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAnalyticsInstanceNetworkEndpointResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAnalyticsInstanceNetworkEndpointResponse")
 	}
@@ -215,10 +219,12 @@ func (client AnalyticsClient) CreateAnalyticsInstance(ctx context.Context, reque
 				response = CreateAnalyticsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAnalyticsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAnalyticsInstanceResponse")
 	}
@@ -271,10 +277,12 @@ func (client AnalyticsClient) DeleteAnalyticsInstance(ctx context.Context, reque
 				response = DeleteAnalyticsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteAnalyticsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteAnalyticsInstanceResponse")
 	}
@@ -677,10 +685,12 @@ func (client AnalyticsClient) ScaleAnalyticsInstance(ctx context.Context, reques
 				response = ScaleAnalyticsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ScaleAnalyticsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ScaleAnalyticsInstanceResponse")
 	}
@@ -733,10 +743,12 @@ func (client AnalyticsClient) StartAnalyticsInstance(ctx context.Context, reques
 				response = StartAnalyticsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartAnalyticsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartAnalyticsInstanceResponse")
 	}
@@ -789,10 +801,12 @@ func (client AnalyticsClient) StopAnalyticsInstance(ctx context.Context, request
 				response = StopAnalyticsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopAnalyticsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopAnalyticsInstanceResponse")
 	}
@@ -0,0 +1,68 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package analytics
+
+import (
+	"context"
+)
+
+// AnalyticsClientAPI is the interface of the operations of AnalyticsClient, to inject the client and mock it in tests
+type AnalyticsClientAPI interface {
+	// ChangeAnalyticsInstanceCompartment Change the compartment of an Analytics instance. The operation is long-running
+	// and creates a new WorkRequest.
+	ChangeAnalyticsInstanceCompartment(ctx context.Context, request ChangeAnalyticsInstanceCompartmentRequest) (response ChangeAnalyticsInstanceCompartmentResponse, err error)
+
+	// ChangeAnalyticsInstanceNetworkEndpoint Change an Analytics instance network endpoint. The operation is long-running
+	// and creates a new WorkRequest.
+	ChangeAnalyticsInstanceNetworkEndpoint(ctx context.Context, request ChangeAnalyticsInstanceNetworkEndpointRequest) (response ChangeAnalyticsInstanceNetworkEndpointResponse, err error)
+
+	// CreateAnalyticsInstance Create a new AnalyticsInstance in the specified compartment. The operation is long-running
+	// and creates a new WorkRequest.
+	CreateAnalyticsInstance(ctx context.Context, request CreateAnalyticsInstanceRequest) (response CreateAnalyticsInstanceResponse, err error)
+
+	// DeleteAnalyticsInstance Terminates the specified Analytics instance. The operation is long-running
+	// and creates a new WorkRequest.
+	DeleteAnalyticsInstance(ctx context.Context, request DeleteAnalyticsInstanceRequest) (response DeleteAnalyticsInstanceResponse, err error)
+
+	// DeleteWorkRequest Cancel a work request that has not started yet.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// GetAnalyticsInstance Info for a specific Analytics instance.
+	GetAnalyticsInstance(ctx context.Context, request GetAnalyticsInstanceRequest) (response GetAnalyticsInstanceResponse, err error)
+
+	// GetWorkRequest Get the details of a work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListAnalyticsInstances List Analytics instances.
+	ListAnalyticsInstances(ctx context.Context, request ListAnalyticsInstancesRequest) (response ListAnalyticsInstancesResponse, err error)
+
+	// ListWorkRequestErrors Get the errors of a work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Get the logs of a work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests List all work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// ScaleAnalyticsInstance Scale an Analytics instance up or down. The operation is long-running
+	// and creates a new WorkRequest.
+	ScaleAnalyticsInstance(ctx context.Context, request ScaleAnalyticsInstanceRequest) (response ScaleAnalyticsInstanceResponse, err error)
+
+	// StartAnalyticsInstance Starts the specified Analytics instance. The operation is long-running
+	// and creates a new WorkRequest.
+	StartAnalyticsInstance(ctx context.Context, request StartAnalyticsInstanceRequest) (response StartAnalyticsInstanceResponse, err error)
+
+	// StopAnalyticsInstance Stop the specified Analytics instance. The operation is long-running
+	// and creates a new WorkRequest.
+	StopAnalyticsInstance(ctx context.Context, request StopAnalyticsInstanceRequest) (response StopAnalyticsInstanceResponse, err error)
+
+	// UpdateAnalyticsInstance Updates certain fields of an Analytics instance. Fields that are not provided in the
+	// request will not be updated.
+	UpdateAnalyticsInstance(ctx context.Context, request UpdateAnalyticsInstanceRequest) (response UpdateAnalyticsInstanceResponse, err error)
+}
+
+// AnalyticsClient implements AnalyticsClientAPI
+var _ AnalyticsClientAPI = AnalyticsClient{}
@@ -0,0 +1,150 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package analytics
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetAnalyticsInstanceLifecycleStateEnumValues(),
+		GetCapacityTypeEnumValues(),
+		GetFeatureSetEnumValues(),
+		GetLicenseTypeEnumValues(),
+		GetListAnalyticsInstancesCapacityTypeEnumValues(),
+		GetListAnalyticsInstancesFeatureSetEnumValues(),
+		GetListAnalyticsInstancesLifecycleStateEnumValues(),
+		GetListAnalyticsInstancesSortByEnumValues(),
+		GetListAnalyticsInstancesSortOrderEnumValues(),
+		GetListWorkRequestsResourceTypeEnumValues(),
+		GetListWorkRequestsSortByEnumValues(),
+		GetListWorkRequestsSortOrderEnumValues(),
+		GetListWorkRequestsStatusEnumValues(),
+		GetNetworkEndpointTypeEnumValues(),
+		GetSortByEnumValues(),
+		GetSortOrderEnumValues(),
+		GetWorkRequestActionResultEnumValues(),
+		GetWorkRequestOperationTypeEnumValues(),
+		GetWorkRequestResourceTypeEnumValues(),
+		GetWorkRequestSortByEnumValues(),
+		GetWorkRequestStatusEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAnalyticsInstanceCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAnalyticsInstanceNetworkEndpointRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAnalyticsInstancesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ScaleAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StartAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StopAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAnalyticsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeAnalyticsInstanceNetworkEndpointDetails, see common.ValidateStruct
+func (m ChangeAnalyticsInstanceNetworkEndpointDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCompartmentDetails, see common.ValidateStruct
+func (m ChangeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAnalyticsInstanceDetails, see common.ValidateStruct
+func (m CreateAnalyticsInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of PrivateEndpointDetails, see common.ValidateStruct
+func (m PrivateEndpointDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of PublicEndpointDetails, see common.ValidateStruct
+func (m PublicEndpointDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ScaleAnalyticsInstanceDetails, see common.ValidateStruct
+func (m ScaleAnalyticsInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAnalyticsInstanceDetails, see common.ValidateStruct
+func (m UpdateAnalyticsInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
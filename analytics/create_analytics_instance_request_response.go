@@ -67,6 +67,9 @@ type CreateAnalyticsInstanceResponse struct {
 
 	// The full URI of the resource.
 	Location *string `presentIn:"header" name:"location"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateAnalyticsInstanceResponse) String() string {
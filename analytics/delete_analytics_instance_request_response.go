@@ -63,6 +63,9 @@ type DeleteAnalyticsInstanceResponse struct {
 	// The OCID of the work request. Use GetWorkRequest with this ID to track the status
 	// of the request.
 	OpcWorkRequestId *string `presentIn:"header" name:"opc-work-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response DeleteAnalyticsInstanceResponse) String() string {
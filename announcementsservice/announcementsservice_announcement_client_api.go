@@ -0,0 +1,27 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package announcementsservice
+
+import (
+	"context"
+)
+
+// AnnouncementClientAPI is the interface of the operations of AnnouncementClient, to inject the client and mock it in tests
+type AnnouncementClientAPI interface {
+	// GetAnnouncement Gets the details of a specific announcement.
+	GetAnnouncement(ctx context.Context, request GetAnnouncementRequest) (response GetAnnouncementResponse, err error)
+
+	// GetAnnouncementUserStatus Gets information about whether a specific announcement was acknowledged by a user.
+	GetAnnouncementUserStatus(ctx context.Context, request GetAnnouncementUserStatusRequest) (response GetAnnouncementUserStatusResponse, err error)
+
+	// ListAnnouncements Gets a list of announcements for the current tenancy.
+	ListAnnouncements(ctx context.Context, request ListAnnouncementsRequest) (response ListAnnouncementsResponse, err error)
+
+	// UpdateAnnouncementUserStatus Updates the status of the specified announcement with regard to whether it has been marked as read.
+	UpdateAnnouncementUserStatus(ctx context.Context, request UpdateAnnouncementUserStatusRequest) (response UpdateAnnouncementUserStatusResponse, err error)
+}
+
+// AnnouncementClient implements AnnouncementClientAPI
+var _ AnnouncementClientAPI = AnnouncementClient{}
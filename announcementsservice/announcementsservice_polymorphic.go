@@ -0,0 +1,31 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package announcementsservice
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*BaseAnnouncement)(nil), func() common.PolymorphicJSONUnmarshaler { return &baseannouncement{} })
+}
+
+// MarshalJSON marshals a BaseAnnouncement whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m baseannouncement) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypebaseannouncement baseannouncement
+	s := struct {
+		MarshalTypebaseannouncement
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypebaseannouncement: (MarshalTypebaseannouncement)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -104,10 +104,12 @@ func (client ApiGatewayClient) ChangeApiCompartment(ctx context.Context, request
 				response = ChangeApiCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeApiCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeApiCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client ApiGatewayClient) ChangeCertificateCompartment(ctx context.Context,
 				response = ChangeCertificateCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCertificateCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCertificateCompartmentResponse")
 	}
@@ -214,10 +218,12 @@ func (client ApiGatewayClient) CreateApi(ctx context.Context, request CreateApiR
 				response = CreateApiResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateApiResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateApiResponse")
 	}
@@ -269,10 +275,12 @@ func (client ApiGatewayClient) CreateCertificate(ctx context.Context, request Cr
 				response = CreateCertificateResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCertificateResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCertificateResponse")
 	}
@@ -0,0 +1,60 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"context"
+)
+
+// ApiGatewayClientAPI is the interface of the operations of ApiGatewayClient, to inject the client and mock it in tests
+type ApiGatewayClientAPI interface {
+	// ChangeApiCompartment Changes the API compartment.
+	ChangeApiCompartment(ctx context.Context, request ChangeApiCompartmentRequest) (response ChangeApiCompartmentResponse, err error)
+
+	// ChangeCertificateCompartment Changes the certificate compartment.
+	ChangeCertificateCompartment(ctx context.Context, request ChangeCertificateCompartmentRequest) (response ChangeCertificateCompartmentResponse, err error)
+
+	// CreateApi Creates a new API.
+	CreateApi(ctx context.Context, request CreateApiRequest) (response CreateApiResponse, err error)
+
+	// CreateCertificate Creates a new Certificate.
+	CreateCertificate(ctx context.Context, request CreateCertificateRequest) (response CreateCertificateResponse, err error)
+
+	// DeleteApi Deletes the API with the given identifier.
+	DeleteApi(ctx context.Context, request DeleteApiRequest) (response DeleteApiResponse, err error)
+
+	// DeleteCertificate Deletes the certificate with the given identifier.
+	DeleteCertificate(ctx context.Context, request DeleteCertificateRequest) (response DeleteCertificateResponse, err error)
+
+	// GetApi Gets an API by identifier.
+	GetApi(ctx context.Context, request GetApiRequest) (response GetApiResponse, err error)
+
+	// GetApiContent Get the raw API content.
+	GetApiContent(ctx context.Context, request GetApiContentRequest) (response GetApiContentResponse, err error)
+
+	// GetApiDeploymentSpecification Gets an API Deployment specification by identifier.
+	GetApiDeploymentSpecification(ctx context.Context, request GetApiDeploymentSpecificationRequest) (response GetApiDeploymentSpecificationResponse, err error)
+
+	// GetApiValidations Gets the API validation results.
+	GetApiValidations(ctx context.Context, request GetApiValidationsRequest) (response GetApiValidationsResponse, err error)
+
+	// GetCertificate Gets a certificate by identifier.
+	GetCertificate(ctx context.Context, request GetCertificateRequest) (response GetCertificateResponse, err error)
+
+	// ListApis Returns a list of APIs.
+	ListApis(ctx context.Context, request ListApisRequest) (response ListApisResponse, err error)
+
+	// ListCertificates Returns a list of certificates.
+	ListCertificates(ctx context.Context, request ListCertificatesRequest) (response ListCertificatesResponse, err error)
+
+	// UpdateApi Updates the API with the given identifier.
+	UpdateApi(ctx context.Context, request UpdateApiRequest) (response UpdateApiResponse, err error)
+
+	// UpdateCertificate Updates a certificate with the given identifier
+	UpdateCertificate(ctx context.Context, request UpdateCertificateRequest) (response UpdateCertificateResponse, err error)
+}
+
+// ApiGatewayClient implements ApiGatewayClientAPI
+var _ ApiGatewayClientAPI = ApiGatewayClient{}
@@ -104,10 +104,12 @@ func (client DeploymentClient) ChangeDeploymentCompartment(ctx context.Context,
 				response = ChangeDeploymentCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDeploymentCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDeploymentCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client DeploymentClient) CreateDeployment(ctx context.Context, request Cre
 				response = CreateDeploymentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDeploymentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDeploymentResponse")
 	}
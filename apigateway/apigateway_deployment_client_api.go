@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"context"
+)
+
+// DeploymentClientAPI is the interface of the operations of DeploymentClient, to inject the client and mock it in tests
+type DeploymentClientAPI interface {
+	// ChangeDeploymentCompartment Changes the deployment compartment.
+	ChangeDeploymentCompartment(ctx context.Context, request ChangeDeploymentCompartmentRequest) (response ChangeDeploymentCompartmentResponse, err error)
+
+	// CreateDeployment Creates a new deployment.
+	CreateDeployment(ctx context.Context, request CreateDeploymentRequest) (response CreateDeploymentResponse, err error)
+
+	// DeleteDeployment Deletes the deployment with the given identifier.
+	DeleteDeployment(ctx context.Context, request DeleteDeploymentRequest) (response DeleteDeploymentResponse, err error)
+
+	// GetDeployment Gets a deployment by identifier.
+	GetDeployment(ctx context.Context, request GetDeploymentRequest) (response GetDeploymentResponse, err error)
+
+	// ListDeployments Returns a list of deployments.
+	ListDeployments(ctx context.Context, request ListDeploymentsRequest) (response ListDeploymentsResponse, err error)
+
+	// UpdateDeployment Updates the deployment with the given identifier.
+	UpdateDeployment(ctx context.Context, request UpdateDeploymentRequest) (response UpdateDeploymentResponse, err error)
+}
+
+// DeploymentClient implements DeploymentClientAPI
+var _ DeploymentClientAPI = DeploymentClient{}
@@ -104,10 +104,12 @@ func (client GatewayClient) ChangeGatewayCompartment(ctx context.Context, reques
 				response = ChangeGatewayCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeGatewayCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeGatewayCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client GatewayClient) CreateGateway(ctx context.Context, request CreateGat
 				response = CreateGatewayResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateGatewayResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateGatewayResponse")
 	}
@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"context"
+)
+
+// GatewayClientAPI is the interface of the operations of GatewayClient, to inject the client and mock it in tests
+type GatewayClientAPI interface {
+	// ChangeGatewayCompartment Changes the gateway compartment.
+	ChangeGatewayCompartment(ctx context.Context, request ChangeGatewayCompartmentRequest) (response ChangeGatewayCompartmentResponse, err error)
+
+	// CreateGateway Creates a new gateway.
+	CreateGateway(ctx context.Context, request CreateGatewayRequest) (response CreateGatewayResponse, err error)
+
+	// DeleteGateway Deletes the gateway with the given identifier.
+	DeleteGateway(ctx context.Context, request DeleteGatewayRequest) (response DeleteGatewayResponse, err error)
+
+	// GetGateway Gets a gateway by identifier.
+	GetGateway(ctx context.Context, request GetGatewayRequest) (response GetGatewayResponse, err error)
+
+	// ListGateways Returns a list of gateways.
+	ListGateways(ctx context.Context, request ListGatewaysRequest) (response ListGatewaysResponse, err error)
+
+	// UpdateGateway Updates the gateway with the given identifier.
+	UpdateGateway(ctx context.Context, request UpdateGatewayRequest) (response UpdateGatewayResponse, err error)
+}
+
+// GatewayClient implements GatewayClientAPI
+var _ GatewayClientAPI = GatewayClient{}
@@ -0,0 +1,103 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*ApiSpecificationRouteBackend)(nil), func() common.PolymorphicJSONUnmarshaler { return &apispecificationroutebackend{} })
+	common.RegisterPolymorphicModel((*AuthenticationPolicy)(nil), func() common.PolymorphicJSONUnmarshaler { return &authenticationpolicy{} })
+	common.RegisterPolymorphicModel((*PublicKeySet)(nil), func() common.PolymorphicJSONUnmarshaler { return &publickeyset{} })
+	common.RegisterPolymorphicModel((*RouteAuthorizationPolicy)(nil), func() common.PolymorphicJSONUnmarshaler { return &routeauthorizationpolicy{} })
+	common.RegisterPolymorphicModel((*StaticPublicKey)(nil), func() common.PolymorphicJSONUnmarshaler { return &staticpublickey{} })
+}
+
+// MarshalJSON marshals a ApiSpecificationRouteBackend whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m apispecificationroutebackend) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeapispecificationroutebackend apispecificationroutebackend
+	s := struct {
+		MarshalTypeapispecificationroutebackend
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeapispecificationroutebackend: (MarshalTypeapispecificationroutebackend)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AuthenticationPolicy whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m authenticationpolicy) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeauthenticationpolicy authenticationpolicy
+	s := struct {
+		MarshalTypeauthenticationpolicy
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeauthenticationpolicy: (MarshalTypeauthenticationpolicy)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a PublicKeySet whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m publickeyset) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypepublickeyset publickeyset
+	s := struct {
+		MarshalTypepublickeyset
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypepublickeyset: (MarshalTypepublickeyset)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a RouteAuthorizationPolicy whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m routeauthorizationpolicy) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTyperouteauthorizationpolicy routeauthorizationpolicy
+	s := struct {
+		MarshalTyperouteauthorizationpolicy
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTyperouteauthorizationpolicy: (MarshalTyperouteauthorizationpolicy)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a StaticPublicKey whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m staticpublickey) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypestaticpublickey staticpublickey
+	s := struct {
+		MarshalTypestaticpublickey
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypestaticpublickey: (MarshalTypestaticpublickey)(m),
+	}
+	return json.Marshal(&s)
+}
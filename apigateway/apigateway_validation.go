@@ -0,0 +1,285 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetApiLifecycleStateEnumValues(),
+		GetApiSpecificationRouteBackendTypeEnumValues(),
+		GetApiSpecificationRouteMethodsEnumValues(),
+		GetApiSummaryLifecycleStateEnumValues(),
+		GetApiValidationDetailSeverityEnumValues(),
+		GetApiValidationDetailsResultEnumValues(),
+		GetApiValidationResultResultEnumValues(),
+		GetAuthenticationPolicyTypeEnumValues(),
+		GetCertificateLifecycleStateEnumValues(),
+		GetDeploymentLifecycleStateEnumValues(),
+		GetExecutionLogPolicyLogLevelEnumValues(),
+		GetFilterHeaderPolicyTypeEnumValues(),
+		GetFilterQueryParameterPolicyTypeEnumValues(),
+		GetGatewayEndpointTypeEnumValues(),
+		GetGatewayLifecycleStateEnumValues(),
+		GetJsonWebKeyKeyOpsEnumValues(),
+		GetJsonWebKeyKtyEnumValues(),
+		GetJsonWebKeyUseEnumValues(),
+		GetListApisSortByEnumValues(),
+		GetListApisSortOrderEnumValues(),
+		GetListCertificatesSortByEnumValues(),
+		GetListCertificatesSortOrderEnumValues(),
+		GetListDeploymentsSortByEnumValues(),
+		GetListDeploymentsSortOrderEnumValues(),
+		GetListGatewaysSortByEnumValues(),
+		GetListGatewaysSortOrderEnumValues(),
+		GetListWorkRequestErrorsSortByEnumValues(),
+		GetListWorkRequestErrorsSortOrderEnumValues(),
+		GetListWorkRequestLogsSortByEnumValues(),
+		GetListWorkRequestLogsSortOrderEnumValues(),
+		GetListWorkRequestsSortByEnumValues(),
+		GetListWorkRequestsSortOrderEnumValues(),
+		GetPublicKeySetTypeEnumValues(),
+		GetRateLimitingPolicyRateKeyEnumValues(),
+		GetRouteAuthorizationPolicyTypeEnumValues(),
+		GetSetHeaderPolicyItemIfExistsEnumValues(),
+		GetSetQueryParameterPolicyItemIfExistsEnumValues(),
+		GetStaticPublicKeyFormatEnumValues(),
+		GetWorkRequestOperationTypeEnumValues(),
+		GetWorkRequestResourceActionTypeEnumValues(),
+		GetWorkRequestStatusEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CancelWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeApiCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeCertificateCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeDeploymentCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeGatewayCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateApiRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateCertificateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDeploymentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateGatewayRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteApiRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteCertificateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDeploymentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteGatewayRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetApiContentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetApiDeploymentSpecificationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetApiRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetApiValidationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCertificateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDeploymentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetGatewayRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListApisRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListCertificatesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDeploymentsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListGatewaysRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateApiRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateCertificateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDeploymentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateGatewayRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ApiValidationDetails, see common.ValidateStruct
+func (m ApiValidationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeApiCompartmentDetails, see common.ValidateStruct
+func (m ChangeApiCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCertificateCompartmentDetails, see common.ValidateStruct
+func (m ChangeCertificateCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeDeploymentCompartmentDetails, see common.ValidateStruct
+func (m ChangeDeploymentCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeGatewayCompartmentDetails, see common.ValidateStruct
+func (m ChangeGatewayCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateApiDetails, see common.ValidateStruct
+func (m CreateApiDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateCertificateDetails, see common.ValidateStruct
+func (m CreateCertificateDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDeploymentDetails, see common.ValidateStruct
+func (m CreateDeploymentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateGatewayDetails, see common.ValidateStruct
+func (m CreateGatewayDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateApiDetails, see common.ValidateStruct
+func (m UpdateApiDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateCertificateDetails, see common.ValidateStruct
+func (m UpdateCertificateDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDeploymentDetails, see common.ValidateStruct
+func (m UpdateDeploymentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateGatewayDetails, see common.ValidateStruct
+func (m UpdateGatewayDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
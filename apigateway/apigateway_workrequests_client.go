@@ -104,10 +104,12 @@ func (client WorkRequestsClient) CancelWorkRequest(ctx context.Context, request
 				response = CancelWorkRequestResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CancelWorkRequestResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CancelWorkRequestResponse")
 	}
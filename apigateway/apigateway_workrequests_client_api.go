@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package apigateway
+
+import (
+	"context"
+)
+
+// WorkRequestsClientAPI is the interface of the operations of WorkRequestsClient, to inject the client and mock it in tests
+type WorkRequestsClientAPI interface {
+	// CancelWorkRequest Cancels the work request.
+	CancelWorkRequest(ctx context.Context, request CancelWorkRequestRequest) (response CancelWorkRequestResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given identifier.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListWorkRequestErrors Returns a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Returns a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+}
+
+// WorkRequestsClient implements WorkRequestsClientAPI
+var _ WorkRequestsClientAPI = WorkRequestsClient{}
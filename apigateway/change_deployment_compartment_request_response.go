@@ -70,6 +70,9 @@ type ChangeDeploymentCompartmentResponse struct {
 	// contact Oracle about a particular request, please provide the request
 	// id.
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangeDeploymentCompartmentResponse) String() string {
@@ -69,6 +69,9 @@ type CreateDeploymentResponse struct {
 
 	// Location of the resource.
 	Location *string `presentIn:"header" name:"location"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateDeploymentResponse) String() string {
@@ -152,10 +152,12 @@ func (client ApplicationMigrationClient) ChangeMigrationCompartment(ctx context.
 				response = ChangeMigrationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeMigrationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeMigrationCompartmentResponse")
 	}
@@ -207,10 +209,12 @@ func (client ApplicationMigrationClient) ChangeSourceCompartment(ctx context.Con
 				response = ChangeSourceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSourceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSourceCompartmentResponse")
 	}
@@ -263,10 +267,12 @@ func (client ApplicationMigrationClient) CreateMigration(ctx context.Context, re
 				response = CreateMigrationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateMigrationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateMigrationResponse")
 	}
@@ -319,10 +325,12 @@ func (client ApplicationMigrationClient) CreateSource(ctx context.Context, reque
 				response = CreateSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSourceResponse")
 	}
@@ -974,10 +982,12 @@ func (client ApplicationMigrationClient) UpdateMigration(ctx context.Context, re
 				response = UpdateMigrationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateMigrationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateMigrationResponse")
 	}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package applicationmigration
+
+import (
+	"context"
+)
+
+// ApplicationMigrationClientAPI is the interface of the operations of ApplicationMigrationClient, to inject the client and mock it in tests
+type ApplicationMigrationClientAPI interface {
+	// CancelWorkRequest Cancels the specified work request
+	CancelWorkRequest(ctx context.Context, request CancelWorkRequestRequest) (response CancelWorkRequestResponse, err error)
+
+	// ChangeMigrationCompartment Moves a Migration into a different compartment.
+	ChangeMigrationCompartment(ctx context.Context, request ChangeMigrationCompartmentRequest) (response ChangeMigrationCompartmentResponse, err error)
+
+	// ChangeSourceCompartment Moves a Source into a different compartment.
+	ChangeSourceCompartment(ctx context.Context, request ChangeSourceCompartmentRequest) (response ChangeSourceCompartmentResponse, err error)
+
+	// CreateMigration Creates an application migration in the specified compartment.
+	// Specify the compartment using the compartment ID.
+	CreateMigration(ctx context.Context, request CreateMigrationRequest) (response CreateMigrationResponse, err error)
+
+	// CreateSource Creates a migration source in the specified compartment.
+	// Specify the compartment using the compartment ID.
+	CreateSource(ctx context.Context, request CreateSourceRequest) (response CreateSourceResponse, err error)
+
+	// DeleteMigration Deletes the specified Application object.
+	DeleteMigration(ctx context.Context, request DeleteMigrationRequest) (response DeleteMigrationResponse, err error)
+
+	// DeleteSource Deletes the specified Source object.
+	DeleteSource(ctx context.Context, request DeleteSourceRequest) (response DeleteSourceResponse, err error)
+
+	// GetMigration Gets an application migration using the ID.
+	GetMigration(ctx context.Context, request GetMigrationRequest) (response GetMigrationResponse, err error)
+
+	// GetSource Gets a migration source using the source ID.
+	GetSource(ctx context.Context, request GetSourceRequest) (response GetSourceResponse, err error)
+
+	// GetWorkRequest Gets the details of a work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListMigrations Returns a list of migrations in a given compartment.
+	ListMigrations(ctx context.Context, request ListMigrationsRequest) (response ListMigrationsResponse, err error)
+
+	// ListSourceApplications Returns a list of applications running in the source environment. This list is generated dynamically by interrogating the source and changes as applications are started or stopped in that environment.
+	ListSourceApplications(ctx context.Context, request ListSourceApplicationsRequest) (response ListSourceApplicationsResponse, err error)
+
+	// ListSources Returns a list of migration sources in a specified compartment.
+	ListSources(ctx context.Context, request ListSourcesRequest) (response ListSourcesResponse, err error)
+
+	// ListWorkRequestErrors Gets the errors for a work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Gets the logs for a work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment or for a specified resource.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// MigrateApplication Validates target configuration and migrates a PaaS application running in a Source environment into the customers Oracle Cloud Infrastructure tenancy. This an optional action and only required if automatic start of migration was not selected when creating the migration.
+	MigrateApplication(ctx context.Context, request MigrateApplicationRequest) (response MigrateApplicationResponse, err error)
+
+	// UpdateMigration Update the configuration for an application migration.
+	UpdateMigration(ctx context.Context, request UpdateMigrationRequest) (response UpdateMigrationResponse, err error)
+
+	// UpdateSource Update source details.
+	UpdateSource(ctx context.Context, request UpdateSourceRequest) (response UpdateSourceResponse, err error)
+}
+
+// ApplicationMigrationClient implements ApplicationMigrationClientAPI
+var _ ApplicationMigrationClientAPI = ApplicationMigrationClient{}
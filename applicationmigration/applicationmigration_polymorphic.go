@@ -0,0 +1,67 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package applicationmigration
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*AuthorizationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &authorizationdetails{} })
+	common.RegisterPolymorphicModel((*DiscoveryDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &discoverydetails{} })
+	common.RegisterPolymorphicModel((*SourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &sourcedetails{} })
+}
+
+// MarshalJSON marshals a AuthorizationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m authorizationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeauthorizationdetails authorizationdetails
+	s := struct {
+		MarshalTypeauthorizationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeauthorizationdetails: (MarshalTypeauthorizationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DiscoveryDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m discoverydetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypediscoverydetails discoverydetails
+	s := struct {
+		MarshalTypediscoverydetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypediscoverydetails: (MarshalTypediscoverydetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a SourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m sourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesourcedetails sourcedetails
+	s := struct {
+		MarshalTypesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesourcedetails: (MarshalTypesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
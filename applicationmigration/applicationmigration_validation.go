@@ -0,0 +1,206 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package applicationmigration
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetListMigrationsSortByEnumValues(),
+		GetListMigrationsSortOrderEnumValues(),
+		GetListSourceApplicationsSortByEnumValues(),
+		GetListSourceApplicationsSortOrderEnumValues(),
+		GetListSourcesSortByEnumValues(),
+		GetListSourcesSortOrderEnumValues(),
+		GetListWorkRequestErrorsSortOrderEnumValues(),
+		GetListWorkRequestLogsSortOrderEnumValues(),
+		GetMigrationLifecycleStatesEnumValues(),
+		GetMigrationStatesEnumValues(),
+		GetMigrationTypesEnumValues(),
+		GetOperationStatusEnumValues(),
+		GetOperationTypesEnumValues(),
+		GetSortOrdersEnumValues(),
+		GetSourceLifecycleStatesEnumValues(),
+		GetSourceTypesEnumValues(),
+		GetWorkRequestResourceActionTypeEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CancelWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeMigrationCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeSourceCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateMigrationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteMigrationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetMigrationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListMigrationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourceApplicationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourcesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request MigrateApplicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateMigrationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCompartmentDetails, see common.ValidateStruct
+func (m ChangeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateMigrationDetails, see common.ValidateStruct
+func (m CreateMigrationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateSourceDetails, see common.ValidateStruct
+func (m CreateSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IcsDiscoveryDetails, see common.ValidateStruct
+func (m IcsDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of InternalAuthorizationDetails, see common.ValidateStruct
+func (m InternalAuthorizationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of InternalSourceDetails, see common.ValidateStruct
+func (m InternalSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of JcsDiscoveryDetails, see common.ValidateStruct
+func (m JcsDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of OacDiscoveryDetails, see common.ValidateStruct
+func (m OacDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of OcicAuthorizationDetails, see common.ValidateStruct
+func (m OcicAuthorizationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of OcicSourceDetails, see common.ValidateStruct
+func (m OcicSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of OicDiscoveryDetails, see common.ValidateStruct
+func (m OicDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of PcsDiscoveryDetails, see common.ValidateStruct
+func (m PcsDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SoacsDiscoveryDetails, see common.ValidateStruct
+func (m SoacsDiscoveryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateMigrationDetails, see common.ValidateStruct
+func (m UpdateMigrationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateSourceDetails, see common.ValidateStruct
+func (m UpdateSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
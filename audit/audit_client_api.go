@@ -0,0 +1,25 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package audit
+
+import (
+	"context"
+)
+
+// AuditClientAPI is the interface of the operations of AuditClient, to inject the client and mock it in tests
+type AuditClientAPI interface {
+	// GetConfiguration Get the configuration
+	GetConfiguration(ctx context.Context, request GetConfigurationRequest) (response GetConfigurationResponse, err error)
+
+	// ListEvents Returns all the audit events processed for the specified compartment within the specified
+	// time range.
+	ListEvents(ctx context.Context, request ListEventsRequest) (response ListEventsResponse, err error)
+
+	// UpdateConfiguration Update the configuration
+	UpdateConfiguration(ctx context.Context, request UpdateConfigurationRequest) (response UpdateConfigurationResponse, err error)
+}
+
+// AuditClient implements AuditClientAPI
+var _ AuditClientAPI = AuditClient{}
@@ -110,10 +110,12 @@ func (client AutoScalingClient) ChangeAutoScalingConfigurationCompartment(ctx co
 				response = ChangeAutoScalingConfigurationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAutoScalingConfigurationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAutoScalingConfigurationCompartmentResponse")
 	}
@@ -165,10 +167,12 @@ func (client AutoScalingClient) CreateAutoScalingConfiguration(ctx context.Conte
 				response = CreateAutoScalingConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutoScalingConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutoScalingConfigurationResponse")
 	}
@@ -220,10 +224,12 @@ func (client AutoScalingClient) CreateAutoScalingPolicy(ctx context.Context, req
 				response = CreateAutoScalingPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutoScalingPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutoScalingPolicyResponse")
 	}
@@ -576,10 +582,12 @@ func (client AutoScalingClient) UpdateAutoScalingConfiguration(ctx context.Conte
 				response = UpdateAutoScalingConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateAutoScalingConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateAutoScalingConfigurationResponse")
 	}
@@ -631,10 +639,12 @@ func (client AutoScalingClient) UpdateAutoScalingPolicy(ctx context.Context, req
 				response = UpdateAutoScalingPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateAutoScalingPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateAutoScalingPolicyResponse")
 	}
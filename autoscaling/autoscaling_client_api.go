@@ -0,0 +1,53 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package autoscaling
+
+import (
+	"context"
+)
+
+// AutoScalingClientAPI is the interface of the operations of AutoScalingClient, to inject the client and mock it in tests
+type AutoScalingClientAPI interface {
+	// ChangeAutoScalingConfigurationCompartment Moves an autoscaling configuration into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When you move an autoscaling configuration to a different compartment, associated resources such as instance
+	// pools are not moved.
+	ChangeAutoScalingConfigurationCompartment(ctx context.Context, request ChangeAutoScalingConfigurationCompartmentRequest) (response ChangeAutoScalingConfigurationCompartmentResponse, err error)
+
+	// CreateAutoScalingConfiguration Creates an autoscaling configuration.
+	CreateAutoScalingConfiguration(ctx context.Context, request CreateAutoScalingConfigurationRequest) (response CreateAutoScalingConfigurationResponse, err error)
+
+	// CreateAutoScalingPolicy Creates an autoscaling policy for the specified autoscaling configuration.
+	CreateAutoScalingPolicy(ctx context.Context, request CreateAutoScalingPolicyRequest) (response CreateAutoScalingPolicyResponse, err error)
+
+	// DeleteAutoScalingConfiguration Deletes an autoscaling configuration.
+	DeleteAutoScalingConfiguration(ctx context.Context, request DeleteAutoScalingConfigurationRequest) (response DeleteAutoScalingConfigurationResponse, err error)
+
+	// DeleteAutoScalingPolicy Deletes an autoscaling policy for the specified autoscaling configuration.
+	DeleteAutoScalingPolicy(ctx context.Context, request DeleteAutoScalingPolicyRequest) (response DeleteAutoScalingPolicyResponse, err error)
+
+	// GetAutoScalingConfiguration Gets information about the specified autoscaling configuration.
+	GetAutoScalingConfiguration(ctx context.Context, request GetAutoScalingConfigurationRequest) (response GetAutoScalingConfigurationResponse, err error)
+
+	// GetAutoScalingPolicy Gets information about the specified autoscaling policy in the specified autoscaling configuration.
+	GetAutoScalingPolicy(ctx context.Context, request GetAutoScalingPolicyRequest) (response GetAutoScalingPolicyResponse, err error)
+
+	// ListAutoScalingConfigurations Lists autoscaling configurations in the specifed compartment.
+	ListAutoScalingConfigurations(ctx context.Context, request ListAutoScalingConfigurationsRequest) (response ListAutoScalingConfigurationsResponse, err error)
+
+	// ListAutoScalingPolicies Lists the autoscaling policies in the specified autoscaling configuration.
+	ListAutoScalingPolicies(ctx context.Context, request ListAutoScalingPoliciesRequest) (response ListAutoScalingPoliciesResponse, err error)
+
+	// UpdateAutoScalingConfiguration Updates certain fields on the specified autoscaling configuration, such as the name, the cooldown period,
+	// and whether the autoscaling configuration is enabled.
+	UpdateAutoScalingConfiguration(ctx context.Context, request UpdateAutoScalingConfigurationRequest) (response UpdateAutoScalingConfigurationResponse, err error)
+
+	// UpdateAutoScalingPolicy Updates an autoscaling policy in the specified autoscaling configuration.
+	UpdateAutoScalingPolicy(ctx context.Context, request UpdateAutoScalingPolicyRequest) (response UpdateAutoScalingPolicyResponse, err error)
+}
+
+// AutoScalingClient implements AutoScalingClientAPI
+var _ AutoScalingClientAPI = AutoScalingClient{}
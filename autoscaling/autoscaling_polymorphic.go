@@ -0,0 +1,103 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package autoscaling
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*AutoScalingPolicy)(nil), func() common.PolymorphicJSONUnmarshaler { return &autoscalingpolicy{} })
+	common.RegisterPolymorphicModel((*CreateAutoScalingPolicyDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createautoscalingpolicydetails{} })
+	common.RegisterPolymorphicModel((*ExecutionSchedule)(nil), func() common.PolymorphicJSONUnmarshaler { return &executionschedule{} })
+	common.RegisterPolymorphicModel((*Resource)(nil), func() common.PolymorphicJSONUnmarshaler { return &resource{} })
+	common.RegisterPolymorphicModel((*UpdateAutoScalingPolicyDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateautoscalingpolicydetails{} })
+}
+
+// MarshalJSON marshals a AutoScalingPolicy whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m autoscalingpolicy) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeautoscalingpolicy autoscalingpolicy
+	s := struct {
+		MarshalTypeautoscalingpolicy
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeautoscalingpolicy: (MarshalTypeautoscalingpolicy)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateAutoScalingPolicyDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createautoscalingpolicydetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateautoscalingpolicydetails createautoscalingpolicydetails
+	s := struct {
+		MarshalTypecreateautoscalingpolicydetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateautoscalingpolicydetails: (MarshalTypecreateautoscalingpolicydetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ExecutionSchedule whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m executionschedule) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeexecutionschedule executionschedule
+	s := struct {
+		MarshalTypeexecutionschedule
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeexecutionschedule: (MarshalTypeexecutionschedule)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Resource whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m resource) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTyperesource resource
+	s := struct {
+		MarshalTyperesource
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTyperesource: (MarshalTyperesource)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateAutoScalingPolicyDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateautoscalingpolicydetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateautoscalingpolicydetails updateautoscalingpolicydetails
+	s := struct {
+		MarshalTypeupdateautoscalingpolicydetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateautoscalingpolicydetails: (MarshalTypeupdateautoscalingpolicydetails)(m),
+	}
+	return json.Marshal(&s)
+}
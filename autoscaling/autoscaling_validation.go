@@ -0,0 +1,127 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package autoscaling
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetActionTypeEnumValues(),
+		GetExecutionScheduleTimezoneEnumValues(),
+		GetListAutoScalingConfigurationsSortByEnumValues(),
+		GetListAutoScalingConfigurationsSortOrderEnumValues(),
+		GetListAutoScalingPoliciesSortByEnumValues(),
+		GetListAutoScalingPoliciesSortOrderEnumValues(),
+		GetMetricMetricTypeEnumValues(),
+		GetThresholdOperatorEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAutoScalingConfigurationCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutoScalingConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutoScalingPolicyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAutoScalingConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAutoScalingPolicyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutoScalingConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutoScalingPolicyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutoScalingConfigurationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutoScalingPoliciesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutoScalingConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutoScalingPolicyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeAutoScalingCompartmentDetails, see common.ValidateStruct
+func (m ChangeAutoScalingCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutoScalingConfigurationDetails, see common.ValidateStruct
+func (m CreateAutoScalingConfigurationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateConditionDetails, see common.ValidateStruct
+func (m CreateConditionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateScheduledPolicyDetails, see common.ValidateStruct
+func (m CreateScheduledPolicyDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateThresholdPolicyDetails, see common.ValidateStruct
+func (m CreateThresholdPolicyDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutoScalingConfigurationDetails, see common.ValidateStruct
+func (m UpdateAutoScalingConfigurationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateConditionDetails, see common.ValidateStruct
+func (m UpdateConditionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateScheduledPolicyDetails, see common.ValidateStruct
+func (m UpdateScheduledPolicyDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateThresholdPolicyDetails, see common.ValidateStruct
+func (m UpdateThresholdPolicyDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
@@ -105,10 +105,12 @@ func (client BdsClient) AddBlockStorage(ctx context.Context, request AddBlockSto
 				response = AddBlockStorageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddBlockStorageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddBlockStorageResponse")
 	}
@@ -161,10 +163,12 @@ func (client BdsClient) AddCloudSql(ctx context.Context, request AddCloudSqlRequ
 				response = AddCloudSqlResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddCloudSqlResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddCloudSqlResponse")
 	}
@@ -217,10 +221,12 @@ func (client BdsClient) AddWorkerNodes(ctx context.Context, request AddWorkerNod
 				response = AddWorkerNodesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddWorkerNodesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddWorkerNodesResponse")
 	}
@@ -272,10 +278,12 @@ func (client BdsClient) ChangeBdsInstanceCompartment(ctx context.Context, reques
 				response = ChangeBdsInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeBdsInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeBdsInstanceCompartmentResponse")
 	}
@@ -329,10 +337,12 @@ func (client BdsClient) ChangeShape(ctx context.Context, request ChangeShapeRequ
 				response = ChangeShapeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeShapeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeShapeResponse")
 	}
@@ -384,10 +394,12 @@ func (client BdsClient) CreateBdsInstance(ctx context.Context, request CreateBds
 				response = CreateBdsInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBdsInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBdsInstanceResponse")
 	}
@@ -839,10 +851,12 @@ func (client BdsClient) RestartNode(ctx context.Context, request RestartNodeRequ
 				response = RestartNodeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestartNodeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestartNodeResponse")
 	}
@@ -0,0 +1,68 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package bds
+
+import (
+	"context"
+)
+
+// BdsClientAPI is the interface of the operations of BdsClient, to inject the client and mock it in tests
+type BdsClientAPI interface {
+	// AddBlockStorage Adds storage to existing worker nodes. The same amount of storage will be added to all workers.
+	// No change will be made to already attached storage. Block Storage once added cannot be removed.
+	AddBlockStorage(ctx context.Context, request AddBlockStorageRequest) (response AddBlockStorageResponse, err error)
+
+	// AddCloudSql Adds Cloud SQL to your cluster. This will add a query server node to the cluster
+	// and create cell servers on all your worker nodes.
+	AddCloudSql(ctx context.Context, request AddCloudSqlRequest) (response AddCloudSqlResponse, err error)
+
+	// AddWorkerNodes Add worker nodes to an existing cluster. The worker nodes added will be based on an identical shape
+	// and have the same amount of attached block storage as other worker nodes in the cluster.
+	AddWorkerNodes(ctx context.Context, request AddWorkerNodesRequest) (response AddWorkerNodesResponse, err error)
+
+	// ChangeBdsInstanceCompartment Moves a BDS instance into a different compartment.
+	ChangeBdsInstanceCompartment(ctx context.Context, request ChangeBdsInstanceCompartmentRequest) (response ChangeBdsInstanceCompartmentResponse, err error)
+
+	// ChangeShape Scale-up/down individial nodes (per role type) in the cluster. Customer can choose
+	// arbitrarty VM_STANDARD shape to scale-up/down the instance. Only VM_STANDARD nodes
+	// can be re-shaped.
+	ChangeShape(ctx context.Context, request ChangeShapeRequest) (response ChangeShapeResponse, err error)
+
+	// CreateBdsInstance Creates a new BDS instance.
+	CreateBdsInstance(ctx context.Context, request CreateBdsInstanceRequest) (response CreateBdsInstanceResponse, err error)
+
+	// DeleteBdsInstance Deletes a BDS instance by identifier
+	DeleteBdsInstance(ctx context.Context, request DeleteBdsInstanceRequest) (response DeleteBdsInstanceResponse, err error)
+
+	// GetBdsInstance Gets a BDS instance by identifier
+	GetBdsInstance(ctx context.Context, request GetBdsInstanceRequest) (response GetBdsInstanceResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListBdsInstances Returns a list of BDS instances.
+	ListBdsInstances(ctx context.Context, request ListBdsInstancesRequest) (response ListBdsInstancesResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// RemoveCloudSql Remove Cloud SQL capability.
+	RemoveCloudSql(ctx context.Context, request RemoveCloudSqlRequest) (response RemoveCloudSqlResponse, err error)
+
+	// RestartNode Restarts a single node of a BDS instance.
+	RestartNode(ctx context.Context, request RestartNodeRequest) (response RestartNodeResponse, err error)
+
+	// UpdateBdsInstance Update the BDS instance identified by the id
+	UpdateBdsInstance(ctx context.Context, request UpdateBdsInstanceRequest) (response UpdateBdsInstanceResponse, err error)
+}
+
+// BdsClient implements BdsClientAPI
+var _ BdsClientAPI = BdsClient{}
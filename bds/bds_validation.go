@@ -0,0 +1,180 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package bds
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetActionTypesEnumValues(),
+		GetBdsInstanceClusterVersionEnumValues(),
+		GetBdsInstanceLifecycleStateEnumValues(),
+		GetListBdsInstancesSortByEnumValues(),
+		GetListBdsInstancesSortOrderEnumValues(),
+		GetListWorkRequestErrorsSortByEnumValues(),
+		GetListWorkRequestErrorsSortOrderEnumValues(),
+		GetListWorkRequestLogsSortByEnumValues(),
+		GetListWorkRequestLogsSortOrderEnumValues(),
+		GetListWorkRequestsSortByEnumValues(),
+		GetListWorkRequestsSortOrderEnumValues(),
+		GetNodeLifecycleStateEnumValues(),
+		GetNodeNodeTypeEnumValues(),
+		GetOperationStatusEnumValues(),
+		GetOperationTypesEnumValues(),
+		GetSortOrdersEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AddBlockStorageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AddCloudSqlRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AddWorkerNodesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeBdsInstanceCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeShapeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateBdsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteBdsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetBdsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListBdsInstancesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RemoveCloudSqlRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestartNodeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateBdsInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AddBlockStorageDetails, see common.ValidateStruct
+func (m AddBlockStorageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AddCloudSqlDetails, see common.ValidateStruct
+func (m AddCloudSqlDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AddWorkerNodesDetails, see common.ValidateStruct
+func (m AddWorkerNodesDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeBdsInstanceCompartmentDetails, see common.ValidateStruct
+func (m ChangeBdsInstanceCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeShapeDetails, see common.ValidateStruct
+func (m ChangeShapeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CloudSqlDetails, see common.ValidateStruct
+func (m CloudSqlDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ClusterDetails, see common.ValidateStruct
+func (m ClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateBdsInstanceDetails, see common.ValidateStruct
+func (m CreateBdsInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateNodeDetails, see common.ValidateStruct
+func (m CreateNodeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of KerberosDetails, see common.ValidateStruct
+func (m KerberosDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RemoveCloudSqlDetails, see common.ValidateStruct
+func (m RemoveCloudSqlDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RestartNodeDetails, see common.ValidateStruct
+func (m RestartNodeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateBdsInstanceDetails, see common.ValidateStruct
+func (m UpdateBdsInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
@@ -102,10 +102,12 @@ func (client BlockchainPlatformClient) ChangeBlockchainPlatformCompartment(ctx c
 				response = ChangeBlockchainPlatformCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeBlockchainPlatformCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeBlockchainPlatformCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client BlockchainPlatformClient) CreateBlockchainPlatform(ctx context.Cont
 				response = CreateBlockchainPlatformResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBlockchainPlatformResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBlockchainPlatformResponse")
 	}
@@ -212,10 +216,12 @@ func (client BlockchainPlatformClient) CreateOsn(ctx context.Context, request Cr
 				response = CreateOsnResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateOsnResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateOsnResponse")
 	}
@@ -267,10 +273,12 @@ func (client BlockchainPlatformClient) CreatePeer(ctx context.Context, request C
 				response = CreatePeerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePeerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePeerResponse")
 	}
@@ -772,10 +780,12 @@ func (client BlockchainPlatformClient) ListOsns(ctx context.Context, request Lis
 				response = ListOsnsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ListOsnsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ListOsnsResponse")
 	}
@@ -827,10 +837,12 @@ func (client BlockchainPlatformClient) ListPeers(ctx context.Context, request Li
 				response = ListPeersResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ListPeersResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ListPeersResponse")
 	}
@@ -1082,10 +1094,12 @@ func (client BlockchainPlatformClient) ScaleBlockchainPlatform(ctx context.Conte
 				response = ScaleBlockchainPlatformResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ScaleBlockchainPlatformResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ScaleBlockchainPlatformResponse")
 	}
@@ -1287,10 +1301,12 @@ func (client BlockchainPlatformClient) UpdateOsn(ctx context.Context, request Up
 				response = UpdateOsnResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateOsnResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateOsnResponse")
 	}
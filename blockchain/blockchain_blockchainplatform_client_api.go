@@ -0,0 +1,90 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package blockchain
+
+import (
+	"context"
+)
+
+// BlockchainPlatformClientAPI is the interface of the operations of BlockchainPlatformClient, to inject the client and mock it in tests
+type BlockchainPlatformClientAPI interface {
+	// ChangeBlockchainPlatformCompartment Change Blockchain Platform Compartment
+	ChangeBlockchainPlatformCompartment(ctx context.Context, request ChangeBlockchainPlatformCompartmentRequest) (response ChangeBlockchainPlatformCompartmentResponse, err error)
+
+	// CreateBlockchainPlatform Creates a new Blockchain Platform.
+	CreateBlockchainPlatform(ctx context.Context, request CreateBlockchainPlatformRequest) (response CreateBlockchainPlatformResponse, err error)
+
+	// CreateOsn Create Blockchain Platform Osn
+	CreateOsn(ctx context.Context, request CreateOsnRequest) (response CreateOsnResponse, err error)
+
+	// CreatePeer Create Blockchain Platform Peer
+	CreatePeer(ctx context.Context, request CreatePeerRequest) (response CreatePeerResponse, err error)
+
+	// DeleteBlockchainPlatform Delete a particular of a Blockchain Platform
+	DeleteBlockchainPlatform(ctx context.Context, request DeleteBlockchainPlatformRequest) (response DeleteBlockchainPlatformResponse, err error)
+
+	// DeleteOsn Delete a particular OSN of a Blockchain Platform
+	DeleteOsn(ctx context.Context, request DeleteOsnRequest) (response DeleteOsnResponse, err error)
+
+	// DeletePeer Delete a particular peer of a Blockchain Platform
+	DeletePeer(ctx context.Context, request DeletePeerRequest) (response DeletePeerResponse, err error)
+
+	// DeleteWorkRequest Attempts to cancel the work request with the given ID.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// GetBlockchainPlatform Gets information about a Blockchain Platform identified by the specific id
+	GetBlockchainPlatform(ctx context.Context, request GetBlockchainPlatformRequest) (response GetBlockchainPlatformResponse, err error)
+
+	// GetOsn Gets information about an OSN identified by the specific id
+	GetOsn(ctx context.Context, request GetOsnRequest) (response GetOsnResponse, err error)
+
+	// GetPeer Gets information about a peer identified by the specific id
+	GetPeer(ctx context.Context, request GetPeerRequest) (response GetPeerResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListBlockchainPlatforms Returns a list Blockchain Platform Instances in a compartment
+	ListBlockchainPlatforms(ctx context.Context, request ListBlockchainPlatformsRequest) (response ListBlockchainPlatformsResponse, err error)
+
+	// ListOsns List Blockchain Platform OSNs
+	ListOsns(ctx context.Context, request ListOsnsRequest) (response ListOsnsResponse, err error)
+
+	// ListPeers List Blockchain Platform Peers
+	ListPeers(ctx context.Context, request ListPeersRequest) (response ListPeersResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// PreviewScaleBlockchainPlatform Preview Scale Blockchain Platform
+	PreviewScaleBlockchainPlatform(ctx context.Context, request PreviewScaleBlockchainPlatformRequest) (response PreviewScaleBlockchainPlatformResponse, err error)
+
+	// ScaleBlockchainPlatform Scale Blockchain Platform
+	ScaleBlockchainPlatform(ctx context.Context, request ScaleBlockchainPlatformRequest) (response ScaleBlockchainPlatformResponse, err error)
+
+	// StartBlockchainPlatform Start a Blockchain Platform
+	StartBlockchainPlatform(ctx context.Context, request StartBlockchainPlatformRequest) (response StartBlockchainPlatformResponse, err error)
+
+	// StopBlockchainPlatform Stop a Blockchain Platform
+	StopBlockchainPlatform(ctx context.Context, request StopBlockchainPlatformRequest) (response StopBlockchainPlatformResponse, err error)
+
+	// UpdateBlockchainPlatform Update a particular of a Blockchain Platform
+	UpdateBlockchainPlatform(ctx context.Context, request UpdateBlockchainPlatformRequest) (response UpdateBlockchainPlatformResponse, err error)
+
+	// UpdateOsn Update Blockchain Platform OSN
+	UpdateOsn(ctx context.Context, request UpdateOsnRequest) (response UpdateOsnResponse, err error)
+
+	// UpdatePeer Update Blockchain Platform Peer
+	UpdatePeer(ctx context.Context, request UpdatePeerRequest) (response UpdatePeerResponse, err error)
+}
+
+// BlockchainPlatformClient implements BlockchainPlatformClientAPI
+var _ BlockchainPlatformClientAPI = BlockchainPlatformClient{}
@@ -102,10 +102,12 @@ func (client BudgetClient) CreateAlertRule(ctx context.Context, request CreateAl
 				response = CreateAlertRuleResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAlertRuleResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAlertRuleResponse")
 	}
@@ -157,10 +159,12 @@ func (client BudgetClient) CreateBudget(ctx context.Context, request CreateBudge
 				response = CreateBudgetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBudgetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBudgetResponse")
 	}
@@ -0,0 +1,51 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package budget
+
+import (
+	"context"
+)
+
+// BudgetClientAPI is the interface of the operations of BudgetClient, to inject the client and mock it in tests
+type BudgetClientAPI interface {
+	// CreateAlertRule Creates a new Alert Rule.
+	CreateAlertRule(ctx context.Context, request CreateAlertRuleRequest) (response CreateAlertRuleResponse, err error)
+
+	// CreateBudget Creates a new Budget.
+	CreateBudget(ctx context.Context, request CreateBudgetRequest) (response CreateBudgetResponse, err error)
+
+	// DeleteAlertRule Deletes a specified Alert Rule resource.
+	DeleteAlertRule(ctx context.Context, request DeleteAlertRuleRequest) (response DeleteAlertRuleResponse, err error)
+
+	// DeleteBudget Deletes a specified Budget resource
+	DeleteBudget(ctx context.Context, request DeleteBudgetRequest) (response DeleteBudgetResponse, err error)
+
+	// GetAlertRule Gets an Alert Rule for a specified Budget.
+	GetAlertRule(ctx context.Context, request GetAlertRuleRequest) (response GetAlertRuleResponse, err error)
+
+	// GetBudget Gets a Budget by identifier
+	GetBudget(ctx context.Context, request GetBudgetRequest) (response GetBudgetResponse, err error)
+
+	// ListAlertRules Returns a list of Alert Rules for a specified Budget.
+	ListAlertRules(ctx context.Context, request ListAlertRulesRequest) (response ListAlertRulesResponse, err error)
+
+	// ListBudgets Gets a list of Budgets in a compartment.
+	// By default, ListBudgets returns budgets of 'COMPARTMENT' target type and the budget records with only ONE target compartment OCID.
+	// To list ALL budgets, set the targetType query parameter to ALL.
+	// Example:
+	//   'targetType=ALL'
+	// Additional targetTypes would be available in future releases. Clients should ignore new targetType
+	// or upgrade to latest version of client SDK to handle new targetType.
+	ListBudgets(ctx context.Context, request ListBudgetsRequest) (response ListBudgetsResponse, err error)
+
+	// UpdateAlertRule Update an Alert Rule for the budget identified by the OCID.
+	UpdateAlertRule(ctx context.Context, request UpdateAlertRuleRequest) (response UpdateAlertRuleResponse, err error)
+
+	// UpdateBudget Update a Budget identified by the OCID
+	UpdateBudget(ctx context.Context, request UpdateBudgetRequest) (response UpdateBudgetResponse, err error)
+}
+
+// BudgetClient implements BudgetClientAPI
+var _ BudgetClientAPI = BudgetClient{}
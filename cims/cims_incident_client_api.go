@@ -0,0 +1,36 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cims
+
+import (
+	"context"
+)
+
+// IncidentClientAPI is the interface of the operations of IncidentClient, to inject the client and mock it in tests
+type IncidentClientAPI interface {
+	// CreateIncident Enables the customer to create an support ticket.
+	CreateIncident(ctx context.Context, request CreateIncidentRequest) (response CreateIncidentResponse, err error)
+
+	// GetIncident Gets the details of the support ticket.
+	GetIncident(ctx context.Context, request GetIncidentRequest) (response GetIncidentResponse, err error)
+
+	// GetStatus Gets the status of the service.
+	GetStatus(ctx context.Context, request GetStatusRequest) (response GetStatusResponse, err error)
+
+	// ListIncidentResourceTypes During support ticket creation, returns the list of all possible products that Oracle Cloud Infrastructure supports.
+	ListIncidentResourceTypes(ctx context.Context, request ListIncidentResourceTypesRequest) (response ListIncidentResourceTypesResponse, err error)
+
+	// ListIncidents Returns the list of support tickets raised by the tenancy.
+	ListIncidents(ctx context.Context, request ListIncidentsRequest) (response ListIncidentsResponse, err error)
+
+	// UpdateIncident Updates the specified support ticket's information.
+	UpdateIncident(ctx context.Context, request UpdateIncidentRequest) (response UpdateIncidentResponse, err error)
+
+	// ValidateUser Checks whether the requested user is valid.
+	ValidateUser(ctx context.Context, request ValidateUserRequest) (response ValidateUserResponse, err error)
+}
+
+// IncidentClient implements IncidentClientAPI
+var _ IncidentClientAPI = IncidentClient{}
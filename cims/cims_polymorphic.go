@@ -0,0 +1,67 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cims
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*CreateItemDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createitemdetails{} })
+	common.RegisterPolymorphicModel((*Item)(nil), func() common.PolymorphicJSONUnmarshaler { return &item{} })
+	common.RegisterPolymorphicModel((*UpdateItemDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateitemdetails{} })
+}
+
+// MarshalJSON marshals a CreateItemDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createitemdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateitemdetails createitemdetails
+	s := struct {
+		MarshalTypecreateitemdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateitemdetails: (MarshalTypecreateitemdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Item whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m item) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeitem item
+	s := struct {
+		MarshalTypeitem
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeitem: (MarshalTypeitem)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateItemDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateitemdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateitemdetails updateitemdetails
+	s := struct {
+		MarshalTypeupdateitemdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateitemdetails: (MarshalTypeupdateitemdetails)(m),
+	}
+	return json.Marshal(&s)
+}
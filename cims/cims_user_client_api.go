@@ -0,0 +1,18 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cims
+
+import (
+	"context"
+)
+
+// UserClientAPI is the interface of the operations of UserClient, to inject the client and mock it in tests
+type UserClientAPI interface {
+	// CreateUser Create user to request Customer Support Identifier(CSI) to Customer User Administrator(CUA).
+	CreateUser(ctx context.Context, request CreateUserRequest) (response CreateUserResponse, err error)
+}
+
+// UserClient implements UserClientAPI
+var _ UserClientAPI = UserClient{}
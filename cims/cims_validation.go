@@ -0,0 +1,140 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cims
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetActivityItemActivityAuthorEnumValues(),
+		GetActivityItemActivityTypeEnumValues(),
+		GetAvailabilityDomainEnumValues(),
+		GetClassifierScopeEnumValues(),
+		GetClassifierUnitEnumValues(),
+		GetContactContactTypeEnumValues(),
+		GetCreateLimitItemDetailsLimitStatusEnumValues(),
+		GetCreateTicketDetailsSeverityEnumValues(),
+		GetErrorCodeEnumValues(),
+		GetLifecycleDetailsEnumValues(),
+		GetLifecycleStateEnumValues(),
+		GetLimitItemLimitStatusEnumValues(),
+		GetListIncidentResourceTypesSortByEnumValues(),
+		GetListIncidentResourceTypesSortOrderEnumValues(),
+		GetListIncidentsLifecycleStateEnumValues(),
+		GetListIncidentsSortByEnumValues(),
+		GetListIncidentsSortOrderEnumValues(),
+		GetProblemTypeEnumValues(),
+		GetRegionEnumValues(),
+		GetScopeEnumValues(),
+		GetSortByEnumValues(),
+		GetSortOrderEnumValues(),
+		GetTicketSeverityEnumValues(),
+		GetTimeZoneEnumValues(),
+		GetUnitEnumValues(),
+		GetUpdateActivityItemDetailsActivityTypeEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateIncidentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateUserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetIncidentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetStatusRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListIncidentResourceTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListIncidentsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateIncidentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateUserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateCategoryDetails, see common.ValidateStruct
+func (m CreateCategoryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateIssueTypeDetails, see common.ValidateStruct
+func (m CreateIssueTypeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateLimitItemDetails, see common.ValidateStruct
+func (m CreateLimitItemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateResourceDetails, see common.ValidateStruct
+func (m CreateResourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateSubCategoryDetails, see common.ValidateStruct
+func (m CreateSubCategoryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTechSupportItemDetails, see common.ValidateStruct
+func (m CreateTechSupportItemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTicketDetails, see common.ValidateStruct
+func (m CreateTicketDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateUserDetails, see common.ValidateStruct
+func (m CreateUserDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateActivityItemDetails, see common.ValidateStruct
+func (m UpdateActivityItemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateResourceDetails, see common.ValidateStruct
+func (m UpdateResourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTicketDetails, see common.ValidateStruct
+func (m UpdateTicketDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
@@ -102,10 +102,12 @@ func (client CloudGuardClient) ChangeDetectorRecipeCompartment(ctx context.Conte
 				response = ChangeDetectorRecipeCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDetectorRecipeCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDetectorRecipeCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client CloudGuardClient) ChangeManagedListCompartment(ctx context.Context,
 				response = ChangeManagedListCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeManagedListCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeManagedListCompartmentResponse")
 	}
@@ -212,10 +216,12 @@ func (client CloudGuardClient) ChangeResponderRecipeCompartment(ctx context.Cont
 				response = ChangeResponderRecipeCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeResponderRecipeCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeResponderRecipeCompartmentResponse")
 	}
@@ -267,10 +273,12 @@ func (client CloudGuardClient) CreateDetectorRecipe(ctx context.Context, request
 				response = CreateDetectorRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDetectorRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDetectorRecipeResponse")
 	}
@@ -322,10 +330,12 @@ func (client CloudGuardClient) CreateManagedList(ctx context.Context, request Cr
 				response = CreateManagedListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateManagedListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateManagedListResponse")
 	}
@@ -377,10 +387,12 @@ func (client CloudGuardClient) CreateResponderRecipe(ctx context.Context, reques
 				response = CreateResponderRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateResponderRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateResponderRecipeResponse")
 	}
@@ -432,10 +444,12 @@ func (client CloudGuardClient) CreateTarget(ctx context.Context, request CreateT
 				response = CreateTargetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTargetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTargetResponse")
 	}
@@ -487,10 +501,12 @@ func (client CloudGuardClient) CreateTargetDetectorRecipe(ctx context.Context, r
 				response = CreateTargetDetectorRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTargetDetectorRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTargetDetectorRecipeResponse")
 	}
@@ -542,10 +558,12 @@ func (client CloudGuardClient) CreateTargetResponderRecipe(ctx context.Context,
 				response = CreateTargetResponderRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTargetResponderRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTargetResponderRecipeResponse")
 	}
@@ -597,10 +615,12 @@ func (client CloudGuardClient) DeleteDetectorRecipe(ctx context.Context, request
 				response = DeleteDetectorRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteDetectorRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteDetectorRecipeResponse")
 	}
@@ -652,10 +672,12 @@ func (client CloudGuardClient) DeleteManagedList(ctx context.Context, request De
 				response = DeleteManagedListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteManagedListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteManagedListResponse")
 	}
@@ -907,10 +929,12 @@ func (client CloudGuardClient) ExecuteResponderExecution(ctx context.Context, re
 				response = ExecuteResponderExecutionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExecuteResponderExecutionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExecuteResponderExecutionResponse")
 	}
@@ -3616,10 +3640,12 @@ func (client CloudGuardClient) SkipResponderExecution(ctx context.Context, reque
 				response = SkipResponderExecutionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(SkipResponderExecutionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into SkipResponderExecutionResponse")
 	}
@@ -3671,10 +3697,12 @@ func (client CloudGuardClient) TriggerResponder(ctx context.Context, request Tri
 				response = TriggerResponderResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(TriggerResponderResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into TriggerResponderResponse")
 	}
@@ -3777,10 +3805,12 @@ func (client CloudGuardClient) UpdateConfiguration(ctx context.Context, request
 				response = UpdateConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateConfigurationResponse")
 	}
@@ -3832,10 +3862,12 @@ func (client CloudGuardClient) UpdateDetectorRecipe(ctx context.Context, request
 				response = UpdateDetectorRecipeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateDetectorRecipeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateDetectorRecipeResponse")
 	}
@@ -3937,10 +3969,12 @@ func (client CloudGuardClient) UpdateManagedList(ctx context.Context, request Up
 				response = UpdateManagedListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateManagedListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateManagedListResponse")
 	}
@@ -3992,10 +4026,12 @@ func (client CloudGuardClient) UpdateProblemStatus(ctx context.Context, request
 				response = UpdateProblemStatusResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateProblemStatusResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateProblemStatusResponse")
 	}
@@ -0,0 +1,366 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cloudguard
+
+import (
+	"context"
+)
+
+// CloudGuardClientAPI is the interface of the operations of CloudGuardClient, to inject the client and mock it in tests
+type CloudGuardClientAPI interface {
+	// ChangeDetectorRecipeCompartment Moves the DetectorRecipe from current compartment to another.
+	ChangeDetectorRecipeCompartment(ctx context.Context, request ChangeDetectorRecipeCompartmentRequest) (response ChangeDetectorRecipeCompartmentResponse, err error)
+
+	// ChangeManagedListCompartment Moves the ManagedList from current compartment to another.
+	ChangeManagedListCompartment(ctx context.Context, request ChangeManagedListCompartmentRequest) (response ChangeManagedListCompartmentResponse, err error)
+
+	// ChangeResponderRecipeCompartment Moves the ResponderRecipe from current compartment to another.
+	ChangeResponderRecipeCompartment(ctx context.Context, request ChangeResponderRecipeCompartmentRequest) (response ChangeResponderRecipeCompartmentResponse, err error)
+
+	// CreateDetectorRecipe Creates a DetectorRecipe
+	CreateDetectorRecipe(ctx context.Context, request CreateDetectorRecipeRequest) (response CreateDetectorRecipeResponse, err error)
+
+	// CreateManagedList Creates a new ManagedList.
+	CreateManagedList(ctx context.Context, request CreateManagedListRequest) (response CreateManagedListResponse, err error)
+
+	// CreateResponderRecipe Create a ResponderRecipe.
+	CreateResponderRecipe(ctx context.Context, request CreateResponderRecipeRequest) (response CreateResponderRecipeResponse, err error)
+
+	// CreateTarget Creates a new Target
+	CreateTarget(ctx context.Context, request CreateTargetRequest) (response CreateTargetResponse, err error)
+
+	// CreateTargetDetectorRecipe Attach a DetectorRecipe with the Target
+	CreateTargetDetectorRecipe(ctx context.Context, request CreateTargetDetectorRecipeRequest) (response CreateTargetDetectorRecipeResponse, err error)
+
+	// CreateTargetResponderRecipe Attach a ResponderRecipe with the Target
+	CreateTargetResponderRecipe(ctx context.Context, request CreateTargetResponderRecipeRequest) (response CreateTargetResponderRecipeResponse, err error)
+
+	// DeleteDetectorRecipe Deletes a DetectorRecipe identified by detectorRecipeId
+	DeleteDetectorRecipe(ctx context.Context, request DeleteDetectorRecipeRequest) (response DeleteDetectorRecipeResponse, err error)
+
+	// DeleteManagedList Deletes a managed list identified by managedListId
+	DeleteManagedList(ctx context.Context, request DeleteManagedListRequest) (response DeleteManagedListResponse, err error)
+
+	// DeleteResponderRecipe Delete the ResponderRecipe resource by identifier
+	DeleteResponderRecipe(ctx context.Context, request DeleteResponderRecipeRequest) (response DeleteResponderRecipeResponse, err error)
+
+	// DeleteTarget Deletes a Target identified by targetId
+	DeleteTarget(ctx context.Context, request DeleteTargetRequest) (response DeleteTargetResponse, err error)
+
+	// DeleteTargetDetectorRecipe Delete the TargetDetectorRecipe resource by identifier
+	DeleteTargetDetectorRecipe(ctx context.Context, request DeleteTargetDetectorRecipeRequest) (response DeleteTargetDetectorRecipeResponse, err error)
+
+	// DeleteTargetResponderRecipe Delete the TargetResponderRecipe resource by identifier
+	DeleteTargetResponderRecipe(ctx context.Context, request DeleteTargetResponderRecipeRequest) (response DeleteTargetResponderRecipeResponse, err error)
+
+	// ExecuteResponderExecution Executes the responder execution. When provided, If-Match is checked against ETag values of the resource.
+	ExecuteResponderExecution(ctx context.Context, request ExecuteResponderExecutionRequest) (response ExecuteResponderExecutionResponse, err error)
+
+	// GetConditionMetadataType Returns ConditionType with its details.
+	GetConditionMetadataType(ctx context.Context, request GetConditionMetadataTypeRequest) (response GetConditionMetadataTypeResponse, err error)
+
+	// GetConfiguration GET Cloud Guard Configuration Details for a Tenancy.
+	GetConfiguration(ctx context.Context, request GetConfigurationRequest) (response GetConfigurationResponse, err error)
+
+	// GetDetector Returns a Detector identified by detectorId.
+	GetDetector(ctx context.Context, request GetDetectorRequest) (response GetDetectorResponse, err error)
+
+	// GetDetectorRecipe Returns a DetectorRecipe identified by detectorRecipeId
+	GetDetectorRecipe(ctx context.Context, request GetDetectorRecipeRequest) (response GetDetectorRecipeResponse, err error)
+
+	// GetDetectorRecipeDetectorRule Get DetectorRule by identifier
+	GetDetectorRecipeDetectorRule(ctx context.Context, request GetDetectorRecipeDetectorRuleRequest) (response GetDetectorRecipeDetectorRuleResponse, err error)
+
+	// GetDetectorRule Returns a Detector Rule identified by detectorRuleId
+	GetDetectorRule(ctx context.Context, request GetDetectorRuleRequest) (response GetDetectorRuleResponse, err error)
+
+	// GetManagedList Returns a managed list identified by managedListId
+	GetManagedList(ctx context.Context, request GetManagedListRequest) (response GetManagedListResponse, err error)
+
+	// GetProblem Returns a Problems response
+	GetProblem(ctx context.Context, request GetProblemRequest) (response GetProblemResponse, err error)
+
+	// GetResponderExecution Returns a Responder Execution identified by responderExecutionId
+	GetResponderExecution(ctx context.Context, request GetResponderExecutionRequest) (response GetResponderExecutionResponse, err error)
+
+	// GetResponderRecipe Get a ResponderRecipe by identifier
+	GetResponderRecipe(ctx context.Context, request GetResponderRecipeRequest) (response GetResponderRecipeResponse, err error)
+
+	// GetResponderRecipeResponderRule Get ResponderRule by identifier
+	GetResponderRecipeResponderRule(ctx context.Context, request GetResponderRecipeResponderRuleRequest) (response GetResponderRecipeResponderRuleResponse, err error)
+
+	// GetResponderRule Get a ResponderRule by identifier
+	GetResponderRule(ctx context.Context, request GetResponderRuleRequest) (response GetResponderRuleResponse, err error)
+
+	// GetTarget Returns a Target identified by targetId
+	GetTarget(ctx context.Context, request GetTargetRequest) (response GetTargetResponse, err error)
+
+	// GetTargetDetectorRecipe Get a TargetDetectorRecipe by identifier
+	GetTargetDetectorRecipe(ctx context.Context, request GetTargetDetectorRecipeRequest) (response GetTargetDetectorRecipeResponse, err error)
+
+	// GetTargetDetectorRecipeDetectorRule Get DetectorRule by identifier
+	GetTargetDetectorRecipeDetectorRule(ctx context.Context, request GetTargetDetectorRecipeDetectorRuleRequest) (response GetTargetDetectorRecipeDetectorRuleResponse, err error)
+
+	// GetTargetResponderRecipe Get a TargetResponderRecipe by identifier
+	GetTargetResponderRecipe(ctx context.Context, request GetTargetResponderRecipeRequest) (response GetTargetResponderRecipeResponse, err error)
+
+	// GetTargetResponderRecipeResponderRule Get ResponderRule by identifier
+	GetTargetResponderRecipeResponderRule(ctx context.Context, request GetTargetResponderRecipeResponderRuleRequest) (response GetTargetResponderRecipeResponderRuleResponse, err error)
+
+	// ListConditionMetadataTypes Returns a list of condition types.
+	ListConditionMetadataTypes(ctx context.Context, request ListConditionMetadataTypesRequest) (response ListConditionMetadataTypesResponse, err error)
+
+	// ListDetectorRecipeDetectorRules Returns a list of DetectorRule associated with DetectorRecipe.
+	ListDetectorRecipeDetectorRules(ctx context.Context, request ListDetectorRecipeDetectorRulesRequest) (response ListDetectorRecipeDetectorRulesResponse, err error)
+
+	// ListDetectorRecipes Returns a list of all Detector Recipes in a compartment
+	// The ListDetectorRecipes operation returns only the detector recipes in `compartmentId` passed.
+	// The list does not include any subcompartments of the compartmentId passed.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform ListDetectorRecipes on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	ListDetectorRecipes(ctx context.Context, request ListDetectorRecipesRequest) (response ListDetectorRecipesResponse, err error)
+
+	// ListDetectorRules Returns a list of detector rules for the detectorId passed.
+	ListDetectorRules(ctx context.Context, request ListDetectorRulesRequest) (response ListDetectorRulesResponse, err error)
+
+	// ListDetectors Returns detector catalog - list of detectors supported by Cloud Guard
+	ListDetectors(ctx context.Context, request ListDetectorsRequest) (response ListDetectorsResponse, err error)
+
+	// ListImpactedResources Returns a list of Impacted Resources for a CloudGuard Problem
+	ListImpactedResources(ctx context.Context, request ListImpactedResourcesRequest) (response ListImpactedResourcesResponse, err error)
+
+	// ListManagedListTypes Returns all ManagedList types supported by Cloud Guard
+	ListManagedListTypes(ctx context.Context, request ListManagedListTypesRequest) (response ListManagedListTypesResponse, err error)
+
+	// ListManagedLists Returns a list of ListManagedLists.
+	// The ListManagedLists operation returns only the managed lists in `compartmentId` passed.
+	// The list does not include any subcompartments of the compartmentId passed.
+	// The parameter `accessLevel` specifies whether to return ManagedLists in only
+	// those compartments for which the requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform ListManagedLists on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	ListManagedLists(ctx context.Context, request ListManagedListsRequest) (response ListManagedListsResponse, err error)
+
+	// ListProblemHistories Returns a list of Actions done on CloudGuard Problem
+	ListProblemHistories(ctx context.Context, request ListProblemHistoriesRequest) (response ListProblemHistoriesResponse, err error)
+
+	// ListProblems Returns a list of all Problems identified by the Cloud Guard
+	// The ListProblems operation returns only the problems in `compartmentId` passed.
+	// The list does not include any subcompartments of the compartmentId passed.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform ListProblems on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	ListProblems(ctx context.Context, request ListProblemsRequest) (response ListProblemsResponse, err error)
+
+	// ListRecommendations Returns a list of all Recommendations.
+	ListRecommendations(ctx context.Context, request ListRecommendationsRequest) (response ListRecommendationsResponse, err error)
+
+	// ListResourceTypes Returns a list of resource types.
+	ListResourceTypes(ctx context.Context, request ListResourceTypesRequest) (response ListResourceTypesResponse, err error)
+
+	// ListResponderActivities Returns a list of Responder activities done on CloudGuard Problem
+	ListResponderActivities(ctx context.Context, request ListResponderActivitiesRequest) (response ListResponderActivitiesResponse, err error)
+
+	// ListResponderExecutions Returns a list of Responder Executions. A Responder Execution is an entity that tracks the collective execution of multiple Responder Rule Executions for a given Problem.
+	ListResponderExecutions(ctx context.Context, request ListResponderExecutionsRequest) (response ListResponderExecutionsResponse, err error)
+
+	// ListResponderRecipeResponderRules Returns a list of ResponderRule associated with ResponderRecipe.
+	ListResponderRecipeResponderRules(ctx context.Context, request ListResponderRecipeResponderRulesRequest) (response ListResponderRecipeResponderRulesResponse, err error)
+
+	// ListResponderRecipes Returns a list of all ResponderRecipes in a compartment
+	// The ListResponderRecipe operation returns only the targets in `compartmentId` passed.
+	// The list does not include any subcompartments of the compartmentId passed.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform ListResponderRecipe on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	ListResponderRecipes(ctx context.Context, request ListResponderRecipesRequest) (response ListResponderRecipesResponse, err error)
+
+	// ListResponderRules Returns a list of ResponderRule.
+	ListResponderRules(ctx context.Context, request ListResponderRulesRequest) (response ListResponderRulesResponse, err error)
+
+	// ListTargetDetectorRecipeDetectorRules Returns a list of DetectorRule associated with DetectorRecipe within a Target.
+	ListTargetDetectorRecipeDetectorRules(ctx context.Context, request ListTargetDetectorRecipeDetectorRulesRequest) (response ListTargetDetectorRecipeDetectorRulesResponse, err error)
+
+	// ListTargetDetectorRecipes Returns a list of all detector recipes associated with the target identified by targetId
+	ListTargetDetectorRecipes(ctx context.Context, request ListTargetDetectorRecipesRequest) (response ListTargetDetectorRecipesResponse, err error)
+
+	// ListTargetResponderRecipeResponderRules Returns a list of ResponderRule associated with ResponderRecipe within a Target.
+	ListTargetResponderRecipeResponderRules(ctx context.Context, request ListTargetResponderRecipeResponderRulesRequest) (response ListTargetResponderRecipeResponderRulesResponse, err error)
+
+	// ListTargetResponderRecipes Returns a list of all responder recipes associated with the target identified by targetId
+	ListTargetResponderRecipes(ctx context.Context, request ListTargetResponderRecipesRequest) (response ListTargetResponderRecipesResponse, err error)
+
+	// ListTargets Returns a list of all Targets in a compartment
+	// The ListTargets operation returns only the targets in `compartmentId` passed.
+	// The list does not include any subcompartments of the compartmentId passed.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform ListTargets on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	ListTargets(ctx context.Context, request ListTargetsRequest) (response ListTargetsResponse, err error)
+
+	// RequestRiskScores Examines the number of problems related to the resource and the relative severity of those problems.
+	RequestRiskScores(ctx context.Context, request RequestRiskScoresRequest) (response RequestRiskScoresResponse, err error)
+
+	// RequestSecurityScoreSummarizedTrend Measures the number of resources examined across all regions and compares it with the
+	// number of problems detected, for a given time period.
+	RequestSecurityScoreSummarizedTrend(ctx context.Context, request RequestSecurityScoreSummarizedTrendRequest) (response RequestSecurityScoreSummarizedTrendResponse, err error)
+
+	// RequestSecurityScores Measures the number of resources examined across all regions and compares it with the number of problems detected.
+	RequestSecurityScores(ctx context.Context, request RequestSecurityScoresRequest) (response RequestSecurityScoresResponse, err error)
+
+	// RequestSummarizedActivityProblems Returns the summary of Activity type problems identified by cloud guard, for a given set of dimensions.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform summarize API on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	// The compartmentId to be passed with `accessLevel` and `compartmentIdInSubtree` params has to be the root
+	// compartment id (tenant-id) only.
+	RequestSummarizedActivityProblems(ctx context.Context, request RequestSummarizedActivityProblemsRequest) (response RequestSummarizedActivityProblemsResponse, err error)
+
+	// RequestSummarizedProblems Returns the number of problems identified by cloud guard, for a given set of dimensions.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform summarize API on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	RequestSummarizedProblems(ctx context.Context, request RequestSummarizedProblemsRequest) (response RequestSummarizedProblemsResponse, err error)
+
+	// RequestSummarizedResponderExecutions Returns the number of Responder Executions, for a given set of dimensions.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform summarize API on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	RequestSummarizedResponderExecutions(ctx context.Context, request RequestSummarizedResponderExecutionsRequest) (response RequestSummarizedResponderExecutionsResponse, err error)
+
+	// RequestSummarizedRiskScores DEPRECATED
+	RequestSummarizedRiskScores(ctx context.Context, request RequestSummarizedRiskScoresRequest) (response RequestSummarizedRiskScoresResponse, err error)
+
+	// RequestSummarizedSecurityScores DEPRECATED
+	RequestSummarizedSecurityScores(ctx context.Context, request RequestSummarizedSecurityScoresRequest) (response RequestSummarizedSecurityScoresResponse, err error)
+
+	// RequestSummarizedTrendProblems Returns the number of problems identified by cloud guard, for a given time period.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform summarize API on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	RequestSummarizedTrendProblems(ctx context.Context, request RequestSummarizedTrendProblemsRequest) (response RequestSummarizedTrendProblemsResponse, err error)
+
+	// RequestSummarizedTrendResponderExecutions Returns the number of remediations performed by Responders, for a given time period.
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (ACCESSIBLE) (the resource can be in a subcompartment) or to return Not Authorized if
+	// Principal doesn't have access to even one of the child compartments. This is valid only when
+	// `compartmentIdInSubtree` is set to `true`.
+	// The parameter `compartmentIdInSubtree` applies when you perform summarize API on the
+	// `compartmentId` passed and when it is set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ACCESSIBLE.
+	RequestSummarizedTrendResponderExecutions(ctx context.Context, request RequestSummarizedTrendResponderExecutionsRequest) (response RequestSummarizedTrendResponderExecutionsResponse, err error)
+
+	// RequestSummarizedTrendSecurityScores DEPRECATED
+	RequestSummarizedTrendSecurityScores(ctx context.Context, request RequestSummarizedTrendSecurityScoresRequest) (response RequestSummarizedTrendSecurityScoresResponse, err error)
+
+	// SkipBulkResponderExecution Skips the execution for a bulk of responder executions
+	// The operation is atomic in nature
+	SkipBulkResponderExecution(ctx context.Context, request SkipBulkResponderExecutionRequest) (response SkipBulkResponderExecutionResponse, err error)
+
+	// SkipResponderExecution Skips the execution of the responder execution. When provided, If-Match is checked against ETag values of the resource.
+	SkipResponderExecution(ctx context.Context, request SkipResponderExecutionRequest) (response SkipResponderExecutionResponse, err error)
+
+	// TriggerResponder push the problem to responder
+	TriggerResponder(ctx context.Context, request TriggerResponderRequest) (response TriggerResponderResponse, err error)
+
+	// UpdateBulkProblemStatus Updates the statuses in bulk for a list of problems
+	// The operation is atomic in nature
+	UpdateBulkProblemStatus(ctx context.Context, request UpdateBulkProblemStatusRequest) (response UpdateBulkProblemStatusResponse, err error)
+
+	// UpdateConfiguration Enable/Disable Cloud Guard. The reporting region cannot be updated once created.
+	UpdateConfiguration(ctx context.Context, request UpdateConfigurationRequest) (response UpdateConfigurationResponse, err error)
+
+	// UpdateDetectorRecipe Updates a detector recipe identified by detectorRecipeId
+	UpdateDetectorRecipe(ctx context.Context, request UpdateDetectorRecipeRequest) (response UpdateDetectorRecipeResponse, err error)
+
+	// UpdateDetectorRecipeDetectorRule Update the DetectorRule by identifier
+	UpdateDetectorRecipeDetectorRule(ctx context.Context, request UpdateDetectorRecipeDetectorRuleRequest) (response UpdateDetectorRecipeDetectorRuleResponse, err error)
+
+	// UpdateManagedList Updates a managed list identified by managedListId
+	UpdateManagedList(ctx context.Context, request UpdateManagedListRequest) (response UpdateManagedListResponse, err error)
+
+	// UpdateProblemStatus updates the problem details
+	UpdateProblemStatus(ctx context.Context, request UpdateProblemStatusRequest) (response UpdateProblemStatusResponse, err error)
+
+	// UpdateResponderRecipe Update the ResponderRecipe resource by identifier
+	UpdateResponderRecipe(ctx context.Context, request UpdateResponderRecipeRequest) (response UpdateResponderRecipeResponse, err error)
+
+	// UpdateResponderRecipeResponderRule Update the ResponderRule by identifier
+	UpdateResponderRecipeResponderRule(ctx context.Context, request UpdateResponderRecipeResponderRuleRequest) (response UpdateResponderRecipeResponderRuleResponse, err error)
+
+	// UpdateTarget Updates a Target identified by targetId
+	UpdateTarget(ctx context.Context, request UpdateTargetRequest) (response UpdateTargetResponse, err error)
+
+	// UpdateTargetDetectorRecipe Update the TargetDetectorRecipe resource by identifier
+	UpdateTargetDetectorRecipe(ctx context.Context, request UpdateTargetDetectorRecipeRequest) (response UpdateTargetDetectorRecipeResponse, err error)
+
+	// UpdateTargetDetectorRecipeDetectorRule Update the DetectorRule by identifier
+	UpdateTargetDetectorRecipeDetectorRule(ctx context.Context, request UpdateTargetDetectorRecipeDetectorRuleRequest) (response UpdateTargetDetectorRecipeDetectorRuleResponse, err error)
+
+	// UpdateTargetResponderRecipe Update the TargetResponderRecipe resource by identifier
+	UpdateTargetResponderRecipe(ctx context.Context, request UpdateTargetResponderRecipeRequest) (response UpdateTargetResponderRecipeResponse, err error)
+
+	// UpdateTargetResponderRecipeResponderRule Update the ResponderRule by identifier
+	UpdateTargetResponderRecipeResponderRule(ctx context.Context, request UpdateTargetResponderRecipeResponderRuleRequest) (response UpdateTargetResponderRecipeResponderRuleResponse, err error)
+}
+
+// CloudGuardClient implements CloudGuardClientAPI
+var _ CloudGuardClientAPI = CloudGuardClient{}
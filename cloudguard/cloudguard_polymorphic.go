@@ -0,0 +1,31 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cloudguard
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*Condition)(nil), func() common.PolymorphicJSONUnmarshaler { return &condition{} })
+}
+
+// MarshalJSON marshals a Condition whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m condition) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecondition condition
+	s := struct {
+		MarshalTypecondition
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecondition: (MarshalTypecondition)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -0,0 +1,744 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package cloudguard
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetActorTypeEnumValues(),
+		GetCloudGuardStatusEnumValues(),
+		GetCompositeConditionCompositeOperatorEnumValues(),
+		GetConditionFilterTypeEnumValues(),
+		GetConditionKindEnumValues(),
+		GetConditionOperatorNameEnumValues(),
+		GetConditionTypeEnumEnumValues(),
+		GetConditionValueTypeEnumValues(),
+		GetConfigurationListItemTypeEnumValues(),
+		GetDetectorEnumEnumValues(),
+		GetDetectorRecipeDetectorRuleManagedListTypesEnumValues(),
+		GetDetectorRecipeDetectorRuleSummaryManagedListTypesEnumValues(),
+		GetDetectorRuleManagedListTypesEnumValues(),
+		GetDetectorRuleSummaryManagedListTypesEnumValues(),
+		GetEventStatusEnumValues(),
+		GetFeedProviderTypeEnumValues(),
+		GetGetConditionMetadataTypeConditionMetadataTypeIdEnumValues(),
+		GetLifecycleStateEnumValues(),
+		GetListConditionMetadataTypesLifecycleStateEnumValues(),
+		GetListConditionMetadataTypesSortByEnumValues(),
+		GetListConditionMetadataTypesSortOrderEnumValues(),
+		GetListDetectorRecipeDetectorRulesLifecycleStateEnumValues(),
+		GetListDetectorRecipeDetectorRulesSortByEnumValues(),
+		GetListDetectorRecipeDetectorRulesSortOrderEnumValues(),
+		GetListDetectorRecipesAccessLevelEnumValues(),
+		GetListDetectorRecipesLifecycleStateEnumValues(),
+		GetListDetectorRecipesSortByEnumValues(),
+		GetListDetectorRecipesSortOrderEnumValues(),
+		GetListDetectorRulesLifecycleStateEnumValues(),
+		GetListDetectorRulesSortByEnumValues(),
+		GetListDetectorRulesSortOrderEnumValues(),
+		GetListDetectorsLifecycleStateEnumValues(),
+		GetListDetectorsSortByEnumValues(),
+		GetListDetectorsSortOrderEnumValues(),
+		GetListImpactedResourcesSortByEnumValues(),
+		GetListImpactedResourcesSortOrderEnumValues(),
+		GetListManagedListTypesLifecycleStateEnumValues(),
+		GetListManagedListTypesSortByEnumValues(),
+		GetListManagedListTypesSortOrderEnumValues(),
+		GetListManagedListsAccessLevelEnumValues(),
+		GetListManagedListsLifecycleStateEnumValues(),
+		GetListManagedListsListTypeEnumValues(),
+		GetListManagedListsSortByEnumValues(),
+		GetListManagedListsSortOrderEnumValues(),
+		GetListProblemHistoriesSortByEnumValues(),
+		GetListProblemHistoriesSortOrderEnumValues(),
+		GetListProblemsAccessLevelEnumValues(),
+		GetListProblemsDetectorTypeEnumValues(),
+		GetListProblemsLifecycleDetailEnumValues(),
+		GetListProblemsLifecycleStateEnumValues(),
+		GetListProblemsSortByEnumValues(),
+		GetListProblemsSortOrderEnumValues(),
+		GetListRecommendationsAccessLevelEnumValues(),
+		GetListRecommendationsLifecycleDetailEnumValues(),
+		GetListRecommendationsLifecycleStateEnumValues(),
+		GetListRecommendationsSortByEnumValues(),
+		GetListRecommendationsSortOrderEnumValues(),
+		GetListResourceTypesLifecycleStateEnumValues(),
+		GetListResourceTypesSortByEnumValues(),
+		GetListResourceTypesSortOrderEnumValues(),
+		GetListResponderActivitiesSortByEnumValues(),
+		GetListResponderActivitiesSortOrderEnumValues(),
+		GetListResponderExecutionsAccessLevelEnumValues(),
+		GetListResponderExecutionsResponderExecutionModeEnumValues(),
+		GetListResponderExecutionsResponderExecutionStatusEnumValues(),
+		GetListResponderExecutionsResponderTypeEnumValues(),
+		GetListResponderExecutionsSortByEnumValues(),
+		GetListResponderExecutionsSortOrderEnumValues(),
+		GetListResponderRecipeResponderRulesLifecycleStateEnumValues(),
+		GetListResponderRecipeResponderRulesSortByEnumValues(),
+		GetListResponderRecipeResponderRulesSortOrderEnumValues(),
+		GetListResponderRecipesAccessLevelEnumValues(),
+		GetListResponderRecipesLifecycleStateEnumValues(),
+		GetListResponderRecipesSortByEnumValues(),
+		GetListResponderRecipesSortOrderEnumValues(),
+		GetListResponderRulesLifecycleStateEnumValues(),
+		GetListResponderRulesSortByEnumValues(),
+		GetListResponderRulesSortOrderEnumValues(),
+		GetListTargetDetectorRecipeDetectorRulesLifecycleStateEnumValues(),
+		GetListTargetDetectorRecipeDetectorRulesSortByEnumValues(),
+		GetListTargetDetectorRecipeDetectorRulesSortOrderEnumValues(),
+		GetListTargetDetectorRecipesLifecycleStateEnumValues(),
+		GetListTargetDetectorRecipesSortByEnumValues(),
+		GetListTargetDetectorRecipesSortOrderEnumValues(),
+		GetListTargetResponderRecipeResponderRulesLifecycleStateEnumValues(),
+		GetListTargetResponderRecipeResponderRulesSortByEnumValues(),
+		GetListTargetResponderRecipeResponderRulesSortOrderEnumValues(),
+		GetListTargetResponderRecipesLifecycleStateEnumValues(),
+		GetListTargetResponderRecipesSortByEnumValues(),
+		GetListTargetResponderRecipesSortOrderEnumValues(),
+		GetListTargetsAccessLevelEnumValues(),
+		GetListTargetsLifecycleStateEnumValues(),
+		GetListTargetsSortByEnumValues(),
+		GetListTargetsSortOrderEnumValues(),
+		GetManagedListTypeEnumValues(),
+		GetOperatorTypeEnumValues(),
+		GetOwnerTypeEnumValues(),
+		GetProblemDimensionEnumValues(),
+		GetProblemLifecycleDetailEnumValues(),
+		GetProblemLifecycleStateEnumValues(),
+		GetRecommendationLifecycleDetailEnumValues(),
+		GetRecommendationTypeEnumValues(),
+		GetRequestSummarizedActivityProblemsAccessLevelEnumValues(),
+		GetRequestSummarizedProblemsAccessLevelEnumValues(),
+		GetRequestSummarizedProblemsListDimensionsEnumValues(),
+		GetRequestSummarizedResponderExecutionsAccessLevelEnumValues(),
+		GetRequestSummarizedResponderExecutionsResponderExecutionStatusFilterEnumValues(),
+		GetRequestSummarizedResponderExecutionsResponderExecutionsDimensionsEnumValues(),
+		GetRequestSummarizedResponderExecutionsResponderTypeFilterEnumValues(),
+		GetRequestSummarizedTrendProblemsAccessLevelEnumValues(),
+		GetRequestSummarizedTrendResponderExecutionsAccessLevelEnumValues(),
+		GetResponderActivityTypeEnumValues(),
+		GetResponderDimensionEnumValues(),
+		GetResponderExecutionModesEnumValues(),
+		GetResponderExecutionStatesEnumValues(),
+		GetResponderExecutionStatusEnumValues(),
+		GetResponderModeTypesEnumValues(),
+		GetResponderRecipeResponderRuleSummarySupportedModesEnumValues(),
+		GetResponderRecipeResponderRuleSupportedModesEnumValues(),
+		GetResponderRuleSummarySupportedModesEnumValues(),
+		GetResponderRuleSupportedModesEnumValues(),
+		GetResponderTypeEnumValues(),
+		GetRiskLevelEnumValues(),
+		GetSecurityRatingEnumValues(),
+		GetSortOrdersEnumValues(),
+		GetTargetDetectorRecipeDetectorRuleManagedListTypesEnumValues(),
+		GetTargetDetectorRecipeDetectorRuleSummaryManagedListTypesEnumValues(),
+		GetTargetResourceTypeEnumValues(),
+		GetTargetResponderRecipeResponderRuleSummarySupportedModesEnumValues(),
+		GetTargetResponderRecipeResponderRuleSupportedModesEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeDetectorRecipeCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeManagedListCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeResponderRecipeCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateManagedListRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTargetDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTargetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTargetResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteManagedListRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTargetDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTargetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTargetResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ExecuteResponderExecutionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConditionMetadataTypeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDetectorRecipeDetectorRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDetectorRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDetectorRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetManagedListRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetProblemRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetResponderExecutionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetResponderRecipeResponderRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetResponderRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTargetDetectorRecipeDetectorRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTargetDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTargetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTargetResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTargetResponderRecipeResponderRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListConditionMetadataTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDetectorRecipeDetectorRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDetectorRecipesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDetectorRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDetectorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListImpactedResourcesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListManagedListTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListManagedListsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListProblemHistoriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListProblemsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListRecommendationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResourceTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResponderActivitiesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResponderExecutionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResponderRecipeResponderRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResponderRecipesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListResponderRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTargetDetectorRecipeDetectorRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTargetDetectorRecipesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTargetResponderRecipeResponderRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTargetResponderRecipesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTargetsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestRiskScoresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSecurityScoreSummarizedTrendRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSecurityScoresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedActivityProblemsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedProblemsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedResponderExecutionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedRiskScoresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedSecurityScoresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedTrendProblemsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedTrendResponderExecutionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RequestSummarizedTrendSecurityScoresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SkipBulkResponderExecutionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SkipResponderExecutionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request TriggerResponderRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateBulkProblemStatusRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateConfigurationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDetectorRecipeDetectorRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateManagedListRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateProblemStatusRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateResponderRecipeResponderRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTargetDetectorRecipeDetectorRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTargetDetectorRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTargetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTargetResponderRecipeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTargetResponderRecipeResponderRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AttachTargetDetectorRecipeDetails, see common.ValidateStruct
+func (m AttachTargetDetectorRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AttachTargetResponderRecipeDetails, see common.ValidateStruct
+func (m AttachTargetResponderRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeDetectorRecipeCompartmentDetails, see common.ValidateStruct
+func (m ChangeDetectorRecipeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeManagedListCompartmentDetails, see common.ValidateStruct
+func (m ChangeManagedListCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeResponderRecipeCompartmentDetails, see common.ValidateStruct
+func (m ChangeResponderRecipeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDetectorRecipeDetails, see common.ValidateStruct
+func (m CreateDetectorRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateManagedListDetails, see common.ValidateStruct
+func (m CreateManagedListDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateResponderRecipeDetails, see common.ValidateStruct
+func (m CreateResponderRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTargetDetails, see common.ValidateStruct
+func (m CreateTargetDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTargetDetectorRecipeDetails, see common.ValidateStruct
+func (m CreateTargetDetectorRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTargetResponderRecipeDetails, see common.ValidateStruct
+func (m CreateTargetResponderRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DetectorDetails, see common.ValidateStruct
+func (m DetectorDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ExecuteResponderExecutionDetails, see common.ValidateStruct
+func (m ExecuteResponderExecutionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ResponderRuleDetails, see common.ValidateStruct
+func (m ResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ResponderRuleExecutionDetails, see common.ValidateStruct
+func (m ResponderRuleExecutionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SkipBulkResponderExecutionDetails, see common.ValidateStruct
+func (m SkipBulkResponderExecutionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TargetDetectorDetails, see common.ValidateStruct
+func (m TargetDetectorDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TriggerResponderDetails, see common.ValidateStruct
+func (m TriggerResponderDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateBulkProblemStatusDetails, see common.ValidateStruct
+func (m UpdateBulkProblemStatusDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateConfigurationDetails, see common.ValidateStruct
+func (m UpdateConfigurationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDetectorRecipeDetails, see common.ValidateStruct
+func (m UpdateDetectorRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDetectorRecipeDetectorRuleDetails, see common.ValidateStruct
+func (m UpdateDetectorRecipeDetectorRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDetectorRuleDetails, see common.ValidateStruct
+func (m UpdateDetectorRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateManagedListDetails, see common.ValidateStruct
+func (m UpdateManagedListDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateProblemStatusDetails, see common.ValidateStruct
+func (m UpdateProblemStatusDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateResponderRecipeDetails, see common.ValidateStruct
+func (m UpdateResponderRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateResponderRecipeResponderRuleDetails, see common.ValidateStruct
+func (m UpdateResponderRecipeResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateResponderRuleDetails, see common.ValidateStruct
+func (m UpdateResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetDetails, see common.ValidateStruct
+func (m UpdateTargetDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetDetectorRecipeDetails, see common.ValidateStruct
+func (m UpdateTargetDetectorRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetDetectorRecipeDetectorRuleDetails, see common.ValidateStruct
+func (m UpdateTargetDetectorRecipeDetectorRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetDetectorRuleDetails, see common.ValidateStruct
+func (m UpdateTargetDetectorRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetRecipeDetectorRuleDetails, see common.ValidateStruct
+func (m UpdateTargetRecipeDetectorRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetRecipeResponderRuleDetails, see common.ValidateStruct
+func (m UpdateTargetRecipeResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetResponderRecipeDetails, see common.ValidateStruct
+func (m UpdateTargetResponderRecipeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetResponderRecipeResponderRuleDetails, see common.ValidateStruct
+func (m UpdateTargetResponderRecipeResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTargetResponderRuleDetails, see common.ValidateStruct
+func (m UpdateTargetResponderRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
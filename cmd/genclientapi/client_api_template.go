@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package main
+
+const clientAPITemplate = `// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+)
+{{range .Clients}}
+// {{.Name}}API is the interface of the operations of {{.Name}}, to inject the client and mock it in tests
+type {{.Name}}API interface {
+{{- range $index, $operation := .Operations}}
+{{- if $index}}
+{{end}}
+{{- range .Doc}}
+	{{.}}
+{{- end}}
+	{{.Name}}{{.Signature}}
+{{- end}}
+}
+
+// {{.Name}} implements {{.Name}}API
+var _ {{.Name}}API = {{.Name}}{}
+{{end}}`
@@ -0,0 +1,188 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package main The following code is used to generate the interfaces of the service clients
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// generatedMarker marks the generated client files, the only ones whose clients get an interface
+const generatedMarker = "// Code generated. DO NOT EDIT."
+
+var root = flag.String("root", ".", "root directory of the sdk")
+
+// operation is a method of a client calling an operation of the service
+type operation struct {
+	Doc       []string
+	Name      string
+	Signature string
+}
+
+// client is a service client and its operations
+type client struct {
+	Name       string
+	Operations []operation
+}
+
+// clientFile is the file of the interfaces of the clients of a generated client file
+type clientFile struct {
+	Package string
+	Clients []client
+}
+
+// Generates the file <name>_api.go, with an interface per client, next to every generated file <name>_client.go
+func main() {
+	flag.Parse()
+	genTemplate := template.Must(template.New("clientapi").Parse(clientAPITemplate))
+
+	files, err := filepath.Glob(filepath.Join(*root, "*", "*_client.go"))
+	if err != nil {
+		log.Fatalf("could not list the client files: %s", err)
+	}
+
+	for _, file := range files {
+		parsed, err := parseClientFile(file)
+		if err != nil {
+			log.Fatalf("could not parse %s: %s", file, err)
+		}
+		if parsed == nil {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := genTemplate.Execute(&buf, parsed); err != nil {
+			log.Fatalf("error while generating the interfaces of %s: %s", file, err)
+		}
+
+		source, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("error while formatting the interfaces of %s: %s", file, err)
+		}
+
+		output := strings.TrimSuffix(file, ".go") + "_api.go"
+		if err := ioutil.WriteFile(output, source, 0644); err != nil {
+			log.Fatalf("could not write output file: %s", err)
+		}
+	}
+}
+
+// parseClientFile returns the clients of a generated client file, or nil if the file is not generated or has no
+// client
+func parseClientFile(path string) (*clientFile, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Contains(content, []byte(generatedMarker)) {
+		return nil, nil
+	}
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, path, content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]*client)
+	for _, decl := range file.Decls {
+		if typeDecl, ok := decl.(*ast.GenDecl); ok && typeDecl.Tok == token.TYPE {
+			for _, spec := range typeDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if isClient(typeSpec) {
+					clients[typeSpec.Name.Name] = &client{Name: typeSpec.Name.Name}
+				}
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || !funcDecl.Name.IsExported() || !isOperation(funcDecl.Type) {
+			continue
+		}
+
+		// the operations have value receivers, the configuration methods pointer receivers
+		receiver, ok := funcDecl.Recv.List[0].Type.(*ast.Ident)
+		if !ok || clients[receiver.Name] == nil {
+			continue
+		}
+
+		signature := string(content[fileSet.Position(funcDecl.Type.Params.Pos()).Offset:fileSet.Position(funcDecl.Type.End()).Offset])
+		clients[receiver.Name].Operations = append(clients[receiver.Name].Operations, operation{
+			Doc:       docLines(funcDecl.Doc),
+			Name:      funcDecl.Name.Name,
+			Signature: signature,
+		})
+	}
+
+	if len(clients) == 0 {
+		return nil, nil
+	}
+
+	result := &clientFile{Package: file.Name.Name}
+	for _, client := range clients {
+		result.Clients = append(result.Clients, *client)
+	}
+	sort.Slice(result.Clients, func(i, j int) bool {
+		return result.Clients[i].Name < result.Clients[j].Name
+	})
+	return result, nil
+}
+
+// isClient returns whether a type is a service client, a struct embedding common.BaseClient
+func isClient(typeSpec *ast.TypeSpec) bool {
+	structType, ok := typeSpec.Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+
+	for _, field := range structType.Fields.List {
+		if selector, ok := field.Type.(*ast.SelectorExpr); ok && len(field.Names) == 0 && selector.Sel.Name == "BaseClient" {
+			return true
+		}
+	}
+	return false
+}
+
+// isOperation returns whether a method has the signature of an operation,
+// (ctx context.Context, request XRequest) (response XResponse, err error)
+func isOperation(funcType *ast.FuncType) bool {
+	if funcType.Params.NumFields() != 2 || funcType.Results.NumFields() != 2 {
+		return false
+	}
+
+	request, ok := funcType.Params.List[len(funcType.Params.List)-1].Type.(*ast.Ident)
+	if !ok || !strings.HasSuffix(request.Name, "Request") {
+		return false
+	}
+
+	response, ok := funcType.Results.List[0].Type.(*ast.Ident)
+	return ok && strings.HasSuffix(response.Name, "Response")
+}
+
+func docLines(doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+
+	var lines []string
+	for _, comment := range doc.List {
+		lines = append(lines, comment.Text)
+	}
+	return lines
+}
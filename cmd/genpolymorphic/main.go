@@ -0,0 +1,143 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package main The following code is used to generate the (un)marshaling of the polymorphic models
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// generatedMarker marks the generated client files, whose packages get a polymorphic file
+const generatedMarker = "// Code generated. DO NOT EDIT."
+
+var root = flag.String("root", ".", "root directory of the sdk")
+
+// model is a polymorphic model, an interface unmarshaled by the base struct of its types
+type model struct {
+	Interface string
+	Base      string
+}
+
+// polymorphicFile is the (un)marshaling of the polymorphic models of a package
+type polymorphicFile struct {
+	Package string
+	Models  []model
+}
+
+// Generates the file <package>_polymorphic.go, which registers the polymorphic models of the package in common, and
+// marshals the models of unknown types, in every package with a generated client file and polymorphic models
+func main() {
+	flag.Parse()
+	genTemplate := template.Must(template.New("polymorphic").Parse(polymorphicTemplate))
+
+	files, err := filepath.Glob(filepath.Join(*root, "*", "*_client.go"))
+	if err != nil {
+		log.Fatalf("could not list the client files: %s", err)
+	}
+
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Fatalf("could not read %s: %s", file, err)
+		}
+		if bytes.Contains(content, []byte(generatedMarker)) {
+			dirs[filepath.Dir(file)] = true
+		}
+	}
+
+	for dir := range dirs {
+		output := filepath.Join(dir, filepath.Base(dir)+"_polymorphic.go")
+		parsed, err := parsePackage(dir, output)
+		if err != nil {
+			log.Fatalf("could not parse %s: %s", dir, err)
+		}
+		if len(parsed.Models) == 0 {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := genTemplate.Execute(&buf, parsed); err != nil {
+			log.Fatalf("error while generating the polymorphic models of %s: %s", dir, err)
+		}
+
+		source, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("error while formatting the polymorphic models of %s: %s", dir, err)
+		}
+
+		if err := ioutil.WriteFile(output, source, 0644); err != nil {
+			log.Fatalf("could not write output file: %s", err)
+		}
+	}
+}
+
+// parsePackage returns the polymorphic models of the package in a directory: the interfaces whose base struct, named
+// after them in lower case, has an UnmarshalPolymorphicJSON method
+func parsePackage(dir, output string) (*polymorphicFile, error) {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != filepath.Base(output)
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &polymorphicFile{}
+	for name, pkg := range packages {
+		result.Package = name
+
+		interfaces := make(map[string]bool)
+		bases := make(map[string]bool)
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.GenDecl:
+					if decl.Tok != token.TYPE {
+						continue
+					}
+					for _, spec := range decl.Specs {
+						typeSpec := spec.(*ast.TypeSpec)
+						if _, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.IsExported() {
+							interfaces[typeSpec.Name.Name] = true
+						}
+					}
+				case *ast.FuncDecl:
+					if decl.Name.Name != "UnmarshalPolymorphicJSON" || decl.Recv == nil {
+						continue
+					}
+					if receiver, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+						if ident, ok := receiver.X.(*ast.Ident); ok {
+							bases[ident.Name] = true
+						}
+					}
+				}
+			}
+		}
+
+		for name := range interfaces {
+			if base := strings.ToLower(name); base != name && bases[base] {
+				result.Models = append(result.Models, model{Interface: name, Base: base})
+			}
+		}
+	}
+
+	sort.Slice(result.Models, func(i, j int) bool {
+		return result.Models[i].Interface < result.Models[j].Interface
+	})
+	return result, nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package main
+
+const polymorphicTemplate = `// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+{{- range .Models}}
+	common.RegisterPolymorphicModel((*{{.Interface}})(nil), func() common.PolymorphicJSONUnmarshaler { return &{{.Base}}{} })
+{{- end}}
+}
+{{range .Models}}
+// MarshalJSON marshals a {{.Interface}} whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m {{.Base}}) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalType{{.Base}} {{.Base}}
+	s := struct {
+		MarshalType{{.Base}}
+		JsonData []byte ` + "`json:\"-\"`" + `
+	}{
+		MarshalType{{.Base}}: (MarshalType{{.Base}})(m),
+	}
+	return json.Marshal(&s)
+}
+{{end}}`
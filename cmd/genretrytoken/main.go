@@ -0,0 +1,136 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package main The following code is used to return the retry tokens of the requests on their responses
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// generatedMarker marks the generated client files, the only ones rewritten
+const generatedMarker = "// Code generated. DO NOT EDIT."
+
+// tokenGeneration is the statement of the operations generating the retry token of their request when it is not set
+const tokenGeneration = "request.OpcRetryToken = common.String(common.RetryToken())"
+
+// tokenEcho is the statement returning the retry token of the request on the response
+const tokenEcho = "response.OpcRetryToken = request.OpcRetryToken"
+
+// tokenField is the field of the responses returning the retry token
+const tokenField = `
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
+`
+
+var root = flag.String("root", ".", "root directory of the sdk")
+
+var (
+	// requestToken matches the retry token header of a request
+	requestToken = regexp.MustCompile(`(?m)^\s+OpcRetryToken \*string .*contributesTo:"header"`)
+
+	// responseStruct matches the declaration of a response struct, up to its closing brace
+	responseStruct = regexp.MustCompile(`(?s)type \w+Response struct \{.*?\n\}\n`)
+
+	// errorReturn matches the return of an operation which failed, before converting its response
+	errorReturn = regexp.MustCompile(`(\n\t\t\}\n)(\t\treturn\n\t\}\n\tif convertedResponse, ok := )`)
+
+	// convertedReturn matches the assignment of the converted response of an operation
+	convertedReturn = regexp.MustCompile(`(\n\t\tresponse = convertedResponse\n)(\t\} else)`)
+)
+
+// Rewrites the generated clients and responses, so that the operations sending a retry token return it on their
+// response, whether they succeed or fail. The rewriting is idempotent, it is run after generating the clients. It
+// fails if the code of an operation generating a retry token is not the one expected, rather than leaving the
+// operation without its token.
+func main() {
+	flag.Parse()
+
+	clients, err := filepath.Glob(filepath.Join(*root, "*", "*_client.go"))
+	if err != nil {
+		log.Fatalf("could not list the client files: %s", err)
+	}
+	for _, file := range clients {
+		rewrite(file, func(content []byte) ([]byte, error) {
+			if !bytes.Contains(content, []byte(generatedMarker)) {
+				return content, nil
+			}
+			return rewriteClient(content)
+		})
+	}
+
+	requests, err := filepath.Glob(filepath.Join(*root, "*", "*_request_response.go"))
+	if err != nil {
+		log.Fatalf("could not list the request files: %s", err)
+	}
+	for _, file := range requests {
+		rewrite(file, func(content []byte) ([]byte, error) {
+			return rewriteResponse(content), nil
+		})
+	}
+}
+
+// rewrite replaces the content of a file, if it changed
+func rewrite(file string, rewriter func([]byte) ([]byte, error)) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		log.Fatalf("could not read %s: %s", file, err)
+	}
+
+	rewritten, err := rewriter(content)
+	if err != nil {
+		log.Fatalf("could not rewrite %s: %s", file, err)
+	}
+
+	if bytes.Equal(rewritten, content) {
+		return
+	}
+
+	// the generated files are not formatted again, not to reformat the code of the generator
+	if err := ioutil.WriteFile(file, rewritten, 0644); err != nil {
+		log.Fatalf("could not write %s: %s", file, err)
+	}
+}
+
+// rewriteClient makes the operations generating a retry token set it on their response, after a failure, including
+// when there is no response at all, and after a success. It returns an error if an operation does not match both
+// patterns, for example once the generator changes its output.
+func rewriteClient(content []byte) ([]byte, error) {
+	methods := strings.Split(string(content), "\nfunc ")
+	for i, method := range methods {
+		if !strings.Contains(method, tokenGeneration) || strings.Contains(method, tokenEcho) {
+			continue
+		}
+
+		if !errorReturn.MatchString(method) || !convertedReturn.MatchString(method) {
+			signature := strings.TrimSuffix(strings.SplitN(method, "\n", 2)[0], " {")
+			return nil, fmt.Errorf("the operation %s does not match the code expected to return its retry token", signature)
+		}
+		method = errorReturn.ReplaceAllString(method, "${1}\t\t"+tokenEcho+"\n${2}")
+		methods[i] = convertedReturn.ReplaceAllString(method, "${1}\t\t"+tokenEcho+"\n${2}")
+	}
+	return []byte(strings.Join(methods, "\nfunc ")), nil
+}
+
+// rewriteResponse adds the retry token field to the response of a request sending one
+func rewriteResponse(content []byte) []byte {
+	if !requestToken.Match(content) {
+		return content
+	}
+
+	return responseStruct.ReplaceAllFunc(content, func(declaration []byte) []byte {
+		if bytes.Contains(declaration, []byte("\tOpcRetryToken *string\n")) {
+			return declaration
+		}
+		end := len(declaration) - len("}\n")
+		return append(append(append([]byte{}, declaration[:end]...), tokenField...), declaration[end:]...)
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package main The following code is used to generate the validation of the requests and of their details
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// generatedMarker marks the generated client files, whose packages get a validation file
+const generatedMarker = "// Code generated. DO NOT EDIT."
+
+var root = flag.String("root", ".", "root directory of the sdk")
+
+// validationFile is the validation of the requests and of the details of a package
+type validationFile struct {
+	Package  string
+	Enums    []string
+	Requests []string
+	Details  []string
+}
+
+// Generates the file <package>_validation.go, with a Validate method per request and details struct, in every
+// package with a generated client file
+func main() {
+	flag.Parse()
+	genTemplate := template.Must(template.New("validation").Parse(validationTemplate))
+
+	files, err := filepath.Glob(filepath.Join(*root, "*", "*_client.go"))
+	if err != nil {
+		log.Fatalf("could not list the client files: %s", err)
+	}
+
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Fatalf("could not read %s: %s", file, err)
+		}
+		if bytes.Contains(content, []byte(generatedMarker)) {
+			dirs[filepath.Dir(file)] = true
+		}
+	}
+
+	for dir := range dirs {
+		output := filepath.Join(dir, filepath.Base(dir)+"_validation.go")
+		parsed, err := parsePackage(dir, output)
+		if err != nil {
+			log.Fatalf("could not parse %s: %s", dir, err)
+		}
+
+		var buf bytes.Buffer
+		if err := genTemplate.Execute(&buf, parsed); err != nil {
+			log.Fatalf("error while generating the validation of %s: %s", dir, err)
+		}
+
+		source, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("error while formatting the validation of %s: %s", dir, err)
+		}
+
+		if err := ioutil.WriteFile(output, source, 0644); err != nil {
+			log.Fatalf("could not write output file: %s", err)
+		}
+	}
+}
+
+// parsePackage returns the requests, the details and the enum types of the package in a directory, leaving out the
+// tests and the previously generated output
+func parsePackage(dir, output string) (*validationFile, error) {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != filepath.Base(output)
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &validationFile{}
+	for name, pkg := range packages {
+		result.Package = name
+		for path, file := range pkg.Files {
+			isRequestFile := strings.HasSuffix(path, "_request_response.go")
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.GenDecl:
+					for _, typeName := range structTypes(decl) {
+						if isRequestFile && strings.HasSuffix(typeName, "Request") {
+							result.Requests = append(result.Requests, typeName)
+						} else if strings.HasSuffix(typeName, "Details") {
+							result.Details = append(result.Details, typeName)
+						}
+					}
+				case *ast.FuncDecl:
+					if isEnumValues(decl) {
+						result.Enums = append(result.Enums, decl.Name.Name)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(result.Enums)
+	sort.Strings(result.Requests)
+	sort.Strings(result.Details)
+	return result, nil
+}
+
+// structTypes returns the exported struct types of a declaration
+func structTypes(decl *ast.GenDecl) []string {
+	if decl.Tok != token.TYPE {
+		return nil
+	}
+
+	var names []string
+	for _, spec := range decl.Specs {
+		typeSpec := spec.(*ast.TypeSpec)
+		if _, ok := typeSpec.Type.(*ast.StructType); ok && typeSpec.Name.IsExported() {
+			names = append(names, typeSpec.Name.Name)
+		}
+	}
+	return names
+}
+
+// isEnumValues returns whether a function returns the values of an enum type, func GetXxxEnumValues() []XxxEnum
+func isEnumValues(funcDecl *ast.FuncDecl) bool {
+	name := funcDecl.Name.Name
+	return funcDecl.Recv == nil && strings.HasPrefix(name, "Get") && strings.HasSuffix(name, "EnumValues") &&
+		funcDecl.Type.Params.NumFields() == 0 && funcDecl.Type.Results.NumFields() == 1
+}
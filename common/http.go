@@ -15,6 +15,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -67,7 +68,7 @@ func toStringValue(v reflect.Value, field reflect.StructField) (string, error) {
 	}
 }
 
-func addBinaryBody(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+func addBinaryBody(request *http.Request, value reflect.Value, field *requestField) (e error) {
 	readCloser, ok := value.Interface().(io.ReadCloser)
 	isMandatory, err := strconv.ParseBool(field.Tag.Get("mandatory"))
 	if err != nil {
@@ -88,9 +89,61 @@ func addBinaryBody(request *http.Request, value reflect.Value, field reflect.Str
 	return nil
 }
 
-// getTaggedNilFieldNameOrError, evaluates if a field with json and  non mandatory tags is nil
+// jsonField is a field of a struct with a json tag, whose nil value may have to be removed from its json
+// representation
+type jsonField struct {
+	index int
+	name  string
+
+	// whether the field is tagged with mandatory:false and its type can be nil, the nil values are then removed
+	omitNil bool
+
+	// whether the json representation of the field is not adjusted, the times being marshaled as strings
+	skipNested bool
+
+	// the error of the tags of the field, returned when the field is adjusted
+	err error
+}
+
+// jsonFieldsCache is the json fields of the struct types, keyed by reflect.Type
+var jsonFieldsCache sync.Map
+
+// jsonFieldsFor returns the exported fields of a struct type with a json tag, computed once per type
+func jsonFieldsFor(typ reflect.Type) []jsonField {
+	if fields, ok := jsonFieldsCache.Load(typ); ok {
+		return fields.([]jsonField)
+	}
+
+	var fields []jsonField
+	for i := 0; i < typ.NumField(); i++ {
+		currentField := typ.Field(i)
+		//unexported skip
+		if currentField.PkgPath != "" {
+			continue
+		}
+
+		//Does not have json tag, no-op
+		if _, ok := currentField.Tag.Lookup("json"); !ok {
+			continue
+		}
+
+		field := jsonField{index: i}
+		var err error
+		if field.omitNil, field.name, err = getTaggedNilFieldNameOrError(currentField); err != nil {
+			field.err = fmt.Errorf("can not omit nil fields for field: %s, due to: %s", currentField.Name, err.Error())
+		}
+		field.skipNested = currentField.Type == timeType || currentField.Type == timeTypePtr ||
+			currentField.Type == sdkDateType || currentField.Type == sdkDateTypePtr
+		fields = append(fields, field)
+	}
+
+	jsonFieldsCache.Store(typ, fields)
+	return fields
+}
+
+// getTaggedNilFieldNameOrError, evaluates if a field with json and non mandatory tags can be omitted when nil
 // returns the json tag name, or an error if the tags are incorrectly present
-func getTaggedNilFieldNameOrError(field reflect.StructField, fieldValue reflect.Value) (bool, string, error) {
+func getTaggedNilFieldNameOrError(field reflect.StructField) (bool, string, error) {
 	currentTag := field.Tag
 	jsonTag := currentTag.Get("json")
 
@@ -118,19 +171,19 @@ func getTaggedNilFieldNameOrError(field reflect.StructField, fieldValue reflect.
 	Debugf("Adjusting tag: mandatory is false and json tag is valid on field: %s", field.Name)
 
 	// If the field can not be nil, then no-op
-	if !isNillableType(&fieldValue) {
+	if !isNillableType(field.Type) {
 		Debugf("WARNING json field is tagged with mandatory flags, but the type can not be nil, field name: %s", field.Name)
 		return false, nameJSONField, nil
 	}
 
 	// If field value is nil, tag it as omitEmpty
-	return fieldValue.IsNil(), nameJSONField, nil
+	return true, nameJSONField, nil
 
 }
 
 // isNillableType returns true if the filed can be nil
-func isNillableType(value *reflect.Value) bool {
-	k := value.Kind()
+func isNillableType(typ reflect.Type) bool {
+	k := typ.Kind()
 	switch k {
 	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Slice:
 		return true
@@ -143,51 +196,40 @@ func isNillableType(value *reflect.Value) bool {
 func omitNilFieldsInJSON(data interface{}, value reflect.Value) (interface{}, error) {
 	switch value.Kind() {
 	case reflect.Struct:
-		jsonMap := data.(map[string]interface{})
+		// the structs marshaled to other json values, such as the times, are not adjusted
+		jsonMap, ok := data.(map[string]interface{})
+		if !ok {
+			return data, nil
+		}
 		fieldType := value.Type()
-		for i := 0; i < fieldType.NumField(); i++ {
-			currentField := fieldType.Field(i)
-			//unexported skip
-			if currentField.PkgPath != "" {
-				continue
-			}
-
-			//Does not have json tag, no-op
-			if _, ok := currentField.Tag.Lookup("json"); !ok {
-				continue
-			}
-
-			currentFieldValue := value.Field(i)
-			ok, jsonFieldName, err := getTaggedNilFieldNameOrError(currentField, currentFieldValue)
-			if err != nil {
-				return nil, fmt.Errorf("can not omit nil fields for field: %s, due to: %s",
-					currentField.Name, err.Error())
+		for _, field := range jsonFieldsFor(fieldType) {
+			if field.err != nil {
+				return nil, field.err
 			}
 
+			currentFieldValue := value.Field(field.index)
 			//Delete the struct field from the json representation
-			if ok {
-				delete(jsonMap, jsonFieldName)
+			if field.omitNil && currentFieldValue.IsNil() {
+				delete(jsonMap, field.name)
 				continue
 			}
 
 			// Check to make sure the field is part of the json representation of the value
-			if _, contains := jsonMap[jsonFieldName]; !contains {
-				Debugf("Field %s is not present in json, omitting", jsonFieldName)
+			if _, contains := jsonMap[field.name]; !contains {
+				Debugf("Field %s is not present in json, omitting", field.name)
 				continue
 			}
 
-			if currentFieldValue.Type() == timeType || currentFieldValue.Type() == timeTypePtr ||
-				currentField.Type == sdkDateType || currentField.Type == sdkDateTypePtr {
+			if field.skipNested {
 				continue
 			}
 			// does it need to be adjusted?
-			var adjustedValue interface{}
-			adjustedValue, err = omitNilFieldsInJSON(jsonMap[jsonFieldName], currentFieldValue)
+			adjustedValue, err := omitNilFieldsInJSON(jsonMap[field.name], currentFieldValue)
 			if err != nil {
 				return nil, fmt.Errorf("can not omit nil fields for field: %s, due to: %s",
-					currentField.Name, err.Error())
+					fieldType.Field(field.index).Name, err.Error())
 			}
-			jsonMap[jsonFieldName] = adjustedValue
+			jsonMap[field.name] = adjustedValue
 		}
 		return jsonMap, nil
 	case reflect.Slice, reflect.Array:
@@ -249,15 +291,13 @@ func removeNilFieldsInJSONWithTaggedStruct(rawJSON []byte, value reflect.Value)
 	return json.Marshal(fixedMap)
 }
 
-func addToBody(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+func addToBody(request *http.Request, value reflect.Value, field *requestField) (e error) {
 	Debugln("Marshaling to body from field:", field.Name)
 	if request.Body != nil {
 		Logf("The body of the request is already set. Structure: %s will overwrite it\n", field.Name)
 	}
-	tag := field.Tag
-	encoding := tag.Get("encoding")
 
-	if encoding == "binary" {
+	if field.encoding == "binary" {
 		return addBinaryBody(request, value, field)
 	}
 
@@ -288,19 +328,20 @@ func addToBody(request *http.Request, value reflect.Value, field reflect.StructF
 	return
 }
 
-func addToQuery(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+// addToQuery adds a field to the query parameters of the request, parsed from its url the first time, the url being
+// updated once all the fields are added
+func addToQuery(request *http.Request, query *url.Values, value reflect.Value, field *requestField) (e error) {
 	Debugln("Marshaling to query from field: ", field.Name)
 	if request.URL == nil {
 		request.URL = &url.URL{}
 	}
-	query := request.URL.Query()
 	var queryParameterValue, queryParameterName string
 
-	if queryParameterName = field.Tag.Get("name"); queryParameterName == "" {
+	if queryParameterName = field.name; queryParameterName == "" {
 		return fmt.Errorf("marshaling request to a query requires the 'name' tag for field: %s ", field.Name)
 	}
 
-	mandatory, _ := strconv.ParseBool(strings.ToLower(field.Tag.Get("mandatory")))
+	mandatory := field.mandatory
 
 	//If mandatory and nil. Error out
 	if mandatory && isNil(value) {
@@ -313,7 +354,7 @@ func addToQuery(request *http.Request, value reflect.Value, field reflect.Struct
 		return
 	}
 
-	encoding := strings.ToLower(field.Tag.Get("collectionFormat"))
+	encoding := field.collectionFormat
 	var collectionFormatStringValues []string
 	switch encoding {
 	case "csv", "multi":
@@ -325,14 +366,14 @@ func addToQuery(request *http.Request, value reflect.Value, field reflect.Struct
 		numOfElements := value.Len()
 		collectionFormatStringValues = make([]string, numOfElements)
 		for i := 0; i < numOfElements; i++ {
-			collectionFormatStringValues[i], e = toStringValue(value.Index(i), field)
+			collectionFormatStringValues[i], e = toStringValue(value.Index(i), field.StructField)
 			if e != nil {
 				break
 			}
 		}
 		queryParameterValue = strings.Join(collectionFormatStringValues, ",")
 	case "":
-		queryParameterValue, e = toStringValue(value, field)
+		queryParameterValue, e = toStringValue(value, field.StructField)
 	default:
 		e = fmt.Errorf("encoding of type %s is not supported for query param: %s", encoding, field.Name)
 	}
@@ -341,20 +382,17 @@ func addToQuery(request *http.Request, value reflect.Value, field reflect.Struct
 		return
 	}
 
+	if *query == nil {
+		*query = request.URL.Query()
+	}
+
 	//check for tag "omitEmpty", this is done to accomodate unset fields that do not
 	//support an empty string: enums in query params
-	if omitEmpty, present := field.Tag.Lookup("omitEmpty"); present {
-		omitEmptyBool, _ := strconv.ParseBool(strings.ToLower(omitEmpty))
-		if queryParameterValue != "" || !omitEmptyBool {
-			addToQueryForEncoding(&query, encoding, queryParameterName, queryParameterValue, collectionFormatStringValues)
-		} else {
-			Debugf("Omitting %s, is empty and omitEmpty tag is set", field.Name)
-		}
+	if queryParameterValue != "" || !field.omitEmpty {
+		addToQueryForEncoding(query, encoding, queryParameterName, queryParameterValue, collectionFormatStringValues)
 	} else {
-		addToQueryForEncoding(&query, encoding, queryParameterName, queryParameterValue, collectionFormatStringValues)
+		Debugf("Omitting %s, is empty and omitEmpty tag is set", field.Name)
 	}
-
-	request.URL.RawQuery = query.Encode()
 	return
 }
 
@@ -368,10 +406,13 @@ func addToQueryForEncoding(query *url.Values, encoding string, queryParameterNam
 	}
 }
 
+// templatedPathRegex matches the paths with templates, such as /users/{userId}
+var templatedPathRegex = regexp.MustCompile(".*{.+}.*")
+
 // Adds to the path of the url in the order they appear in the structure
-func addToPath(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+func addToPath(request *http.Request, value reflect.Value, field *requestField) (e error) {
 	var additionalURLPathPart string
-	if additionalURLPathPart, e = toStringValue(value, field); e != nil {
+	if additionalURLPathPart, e = toStringValue(value, field.StructField); e != nil {
 		return fmt.Errorf("can not marshal to path in request for field %s. Due to %s", field.Name, e.Error())
 	}
 
@@ -386,14 +427,13 @@ func addToPath(request *http.Request, value reflect.Value, field reflect.StructF
 	}
 	var currentURLPath = request.URL.Path
 
-	var templatedPathRegex, _ = regexp.Compile(".*{.+}.*")
 	if !templatedPathRegex.MatchString(currentURLPath) {
 		Debugln("Marshaling request to path by appending field:", field.Name)
 		allPath := []string{currentURLPath, additionalURLPathPart}
 		request.URL.Path = strings.Join(allPath, "/")
 	} else {
 		var fieldName string
-		if fieldName = field.Tag.Get("name"); fieldName == "" {
+		if fieldName = field.name; fieldName == "" {
 			e = fmt.Errorf("marshaling request to path name and template requires a 'name' tag for field: %s", field.Name)
 			return
 		}
@@ -417,18 +457,18 @@ func setWellKnownHeaders(request *http.Request, headerName, headerValue string)
 	return nil
 }
 
-func addToHeader(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+func addToHeader(request *http.Request, value reflect.Value, field *requestField) (e error) {
 	Debugln("Marshaling to header from field: ", field.Name)
 	if request.Header == nil {
 		request.Header = http.Header{}
 	}
 
 	var headerName, headerValue string
-	if headerName = field.Tag.Get("name"); headerName == "" {
+	if headerName = field.name; headerName == "" {
 		return fmt.Errorf("marshaling request to a header requires the 'name' tag for field: %s", field.Name)
 	}
 
-	mandatory, _ := strconv.ParseBool(strings.ToLower(field.Tag.Get("mandatory")))
+	mandatory := field.mandatory
 	//If mandatory and nil. Error out
 	if mandatory && isNil(value) {
 		return fmt.Errorf("marshaling request to a header requires not nil pointer for field: %s", field.Name)
@@ -444,7 +484,7 @@ func addToHeader(request *http.Request, value reflect.Value, field reflect.Struc
 	}
 
 	//Otherwise get value and set header
-	if headerValue, e = toStringValue(value, field); e != nil {
+	if headerValue, e = toStringValue(value, field.StructField); e != nil {
 		return
 	}
 
@@ -466,18 +506,18 @@ func isUniqueHeaderRequired(headerName string) bool {
 }
 
 // Header collection is a map of string to string that gets rendered as individual headers with a given prefix
-func addToHeaderCollection(request *http.Request, value reflect.Value, field reflect.StructField) (e error) {
+func addToHeaderCollection(request *http.Request, value reflect.Value, field *requestField) (e error) {
 	Debugln("Marshaling to header-collection from field:", field.Name)
 	if request.Header == nil {
 		request.Header = http.Header{}
 	}
 
 	var headerPrefix string
-	if headerPrefix = field.Tag.Get("prefix"); headerPrefix == "" {
+	if headerPrefix = field.prefix; headerPrefix == "" {
 		return fmt.Errorf("marshaling request to a header requires the 'prefix' tag for field: %s", field.Name)
 	}
 
-	mandatory, _ := strconv.ParseBool(strings.ToLower(field.Tag.Get("mandatory")))
+	mandatory := field.mandatory
 	//If mandatory and nil. Error out
 	if mandatory && isNil(value) {
 		return fmt.Errorf("marshaling request to a header requires not nil pointer for field: %s", field.Name)
@@ -525,41 +565,105 @@ func checkForValidRequestStruct(s interface{}) (*reflect.Value, error) {
 	return &val, nil
 }
 
-// Populates the parts of a request by reading tags in the passed structure
-// nested structs are followed recursively depth-first.
-func structToRequestPart(request *http.Request, val reflect.Value) (err error) {
-	typ := val.Type()
-	for i := 0; i < typ.NumField(); i++ {
-		if err != nil {
-			return
-		}
+// requestField is a field of a request struct contributing to the http request, with its parsed tags
+type requestField struct {
+	reflect.StructField
+
+	// the contributesTo tag: header, header-collection, path, query or body
+	contributesTo string
+
+	// the name tag of a header, path or query field
+	name string
+
+	// the prefix tag of a header collection
+	prefix string
+
+	// the mandatory tag of a header, header collection or query field, false if it is not valid
+	mandatory bool
+
+	// the encoding tag of a body
+	encoding string
+
+	// the lower cased collectionFormat tag of a query field
+	collectionFormat string
+
+	// the omitEmpty tag of a query field
+	omitEmpty bool
+}
 
+// requestFieldsCache is the fields of the request structs contributing to the http requests, keyed by reflect.Type
+var requestFieldsCache sync.Map
+
+// requestFieldsFor returns the fields of a request struct type contributing to the http request, in the order they
+// appear in the structure. They are computed once per type, instead of reading the tags of every request.
+func requestFieldsFor(typ reflect.Type) []requestField {
+	if fields, ok := requestFieldsCache.Load(typ); ok {
+		return fields.([]requestField)
+	}
+
+	var fields []requestField
+	for i := 0; i < typ.NumField(); i++ {
 		sf := typ.Field(i)
 		//unexported
 		if sf.PkgPath != "" && !sf.Anonymous {
 			continue
 		}
 
-		sv := val.Field(i)
-		tag := sf.Tag.Get("contributesTo")
-		switch tag {
+		contributesTo := sf.Tag.Get("contributesTo")
+		if contributesTo == "" {
+			Debugln(sf.Name, " does not contain contributes tag. Skipping.")
+			continue
+		}
+
+		field := requestField{
+			StructField:      sf,
+			contributesTo:    contributesTo,
+			name:             sf.Tag.Get("name"),
+			prefix:           sf.Tag.Get("prefix"),
+			encoding:         sf.Tag.Get("encoding"),
+			collectionFormat: strings.ToLower(sf.Tag.Get("collectionFormat")),
+		}
+		field.mandatory, _ = strconv.ParseBool(strings.ToLower(sf.Tag.Get("mandatory")))
+		field.omitEmpty, _ = strconv.ParseBool(strings.ToLower(sf.Tag.Get("omitEmpty")))
+		fields = append(fields, field)
+	}
+
+	requestFieldsCache.Store(typ, fields)
+	return fields
+}
+
+// Populates the parts of a request by reading tags in the passed structure
+// nested structs are followed recursively depth-first.
+func structToRequestPart(request *http.Request, val reflect.Value) (err error) {
+	fields := requestFieldsFor(val.Type())
+	var query url.Values
+	for i := range fields {
+		field := &fields[i]
+		sv := val.Field(field.Index[0])
+		switch field.contributesTo {
 		case "header":
-			err = addToHeader(request, sv, sf)
+			err = addToHeader(request, sv, field)
 		case "header-collection":
-			err = addToHeaderCollection(request, sv, sf)
+			err = addToHeaderCollection(request, sv, field)
 		case "path":
-			err = addToPath(request, sv, sf)
+			err = addToPath(request, sv, field)
 		case "query":
-			err = addToQuery(request, sv, sf)
+			err = addToQuery(request, &query, sv, field)
 		case "body":
-			err = addToBody(request, sv, sf)
-		case "":
-			Debugln(sf.Name, " does not contain contributes tag. Skipping.")
+			err = addToBody(request, sv, field)
 		default:
-			err = fmt.Errorf("can not marshal field: %s. It needs to contain valid contributesTo tag", sf.Name)
+			err = fmt.Errorf("can not marshal field: %s. It needs to contain valid contributesTo tag", field.Name)
+		}
+
+		if err != nil {
+			return
 		}
 	}
 
+	if query != nil {
+		request.URL.RawQuery = query.Encode()
+	}
+
 	//If headers are and the content type was not set, we default to application/json
 	if request.Header != nil && request.Header.Get(requestHeaderContentType) == "" {
 		request.Header.Set(requestHeaderContentType, "application/json")
@@ -815,17 +919,15 @@ func valueFromJSONBody(response *http.Response, value *reflect.Value, unmarshale
 	return
 }
 
-func addFromBody(response *http.Response, value *reflect.Value, field reflect.StructField, unmarshaler PolymorphicJSONUnmarshaler) (err error) {
+func addFromBody(response *http.Response, value *reflect.Value, field *responseField, unmarshaler PolymorphicJSONUnmarshaler) (err error) {
 	Debugln("Unmarshaling from body to field: ", field.Name)
 	if response.Body == nil {
 		Debugln("Unmarshaling body skipped due to nil body content for field: ", field.Name)
 		return nil
 	}
 
-	tag := field.Tag
-	encoding := tag.Get("encoding")
 	var iVal interface{}
-	switch encoding {
+	switch field.encoding {
 	case "binary":
 		value.Set(reflect.ValueOf(response.Body))
 		return
@@ -853,10 +955,10 @@ func addFromBody(response *http.Response, value *reflect.Value, field reflect.St
 	}
 }
 
-func addFromHeader(response *http.Response, value *reflect.Value, field reflect.StructField) (err error) {
+func addFromHeader(response *http.Response, value *reflect.Value, field *responseField) (err error) {
 	Debugln("Unmarshaling from header to field: ", field.Name)
 	var headerName string
-	if headerName = field.Tag.Get("name"); headerName == "" {
+	if headerName = field.name; headerName == "" {
 		return fmt.Errorf("unmarshaling response to a header requires the 'name' tag for field: %s", field.Name)
 	}
 
@@ -866,17 +968,17 @@ func addFromHeader(response *http.Response, value *reflect.Value, field reflect.
 		return nil
 	}
 
-	if err = fromStringValue(headerValue, value, field); err != nil {
+	if err = fromStringValue(headerValue, value, field.StructField); err != nil {
 		return fmt.Errorf("unmarshaling response to a header failed for field %s, due to %s", field.Name,
 			err.Error())
 	}
 	return
 }
 
-func addFromHeaderCollection(response *http.Response, value *reflect.Value, field reflect.StructField) error {
+func addFromHeaderCollection(response *http.Response, value *reflect.Value, field *responseField) error {
 	Debugln("Unmarshaling from header-collection to field:", field.Name)
 	var headerPrefix string
-	if headerPrefix = field.Tag.Get("prefix"); headerPrefix == "" {
+	if headerPrefix = field.prefix; headerPrefix == "" {
 		return fmt.Errorf("Unmarshaling response to a header-collection requires the 'prefix' tag for field: %s", field.Name)
 	}
 
@@ -894,14 +996,35 @@ func addFromHeaderCollection(response *http.Response, value *reflect.Value, fiel
 	return nil
 }
 
-// Populates a struct from parts of a request by reading tags of the struct
-func responseToStruct(response *http.Response, val *reflect.Value, unmarshaler PolymorphicJSONUnmarshaler) (err error) {
-	typ := val.Type()
-	for i := 0; i < typ.NumField(); i++ {
-		if err != nil {
-			return
-		}
+// responseField is a field of a response struct present in the http response, with its parsed tags
+type responseField struct {
+	reflect.StructField
+
+	// the presentIn tag: header, header-collection or body
+	presentIn string
+
+	// the name tag of a header field
+	name string
+
+	// the prefix tag of a header collection
+	prefix string
+
+	// the encoding tag of a body
+	encoding string
+}
+
+// responseFieldsCache is the fields of the response structs present in the http responses, keyed by reflect.Type
+var responseFieldsCache sync.Map
+
+// responseFieldsFor returns the fields of a response struct type present in the http response, computed once per
+// type
+func responseFieldsFor(typ reflect.Type) []responseField {
+	if fields, ok := responseFieldsCache.Load(typ); ok {
+		return fields.([]responseField)
+	}
 
+	var fields []responseField
+	for i := 0; i < typ.NumField(); i++ {
 		sf := typ.Field(i)
 
 		//unexported
@@ -909,19 +1032,44 @@ func responseToStruct(response *http.Response, val *reflect.Value, unmarshaler P
 			continue
 		}
 
-		sv := val.Field(i)
-		tag := sf.Tag.Get("presentIn")
-		switch tag {
+		presentIn := sf.Tag.Get("presentIn")
+		if presentIn == "" {
+			Debugln(sf.Name, " does not contain presentIn tag. Skipping")
+			continue
+		}
+
+		fields = append(fields, responseField{
+			StructField: sf,
+			presentIn:   presentIn,
+			name:        sf.Tag.Get("name"),
+			prefix:      sf.Tag.Get("prefix"),
+			encoding:    sf.Tag.Get("encoding"),
+		})
+	}
+
+	responseFieldsCache.Store(typ, fields)
+	return fields
+}
+
+// Populates a struct from parts of a request by reading tags of the struct
+func responseToStruct(response *http.Response, val *reflect.Value, unmarshaler PolymorphicJSONUnmarshaler) (err error) {
+	fields := responseFieldsFor(val.Type())
+	for i := range fields {
+		field := &fields[i]
+		sv := val.Field(field.Index[0])
+		switch field.presentIn {
 		case "header":
-			err = addFromHeader(response, &sv, sf)
+			err = addFromHeader(response, &sv, field)
 		case "header-collection":
-			err = addFromHeaderCollection(response, &sv, sf)
+			err = addFromHeaderCollection(response, &sv, field)
 		case "body":
-			err = addFromBody(response, &sv, sf, unmarshaler)
-		case "":
-			Debugln(sf.Name, " does not contain presentIn tag. Skipping")
+			err = addFromBody(response, &sv, field, unmarshaler)
 		default:
-			err = fmt.Errorf("can not unmarshal field: %s. It needs to contain valid presentIn tag", sf.Name)
+			err = fmt.Errorf("can not unmarshal field: %s. It needs to contain valid presentIn tag", field.Name)
+		}
+
+		if err != nil {
+			return
 		}
 	}
 	return
@@ -106,6 +106,16 @@ func TestHttpRequestMarshallerQuery(t *testing.T) {
 	assert.True(t, query.Get("fields") == "one,two,three")
 }
 
+func TestHttpRequestMarshallerQueryKeepsExistingParameters(t *testing.T) {
+	s := listCompartmentsRequest{CompartmentID: "ocid1", Fields: []string{"one"}}
+	for i := 0; i < 2; i++ {
+		request := MakeDefaultHTTPRequest(http.MethodGet, "/")
+		request.URL.RawQuery = "existing=value&page=old"
+		assert.NoError(t, HTTPRequestMarshaller(s, &request))
+		assert.Equal(t, "compartmentId=ocid1&existing=value&fields=one&limit=0&page=", request.URL.RawQuery)
+	}
+}
+
 func TestMakeDefault(t *testing.T) {
 	r := MakeDefaultHTTPRequest(http.MethodPost, "/one/two")
 	assert.NotEmpty(t, r.Header.Get(requestHeaderDate))
@@ -1477,3 +1487,76 @@ func TestRemoveNilWithInt64Values(t *testing.T) {
 	assert.True(t, strings.Contains(string(jsonRet), "9223372036854775807"))
 	assert.Equal(t, int64(9223372036854775807), *ss.Data)
 }
+
+type benchmarkMessage struct {
+	Key   []byte  `mandatory:"false" json:"key"`
+	Value []byte  `mandatory:"true" json:"value"`
+	Tag   *string `mandatory:"false" json:"tag"`
+}
+
+type TestputMessagesDetails struct {
+	Messages []benchmarkMessage `mandatory:"true" json:"messages"`
+	Metadata map[string]string  `mandatory:"false" json:"metadata"`
+	Time     *SDKTime           `mandatory:"false" json:"time"`
+}
+
+type benchmarkPutMessagesRequest struct {
+	StreamId               *string `mandatory:"true" contributesTo:"path" name:"streamId"`
+	TestputMessagesDetails `contributesTo:"body"`
+	Limit                  *int              `mandatory:"false" contributesTo:"query" name:"limit"`
+	Fields                 []string          `mandatory:"false" contributesTo:"query" name:"fields" collectionFormat:"multi"`
+	Page                   *string           `mandatory:"false" contributesTo:"query" name:"page"`
+	OpcRequestId           *string           `mandatory:"false" contributesTo:"header" name:"opc-request-id"`
+	IfMatch                *string           `mandatory:"false" contributesTo:"header" name:"if-match"`
+	Meta                   map[string]string `mandatory:"false" contributesTo:"header-collection" prefix:"opc-meta-"`
+	RequestMetadata
+}
+
+type benchmarkPutMessagesResponse struct {
+	RawResponse  *http.Response
+	Entries      []benchmarkMessage `presentIn:"body"`
+	OpcRequestId *string            `presentIn:"header" name:"opc-request-id"`
+	Etag         *string            `presentIn:"header" name:"etag"`
+	LastModified *SDKTime           `presentIn:"header" name:"last-modified"`
+	Count        *int               `presentIn:"header" name:"opc-count"`
+}
+
+func BenchmarkHTTPRequestMarshaller(b *testing.B) {
+	request := benchmarkPutMessagesRequest{
+		StreamId: String("ocid1.stream.oc1.phx.aaaaaaaa"),
+		TestputMessagesDetails: TestputMessagesDetails{
+			Messages: []benchmarkMessage{{Value: []byte("value1")}, {Key: []byte("key"), Value: []byte("value2"), Tag: String("tag")}},
+		},
+		Limit:        Int(100),
+		Fields:       []string{"key", "value"},
+		OpcRequestId: String("request-id"),
+		Meta:         map[string]string{"owner": "bench"},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := MakeDefaultHTTPRequestWithTaggedStruct(http.MethodPost, "/streams/{streamId}/messages", request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalResponse(b *testing.B) {
+	body := []byte(`[{"key":"a2V5","value":"dmFsdWU="},{"value":"dmFsdWU="}]`)
+	header := http.Header{}
+	header.Set("opc-request-id", "request-id")
+	header.Set("etag", "etag")
+	header.Set("last-modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+	header.Set("opc-count", "2")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		httpResponse := http.Response{Header: header, Body: ioutil.NopCloser(bytes.NewReader(body))}
+		response := benchmarkPutMessagesResponse{RawResponse: &httpResponse}
+		if err := UnmarshalResponse(&httpResponse, &response); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
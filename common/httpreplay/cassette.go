@@ -0,0 +1,153 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package httpreplay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"unicode/utf8"
+)
+
+// bodyEncodingBase64 is the encoding of the bodies which are not UTF-8 text
+const bodyEncodingBase64 = "base64"
+
+// Cassette is the content of a cassette file, the interactions recorded in order and the variables of the test
+type Cassette struct {
+	// The values of the variables of the test when it was recorded, see Dispatcher.Variable.
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// The recorded interactions, in the order they happened.
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response it received
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as recorded, scrubbed
+type RecordedRequest struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// RecordedResponse is a response as recorded, scrubbed
+type RecordedResponse struct {
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// loadCassette reads a cassette file
+func loadCassette(path string) (*Cassette, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if err = json.Unmarshal(content, cassette); err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
+
+// save writes the cassette file, creating its directory if needed
+func (cassette *Cassette) save(path string) error {
+	content, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// encodeBody returns a body as recorded, as is if it is UTF-8 text, base64 encoded otherwise
+func encodeBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), bodyEncodingBase64
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	if encoding == bodyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+// matches returns whether a scrubbed request is the recorded request, with the same method, path, query and body.
+// The query parameters are compared whatever their order, and the JSON bodies whatever their formatting.
+func (recorded *RecordedRequest) matches(request *RecordedRequest) bool {
+	if recorded.Method != request.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	requestURL, err := url.Parse(request.URL)
+	if err != nil {
+		return false
+	}
+
+	if recordedURL.Path != requestURL.Path || !reflect.DeepEqual(recordedURL.Query(), requestURL.Query()) {
+		return false
+	}
+	return sameBody(recorded.Body, request.Body)
+}
+
+func sameBody(recorded, request string) bool {
+	if recorded == request {
+		return true
+	}
+
+	var recordedJSON, requestJSON interface{}
+	if json.Unmarshal([]byte(recorded), &recordedJSON) != nil || json.Unmarshal([]byte(request), &requestJSON) != nil {
+		return false
+	}
+	return reflect.DeepEqual(recordedJSON, requestJSON)
+}
+
+// response returns the recorded response as the response of a request
+func (recorded *RecordedResponse) response(request *http.Request) (*http.Response, error) {
+	body, err := decodeBody(recorded.Body, recorded.BodyEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	for name, values := range recorded.Header {
+		header[name] = append([]string{}, values...)
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(recorded.StatusCode),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package httpreplay records the requests of the SDK clients and their responses into cassette files, and replays
+// them, to run tests against the services once and then offline and deterministically.
+//
+// A Dispatcher is the HTTPClient of the clients under test. When recording, it sends the requests with the real
+// dispatcher and records them, scrubbed of the credentials, request ids, secrets and real OCIDs. When replaying, it
+// returns the recorded response of each request, matched by method, path, query and body:
+//
+//	dispatcher, err := httpreplay.NewDispatcher("testdata/TestListInstances.json", httpreplay.DispatcherConfiguration{
+//		Mode: httpreplay.ModeFromEnvironment(),
+//	})
+//	defer dispatcher.Close()
+//	compartmentID := dispatcher.Variable("compartmentId", os.Getenv("COMPARTMENT_ID"))
+//	dispatcher.Bind(&client.BaseClient)
+//
+// The OCIDs are scrubbed into stable values, see ScrubOCID, so that the OCIDs returned by the services when
+// replaying can be used in the following requests. The values the test starts with, such as the OCID of a
+// compartment, are recorded as variables, see Dispatcher.Variable.
+package httpreplay
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// Mode is the mode of a dispatcher
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette, the requests which were not recorded fail
+	ModeReplay Mode = iota
+
+	// ModeRecord sends the requests and records the interactions into the cassette, replacing it
+	ModeRecord
+)
+
+// modeEnvironmentVariable is the environment variable selecting the mode of the dispatchers, "record" or "replay"
+const modeEnvironmentVariable = "OCI_GO_SDK_HTTPREPLAY_MODE"
+
+// ModeFromEnvironment returns ModeRecord if the environment variable OCI_GO_SDK_HTTPREPLAY_MODE is "record",
+// ModeReplay otherwise
+func ModeFromEnvironment() Mode {
+	if strings.EqualFold(os.Getenv(modeEnvironmentVariable), "record") {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// DispatcherConfiguration defines the input parameters of NewDispatcher
+type DispatcherConfiguration struct {
+	// [Optional] Whether the dispatcher records or replays. Defaults to ModeReplay.
+	Mode Mode
+
+	// [Optional] The dispatcher sending the requests when recording. Defaults to an http.Client.
+	Dispatcher common.HTTPRequestDispatcher
+
+	// [Optional] The headers scrubbed in addition to Authorization, opc-obo-token, opc-request-id,
+	// opc-client-request-id and x-content-sha256.
+	ScrubbedHeaders []string
+
+	// [Optional] The fields of the JSON bodies scrubbed in addition to password, adminPassword, passphrase,
+	// privateKey, token, plaintext and content. The names are not case sensitive.
+	ScrubbedFields []string
+}
+
+// Dispatcher is a common.HTTPRequestDispatcher recording or replaying the requests. Dispatcher is safe for
+// concurrent use, but the order of the concurrent requests is not deterministic, they are only replayed if they are
+// distinct.
+type Dispatcher struct {
+	path     string
+	config   DispatcherConfiguration
+	scrubber *scrubber
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewDispatcher returns a dispatcher recording into or replaying the cassette file at path. The cassette must exist
+// when replaying, it is written by Close when recording.
+func NewDispatcher(path string, config DispatcherConfiguration) (*Dispatcher, error) {
+	dispatcher := &Dispatcher{
+		path:     path,
+		config:   config,
+		scrubber: newScrubber(config.ScrubbedHeaders, config.ScrubbedFields),
+		cassette: &Cassette{Variables: make(map[string]string)},
+	}
+
+	switch config.Mode {
+	case ModeRecord:
+		if dispatcher.config.Dispatcher == nil {
+			dispatcher.config.Dispatcher = &http.Client{}
+		}
+	case ModeReplay:
+		cassette, err := loadCassette(path)
+		if err != nil {
+			return nil, fmt.Errorf("can not replay the cassette %s: %s", path, err.Error())
+		}
+		dispatcher.cassette = cassette
+		dispatcher.replayed = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("invalid mode %d", config.Mode)
+	}
+	return dispatcher, nil
+}
+
+// Bind makes a client send its requests with the dispatcher. When replaying, the requests are not signed, so that
+// the client does not need valid credentials.
+func (dispatcher *Dispatcher) Bind(client *common.BaseClient) {
+	client.HTTPClient = dispatcher
+	if dispatcher.config.Mode == ModeReplay {
+		client.Signer = noSigner{}
+	}
+}
+
+// noSigner is the signer of the clients replaying requests
+type noSigner struct{}
+
+func (noSigner) Sign(*http.Request) error {
+	return nil
+}
+
+// Variable returns the value of a variable of the test, such as the OCID of the compartment it uses. When recording,
+// the value is returned and recorded, scrubbed if it contains OCIDs. When replaying, the recorded value is returned,
+// or value if it was not recorded.
+func (dispatcher *Dispatcher) Variable(name, value string) string {
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+
+	if dispatcher.config.Mode == ModeRecord {
+		dispatcher.cassette.Variables[name] = ScrubOCID(value)
+		return value
+	}
+
+	if recorded, ok := dispatcher.cassette.Variables[name]; ok {
+		return recorded
+	}
+	common.Debugf("httpreplay: the variable %s was not recorded in %s\n", name, dispatcher.path)
+	return value
+}
+
+// Do sends or replays a request
+func (dispatcher *Dispatcher) Do(request *http.Request) (*http.Response, error) {
+	recorded, err := dispatcher.recordRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if dispatcher.config.Mode == ModeReplay {
+		return dispatcher.replay(request, recorded)
+	}
+	return dispatcher.record(request, recorded)
+}
+
+// Close writes the cassette when recording
+func (dispatcher *Dispatcher) Close() error {
+	if dispatcher.config.Mode != ModeRecord {
+		return nil
+	}
+
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+	return dispatcher.cassette.save(dispatcher.path)
+}
+
+// recordRequest returns the request as recorded, and restores its body
+func (dispatcher *Dispatcher) recordRequest(request *http.Request) (*RecordedRequest, error) {
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		request.Body.Close()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := &RecordedRequest{
+		Method: request.Method,
+		URL:    ScrubOCID(request.URL.String()),
+		Header: dispatcher.scrubber.header(request.Header),
+	}
+	recorded.Body, recorded.BodyEncoding = encodeBody(dispatcher.scrubber.body(body))
+	return recorded, nil
+}
+
+func (dispatcher *Dispatcher) record(request *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	response, err := dispatcher.config.Dispatcher.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction := &Interaction{
+		Request: *recorded,
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Header:     dispatcher.scrubber.header(response.Header),
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeBody(dispatcher.scrubber.body(body))
+
+	dispatcher.mutex.Lock()
+	dispatcher.cassette.Interactions = append(dispatcher.cassette.Interactions, interaction)
+	dispatcher.mutex.Unlock()
+	return response, nil
+}
+
+// replay returns the response of the first interaction of the request not replayed yet, so that the repeated
+// requests, such as the polling of a resource, get the successive recorded responses
+func (dispatcher *Dispatcher) replay(request *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+
+	for i, interaction := range dispatcher.cassette.Interactions {
+		if !dispatcher.replayed[i] && interaction.Request.matches(recorded) {
+			dispatcher.replayed[i] = true
+			return interaction.Response.response(request)
+		}
+	}
+	return nil, fmt.Errorf("httpreplay: no interaction of %s left for %s %s", dispatcher.path, recorded.Method, recorded.URL)
+}
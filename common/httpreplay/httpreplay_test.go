@@ -0,0 +1,183 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package httpreplay
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+	"github.com/oracle/oci-go-sdk/v27/secrets"
+	"github.com/oracle/oci-go-sdk/v27/vault"
+)
+
+const realCompartmentID = "ocid1.compartment.oc1..aaaaaaaarealcompartment"
+
+// secretSigner signs the requests with a secret, which must not be recorded
+type secretSigner struct{}
+
+func (secretSigner) Sign(request *http.Request) error {
+	request.Header.Set("Authorization", "Signature secret")
+	return nil
+}
+
+func createAndGetKey(t *testing.T, client keymanagement.KmsManagementClient, compartmentID string) keymanagement.Key {
+	ctx := context.Background()
+	created, err := client.CreateKey(ctx, keymanagement.CreateKeyRequest{CreateKeyDetails: keymanagement.CreateKeyDetails{
+		CompartmentId: common.String(compartmentID),
+		DisplayName:   common.String("key1"),
+		KeyShape:      &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(32)},
+	}})
+	assert.NoError(t, err)
+
+	key, err := client.GetKey(ctx, keymanagement.GetKeyRequest{KeyId: created.Id})
+	assert.NoError(t, err)
+	return key.Key
+}
+
+func TestDispatcher_RecordAndReplay(t *testing.T) {
+	directory, err := ioutil.TempDir("", "httpreplay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	cassettePath := filepath.Join(directory, "testdata", "cassette.json")
+
+	// record against the fake vault
+	server := ocitest.NewVaultServer()
+	recorder, err := NewDispatcher(cassettePath, DispatcherConfiguration{Mode: ModeRecord, Dispatcher: server.Client()})
+	assert.NoError(t, err)
+	client := keymanagement.KmsManagementClient{BaseClient: common.DefaultBaseClientWithSigner(secretSigner{})}
+	client.Host = server.URL
+	recorder.Bind(&client.BaseClient)
+
+	compartmentID := recorder.Variable("compartmentId", realCompartmentID)
+	assert.Equal(t, realCompartmentID, compartmentID)
+	recorded := createAndGetKey(t, client, compartmentID)
+	assert.NoError(t, recorder.Close())
+	server.Close()
+
+	content, err := ioutil.ReadFile(cassettePath)
+	assert.NoError(t, err)
+	for _, secret := range []string{realCompartmentID, *recorded.Id, server.VaultId, "Signature secret"} {
+		assert.NotContains(t, string(content), secret)
+	}
+	assert.Contains(t, string(content), ScrubOCID(*recorded.Id))
+
+	// replay without the server nor credentials
+	replayer, err := NewDispatcher(cassettePath, DispatcherConfiguration{})
+	assert.NoError(t, err)
+	defer replayer.Close()
+	client = keymanagement.KmsManagementClient{BaseClient: common.DefaultBaseClientWithSigner(secretSigner{})}
+	client.Host = "https://kms.invalid"
+	replayer.Bind(&client.BaseClient)
+
+	compartmentID = replayer.Variable("compartmentId", "")
+	assert.Equal(t, ScrubOCID(realCompartmentID), compartmentID)
+	replayed := createAndGetKey(t, client, compartmentID)
+	assert.Equal(t, ScrubOCID(*recorded.Id), *replayed.Id)
+	assert.Equal(t, ScrubOCID(*recorded.CurrentKeyVersion), *replayed.CurrentKeyVersion)
+	assert.Equal(t, recorded.TimeCreated.Unix(), replayed.TimeCreated.Unix())
+
+	// every interaction is replayed once
+	_, err = client.GetKey(context.Background(), keymanagement.GetKeyRequest{KeyId: replayed.Id})
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "no interaction"))
+}
+
+func TestDispatcher_ScrubsKeysAndSecrets(t *testing.T) {
+	directory, err := ioutil.TempDir("", "httpreplay")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	cassettePath := filepath.Join(directory, "cassette.json")
+
+	server := ocitest.NewVaultServer()
+	defer server.Close()
+	recorder, err := NewDispatcher(cassettePath, DispatcherConfiguration{Mode: ModeRecord, Dispatcher: server.Client()})
+	assert.NoError(t, err)
+	management := keymanagement.KmsManagementClient{BaseClient: server.BaseClient()}
+	crypto := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	vaults := vault.VaultsClient{BaseClient: server.BaseClient()}
+	vaults.BasePath = "20180608"
+	bundles := secrets.SecretsClient{BaseClient: server.BaseClient()}
+	bundles.BasePath = "20190301"
+	for _, client := range []*common.BaseClient{&management.BaseClient, &crypto.BaseClient, &vaults.BaseClient, &bundles.BaseClient} {
+		recorder.Bind(client)
+	}
+	ctx := context.Background()
+
+	key := createAndGetKey(t, management, realCompartmentID)
+	generated, err := crypto.GenerateDataEncryptionKey(ctx, keymanagement.GenerateDataEncryptionKeyRequest{
+		GenerateKeyDetails: keymanagement.GenerateKeyDetails{
+			KeyId:               key.Id,
+			IncludePlaintextKey: common.Bool(true),
+			KeyShape:            &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(32)},
+		},
+	})
+	assert.NoError(t, err)
+
+	content := base64.StdEncoding.EncodeToString([]byte("db-password-value"))
+	created, err := vaults.CreateSecret(ctx, vault.CreateSecretRequest{CreateSecretDetails: vault.CreateSecretDetails{
+		CompartmentId: common.String(realCompartmentID),
+		SecretName:    common.String("db-password"),
+		VaultId:       common.String(server.VaultId),
+		SecretContent: vault.Base64SecretContentDetails{Content: common.String(content)},
+	}})
+	assert.NoError(t, err)
+	bundle, err := bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: created.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, content, *bundle.SecretBundleContent.(secrets.Base64SecretBundleContentDetails).Content)
+	assert.NoError(t, recorder.Close())
+
+	recorded, err := ioutil.ReadFile(cassettePath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(recorded), *generated.Plaintext)
+	assert.NotContains(t, string(recorded), content)
+
+	cassette, err := loadCassette(cassettePath)
+	assert.NoError(t, err)
+	scrubbed := map[string]bool{}
+	for _, interaction := range cassette.Interactions {
+		var body struct {
+			Plaintext           *string
+			SecretBundleContent *struct{ Content *string }
+		}
+		json.Unmarshal([]byte(interaction.Response.Body), &body)
+		switch {
+		case strings.HasSuffix(interaction.Request.URL, "/generateDataEncryptionKey"):
+			assert.Equal(t, scrubbedValue, *body.Plaintext)
+			scrubbed["plaintext"] = true
+		case strings.Contains(interaction.Request.URL, "/secretbundles/"):
+			assert.Equal(t, scrubbedValue, *body.SecretBundleContent.Content)
+			scrubbed["content"] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"plaintext": true, "content": true}, scrubbed)
+}
+
+func TestScrubber(t *testing.T) {
+	ocid := "ocid1.instance.oc1.phx.abuhgljrexampleuniqueid"
+	scrubbed := ScrubOCID(ocid)
+	assert.True(t, strings.HasPrefix(scrubbed, "ocid1.instance.oc1.phx.scrubbed"))
+	assert.Equal(t, scrubbed, ScrubOCID(scrubbed))
+	assert.Equal(t, scrubbed, ScrubOCID(ocid))
+	assert.Equal(t, "ocid1.tenancy.oc1..", ScrubOCID("ocid1.tenancy.oc1..aaaa")[:len("ocid1.tenancy.oc1..")])
+
+	scrubber := newScrubber([]string{"x-secret"}, []string{"apiKey"})
+	body := scrubber.body([]byte(`{"id":"` + ocid + `","adminPassword":"hunter2","nested":[{"APIKEY":"key"}],"count":12345678901234567890}`))
+	assert.JSONEq(t, `{"id":"`+scrubbed+`","adminPassword":"REDACTED","nested":[{"APIKEY":"REDACTED"}],"count":12345678901234567890}`, string(body))
+	assert.Equal(t, []byte("binary "+scrubbed), scrubber.body([]byte("binary "+ocid)))
+
+	header := scrubber.header(http.Header{"Authorization": {"Signature"}, "X-Secret": {"value"}, "Opc-Work-Request-Id": {ocid}})
+	assert.Equal(t, http.Header{"Authorization": {"REDACTED"}, "X-Secret": {"REDACTED"}, "Opc-Work-Request-Id": {scrubbed}}, header)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package httpreplay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	// scrubbedValue replaces the values of the scrubbed headers and fields
+	scrubbedValue = "REDACTED"
+
+	// scrubbedOCIDPrefix starts the unique part of the scrubbed OCIDs
+	scrubbedOCIDPrefix = "scrubbed"
+)
+
+// ocidPattern matches the OCIDs, ocid1.<resource type>.<realm>.[region][.future use].<unique id>
+var ocidPattern = regexp.MustCompile(`ocid1\.([a-z0-9_-]+)\.([a-z0-9_-]+)\.([a-z0-9._-]*)\.([a-z0-9]+)`)
+
+// defaultScrubbedHeaders are the headers always scrubbed, the credentials and the identifiers of the requests
+var defaultScrubbedHeaders = []string{
+	"Authorization",
+	"Opc-Obo-Token",
+	"Opc-Request-Id",
+	"Opc-Client-Request-Id",
+	"X-Content-Sha256",
+}
+
+// defaultScrubbedFields are the fields of the JSON bodies always scrubbed, whatever their case. plaintext holds the
+// keys and data decrypted by the Key Management service, content the secrets of the Vault and Secrets services.
+var defaultScrubbedFields = []string{
+	"password",
+	"adminPassword",
+	"passphrase",
+	"privateKey",
+	"token",
+	"plaintext",
+	"content",
+}
+
+// scrubber removes the credentials, the secrets and the real OCIDs from the recorded interactions
+type scrubber struct {
+	headers []string
+	fields  map[string]bool
+}
+
+func newScrubber(headers, fields []string) *scrubber {
+	scrubber := &scrubber{fields: make(map[string]bool)}
+	for _, header := range append(append([]string{}, defaultScrubbedHeaders...), headers...) {
+		scrubber.headers = append(scrubber.headers, http.CanonicalHeaderKey(header))
+	}
+	for _, field := range append(append([]string{}, defaultScrubbedFields...), fields...) {
+		scrubber.fields[strings.ToLower(field)] = true
+	}
+	return scrubber
+}
+
+// ScrubOCID returns the OCID replacing an OCID in the recorded interactions. It keeps the resource type, realm and
+// region of the OCID, and replaces its unique id by a hash, so that the same OCID is always scrubbed to the same
+// value. The scrubbed OCIDs are returned as is.
+func ScrubOCID(ocid string) string {
+	return ocidPattern.ReplaceAllStringFunc(ocid, scrubOCID)
+}
+
+func scrubOCID(ocid string) string {
+	parts := ocidPattern.FindStringSubmatch(ocid)
+	if strings.HasPrefix(parts[4], scrubbedOCIDPrefix) {
+		return ocid
+	}
+
+	hash := sha256.Sum256([]byte(ocid))
+	return "ocid1." + parts[1] + "." + parts[2] + "." + parts[3] + "." + scrubbedOCIDPrefix + hex.EncodeToString(hash[:12])
+}
+
+// header returns a scrubbed copy of a header
+func (scrubber *scrubber) header(header http.Header) http.Header {
+	scrubbed := http.Header{}
+	for name, values := range header {
+		for _, value := range values {
+			scrubbed.Add(name, ScrubOCID(value))
+		}
+	}
+
+	for _, name := range scrubber.headers {
+		if _, ok := scrubbed[name]; ok {
+			scrubbed.Set(name, scrubbedValue)
+		}
+	}
+	return scrubbed
+}
+
+// body returns a scrubbed body. The secret fields of the JSON bodies are replaced, and the OCIDs of all bodies.
+func (scrubber *scrubber) body(body []byte) []byte {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err == nil && !decoder.More() {
+		if scrubber.value(value) {
+			if scrubbed, err := json.Marshal(value); err == nil {
+				body = scrubbed
+			}
+		}
+	}
+	return []byte(ScrubOCID(string(body)))
+}
+
+// value scrubs the secret fields of a JSON value in place, and returns whether it changed
+func (scrubber *scrubber) value(value interface{}) bool {
+	changed := false
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			if _, ok := field.(string); ok && scrubber.fields[strings.ToLower(name)] {
+				value[name] = scrubbedValue
+				changed = true
+			} else if scrubber.value(field) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if scrubber.value(item) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// InvokeRequest is a request to any endpoint of a service, such as a new or preview endpoint not supported by the
+// service clients yet, sent with BaseClient.Invoke
+type InvokeRequest struct {
+	// The http method, such as http.MethodGet
+	Method string
+
+	// The path of the endpoint, relative to the base path of the client, such as /vcns/{vcnId}. Its templates are
+	// replaced by the path parameters.
+	Path string
+
+	// [Optional] The values of the templates of the path, keyed by their names, such as vcnId
+	PathParams map[string]string
+
+	// [Optional] The query parameters
+	QueryParams map[string][]string
+
+	// [Optional] The headers, added to the ones set by the SDK, such as opc-request-id or if-match
+	Header http.Header
+
+	// [Optional] The body. An io.Reader is sent as is, as application/octet-stream unless the Content-Type header is
+	// set. It is sent again when the request is retried only if it is also an io.Seeker. Any other value is marshaled
+	// to json, the nil fields tagged with mandatory:"false" being omitted as in the requests of the service clients.
+	Body interface{}
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request InvokeRequest) HTTPRequest(method, path string) (httpRequest http.Request, err error) {
+	httpRequest = MakeDefaultHTTPRequest(method, path)
+	if httpRequest.URL.Path, err = expandPathTemplate(path, request.PathParams); err != nil {
+		return
+	}
+
+	if len(request.QueryParams) > 0 {
+		query := httpRequest.URL.Query()
+		for name, values := range request.QueryParams {
+			query[name] = append(query[name], values...)
+		}
+		httpRequest.URL.RawQuery = query.Encode()
+	}
+
+	if err = addInvokeBody(&httpRequest, request.Body); err != nil {
+		return
+	}
+
+	for name, values := range request.Header {
+		httpRequest.Header.Del(name)
+		for _, value := range values {
+			if err = setWellKnownHeaders(&httpRequest, name, value); err != nil {
+				return
+			}
+			httpRequest.Header.Add(name, value)
+		}
+	}
+
+	if httpRequest.Header.Get(requestHeaderOpcRequestID) == "" {
+		if requestID, e := generateRandUUID(); e == nil {
+			httpRequest.Header.Set(requestHeaderOpcRequestID, requestID)
+		}
+	}
+	if httpRequest.Header.Get(requestHeaderContentType) == "" {
+		httpRequest.Header.Set(requestHeaderContentType, "application/json")
+	}
+	return
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request InvokeRequest) RetryPolicy() *RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// expandPathTemplate replaces the templates of a path, such as {vcnId}, by the values of the path parameters
+func expandPathTemplate(path string, pathParams map[string]string) (string, error) {
+	for name, value := range pathParams {
+		template := "{" + name + "}"
+		if !strings.Contains(path, template) {
+			return "", fmt.Errorf("path parameter %s is not in the path %s", name, path)
+		}
+		if value == "" {
+			return "", fmt.Errorf("value cannot be empty for path parameter %s", name)
+		}
+		path = strings.Replace(path, template, value, -1)
+	}
+
+	if templatedPathRegex.MatchString(path) {
+		return "", fmt.Errorf("the path %s has templates without path parameters", path)
+	}
+	return path, nil
+}
+
+// addInvokeBody sets the body of a request, see InvokeRequest.Body
+func addInvokeBody(request *http.Request, body interface{}) error {
+	if body == nil {
+		return nil
+	}
+
+	reader, ok := body.(io.Reader)
+	if !ok {
+		return addToBody(request, reflect.ValueOf(body), &requestField{StructField: reflect.StructField{Name: "Body"}})
+	}
+
+	// retries send the body again from its start
+	if seeker, ok := reader.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("can not rewind the body of the request: %s", err.Error())
+		}
+	}
+
+	if lengthReader, ok := reader.(interface{ Len() int }); ok {
+		request.ContentLength = int64(lengthReader.Len())
+		request.Header.Set(requestHeaderContentLength, fmt.Sprint(request.ContentLength))
+	} else {
+		request.Header.Del(requestHeaderContentLength)
+	}
+	request.Header.Set(requestHeaderContentType, "application/octet-stream")
+	request.Body = ioutil.NopCloser(reader)
+	return nil
+}
+
+// InvokeResponse is the response of BaseClient.Invoke
+type InvokeResponse struct {
+	// The underlying http response, whose body is closed
+	RawResponse *http.Response
+
+	// Unique Oracle-assigned identifier for the request. If you need to contact Oracle about
+	// a particular request, please provide the request ID.
+	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// For optimistic concurrency control. See `if-match`.
+	Etag *string `presentIn:"header" name:"etag"`
+
+	// For list pagination. When this header appears in the response, additional pages of results remain, to get them
+	// with the page query parameter.
+	OpcNextPage *string `presentIn:"header" name:"opc-next-page"`
+}
+
+// HTTPResponse implements the OCIResponse interface
+func (response InvokeResponse) HTTPResponse() *http.Response {
+	return response.RawResponse
+}
+
+// Invoke sends a request to any endpoint of the service of the client, with the retry policy of the request or of
+// the client, and the signing, the logging and the error handling of the service clients: the failures of the
+// service are returned as a ServiceError. The json body of a successful response is decoded into result, a pointer
+// such as a *core.Vcn, unless result is nil.
+func (client BaseClient) Invoke(ctx context.Context, request InvokeRequest, result interface{}) (response InvokeResponse, err error) {
+	var ociResponse OCIResponse
+	policy := NoRetryPolicy()
+	if client.RetryPolicy() != nil {
+		policy = *client.RetryPolicy()
+	}
+	if request.RetryPolicy() != nil {
+		policy = *request.RetryPolicy()
+	}
+
+	ociResponse, err = Retry(ctx, request, client.invokeOperation(result), policy)
+	if convertedResponse, ok := ociResponse.(InvokeResponse); ok {
+		response = convertedResponse
+	}
+	return
+}
+
+// invokeOperation returns the OCIOperation sending an InvokeRequest, which decodes the body of the response into
+// result
+func (client BaseClient) invokeOperation(result interface{}) OCIOperation {
+	return func(ctx context.Context, request OCIRequest) (OCIResponse, error) {
+		invokeRequest, ok := request.(InvokeRequest)
+		if !ok {
+			return nil, fmt.Errorf("can not invoke the request of type %T, expects an InvokeRequest", request)
+		}
+
+		httpRequest, err := request.HTTPRequest(invokeRequest.Method, invokeRequest.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		var response InvokeResponse
+		var httpResponse *http.Response
+		httpResponse, err = client.Call(ctx, &httpRequest)
+		defer CloseBodyIfValid(httpResponse)
+		response.RawResponse = httpResponse
+		if err != nil {
+			if httpResponse != nil {
+				opcRequestID := httpResponse.Header.Get(requestHeaderOpcRequestID)
+				response.OpcRequestId = &opcRequestID
+			}
+			return response, err
+		}
+
+		if err = UnmarshalResponse(httpResponse, &response); err != nil {
+			return response, err
+		}
+
+		if result == nil || httpResponse.Body == nil {
+			return response, nil
+		}
+		content, err := ioutil.ReadAll(httpResponse.Body)
+		if err != nil {
+			return response, err
+		}
+		if len(content) > 0 {
+			if err = json.Unmarshal(content, result); err != nil {
+				return response, fmt.Errorf("can not unmarshal the body of the response into %T: %s", result, err.Error())
+			}
+		}
+		return response, nil
+	}
+}
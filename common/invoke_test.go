@@ -0,0 +1,134 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type invokeDetails struct {
+	DisplayName *string `mandatory:"true" json:"displayName"`
+	Description *string `mandatory:"false" json:"description"`
+}
+
+type invokeResult struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+func testInvokeClient(call func(r *http.Request) (*http.Response, error)) BaseClient {
+	client := testClientWithRegion(RegionPHX)
+	client.Host = "http://somehost:9000"
+	client.BasePath = "20160918"
+	client.HTTPClient = fakeCaller{Customcall: call}
+	return client
+}
+
+func invokeResponse(statusCode int, body string) *http.Response {
+	header := http.Header{}
+	header.Set("opc-request-id", "request-id")
+	header.Set("etag", "etag")
+	return &http.Response{StatusCode: statusCode, Header: header, Body: ioutil.NopCloser(strings.NewReader(body))}
+}
+
+func TestBaseClient_Invoke(t *testing.T) {
+	client := testInvokeClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/20160918/widgets/ocid1.widget.oc1..aaaa", r.URL.Path)
+		assert.Equal(t, "limit=10&sortBy=name&sortBy=time", r.URL.RawQuery)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "etag", r.Header.Get("if-match"))
+		assert.NotEmpty(t, r.Header.Get("opc-request-id"))
+		assert.Contains(t, r.Header.Get(requestHeaderAuthorization), "signature")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"displayName":"widget"}`, string(body))
+		return invokeResponse(200, `{"id":"ocid1.widget.oc1..aaaa","displayName":"widget"}`), nil
+	})
+
+	var result invokeResult
+	response, err := client.Invoke(context.Background(), InvokeRequest{
+		Method:      http.MethodPut,
+		Path:        "/widgets/{widgetId}",
+		PathParams:  map[string]string{"widgetId": "ocid1.widget.oc1..aaaa"},
+		QueryParams: map[string][]string{"limit": {"10"}, "sortBy": {"name", "time"}},
+		Header:      http.Header{"if-match": {"etag"}},
+		Body:        invokeDetails{DisplayName: String("widget")},
+	}, &result)
+	assert.NoError(t, err)
+	assert.Equal(t, invokeResult{Id: "ocid1.widget.oc1..aaaa", DisplayName: "widget"}, result)
+	assert.Equal(t, "request-id", *response.OpcRequestId)
+	assert.Equal(t, "etag", *response.Etag)
+	assert.Nil(t, response.OpcNextPage)
+}
+
+func TestBaseClient_InvokeRetriesReaderBody(t *testing.T) {
+	attempts := 0
+	client := testInvokeClient(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		assert.Equal(t, int64(7), r.ContentLength)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "content", string(body))
+		if attempts == 1 {
+			return invokeResponse(503, `{"code":"ServiceUnavailable","message":"try again"}`), nil
+		}
+		return invokeResponse(204, ""), nil
+	})
+
+	policy := NewRetryPolicy(2, func(response OCIOperationResponse) bool {
+		return response.Error != nil
+	}, func(OCIOperationResponse) time.Duration {
+		return 0
+	})
+	response, err := client.Invoke(context.Background(), InvokeRequest{
+		Method:          http.MethodPost,
+		Path:            "/widgets/actions/upload",
+		Body:            bytes.NewReader([]byte("content")),
+		RequestMetadata: RequestMetadata{RetryPolicy: &policy},
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 204, response.RawResponse.StatusCode)
+}
+
+func TestBaseClient_InvokeServiceError(t *testing.T) {
+	client := testInvokeClient(func(r *http.Request) (*http.Response, error) {
+		return invokeResponse(404, `{"code":"NotAuthorizedOrNotFound","message":"not found"}`), nil
+	})
+
+	var result invokeResult
+	response, err := client.Invoke(context.Background(), InvokeRequest{Method: http.MethodGet, Path: "/widgets"}, &result)
+	failure, ok := IsServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, 404, failure.GetHTTPStatusCode())
+	assert.Equal(t, "NotAuthorizedOrNotFound", failure.GetCode())
+	assert.Equal(t, "request-id", *response.OpcRequestId)
+	assert.Equal(t, invokeResult{}, result)
+}
+
+func TestBaseClient_InvokeInvalidPath(t *testing.T) {
+	client := testInvokeClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("the request must not be sent")
+		return nil, nil
+	})
+
+	_, err := client.Invoke(context.Background(), InvokeRequest{Method: http.MethodGet, Path: "/widgets/{widgetId}"}, nil)
+	assert.EqualError(t, err, "the path /widgets/{widgetId} has templates without path parameters")
+
+	_, err = client.Invoke(context.Background(), InvokeRequest{
+		Method:     http.MethodGet,
+		Path:       "/widgets/{widgetId}",
+		PathParams: map[string]string{"id": "ocid"},
+	}, nil)
+	assert.EqualError(t, err, "path parameter id is not in the path /widgets/{widgetId}")
+}
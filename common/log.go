@@ -182,6 +182,10 @@ func (l defaultSDKLogger) LogLevel() int {
 }
 
 func (l defaultSDKLogger) Log(logLevel int, format string, v ...interface{}) error {
+	// the messages above the current level are discarded, do not format them
+	if logLevel > l.currentLoggingLevel {
+		return nil
+	}
 	logger := l.getLoggerForLevel(logLevel)
 	logger.Output(4, fmt.Sprintf(format, v...))
 	return nil
@@ -205,12 +209,18 @@ func Debugf(format string, v ...interface{}) {
 
 // Debug  logs v if debug mode is set
 func Debug(v ...interface{}) {
+	if defaultLogger.LogLevel() < debugLogging {
+		return
+	}
 	m := fmt.Sprint(v...)
 	defaultLogger.Log(debugLogging, "%s", m)
 }
 
 // Debugln logs v appending a new line if debug mode is set
 func Debugln(v ...interface{}) {
+	if defaultLogger.LogLevel() < debugLogging {
+		return
+	}
 	m := fmt.Sprint(v...)
 	defaultLogger.Log(debugLogging, "%s\n", m)
 }
@@ -0,0 +1,59 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// polymorphicModels is the unmarshalers of the polymorphic models of the services, keyed by the reflect.Type of
+// their interfaces, registered by their packages
+var polymorphicModels sync.Map
+
+// RegisterPolymorphicModel registers how to unmarshal a polymorphic model, such as core.InstanceSourceDetails, for
+// UnmarshalModel. The model is a nil pointer to the interface of the model, and newUnmarshaler returns the
+// unmarshaler of its discriminator.
+func RegisterPolymorphicModel(model interface{}, newUnmarshaler func() PolymorphicJSONUnmarshaler) {
+	polymorphicModels.Store(reflect.TypeOf(model).Elem(), newUnmarshaler)
+}
+
+// MarshalModel marshals a model of a service, or a slice or a map of models, to json. The json is deterministic:
+// the keys of the objects are sorted, the polymorphic models have their discriminator, and the nil fields tagged
+// mandatory:"false" are omitted as in the bodies of the requests. UnmarshalModel reads it back.
+func MarshalModel(model interface{}) ([]byte, error) {
+	rawJSON, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	return removeNilFieldsInJSONWithTaggedStruct(rawJSON, reflect.ValueOf(model))
+}
+
+// UnmarshalModel unmarshals json to a model of a service. The model is a pointer to a struct, such as
+// *core.LaunchInstanceDetails, or to the interface of a polymorphic model, such as *database.CreateDatabaseBase, set
+// to the model of the type named by the discriminator of the json.
+func UnmarshalModel(data []byte, model interface{}) error {
+	val := reflect.ValueOf(model)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return fmt.Errorf("can not unmarshal a model to %T, expects a non nil pointer", model)
+	}
+
+	newUnmarshaler, ok := polymorphicModels.Load(val.Type().Elem())
+	if !ok {
+		return json.Unmarshal(data, model)
+	}
+
+	polymorphicModel, err := valueFromPolymorphicJSON(data, newUnmarshaler.(func() PolymorphicJSONUnmarshaler)())
+	if err != nil {
+		return err
+	}
+	if polymorphicModel == nil {
+		val.Elem().Set(reflect.Zero(val.Type().Elem()))
+	} else {
+		val.Elem().Set(reflect.ValueOf(polymorphicModel))
+	}
+	return nil
+}
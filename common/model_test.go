@@ -0,0 +1,88 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type modelShape interface{}
+
+type modelshape struct {
+	JsonData []byte
+	Kind     string `json:"kind"`
+}
+
+func (m *modelshape) UnmarshalJSON(data []byte) error {
+	m.JsonData = data
+	type Unmarshalermodelshape modelshape
+	s := struct {
+		Model Unmarshalermodelshape
+	}{}
+	err := json.Unmarshal(data, &s.Model)
+	m.Kind = s.Model.Kind
+	return err
+}
+
+func (m *modelshape) UnmarshalPolymorphicJSON(data []byte) (interface{}, error) {
+	if data == nil || string(data) == "null" {
+		return nil, nil
+	}
+
+	switch m.Kind {
+	case "circle":
+		mm := modelCircle{}
+		err := json.Unmarshal(data, &mm)
+		return mm, err
+	default:
+		return *m, nil
+	}
+}
+
+type modelCircle struct {
+	Radius *int    `mandatory:"true" json:"radius"`
+	Label  *string `mandatory:"false" json:"label"`
+}
+
+func (m modelCircle) MarshalJSON() ([]byte, error) {
+	type MarshalTypemodelCircle modelCircle
+	s := struct {
+		DiscriminatorParam string `json:"kind"`
+		MarshalTypemodelCircle
+	}{"circle", (MarshalTypemodelCircle)(m)}
+	return json.Marshal(&s)
+}
+
+func init() {
+	RegisterPolymorphicModel((*modelShape)(nil), func() PolymorphicJSONUnmarshaler { return &modelshape{} })
+}
+
+func TestMarshalModel(t *testing.T) {
+	data, err := MarshalModel([]modelShape{modelCircle{Radius: Int(2)}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"kind":"circle","radius":2}]`, string(data))
+
+	data, err = MarshalModel(map[string][]SDKDate{"days": {{}}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"days":["0001-01-01"]}`, string(data))
+}
+
+func TestUnmarshalModel(t *testing.T) {
+	var shape modelShape
+	assert.NoError(t, UnmarshalModel([]byte(`{"kind":"circle","radius":2}`), &shape))
+	assert.Equal(t, modelCircle{Radius: Int(2)}, shape)
+
+	assert.NoError(t, UnmarshalModel([]byte(`null`), &shape))
+	assert.Nil(t, shape)
+
+	var circle modelCircle
+	assert.NoError(t, UnmarshalModel([]byte(`{"kind":"circle","radius":3}`), &circle))
+	assert.Equal(t, 3, *circle.Radius)
+
+	assert.Error(t, UnmarshalModel([]byte(`{}`), circle))
+	assert.Error(t, UnmarshalModel([]byte(`{}`), nil))
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package modeldiff compares the models of the services, such as a core.Instance fetched from the service with the
+// desired core.UpdateInstanceDetails, to detect their drift and build the minimal update details.
+//
+// The fields of the models are matched by their json names, so that models of different types can be compared. Only
+// the fields set in the desired model are compared: a nil pointer, map, slice or interface, or a zero value such as an
+// empty enum, is not set, while a pointer to a zero value is. The times are
+// compared as instants, the numbers by their values whatever their types, and the maps, such as the freeform and
+// defined tags, key by key. The slices, and the polymorphic fields whose types differ, such as a
+// core.InstanceSourceViaImageDetails and a core.InstanceSourceViaBootVolumeDetails, change as a whole.
+package modeldiff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// Change is a difference between a field of the actual model and of the desired model
+type Change struct {
+	// The path of the field, made of the json names of the fields and of the keys of the maps, such as
+	// shapeConfig.ocpus or freeformTags.owner
+	Path string
+
+	// The actual value of the field, nil if it is not set
+	From interface{}
+
+	// The desired value of the field, nil if a key of a map is removed
+	To interface{}
+}
+
+func (change Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", change.Path, formatValue(change.From), formatValue(change.To))
+}
+
+var (
+	timeType = reflect.TypeOf(common.SDKTime{})
+	dateType = reflect.TypeOf(common.SDKDate{})
+)
+
+// Diff returns the changes from the actual model to the desired model, in the order of the fields of the desired
+// model, the keys of the maps being sorted. The models are structs or pointers to structs.
+func Diff(actual, desired interface{}) ([]Change, error) {
+	actualValue, desiredValue := indirect(reflect.ValueOf(actual)), indirect(reflect.ValueOf(desired))
+	if desiredValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can not compare to %T, expects a struct", desired)
+	}
+	if actualValue.IsValid() && actualValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can not compare %T, expects a struct", actual)
+	}
+	return diffStructs("", actualValue, desiredValue, nil), nil
+}
+
+// Patch sets the fields of patch, a pointer to a struct such as *core.UpdateInstanceDetails, which differ between
+// the actual model and the desired model, to their desired values, and returns the changes. The fields of the
+// desired model are set as a whole, such as all the fields of the desired shapeConfig when one of them changes. The
+// fields which patch does not have, which can not be updated, are left out of the changes.
+func Patch(actual, desired, patch interface{}) ([]Change, error) {
+	patchValue := reflect.ValueOf(patch)
+	if patchValue.Kind() != reflect.Ptr || patchValue.IsNil() || patchValue.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can not patch %T, expects a pointer to a struct", patch)
+	}
+
+	changes, err := Diff(actual, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredValue := indirect(reflect.ValueOf(desired))
+	desiredFields := jsonFieldsOf(desiredValue.Type())
+	patchFields := jsonFieldsOf(patchValue.Elem().Type())
+	var patched []Change
+	for _, change := range changes {
+		name := strings.SplitN(change.Path, ".", 2)[0]
+		patchIndex, ok := patchFields.byName[name]
+		if !ok {
+			continue
+		}
+
+		desiredField := desiredValue.Field(desiredFields.byName[name])
+		patchField := patchValue.Elem().Field(patchIndex)
+		if !assign(patchField, desiredField) {
+			return nil, fmt.Errorf("can not patch the field %s of type %v with a %v", name, patchField.Type(), desiredField.Type())
+		}
+		patched = append(patched, change)
+	}
+	return patched, nil
+}
+
+// assign sets a field to a value of an assignable or a convertible type, or, such as an *InstanceShapeConfig to an
+// *UpdateInstanceShapeConfigDetails, to a struct made of the fields of the value which it has, and returns whether
+// it could
+func assign(field, value reflect.Value) bool {
+	switch {
+	case value.Type().AssignableTo(field.Type()):
+		field.Set(value)
+		return true
+	case value.Type().ConvertibleTo(field.Type()):
+		field.Set(value.Convert(field.Type()))
+		return true
+	case field.Kind() == reflect.Ptr && value.Kind() == reflect.Ptr:
+		if value.IsNil() {
+			field.Set(reflect.Zero(field.Type()))
+			return true
+		}
+		elem := reflect.New(field.Type().Elem())
+		if !assign(elem.Elem(), value.Elem()) {
+			return false
+		}
+		field.Set(elem)
+		return true
+	case field.Kind() == reflect.Struct && value.Kind() == reflect.Struct:
+		fieldFields, valueFields := jsonFieldsOf(field.Type()), jsonFieldsOf(value.Type())
+		for i, index := range valueFields.indexes {
+			fieldIndex, ok := fieldFields.byName[valueFields.names[i]]
+			if ok && !assign(field.Field(fieldIndex), value.Field(index)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// jsonFields is the exported fields of a struct type with a json name
+type jsonFields struct {
+	// the indexes of the fields, in their order
+	indexes []int
+
+	// the json names of the fields
+	names []string
+
+	// the indexes of the fields, keyed by their json names
+	byName map[string]int
+}
+
+// jsonFieldsCache is the json fields of the struct types, keyed by reflect.Type
+var jsonFieldsCache sync.Map
+
+func jsonFieldsOf(typ reflect.Type) *jsonFields {
+	if fields, ok := jsonFieldsCache.Load(typ); ok {
+		return fields.(*jsonFields)
+	}
+
+	fields := &jsonFields{byName: make(map[string]int)}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.PkgPath != "" || name == "" || name == "-" {
+			continue
+		}
+		fields.indexes = append(fields.indexes, i)
+		fields.names = append(fields.names, name)
+		fields.byName[name] = i
+	}
+
+	jsonFieldsCache.Store(typ, fields)
+	return fields
+}
+
+// diffStructs appends the changes of the fields set in a desired struct, the actual value being an invalid value if
+// it is not set
+func diffStructs(path string, actual, desired reflect.Value, changes []Change) []Change {
+	actualFields := (*jsonFields)(nil)
+	if actual.IsValid() {
+		actualFields = jsonFieldsOf(actual.Type())
+	}
+
+	desiredFields := jsonFieldsOf(desired.Type())
+	for i, index := range desiredFields.indexes {
+		name := desiredFields.names[i]
+		desiredField := desired.Field(index)
+		if !isSet(desiredField) {
+			continue
+		}
+
+		var actualField reflect.Value
+		if actualFields != nil {
+			if actualIndex, ok := actualFields.byName[name]; ok {
+				actualField = actual.Field(actualIndex)
+			}
+		}
+		changes = diffValues(joinPath(path, name), actualField, desiredField, changes)
+	}
+	return changes
+}
+
+// diffValues appends the changes between an actual value and a desired value
+func diffValues(path string, actual, desired reflect.Value, changes []Change) []Change {
+	actual, desired = indirect(actual), indirect(desired)
+	if !desired.IsValid() {
+		if actual.IsValid() {
+			return append(changes, Change{Path: path, From: actual.Interface()})
+		}
+		return changes
+	}
+	if !actual.IsValid() {
+		return append(changes, Change{Path: path, To: desired.Interface()})
+	}
+
+	switch {
+	case desired.Type() == timeType || desired.Type() == dateType:
+		if !equalTimes(actual, desired) {
+			changes = append(changes, Change{Path: path, From: actual.Interface(), To: desired.Interface()})
+		}
+	case desired.Kind() == reflect.Struct:
+		// the polymorphic models of different types change as a whole
+		if actual.Kind() != reflect.Struct || isPolymorphic(actual, desired) {
+			return append(changes, Change{Path: path, From: actual.Interface(), To: desired.Interface()})
+		}
+		changes = diffStructs(path, actual, desired, changes)
+	case desired.Kind() == reflect.Map:
+		if actual.Kind() != reflect.Map {
+			return append(changes, Change{Path: path, From: actual.Interface(), To: desired.Interface()})
+		}
+		changes = diffMaps(path, actual, desired, changes)
+	case desired.Kind() == reflect.Slice || desired.Kind() == reflect.Array:
+		if !equalSlices(actual, desired) {
+			changes = append(changes, Change{Path: path, From: actual.Interface(), To: desired.Interface()})
+		}
+	default:
+		if !equalScalars(actual, desired) {
+			changes = append(changes, Change{Path: path, From: actual.Interface(), To: desired.Interface()})
+		}
+	}
+	return changes
+}
+
+// diffMaps appends the changes of the keys of two maps, the keys missing from the desired map being removed
+func diffMaps(path string, actual, desired reflect.Value, changes []Change) []Change {
+	keys := make(map[string]reflect.Value)
+	for _, key := range actual.MapKeys() {
+		keys[fmt.Sprint(key.Interface())] = key
+	}
+	for _, key := range desired.MapKeys() {
+		keys[fmt.Sprint(key.Interface())] = key
+	}
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		changes = diffValues(joinPath(path, name), mapIndex(actual, keys[name]), mapIndex(desired, keys[name]), changes)
+	}
+	return changes
+}
+
+// mapIndex returns the value of a key in a map, or an invalid value if the map does not have it
+func mapIndex(m, key reflect.Value) reflect.Value {
+	if !key.Type().AssignableTo(m.Type().Key()) {
+		if !key.Type().ConvertibleTo(m.Type().Key()) {
+			return reflect.Value{}
+		}
+		key = key.Convert(m.Type().Key())
+	}
+	return m.MapIndex(key)
+}
+
+// isSet returns whether a field of the desired model is set
+func isSet(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return !value.IsNil()
+	}
+	return !value.IsZero()
+}
+
+// isPolymorphic returns whether two structs are models of a polymorphic model with different types
+func isPolymorphic(actual, desired reflect.Value) bool {
+	if actual.Type() == desired.Type() {
+		return false
+	}
+	_, actualMarshals := actual.Interface().(interface{ MarshalJSON() ([]byte, error) })
+	_, desiredMarshals := desired.Interface().(interface{ MarshalJSON() ([]byte, error) })
+	return actualMarshals || desiredMarshals
+}
+
+func equalTimes(actual, desired reflect.Value) bool {
+	switch desiredTime := desired.Interface().(type) {
+	case common.SDKTime:
+		actualTime, ok := actual.Interface().(common.SDKTime)
+		return ok && actualTime.Equal(desiredTime.Time)
+	case common.SDKDate:
+		actualDate, ok := actual.Interface().(common.SDKDate)
+		return ok && actualDate.Date.Equal(desiredTime.Date)
+	}
+	return false
+}
+
+func equalSlices(actual, desired reflect.Value) bool {
+	if actual.Kind() != reflect.Slice && actual.Kind() != reflect.Array || actual.Len() != desired.Len() {
+		return false
+	}
+	for i := 0; i < desired.Len(); i++ {
+		if len(diffValues("", actual.Index(i), desired.Index(i), nil)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// equalScalars returns whether two scalars are equal, the numbers being compared by their values
+func equalScalars(actual, desired reflect.Value) bool {
+	if actualNumber, ok := toFloat(actual); ok {
+		desiredNumber, ok := toFloat(desired)
+		if ok && (actual.Kind() == reflect.Float32 || desired.Kind() == reflect.Float32) {
+			return float32(actualNumber) == float32(desiredNumber)
+		}
+		return ok && actualNumber == desiredNumber
+	}
+
+	switch desired.Kind() {
+	case reflect.String:
+		return actual.Kind() == reflect.String && actual.String() == desired.String()
+	case reflect.Bool:
+		return actual.Kind() == reflect.Bool && actual.Bool() == desired.Bool()
+	}
+	return reflect.DeepEqual(actual.Interface(), desired.Interface())
+}
+
+func toFloat(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
+// indirect returns the value pointed to or held by a value, or an invalid value if it is nil
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<nil>"
+	}
+	if data, err := common.MarshalModel(value); err == nil {
+		return string(data)
+	}
+	return fmt.Sprint(value)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
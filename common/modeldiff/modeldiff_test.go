@@ -0,0 +1,131 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package modeldiff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func testInstance() core.Instance {
+	return core.Instance{
+		Id:          common.String("ocid1.instance.oc1.phx.aaaaaaaa"),
+		DisplayName: common.String("web"),
+		Shape:       common.String("VM.Standard.E3.Flex"),
+		ShapeConfig: &core.InstanceShapeConfig{Ocpus: common.Float32(1), MemoryInGBs: common.Float32(16)},
+		FreeformTags: map[string]string{
+			"owner":   "team",
+			"project": "web",
+		},
+		DefinedTags: map[string]map[string]interface{}{
+			"Operations": {"CostCenter": "42", "Environment": "test", "Replicas": 2},
+		},
+		LifecycleState: core.InstanceLifecycleStateRunning,
+		TimeCreated:    &common.SDKTime{Time: time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)},
+		SourceDetails:  core.InstanceSourceViaImageDetails{ImageId: common.String("ocid1.image.oc1.phx.aaaaaaaa")},
+	}
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	actual := testInstance()
+	desired := core.UpdateInstanceDetails{
+		DisplayName:  common.String("web"),
+		FreeformTags: map[string]string{"owner": "team", "project": "web"},
+		ShapeConfig:  &core.UpdateInstanceShapeConfigDetails{Ocpus: common.Float32(1)},
+		DefinedTags: map[string]map[string]interface{}{
+			"Operations": {"CostCenter": "42", "Environment": "test", "Replicas": float64(2)},
+		},
+	}
+
+	changes, err := Diff(actual, &desired)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiff_Changes(t *testing.T) {
+	actual := testInstance()
+	desired := core.UpdateInstanceDetails{
+		DisplayName:  common.String(""),
+		FreeformTags: map[string]string{"owner": "ops"},
+		ShapeConfig:  &core.UpdateInstanceShapeConfigDetails{Ocpus: common.Float32(2), MemoryInGBs: common.Float32(16)},
+		DefinedTags: map[string]map[string]interface{}{
+			"Operations": {"CostCenter": "42", "Environment": "prod", "Replicas": 2},
+		},
+		FaultDomain: common.String("FAULT-DOMAIN-2"),
+	}
+
+	changes, err := Diff(&actual, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "definedTags.Operations.Environment", From: "test", To: "prod"},
+		{Path: "displayName", From: "web", To: ""},
+		{Path: "freeformTags.owner", From: "team", To: "ops"},
+		{Path: "freeformTags.project", From: "web"},
+		{Path: "shapeConfig.ocpus", From: float32(1), To: float32(2)},
+		{Path: "faultDomain", To: "FAULT-DOMAIN-2"},
+	}, changes)
+	assert.Equal(t, `freeformTags.project: "web" -> <nil>`, changes[3].String())
+}
+
+func TestDiff_TimesAndPolymorphicModels(t *testing.T) {
+	actual := testInstance()
+	desired := core.Instance{
+		TimeCreated:   &common.SDKTime{Time: time.Date(2020, 8, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))},
+		SourceDetails: core.InstanceSourceViaImageDetails{ImageId: common.String("ocid1.image.oc1.phx.aaaaaaaa")},
+	}
+
+	changes, err := Diff(actual, desired)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	desired.LifecycleState = core.InstanceLifecycleStateStopped
+	desired.SourceDetails = core.InstanceSourceViaBootVolumeDetails{BootVolumeId: common.String("ocid1.bootvolume.oc1.phx.aaaaaaaa")}
+	changes, err = Diff(actual, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "lifecycleState", From: core.InstanceLifecycleStateRunning, To: core.InstanceLifecycleStateStopped},
+		{Path: "sourceDetails", From: actual.SourceDetails, To: desired.SourceDetails},
+	}, changes)
+	assert.Equal(t, `sourceDetails: {"imageId":"ocid1.image.oc1.phx.aaaaaaaa","sourceType":"image"} -> {"bootVolumeId":"ocid1.bootvolume.oc1.phx.aaaaaaaa","sourceType":"bootVolume"}`,
+		changes[1].String())
+}
+
+func TestPatch(t *testing.T) {
+	actual := testInstance()
+	desired := core.Instance{
+		DisplayName: common.String("web"),
+		Shape:       common.String("VM.Standard.E3.Flex"),
+		ShapeConfig: &core.InstanceShapeConfig{Ocpus: common.Float32(2), MemoryInGBs: common.Float32(16)},
+		FreeformTags: map[string]string{
+			"owner":   "team",
+			"project": "api",
+		},
+		LifecycleState: core.InstanceLifecycleStateStopped,
+	}
+
+	var patch core.UpdateInstanceDetails
+	changes, err := Patch(actual, desired, &patch)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "freeformTags.project", From: "web", To: "api"},
+		{Path: "shapeConfig.ocpus", From: float32(1), To: float32(2)},
+	}, changes)
+	assert.Equal(t, core.UpdateInstanceDetails{
+		FreeformTags: map[string]string{"owner": "team", "project": "api"},
+		ShapeConfig:  &core.UpdateInstanceShapeConfigDetails{Ocpus: common.Float32(2), MemoryInGBs: common.Float32(16)},
+	}, patch)
+}
+
+func TestPatch_InvalidModels(t *testing.T) {
+	var patch core.UpdateInstanceDetails
+	_, err := Patch(testInstance(), "instance", &patch)
+	assert.EqualError(t, err, "can not compare to string, expects a struct")
+
+	_, err = Patch(testInstance(), testInstance(), patch)
+	assert.EqualError(t, err, "can not patch core.UpdateInstanceDetails, expects a pointer to a struct")
+}
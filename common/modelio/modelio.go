@@ -0,0 +1,63 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package modelio reads and writes the models of the services as json documents, such as the specifications of
+// resources stored in files for declarative tooling.
+//
+// The documents are deterministic and lossless: the keys are sorted, the polymorphic models have their
+// discriminator, and the nil optional fields are omitted, so that a model read from a document and written back
+// gives the same document. The yaml documents are read and written by the package common/modelio/modelyaml, a
+// module of its own so that the SDK does not depend on a yaml library.
+package modelio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// MarshalJSON marshals a model to an indented json document, see common.MarshalModel
+func MarshalJSON(model interface{}) ([]byte, error) {
+	data, err := common.MarshalModel(model)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err = json.Indent(&buffer, data, "", "  "); err != nil {
+		return nil, err
+	}
+	buffer.WriteByte('\n')
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a json document to a model, a pointer to a struct or to the interface of a polymorphic
+// model, see common.UnmarshalModel
+func UnmarshalJSON(data []byte, model interface{}) error {
+	return common.UnmarshalModel(data, model)
+}
+
+// Load reads a model from a json file, such as a core.LaunchInstanceDetails
+func Load(path string, model interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err = UnmarshalJSON(data, model); err != nil {
+		return fmt.Errorf("can not read the model of %s: %s", path, err.Error())
+	}
+	return nil
+}
+
+// Save writes a model to a json file
+func Save(path string, model interface{}) error {
+	data, err := MarshalJSON(model)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
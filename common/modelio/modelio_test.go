@@ -0,0 +1,130 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package modelio
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+	"github.com/oracle/oci-go-sdk/v27/database"
+	"github.com/stretchr/testify/assert"
+)
+
+const launchInstanceJSON = `{
+  "availabilityDomain": "Uocm:PHX-AD-1",
+  "compartmentId": "ocid1.compartment.oc1..aaaaaaaa",
+  "freeformTags": {
+    "owner": "team",
+    "project": "web"
+  },
+  "shape": "VM.Standard2.1",
+  "shapeConfig": {
+    "ocpus": 1.5
+  },
+  "sourceDetails": {
+    "bootVolumeSizeInGBs": 100,
+    "imageId": "ocid1.image.oc1.phx.aaaaaaaa",
+    "sourceType": "image"
+  }
+}
+`
+
+func launchInstanceDetails() core.LaunchInstanceDetails {
+	return core.LaunchInstanceDetails{
+		AvailabilityDomain: common.String("Uocm:PHX-AD-1"),
+		CompartmentId:      common.String("ocid1.compartment.oc1..aaaaaaaa"),
+		Shape:              common.String("VM.Standard2.1"),
+		FreeformTags:       map[string]string{"project": "web", "owner": "team"},
+		ShapeConfig:        &core.LaunchInstanceShapeConfigDetails{Ocpus: common.Float32(1.5)},
+		SourceDetails: core.InstanceSourceViaImageDetails{
+			ImageId:             common.String("ocid1.image.oc1.phx.aaaaaaaa"),
+			BootVolumeSizeInGBs: common.Int64(100),
+		},
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	details := launchInstanceDetails()
+	data, err := MarshalJSON(details)
+	assert.NoError(t, err)
+	assert.Equal(t, launchInstanceJSON, string(data))
+
+	data, err = MarshalJSON(&details)
+	assert.NoError(t, err)
+	assert.Equal(t, launchInstanceJSON, string(data))
+
+	var unmarshaled core.LaunchInstanceDetails
+	assert.NoError(t, UnmarshalJSON(data, &unmarshaled))
+	assert.Equal(t, details, unmarshaled)
+}
+
+func TestUnmarshal_PolymorphicModel(t *testing.T) {
+	var details database.CreateDatabaseBase
+	err := UnmarshalJSON([]byte(`{"source":"NONE","dbHomeId":"ocid1.dbhome.oc1.phx.aaaaaaaa","database":{"dbName":"db","adminPassword":"password"}}`), &details)
+	assert.NoError(t, err)
+	newDatabase, ok := details.(database.CreateNewDatabaseDetails)
+	assert.True(t, ok)
+	assert.Equal(t, "db", *newDatabase.Database.DbName)
+
+	data, err := MarshalJSON(details)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"source":"NONE","dbHomeId":"ocid1.dbhome.oc1.phx.aaaaaaaa","database":{"dbName":"db","adminPassword":"password"}}`, string(data))
+}
+
+func TestMarshal_UnknownPolymorphicType(t *testing.T) {
+	document := `{
+  "availabilityDomain": "Uocm:PHX-AD-1",
+  "compartmentId": "ocid1.compartment.oc1..aaaaaaaa",
+  "shape": "VM.Standard2.1",
+  "sourceDetails": {
+    "snapshotId": "ocid1.snapshot.oc1.phx.aaaaaaaa",
+    "sourceType": "snapshot"
+  }
+}
+`
+	var details core.LaunchInstanceDetails
+	assert.NoError(t, UnmarshalJSON([]byte(document), &details))
+	assert.NotNil(t, details.SourceDetails)
+
+	data, err := MarshalJSON(details)
+	assert.NoError(t, err)
+	assert.Equal(t, document, string(data))
+}
+
+func TestMarshal_Times(t *testing.T) {
+	created := common.SDKTime{Time: time.Date(2020, 10, 2, 15, 4, 5, 120000000, time.UTC)}
+	volume := core.Volume{TimeCreated: &created}
+	data, err := MarshalJSON(volume)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "\n  \"timeCreated\": \"2020-10-02T15:04:05.12Z\"\n")
+
+	var unmarshaled core.Volume
+	assert.NoError(t, UnmarshalJSON(data, &unmarshaled))
+	assert.Equal(t, created.Time, unmarshaled.TimeCreated.Time)
+}
+
+func TestLoadAndSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "modelio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "instance.json")
+	assert.NoError(t, Save(path, launchInstanceDetails()))
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, launchInstanceJSON, string(data))
+
+	var loaded core.LaunchInstanceDetails
+	assert.NoError(t, Load(path, &loaded))
+	assert.Equal(t, launchInstanceDetails(), loaded)
+
+	var details core.LaunchInstanceDetails
+	assert.Error(t, Load(filepath.Join(dir, "missing.json"), &details))
+	assert.Error(t, Load(path, details))
+}
@@ -0,0 +1,12 @@
+module github.com/oracle/oci-go-sdk/v27/common/modelio/modelyaml
+
+go 1.13
+
+require (
+	github.com/oracle/oci-go-sdk/v27 v27.1.0
+	github.com/stretchr/testify v1.6.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+// the module is released along with the SDK, and built against the SDK of the same commit
+replace github.com/oracle/oci-go-sdk/v27 => ../../..
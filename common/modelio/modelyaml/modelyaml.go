@@ -0,0 +1,223 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package modelyaml reads and writes the models of the services as yaml documents, with the same content as their
+// json documents of the package common/modelio: the keys are sorted, the polymorphic models have their discriminator,
+// the nil optional fields are omitted and the json names of the fields are used.
+//
+// modelyaml is a module of its own, so that the SDK does not depend on a yaml library.
+package modelyaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/common/modelio"
+	"gopkg.in/yaml.v3"
+)
+
+// Marshal marshals a model to a yaml document, with the same content as its json document
+func Marshal(model interface{}) ([]byte, error) {
+	data, err := common.MarshalModel(model)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err = decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(yamlNode(value)); err != nil {
+		return nil, err
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Unmarshal unmarshals a yaml document to a model, a pointer to a struct or to the interface of a polymorphic
+// model, see common.UnmarshalModel
+func Unmarshal(data []byte, model interface{}) error {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if len(document.Content) == 0 {
+		buffer.WriteString("null")
+	} else if err := writeJSON(&buffer, document.Content[0]); err != nil {
+		return err
+	}
+	return common.UnmarshalModel(buffer.Bytes(), model)
+}
+
+// Load reads a model from a yaml file, named *.yaml or *.yml, or from a json file, such as a
+// core.LaunchInstanceDetails
+func Load(path string, model interface{}) error {
+	if !isYAML(path) {
+		return modelio.Load(path, model)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err = Unmarshal(data, model); err != nil {
+		return fmt.Errorf("can not read the model of %s: %s", path, err.Error())
+	}
+	return nil
+}
+
+// Save writes a model to a yaml file, named *.yaml or *.yml, or to a json file
+func Save(path string, model interface{}) error {
+	if !isYAML(path) {
+		return modelio.Save(path, model)
+	}
+
+	data, err := Marshal(model)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+func isYAML(path string) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	return extension == ".yaml" || extension == ".yml"
+}
+
+// yaml11Booleans is the strings read as booleans by the yaml 1.1 parsers, which are quoted for them
+var yaml11Booleans = map[string]bool{
+	"y": true, "Y": true, "yes": true, "Yes": true, "YES": true, "n": true, "N": true, "no": true, "No": true, "NO": true,
+	"on": true, "On": true, "ON": true, "off": true, "Off": true, "OFF": true,
+}
+
+// yamlNode returns the yaml node of a decoded json value, keeping the numbers as they are written in json
+func yamlNode(value interface{}) *yaml.Node {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, key := range keys {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, yamlNode(value[key]))
+		}
+		return node
+	case []interface{}:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range value {
+			node.Content = append(node.Content, yamlNode(item))
+		}
+		return node
+	case json.Number:
+		if _, err := value.Int64(); err == nil {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: value.String()}
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: value.String()}
+	case string:
+		node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+		if yaml11Booleans[value] {
+			node.Style = yaml.DoubleQuotedStyle
+		}
+		return node
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(value)}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
+
+// writeJSON writes the json of a yaml node
+func writeJSON(buffer *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return writeJSON(buffer, node.Content[0])
+	case yaml.AliasNode:
+		return writeJSON(buffer, node.Alias)
+	case yaml.MappingNode:
+		buffer.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buffer.Write(key)
+			buffer.WriteByte(':')
+			if err = writeJSON(buffer, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	case yaml.SequenceNode:
+		buffer.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeJSON(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case yaml.ScalarNode:
+		return writeScalarJSON(buffer, node)
+	}
+	return nil
+}
+
+// writeScalarJSON writes the json of a yaml scalar, the timestamps being written as strings as the times of the
+// models
+func writeScalarJSON(buffer *bytes.Buffer, node *yaml.Node) error {
+	switch node.ShortTag() {
+	case "!!null":
+		buffer.WriteString("null")
+		return nil
+	case "!!int", "!!float":
+		// keeps the numbers written as json numbers as they are, such as 1.50
+		if json.Valid([]byte(node.Value)) {
+			buffer.WriteString(node.Value)
+			return nil
+		}
+		fallthrough
+	case "!!bool":
+		var value interface{}
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("can not convert %s at line %d to json: %s", node.Value, node.Line, err.Error())
+		}
+		buffer.Write(data)
+		return nil
+	default:
+		data, err := json.Marshal(node.Value)
+		if err != nil {
+			return err
+		}
+		buffer.Write(data)
+		return nil
+	}
+}
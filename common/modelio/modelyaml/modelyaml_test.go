@@ -0,0 +1,151 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package modelyaml
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+	"github.com/oracle/oci-go-sdk/v27/database"
+	"github.com/stretchr/testify/assert"
+)
+
+const launchInstanceJSON = `{
+  "availabilityDomain": "Uocm:PHX-AD-1",
+  "compartmentId": "ocid1.compartment.oc1..aaaaaaaa",
+  "freeformTags": {
+    "owner": "team",
+    "project": "web"
+  },
+  "shape": "VM.Standard2.1",
+  "shapeConfig": {
+    "ocpus": 1.5
+  },
+  "sourceDetails": {
+    "bootVolumeSizeInGBs": 100,
+    "imageId": "ocid1.image.oc1.phx.aaaaaaaa",
+    "sourceType": "image"
+  }
+}
+`
+
+const launchInstanceYAML = `availabilityDomain: Uocm:PHX-AD-1
+compartmentId: ocid1.compartment.oc1..aaaaaaaa
+freeformTags:
+  owner: team
+  project: web
+shape: VM.Standard2.1
+shapeConfig:
+  ocpus: 1.5
+sourceDetails:
+  bootVolumeSizeInGBs: 100
+  imageId: ocid1.image.oc1.phx.aaaaaaaa
+  sourceType: image
+`
+
+func launchInstanceDetails() core.LaunchInstanceDetails {
+	return core.LaunchInstanceDetails{
+		AvailabilityDomain: common.String("Uocm:PHX-AD-1"),
+		CompartmentId:      common.String("ocid1.compartment.oc1..aaaaaaaa"),
+		Shape:              common.String("VM.Standard2.1"),
+		FreeformTags:       map[string]string{"project": "web", "owner": "team"},
+		ShapeConfig:        &core.LaunchInstanceShapeConfigDetails{Ocpus: common.Float32(1.5)},
+		SourceDetails: core.InstanceSourceViaImageDetails{
+			ImageId:             common.String("ocid1.image.oc1.phx.aaaaaaaa"),
+			BootVolumeSizeInGBs: common.Int64(100),
+		},
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	details := launchInstanceDetails()
+	data, err := Marshal(details)
+	assert.NoError(t, err)
+	assert.Equal(t, launchInstanceYAML, string(data))
+
+	var unmarshaled core.LaunchInstanceDetails
+	assert.NoError(t, Unmarshal(data, &unmarshaled))
+	assert.Equal(t, details, unmarshaled)
+}
+
+func TestUnmarshal_Scalars(t *testing.T) {
+	var details core.CreateVolumeDetails
+	err := Unmarshal([]byte(`
+compartmentId: ocid1.compartment.oc1..aaaaaaaa
+displayName: "123"
+sizeInGBs: 0x400
+isAutoTuneEnabled: true
+freeformTags:
+  enabled: "yes"
+  count: "10"
+`), &details)
+	assert.NoError(t, err)
+	assert.Equal(t, "123", *details.DisplayName)
+	assert.Equal(t, int64(1024), *details.SizeInGBs)
+	assert.True(t, *details.IsAutoTuneEnabled)
+	assert.Equal(t, map[string]string{"enabled": "yes", "count": "10"}, details.FreeformTags)
+
+	data, err := Marshal(details)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `displayName: "123"`)
+	assert.Contains(t, string(data), `enabled: "yes"`)
+}
+
+func TestUnmarshal_PolymorphicModel(t *testing.T) {
+	var details database.CreateDatabaseBase
+	err := Unmarshal([]byte(`
+source: NONE
+dbHomeId: ocid1.dbhome.oc1.phx.aaaaaaaa
+database:
+  dbName: db
+  adminPassword: password
+`), &details)
+	assert.NoError(t, err)
+	newDatabase, ok := details.(database.CreateNewDatabaseDetails)
+	assert.True(t, ok)
+	assert.Equal(t, "db", *newDatabase.Database.DbName)
+
+	data, err := Marshal(details)
+	assert.NoError(t, err)
+	assert.Equal(t, "database:\n  adminPassword: password\n  dbName: db\ndbHomeId: ocid1.dbhome.oc1.phx.aaaaaaaa\nsource: NONE\n", string(data))
+}
+
+func TestMarshal_Times(t *testing.T) {
+	created := common.SDKTime{Time: time.Date(2020, 10, 2, 15, 4, 5, 120000000, time.UTC)}
+	volume := core.Volume{TimeCreated: &created}
+	data, err := Marshal(volume)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "\ntimeCreated: \"2020-10-02T15:04:05.12Z\"\n")
+
+	var unmarshaled core.Volume
+	assert.NoError(t, Unmarshal([]byte("timeCreated: 2020-10-02T15:04:05.12Z\n"), &unmarshaled))
+	assert.Equal(t, created.Time, unmarshaled.TimeCreated.Time)
+}
+
+func TestLoadAndSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "modelyaml")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for name, document := range map[string]string{"instance.json": launchInstanceJSON, "instance.yaml": launchInstanceYAML} {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, Save(path, launchInstanceDetails()))
+		data, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, document, string(data))
+
+		var loaded core.LaunchInstanceDetails
+		assert.NoError(t, Load(path, &loaded))
+		assert.Equal(t, launchInstanceDetails(), loaded)
+	}
+
+	var details core.LaunchInstanceDetails
+	assert.Error(t, Load(filepath.Join(dir, "missing.yml"), &details))
+	assert.Error(t, Load(filepath.Join(dir, "instance.yaml"), details))
+}
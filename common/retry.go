@@ -5,6 +5,7 @@ package common
 
 import (
 	"context"
+	cryptorand "crypto/rand"
 	"fmt"
 	"math/rand"
 	"runtime"
@@ -41,6 +42,9 @@ type OCIOperationResponse struct {
 
 	// Operation Attempt Number (one-based)
 	AttemptNumber uint
+
+	// The errors of the attempts so far, this one included, in their order. The attempts which succeeded have none.
+	AttemptErrors []error
 }
 
 // NewOCIOperationResponse assembles an OCI Operation Response object.
@@ -65,6 +69,13 @@ type RetryPolicy struct {
 
 	// GetNextDuration computes the duration to pause between operation retries.
 	NextDuration func(OCIOperationResponse) time.Duration
+
+	// [Optional] OnAttempt is called after each attempt of the operation, before deciding whether to retry it, such as
+	// to log or to count the failures of the attempts.
+	OnAttempt func(OCIOperationResponse)
+
+	// [Optional] OnRetry is called before waiting to retry the operation, with the duration of the wait.
+	OnRetry func(response OCIOperationResponse, wait time.Duration)
 }
 
 // NoRetryPolicy is a helper method that assembles and returns a return policy that indicates an operation should
@@ -90,73 +101,117 @@ func shouldContinueIssuingRequests(current, maximum uint) bool {
 	return maximum == UnlimitedNumAttemptsValue || current <= maximum
 }
 
-// RetryToken generates a retry token that must be included on any request passed to the Retry method.
+// retryTokenChars is the characters of the generated retry tokens
+const retryTokenChars = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// RetryToken generates a retry token that must be included on any request passed to the Retry method. The token is
+// cryptographically random, so that the tokens of different requests, of different processes, do not collide.
 func RetryToken() string {
-	alphanumericChars := []rune("abcdefghijklmnopqrstuvwxyz0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
-	retryToken := make([]rune, generatedRetryTokenLength)
-	for i := range retryToken {
-		retryToken[i] = alphanumericChars[rand.Intn(len(alphanumericChars))]
+	retryToken := make([]byte, 0, generatedRetryTokenLength)
+	randomBytes := make([]byte, generatedRetryTokenLength)
+	for len(retryToken) < generatedRetryTokenLength {
+		if _, err := cryptorand.Read(randomBytes); err != nil {
+			Logf("can not generate a cryptographically random retry token: %s\n", err.Error())
+			for len(retryToken) < generatedRetryTokenLength {
+				retryToken = append(retryToken, retryTokenChars[rand.Intn(len(retryTokenChars))])
+			}
+			break
+		}
+
+		// the bytes beyond the largest multiple of the number of characters are dropped, not to bias the token
+		for _, randomByte := range randomBytes {
+			if int(randomByte) < 256-256%len(retryTokenChars) && len(retryToken) < generatedRetryTokenLength {
+				retryToken = append(retryToken, retryTokenChars[int(randomByte)%len(retryTokenChars)])
+			}
+		}
 	}
 	return string(retryToken)
 }
 
 // Retry is a package-level operation that executes the retryable request using the specified operation and retry policy.
-func Retry(ctx context.Context, request OCIRetryableRequest, operation OCIOperation, policy RetryPolicy) (OCIResponse, error) {
-
-	type retrierResult struct {
-		response OCIResponse
-		err      error
+// The attempts run in the calling goroutine, and the waits between them stop as soon as ctx is done, Retry then
+// returning the response of the last attempt with the error of ctx. The error returned is the error of the last
+// attempt, as is, the errors of all the attempts are given to OnAttempt, see OCIOperationResponse.AttemptErrors.
+func Retry(ctx context.Context, request OCIRetryableRequest, operation OCIOperation, policy RetryPolicy) (response OCIResponse, err error) {
+	if isRequestValidationEnabled() {
+		if validator, ok := request.(Validator); ok {
+			if err := validator.Validate(); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	var response OCIResponse
-	var err error
-	retrierChannel := make(chan retrierResult)
+	// Deal with panics more graciously
+	defer func() {
+		if r := recover(); r != nil {
+			stackBuffer := make([]byte, 1024)
+			bytesWritten := runtime.Stack(stackBuffer, false)
+			stack := string(stackBuffer[:bytesWritten])
+			response, err = nil, fmt.Errorf("panicked while retrying operation. Panic was: %s\nStack: %s", r, stack)
+		}
+	}()
 
-	go func() {
+	var attemptErrors []error
 
-		// Deal with panics more graciously
-		defer func() {
-			if r := recover(); r != nil {
-				stackBuffer := make([]byte, 1024)
-				bytesWritten := runtime.Stack(stackBuffer, false)
-				stack := string(stackBuffer[:bytesWritten])
-				retrierChannel <- retrierResult{nil, fmt.Errorf("panicked while retrying operation. Panic was: %s\nStack: %s", r, stack)}
-			}
-		}()
-
-		// use a one-based counter because it's easier to think about operation retry in terms of attempt numbering
-		for currentOperationAttempt := uint(1); shouldContinueIssuingRequests(currentOperationAttempt, policy.MaximumNumberAttempts); currentOperationAttempt++ {
-			Debugln(fmt.Sprintf("operation attempt #%v", currentOperationAttempt))
-			response, err = operation(ctx, request)
-			operationResponse := NewOCIOperationResponse(response, err, currentOperationAttempt)
-
-			if !policy.ShouldRetryOperation(operationResponse) {
-				// we should NOT retry operation based on response and/or error => return
-				retrierChannel <- retrierResult{response, err}
-				return
-			}
+	// use a one-based counter because it's easier to think about operation retry in terms of attempt numbering
+	for currentOperationAttempt := uint(1); shouldContinueIssuingRequests(currentOperationAttempt, policy.MaximumNumberAttempts); currentOperationAttempt++ {
+		if ctx.Err() != nil {
+			return response, ctx.Err()
+		}
 
-			duration := policy.NextDuration(operationResponse)
-			//The following condition is kept for backwards compatibility reasons
-			if deadline, ok := ctx.Deadline(); ok && time.Now().Add(duration).After(deadline) {
-				// we want to retry the operation, but the policy is telling us to wait for a duration that exceeds
-				// the specified overall deadline for the operation => instead of waiting for however long that
-				// time period is and then aborting, abort now and save the cycles
-				retrierChannel <- retrierResult{response, DeadlineExceededByBackoff}
-				return
-			}
-			Debugln(fmt.Sprintf("waiting %v before retrying operation", duration))
-			// sleep before retrying the operation
-			<-time.After(duration)
+		Debugln(fmt.Sprintf("operation attempt #%v", currentOperationAttempt))
+		response, err = operation(ctx, request)
+		if err != nil {
+			attemptErrors = append(attemptErrors, err)
+		}
+		operationResponse := NewOCIOperationResponse(response, err, currentOperationAttempt)
+		operationResponse.AttemptErrors = attemptErrors
+		if policy.OnAttempt != nil {
+			policy.OnAttempt(operationResponse)
 		}
 
-		retrierChannel <- retrierResult{response, err}
-	}()
+		if !policy.ShouldRetryOperation(operationResponse) {
+			// we should NOT retry operation based on response and/or error => return
+			return response, err
+		}
+
+		if !shouldContinueIssuingRequests(currentOperationAttempt+1, policy.MaximumNumberAttempts) {
+			break
+		}
+
+		duration := policy.NextDuration(operationResponse)
+		//The following condition is kept for backwards compatibility reasons
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(duration).After(deadline) {
+			// we want to retry the operation, but the policy is telling us to wait for a duration that exceeds
+			// the specified overall deadline for the operation => instead of waiting for however long that
+			// time period is and then aborting, abort now and save the cycles
+			return response, DeadlineExceededByBackoff
+		}
+		if policy.OnRetry != nil {
+			policy.OnRetry(operationResponse, duration)
+		}
+
+		Debugln(fmt.Sprintf("waiting %v before retrying operation", duration))
+		if err := waitForRetry(ctx, duration); err != nil {
+			return response, err
+		}
+	}
+
+	return response, err
+}
+
+// waitForRetry waits for a duration, or until ctx is done, returning its error
+func waitForRetry(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return ctx.Err()
+	}
 
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
 	select {
 	case <-ctx.Done():
-		return response, ctx.Err()
-	case result := <-retrierChannel:
-		return result.response, result.err
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
 }
@@ -6,9 +6,11 @@ package common
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"math"
 	"net/http"
+	"runtime"
 	"testing"
 	"time"
 )
@@ -150,3 +152,74 @@ func TestRetryWithPanicInOperation(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Error(t, err)
 }
+
+func TestRetryStopsWaitingWhenContextIsCancelled(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/some", nil)
+	policy := NewRetryPolicy(3, func(OCIOperationResponse) bool {
+		return true
+	}, func(OCIOperationResponse) time.Duration {
+		return time.Hour
+	})
+	r := mockedRequest{Request: *req, Policy: &policy}
+	ctx, cancel := context.WithCancel(context.Background())
+	goroutines := runtime.NumGoroutine()
+	attempts := 0
+	operation := func(i context.Context, request OCIRequest) (OCIResponse, error) {
+		attempts++
+		time.AfterFunc(10*time.Millisecond, cancel)
+		return mockedResponse{RawResponse: &http.Response{StatusCode: 503}}, nil
+	}
+
+	start := time.Now()
+	resp, err := Retry(ctx, r, operation, *r.Policy)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 503, resp.HTTPResponse().StatusCode)
+	assert.Equal(t, 1, attempts)
+	assert.True(t, time.Since(start) < time.Minute)
+	assert.True(t, runtime.NumGoroutine() <= goroutines)
+
+	_, err = Retry(ctx, r, operation, *r.Policy)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryHooksAndAttemptErrors(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/some", nil)
+	var attempts []OCIOperationResponse
+	var waits []time.Duration
+	policy := NewRetryPolicy(3, func(response OCIOperationResponse) bool {
+		return response.Error != nil
+	}, func(response OCIOperationResponse) time.Duration {
+		return time.Duration(response.AttemptNumber) * time.Millisecond
+	})
+	policy.OnAttempt = func(response OCIOperationResponse) {
+		attempts = append(attempts, response)
+	}
+	policy.OnRetry = func(response OCIOperationResponse, wait time.Duration) {
+		waits = append(waits, wait)
+	}
+	r := mockedRequest{Request: *req, Policy: &policy}
+	failures := []error{fmt.Errorf("first failure"), fmt.Errorf("second failure"), fmt.Errorf("third failure")}
+	operation := func(i context.Context, request OCIRequest) (OCIResponse, error) {
+		return nil, failures[len(attempts)]
+	}
+
+	_, err := Retry(context.Background(), r, operation, *r.Policy)
+	assert.Equal(t, failures[2], err)
+	assert.Len(t, attempts, 3)
+	assert.Equal(t, uint(3), attempts[2].AttemptNumber)
+	assert.Equal(t, failures, attempts[2].AttemptErrors)
+	assert.Equal(t, failures[:1], attempts[0].AttemptErrors)
+	// there is no wait after the last attempt
+	assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond}, waits)
+}
+
+func TestRetryToken(t *testing.T) {
+	tokens := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		token := RetryToken()
+		assert.Regexp(t, "^[a-zA-Z0-9]{32}$", token)
+		assert.False(t, tokens[token])
+		tokens[token] = true
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// validateRequests is 1 if the requests are validated before they are sent, see EnableRequestValidation. It is
+// accessed atomically, the clients reading it concurrently.
+var validateRequests int32
+
+// ocidPattern matches the OCIDs, ocid1.<resource type>.<realm>.[region][.future use].<unique id>
+var ocidPattern = regexp.MustCompile(`^ocid1\.[a-z0-9_-]+\.[a-z0-9_-]+\.[a-z0-9._-]*\.[a-z0-9]+$`)
+
+// Validator is implemented by the requests and by the details of their bodies, which check their fields
+type Validator interface {
+	// Validate returns a ValidationError if mandatory fields are missing, or if fields have invalid enum values or
+	// malformed OCIDs
+	Validate() error
+}
+
+// EnableRequestValidation makes the clients validate the requests implementing Validator before sending them, so
+// that the obviously invalid requests fail without a round trip to the service. The requests are not validated by
+// default, the services validating them in any case.
+func EnableRequestValidation() {
+	Debugf("Set validateRequests 'true' to validate the requests before sending them.")
+	atomic.StoreInt32(&validateRequests, 1)
+}
+
+// DisableRequestValidation stops the validation of the requests enabled by EnableRequestValidation
+func DisableRequestValidation() {
+	Debugf("Set validateRequests 'false' to send the requests without validating them.")
+	atomic.StoreInt32(&validateRequests, 0)
+}
+
+// isRequestValidationEnabled returns true if the requests are validated before they are sent
+func isRequestValidationEnabled() bool {
+	return atomic.LoadInt32(&validateRequests) == 1
+}
+
+// FieldError is an invalid field of a struct
+type FieldError struct {
+	// The path of the field from the validated struct, such as CreateVcnDetails.CidrBlock or Statements[0]
+	Field string
+
+	// Why the field is not valid
+	Message string
+}
+
+// ValidationError is returned by the validation of a struct, with all its invalid fields
+type ValidationError struct {
+	// The name of the type of the validated struct
+	Type string
+
+	// The invalid fields, in the order of their declaration
+	Errors []FieldError
+}
+
+func (validationError ValidationError) Error() string {
+	messages := make([]string, len(validationError.Errors))
+	for i, fieldError := range validationError.Errors {
+		messages[i] = fieldError.Field + " " + fieldError.Message
+	}
+	return fmt.Sprintf("invalid %s: %s", validationError.Type, strings.Join(messages, "; "))
+}
+
+// enumRegistry is the values of the enum types of the services, registered by their packages. The lookups are lock
+// free once the registered functions are called, the mutex only guards the functions pending.
+var enumRegistry struct {
+	sync.Mutex
+	pending    []func() []interface{}
+	hasPending int32
+	values     sync.Map
+}
+
+// RegisterEnumValues registers the values of the enum types of a package, as returned by their GetXxxEnumValues
+// functions, so that ValidateStruct checks them. The function is called when a struct is validated for the first
+// time.
+func RegisterEnumValues(values func() []interface{}) {
+	enumRegistry.Lock()
+	defer enumRegistry.Unlock()
+	enumRegistry.pending = append(enumRegistry.pending, values)
+	atomic.StoreInt32(&enumRegistry.hasPending, 1)
+}
+
+// enumValues returns the values of an enum type, or false if the type is not a registered enum type
+func enumValues(enumType reflect.Type) ([]string, bool) {
+	if atomic.LoadInt32(&enumRegistry.hasPending) == 1 {
+		loadPendingEnumValues()
+	}
+
+	values, ok := enumRegistry.values.Load(enumType)
+	if !ok {
+		return nil, false
+	}
+	return values.([]string), true
+}
+
+// loadPendingEnumValues calls the functions registered since the last lookup and stores the values they return
+func loadPendingEnumValues() {
+	enumRegistry.Lock()
+	defer enumRegistry.Unlock()
+
+	for _, pending := range enumRegistry.pending {
+		for _, values := range pending() {
+			slice := reflect.ValueOf(values)
+			if slice.Kind() != reflect.Slice || slice.Type().Elem().Kind() != reflect.String || slice.Type().Elem().PkgPath() == "" {
+				continue
+			}
+			names := make([]string, slice.Len())
+			for i := range names {
+				names[i] = slice.Index(i).String()
+			}
+			enumRegistry.values.Store(slice.Type().Elem(), names)
+		}
+	}
+	enumRegistry.pending = nil
+	atomic.StoreInt32(&enumRegistry.hasPending, 0)
+}
+
+// ValidateStruct checks the fields of a struct and of the structs, slices, maps and polymorphic models it contains:
+// the fields tagged mandatory:"true" must be set, the fields of the registered enum types must have one of their
+// values, and the fields named after identifiers, such as CompartmentId or SubnetIds, must be well formed OCIDs
+// when they look like OCIDs. The compartment and tenancy identifiers must always be OCIDs. It returns a
+// ValidationError with all the invalid fields, or nil.
+func ValidateStruct(value interface{}) error {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("can not validate %v, expects struct input", val.Kind())
+	}
+
+	validationError := ValidationError{Type: val.Type().Name()}
+	validationError.Errors = validateFields(val, "", validationError.Errors)
+	if len(validationError.Errors) > 0 {
+		return validationError
+	}
+	return nil
+}
+
+// validateFields appends the errors of the fields of a struct to errors
+func validateFields(val reflect.Value, path string, errors []FieldError) []FieldError {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		// the fields of the embedded structs are promoted, even if their types are not exported
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		// the embedded structs are flattened, except for the bodies of the requests, named after their types
+		fieldPath := path
+		if !field.Anonymous || field.Tag.Get("contributesTo") == "body" {
+			fieldPath = joinFieldPath(path, field.Name)
+		}
+		fieldValue := val.Field(i)
+
+		if field.Tag.Get("mandatory") == "true" && isMissing(fieldValue) {
+			errors = append(errors, FieldError{Field: fieldPath, Message: "is mandatory"})
+			continue
+		}
+
+		// the binary bodies are readers, which are not validated
+		if field.Tag.Get("encoding") == "binary" {
+			continue
+		}
+		errors = validateValue(fieldValue, fieldPath, field.Name, errors)
+	}
+	return errors
+}
+
+// validateValue appends the errors of a value to errors. The name is the name of the field of the value, which tells
+// whether its strings are OCIDs.
+func validateValue(val reflect.Value, path, name string, errors []FieldError) []FieldError {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !val.IsNil() {
+			errors = validateValue(val.Elem(), path, name, errors)
+		}
+	case reflect.Struct:
+		errors = validateFields(val, path, errors)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			errors = validateValue(val.Index(i), fmt.Sprintf("%s[%d]", path, i), name, errors)
+		}
+	case reflect.Map:
+		keys := val.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			errors = validateValue(val.MapIndex(key), fmt.Sprintf("%s[%v]", path, key), name, errors)
+		}
+	case reflect.String:
+		if message := validateString(val, name); message != "" {
+			errors = append(errors, FieldError{Field: path, Message: message})
+		}
+	}
+	return errors
+}
+
+// validateString returns why a string value is not valid, or an empty string
+func validateString(val reflect.Value, name string) string {
+	value := val.String()
+	if values, ok := enumValues(val.Type()); ok {
+		for _, valid := range values {
+			if value == valid {
+				return ""
+			}
+		}
+		if value == "" {
+			return ""
+		}
+		return fmt.Sprintf("has the invalid value %q, expects one of %s", value, strings.Join(values, ", "))
+	}
+
+	if isOCIDField(name) && (strings.HasPrefix(value, "ocid") || mustBeOCID(name)) && !ocidPattern.MatchString(value) {
+		return fmt.Sprintf("has the malformed OCID %q", value)
+	}
+	return ""
+}
+
+// isOCIDField returns whether a field is named after an identifier, whose values are usually OCIDs
+func isOCIDField(name string) bool {
+	return (strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "Ids")) && !strings.HasPrefix(name, "Opc")
+}
+
+// mustBeOCID returns whether the values of a field are always OCIDs
+func mustBeOCID(name string) bool {
+	name = strings.TrimSuffix(name, "s")
+	return strings.HasSuffix(name, "CompartmentId") || strings.HasSuffix(name, "TenancyId")
+}
+
+// isMissing returns whether the value of a mandatory field is missing
+func isMissing(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return val.IsNil()
+	case reflect.String:
+		return val.Len() == 0
+	}
+	return false
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
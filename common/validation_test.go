@@ -0,0 +1,186 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatedShapeEnum string
+
+const (
+	validatedShapeSmall validatedShapeEnum = "SMALL"
+	validatedShapeLarge validatedShapeEnum = "LARGE"
+)
+
+func getValidatedShapeEnumValues() []validatedShapeEnum {
+	return []validatedShapeEnum{validatedShapeSmall, validatedShapeLarge}
+}
+
+func init() {
+	RegisterEnumValues(func() []interface{} {
+		return []interface{}{getValidatedShapeEnumValues()}
+	})
+}
+
+type validatedRule interface {
+	rule()
+}
+
+type validatedPortRule struct {
+	Port *int `mandatory:"true" json:"port"`
+}
+
+func (validatedPortRule) rule() {}
+
+type validatedDetails struct {
+	CompartmentId *string                      `mandatory:"true" json:"compartmentId"`
+	DisplayName   *string                      `mandatory:"false" json:"displayName"`
+	Shape         validatedShapeEnum           `mandatory:"true" json:"shape"`
+	Shapes        []validatedShapeEnum         `mandatory:"false" json:"shapes"`
+	SubnetIds     []string                     `mandatory:"false" json:"subnetIds"`
+	ImageId       *string                      `mandatory:"false" json:"imageId"`
+	Rules         []validatedRule              `mandatory:"false" json:"rules"`
+	Tags          map[string]validatedPortRule `mandatory:"false" json:"tags"`
+}
+
+type validatedRequest struct {
+	validatedDetails `contributesTo:"body"`
+	OpcRequestId     *string `mandatory:"false" contributesTo:"header" name:"opc-request-id"`
+	mockedRequest
+}
+
+func (request validatedRequest) Validate() error {
+	return ValidateStruct(request)
+}
+
+func validDetails() validatedDetails {
+	return validatedDetails{
+		CompartmentId: String("ocid1.compartment.oc1..aaaaaaaa"),
+		Shape:         validatedShapeSmall,
+	}
+}
+
+func TestValidateStruct_Valid(t *testing.T) {
+	details := validDetails()
+	details.DisplayName = String("any name")
+	details.Shapes = []validatedShapeEnum{validatedShapeLarge}
+	details.SubnetIds = []string{"ocid1.subnet.oc1.phx.aaaaaaaa"}
+	details.ImageId = String("not an ocid")
+	details.Rules = []validatedRule{validatedPortRule{Port: Int(22)}}
+	details.Tags = map[string]validatedPortRule{"ssh": {Port: Int(22)}}
+
+	assert.NoError(t, ValidateStruct(details))
+	assert.NoError(t, ValidateStruct(&details))
+	assert.NoError(t, ValidateStruct(validatedRequest{validatedDetails: details, OpcRequestId: String("ocid-like")}))
+}
+
+func TestValidateStruct_ReportsAllErrors(t *testing.T) {
+	details := validatedDetails{
+		Shapes:    []validatedShapeEnum{validatedShapeLarge, "HUGE"},
+		SubnetIds: []string{"ocid1.subnet.oc1.phx.aaaaaaaa", "ocid1.subnet"},
+		Rules:     []validatedRule{validatedPortRule{}},
+		Tags:      map[string]validatedPortRule{"b": {}, "a": {}},
+	}
+
+	err := ValidateStruct(validatedRequest{validatedDetails: details})
+	assert.Error(t, err)
+	validationError, ok := err.(ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, "validatedRequest", validationError.Type)
+	assert.Equal(t, []FieldError{
+		{Field: "validatedDetails.CompartmentId", Message: "is mandatory"},
+		{Field: "validatedDetails.Shape", Message: "is mandatory"},
+		{Field: "validatedDetails.Shapes[1]", Message: `has the invalid value "HUGE", expects one of SMALL, LARGE`},
+		{Field: "validatedDetails.SubnetIds[1]", Message: `has the malformed OCID "ocid1.subnet"`},
+		{Field: "validatedDetails.Rules[0].Port", Message: "is mandatory"},
+		{Field: "validatedDetails.Tags[a].Port", Message: "is mandatory"},
+		{Field: "validatedDetails.Tags[b].Port", Message: "is mandatory"},
+	}, validationError.Errors)
+	assert.Equal(t, "invalid validatedRequest: validatedDetails.CompartmentId is mandatory; validatedDetails.Shape is mandatory; "+
+		`validatedDetails.Shapes[1] has the invalid value "HUGE", expects one of SMALL, LARGE; `+
+		`validatedDetails.SubnetIds[1] has the malformed OCID "ocid1.subnet"; validatedDetails.Rules[0].Port is mandatory; `+
+		"validatedDetails.Tags[a].Port is mandatory; validatedDetails.Tags[b].Port is mandatory", err.Error())
+}
+
+func TestValidateStruct_CompartmentIdMustBeOCID(t *testing.T) {
+	details := validDetails()
+	details.CompartmentId = String("my-compartment")
+	err := ValidateStruct(details)
+	assert.EqualError(t, err, `invalid validatedDetails: CompartmentId has the malformed OCID "my-compartment"`)
+
+	details = validDetails()
+	details.Shape = "MEDIUM"
+	err = ValidateStruct(details)
+	assert.EqualError(t, err, `invalid validatedDetails: Shape has the invalid value "MEDIUM", expects one of SMALL, LARGE`)
+}
+
+func TestValidateStruct_NotStruct(t *testing.T) {
+	assert.Error(t, ValidateStruct("string"))
+	assert.Error(t, ValidateStruct(nil))
+}
+
+func TestRetry_ValidatesRequests(t *testing.T) {
+	req, _ := http.NewRequest("POST", "/some", nil)
+	policy := NoRetryPolicy()
+	calls := 0
+	operation := func(ctx context.Context, request OCIRequest) (OCIResponse, error) {
+		calls++
+		return mockedResponse{RawResponse: &http.Response{StatusCode: 200}}, nil
+	}
+	request := validatedRequest{mockedRequest: mockedRequest{Request: *req, Policy: &policy}}
+
+	// the requests are not validated by default
+	_, err := Retry(context.Background(), request, operation, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	EnableRequestValidation()
+	defer DisableRequestValidation()
+
+	_, err = Retry(context.Background(), request, operation, policy)
+	assert.IsType(t, ValidationError{}, err)
+	assert.Equal(t, 1, calls)
+
+	request.validatedDetails = validDetails()
+	_, err = Retry(context.Background(), request, operation, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// the invalid requests are sent again once the validation is disabled
+	DisableRequestValidation()
+	request.validatedDetails = validatedDetails{}
+	_, err = Retry(context.Background(), request, operation, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestEnumValues_Concurrent(t *testing.T) {
+	type concurrentEnum string
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterEnumValues(func() []interface{} { return []interface{}{[]concurrentEnum{"A"}} })
+		}()
+		go func() {
+			defer wg.Done()
+			values, ok := enumValues(reflect.TypeOf(validatedShapeSmall))
+			assert.True(t, ok)
+			assert.Equal(t, []string{"SMALL", "LARGE"}, values)
+		}()
+	}
+	wg.Wait()
+
+	values, ok := enumValues(reflect.TypeOf(concurrentEnum("")))
+	assert.True(t, ok)
+	assert.Equal(t, []string{"A"}, values)
+}
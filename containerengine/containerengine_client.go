@@ -104,10 +104,12 @@ func (client ContainerEngineClient) CreateCluster(ctx context.Context, request C
 				response = CreateClusterResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateClusterResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateClusterResponse")
 	}
@@ -208,10 +210,12 @@ func (client ContainerEngineClient) CreateNodePool(ctx context.Context, request
 				response = CreateNodePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNodePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNodePoolResponse")
 	}
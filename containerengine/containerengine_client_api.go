@@ -0,0 +1,69 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package containerengine
+
+import (
+	"context"
+)
+
+// ContainerEngineClientAPI is the interface of the operations of ContainerEngineClient, to inject the client and mock it in tests
+type ContainerEngineClientAPI interface {
+	// CreateCluster Create a new cluster.
+	CreateCluster(ctx context.Context, request CreateClusterRequest) (response CreateClusterResponse, err error)
+
+	// CreateKubeconfig Create the Kubeconfig YAML for a cluster.
+	CreateKubeconfig(ctx context.Context, request CreateKubeconfigRequest) (response CreateKubeconfigResponse, err error)
+
+	// CreateNodePool Create a new node pool.
+	CreateNodePool(ctx context.Context, request CreateNodePoolRequest) (response CreateNodePoolResponse, err error)
+
+	// DeleteCluster Delete a cluster.
+	DeleteCluster(ctx context.Context, request DeleteClusterRequest) (response DeleteClusterResponse, err error)
+
+	// DeleteNodePool Delete a node pool.
+	DeleteNodePool(ctx context.Context, request DeleteNodePoolRequest) (response DeleteNodePoolResponse, err error)
+
+	// DeleteWorkRequest Cancel a work request that has not started.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// GetCluster Get the details of a cluster.
+	GetCluster(ctx context.Context, request GetClusterRequest) (response GetClusterResponse, err error)
+
+	// GetClusterOptions Get options available for clusters.
+	GetClusterOptions(ctx context.Context, request GetClusterOptionsRequest) (response GetClusterOptionsResponse, err error)
+
+	// GetNodePool Get the details of a node pool.
+	GetNodePool(ctx context.Context, request GetNodePoolRequest) (response GetNodePoolResponse, err error)
+
+	// GetNodePoolOptions Get options available for node pools.
+	GetNodePoolOptions(ctx context.Context, request GetNodePoolOptionsRequest) (response GetNodePoolOptionsResponse, err error)
+
+	// GetWorkRequest Get the details of a work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListClusters List all the cluster objects in a compartment.
+	ListClusters(ctx context.Context, request ListClustersRequest) (response ListClustersResponse, err error)
+
+	// ListNodePools List all the node pools in a compartment, and optionally filter by cluster.
+	ListNodePools(ctx context.Context, request ListNodePoolsRequest) (response ListNodePoolsResponse, err error)
+
+	// ListWorkRequestErrors Get the errors of a work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Get the logs of a work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests List all work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateCluster Update the details of a cluster.
+	UpdateCluster(ctx context.Context, request UpdateClusterRequest) (response UpdateClusterResponse, err error)
+
+	// UpdateNodePool Update the details of a node pool.
+	UpdateNodePool(ctx context.Context, request UpdateNodePoolRequest) (response UpdateNodePoolResponse, err error)
+}
+
+// ContainerEngineClient implements ContainerEngineClientAPI
+var _ ContainerEngineClientAPI = ContainerEngineClient{}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package containerengine
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*NodeSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &nodesourcedetails{} })
+	common.RegisterPolymorphicModel((*NodeSourceOption)(nil), func() common.PolymorphicJSONUnmarshaler { return &nodesourceoption{} })
+}
+
+// MarshalJSON marshals a NodeSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m nodesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypenodesourcedetails nodesourcedetails
+	s := struct {
+		MarshalTypenodesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypenodesourcedetails: (MarshalTypenodesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a NodeSourceOption whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m nodesourceoption) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypenodesourceoption nodesourceoption
+	s := struct {
+		MarshalTypenodesourceoption
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypenodesourceoption: (MarshalTypenodesourceoption)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -419,10 +419,12 @@ func (client BlockstorageClient) CopyBootVolumeBackup(ctx context.Context, reque
 				response = CopyBootVolumeBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CopyBootVolumeBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CopyBootVolumeBackupResponse")
 	}
@@ -475,10 +477,12 @@ func (client BlockstorageClient) CopyVolumeBackup(ctx context.Context, request C
 				response = CopyVolumeBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CopyVolumeBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CopyVolumeBackupResponse")
 	}
@@ -533,10 +537,12 @@ func (client BlockstorageClient) CreateBootVolume(ctx context.Context, request C
 				response = CreateBootVolumeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBootVolumeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBootVolumeResponse")
 	}
@@ -592,10 +598,12 @@ func (client BlockstorageClient) CreateBootVolumeBackup(ctx context.Context, req
 				response = CreateBootVolumeBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBootVolumeBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBootVolumeBackupResponse")
 	}
@@ -658,10 +666,12 @@ func (client BlockstorageClient) CreateVolume(ctx context.Context, request Creat
 				response = CreateVolumeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVolumeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVolumeResponse")
 	}
@@ -717,10 +727,12 @@ func (client BlockstorageClient) CreateVolumeBackup(ctx context.Context, request
 				response = CreateVolumeBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVolumeBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVolumeBackupResponse")
 	}
@@ -774,10 +786,12 @@ func (client BlockstorageClient) CreateVolumeBackupPolicy(ctx context.Context, r
 				response = CreateVolumeBackupPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVolumeBackupPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVolumeBackupPolicyResponse")
 	}
@@ -886,10 +900,12 @@ func (client BlockstorageClient) CreateVolumeGroup(ctx context.Context, request
 				response = CreateVolumeGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVolumeGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVolumeGroupResponse")
 	}
@@ -942,10 +958,12 @@ func (client BlockstorageClient) CreateVolumeGroupBackup(ctx context.Context, re
 				response = CreateVolumeGroupBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVolumeGroupBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVolumeGroupBackupResponse")
 	}
@@ -2659,10 +2677,12 @@ func (client BlockstorageClient) UpdateVolumeBackupPolicy(ctx context.Context, r
 				response = UpdateVolumeBackupPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateVolumeBackupPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateVolumeBackupPolicyResponse")
 	}
@@ -0,0 +1,246 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package core
+
+import (
+	"context"
+)
+
+// BlockstorageClientAPI is the interface of the operations of BlockstorageClient, to inject the client and mock it in tests
+type BlockstorageClientAPI interface {
+	// ChangeBootVolumeBackupCompartment Moves a boot volume backup into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeBootVolumeBackupCompartment(ctx context.Context, request ChangeBootVolumeBackupCompartmentRequest) (response ChangeBootVolumeBackupCompartmentResponse, err error)
+
+	// ChangeBootVolumeCompartment Moves a boot volume into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeBootVolumeCompartment(ctx context.Context, request ChangeBootVolumeCompartmentRequest) (response ChangeBootVolumeCompartmentResponse, err error)
+
+	// ChangeVolumeBackupCompartment Moves a volume backup into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVolumeBackupCompartment(ctx context.Context, request ChangeVolumeBackupCompartmentRequest) (response ChangeVolumeBackupCompartmentResponse, err error)
+
+	// ChangeVolumeCompartment Moves a volume into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVolumeCompartment(ctx context.Context, request ChangeVolumeCompartmentRequest) (response ChangeVolumeCompartmentResponse, err error)
+
+	// ChangeVolumeGroupBackupCompartment Moves a volume group backup into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVolumeGroupBackupCompartment(ctx context.Context, request ChangeVolumeGroupBackupCompartmentRequest) (response ChangeVolumeGroupBackupCompartmentResponse, err error)
+
+	// ChangeVolumeGroupCompartment Moves a volume group into a different compartment within the same tenancy.
+	// For information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVolumeGroupCompartment(ctx context.Context, request ChangeVolumeGroupCompartmentRequest) (response ChangeVolumeGroupCompartmentResponse, err error)
+
+	// CopyBootVolumeBackup Creates a boot volume backup copy in specified region. For general information about volume backups,
+	// see Overview of Boot Volume Backups (https://docs.cloud.oracle.com/Content/Block/Concepts/bootvolumebackups.htm)
+	CopyBootVolumeBackup(ctx context.Context, request CopyBootVolumeBackupRequest) (response CopyBootVolumeBackupResponse, err error)
+
+	// CopyVolumeBackup Creates a volume backup copy in specified region. For general information about volume backups,
+	// see Overview of Block Volume Service Backups (https://docs.cloud.oracle.com/Content/Block/Concepts/blockvolumebackups.htm)
+	CopyVolumeBackup(ctx context.Context, request CopyVolumeBackupRequest) (response CopyVolumeBackupResponse, err error)
+
+	// CreateBootVolume Creates a new boot volume in the specified compartment from an existing boot volume or a boot volume backup.
+	// For general information about boot volumes, see Boot Volumes (https://docs.cloud.oracle.com/Content/Block/Concepts/bootvolumes.htm).
+	// You may optionally specify a *display name* for the volume, which is simply a friendly name or
+	// description. It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateBootVolume(ctx context.Context, request CreateBootVolumeRequest) (response CreateBootVolumeResponse, err error)
+
+	// CreateBootVolumeBackup Creates a new boot volume backup of the specified boot volume. For general information about boot volume backups,
+	// see Overview of Boot Volume Backups (https://docs.cloud.oracle.com/Content/Block/Concepts/bootvolumebackups.htm)
+	// When the request is received, the backup object is in a REQUEST_RECEIVED state.
+	// When the data is imaged, it goes into a CREATING state.
+	// After the backup is fully uploaded to the cloud, it goes into an AVAILABLE state.
+	CreateBootVolumeBackup(ctx context.Context, request CreateBootVolumeBackupRequest) (response CreateBootVolumeBackupResponse, err error)
+
+	// CreateVolume Creates a new volume in the specified compartment. Volumes can be created in sizes ranging from
+	// 50 GB (51200 MB) to 32 TB (33554432 MB), in 1 GB (1024 MB) increments. By default, volumes are 1 TB (1048576 MB).
+	// For general information about block volumes, see
+	// Overview of Block Volume Service (https://docs.cloud.oracle.com/Content/Block/Concepts/overview.htm).
+	// A volume and instance can be in separate compartments but must be in the same availability domain.
+	// For information about access control and compartments, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about
+	// availability domains, see Regions and Availability Domains (https://docs.cloud.oracle.com/Content/General/Concepts/regions.htm).
+	// To get a list of availability domains, use the `ListAvailabilityDomains` operation
+	// in the Identity and Access Management Service API.
+	// You may optionally specify a *display name* for the volume, which is simply a friendly name or
+	// description. It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateVolume(ctx context.Context, request CreateVolumeRequest) (response CreateVolumeResponse, err error)
+
+	// CreateVolumeBackup Creates a new backup of the specified volume. For general information about volume backups,
+	// see Overview of Block Volume Service Backups (https://docs.cloud.oracle.com/Content/Block/Concepts/blockvolumebackups.htm)
+	// When the request is received, the backup object is in a REQUEST_RECEIVED state.
+	// When the data is imaged, it goes into a CREATING state.
+	// After the backup is fully uploaded to the cloud, it goes into an AVAILABLE state.
+	CreateVolumeBackup(ctx context.Context, request CreateVolumeBackupRequest) (response CreateVolumeBackupResponse, err error)
+
+	// CreateVolumeBackupPolicy Creates a new user defined backup policy.
+	// For more information about Oracle defined backup policies and user defined backup policies,
+	// see Policy-Based Backups (https://docs.cloud.oracle.com/iaas/Content/Block/Tasks/schedulingvolumebackups.htm).
+	CreateVolumeBackupPolicy(ctx context.Context, request CreateVolumeBackupPolicyRequest) (response CreateVolumeBackupPolicyResponse, err error)
+
+	// CreateVolumeBackupPolicyAssignment Assigns a volume backup policy to the specified volume. Note that a given volume can
+	// only have one backup policy assigned to it. If this operation is used for a volume that already
+	// has a different backup policy assigned, the prior backup policy will be silently unassigned.
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, request CreateVolumeBackupPolicyAssignmentRequest) (response CreateVolumeBackupPolicyAssignmentResponse, err error)
+
+	// CreateVolumeGroup Creates a new volume group in the specified compartment.
+	// A volume group is a collection of volumes and may be created from a list of volumes, cloning an existing
+	// volume group, or by restoring a volume group backup. A volume group can contain up to 64 volumes.
+	// You may optionally specify a *display name* for the volume group, which is simply a friendly name or
+	// description. It does not have to be unique, and you can change it. Avoid entering confidential information.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	CreateVolumeGroup(ctx context.Context, request CreateVolumeGroupRequest) (response CreateVolumeGroupResponse, err error)
+
+	// CreateVolumeGroupBackup Creates a new backup volume group of the specified volume group.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	CreateVolumeGroupBackup(ctx context.Context, request CreateVolumeGroupBackupRequest) (response CreateVolumeGroupBackupResponse, err error)
+
+	// DeleteBootVolume Deletes the specified boot volume. The volume cannot have an active connection to an instance.
+	// To disconnect the boot volume from a connected instance, see
+	// Disconnecting From a Boot Volume (https://docs.cloud.oracle.com/Content/Block/Tasks/deletingbootvolume.htm).
+	// **Warning:** All data on the boot volume will be permanently lost when the boot volume is deleted.
+	DeleteBootVolume(ctx context.Context, request DeleteBootVolumeRequest) (response DeleteBootVolumeResponse, err error)
+
+	// DeleteBootVolumeBackup Deletes a boot volume backup.
+	DeleteBootVolumeBackup(ctx context.Context, request DeleteBootVolumeBackupRequest) (response DeleteBootVolumeBackupResponse, err error)
+
+	// DeleteBootVolumeKmsKey Removes the specified boot volume's assigned Key Management encryption key.
+	DeleteBootVolumeKmsKey(ctx context.Context, request DeleteBootVolumeKmsKeyRequest) (response DeleteBootVolumeKmsKeyResponse, err error)
+
+	// DeleteVolume Deletes the specified volume. The volume cannot have an active connection to an instance.
+	// To disconnect the volume from a connected instance, see
+	// Disconnecting From a Volume (https://docs.cloud.oracle.com/Content/Block/Tasks/disconnectingfromavolume.htm).
+	// **Warning:** All data on the volume will be permanently lost when the volume is deleted.
+	DeleteVolume(ctx context.Context, request DeleteVolumeRequest) (response DeleteVolumeResponse, err error)
+
+	// DeleteVolumeBackup Deletes a volume backup.
+	DeleteVolumeBackup(ctx context.Context, request DeleteVolumeBackupRequest) (response DeleteVolumeBackupResponse, err error)
+
+	// DeleteVolumeBackupPolicy Deletes a user defined backup policy.
+	//  For more information about user defined backup policies,
+	//  see Policy-Based Backups (https://docs.cloud.oracle.com/iaas/Content/Block/Tasks/schedulingvolumebackups.htm#UserDefinedBackupPolicies).
+	//  Avoid entering confidential information.
+	DeleteVolumeBackupPolicy(ctx context.Context, request DeleteVolumeBackupPolicyRequest) (response DeleteVolumeBackupPolicyResponse, err error)
+
+	// DeleteVolumeBackupPolicyAssignment Deletes a volume backup policy assignment.
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, request DeleteVolumeBackupPolicyAssignmentRequest) (response DeleteVolumeBackupPolicyAssignmentResponse, err error)
+
+	// DeleteVolumeGroup Deletes the specified volume group. Individual volumes are not deleted, only the volume group is deleted.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	DeleteVolumeGroup(ctx context.Context, request DeleteVolumeGroupRequest) (response DeleteVolumeGroupResponse, err error)
+
+	// DeleteVolumeGroupBackup Deletes a volume group backup. This operation deletes all the backups in the volume group. For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	DeleteVolumeGroupBackup(ctx context.Context, request DeleteVolumeGroupBackupRequest) (response DeleteVolumeGroupBackupResponse, err error)
+
+	// DeleteVolumeKmsKey Removes the specified volume's assigned Key Management encryption key.
+	DeleteVolumeKmsKey(ctx context.Context, request DeleteVolumeKmsKeyRequest) (response DeleteVolumeKmsKeyResponse, err error)
+
+	// GetBootVolume Gets information for the specified boot volume.
+	GetBootVolume(ctx context.Context, request GetBootVolumeRequest) (response GetBootVolumeResponse, err error)
+
+	// GetBootVolumeBackup Gets information for the specified boot volume backup.
+	GetBootVolumeBackup(ctx context.Context, request GetBootVolumeBackupRequest) (response GetBootVolumeBackupResponse, err error)
+
+	// GetBootVolumeKmsKey Gets the Key Management encryption key assigned to the specified boot volume.
+	GetBootVolumeKmsKey(ctx context.Context, request GetBootVolumeKmsKeyRequest) (response GetBootVolumeKmsKeyResponse, err error)
+
+	// GetVolume Gets information for the specified volume.
+	GetVolume(ctx context.Context, request GetVolumeRequest) (response GetVolumeResponse, err error)
+
+	// GetVolumeBackup Gets information for the specified volume backup.
+	GetVolumeBackup(ctx context.Context, request GetVolumeBackupRequest) (response GetVolumeBackupResponse, err error)
+
+	// GetVolumeBackupPolicy Gets information for the specified volume backup policy.
+	GetVolumeBackupPolicy(ctx context.Context, request GetVolumeBackupPolicyRequest) (response GetVolumeBackupPolicyResponse, err error)
+
+	// GetVolumeBackupPolicyAssetAssignment Gets the volume backup policy assignment for the specified volume. The
+	// `assetId` query parameter is required, and the returned list will contain at most
+	// one item, since volume can only have one volume backup policy assigned at a time.
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request GetVolumeBackupPolicyAssetAssignmentRequest) (response GetVolumeBackupPolicyAssetAssignmentResponse, err error)
+
+	// GetVolumeBackupPolicyAssignment Gets information for the specified volume backup policy assignment.
+	GetVolumeBackupPolicyAssignment(ctx context.Context, request GetVolumeBackupPolicyAssignmentRequest) (response GetVolumeBackupPolicyAssignmentResponse, err error)
+
+	// GetVolumeGroup Gets information for the specified volume group. For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	GetVolumeGroup(ctx context.Context, request GetVolumeGroupRequest) (response GetVolumeGroupResponse, err error)
+
+	// GetVolumeGroupBackup Gets information for the specified volume group backup. For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	GetVolumeGroupBackup(ctx context.Context, request GetVolumeGroupBackupRequest) (response GetVolumeGroupBackupResponse, err error)
+
+	// GetVolumeKmsKey Gets the Key Management encryption key assigned to the specified volume.
+	GetVolumeKmsKey(ctx context.Context, request GetVolumeKmsKeyRequest) (response GetVolumeKmsKeyResponse, err error)
+
+	// ListBootVolumeBackups Lists the boot volume backups in the specified compartment. You can filter the results by boot volume.
+	ListBootVolumeBackups(ctx context.Context, request ListBootVolumeBackupsRequest) (response ListBootVolumeBackupsResponse, err error)
+
+	// ListBootVolumes Lists the boot volumes in the specified compartment and availability domain.
+	ListBootVolumes(ctx context.Context, request ListBootVolumesRequest) (response ListBootVolumesResponse, err error)
+
+	// ListVolumeBackupPolicies Lists all the volume backup policies available in the specified compartment.
+	// For more information about Oracle defined backup policies and user defined backup policies,
+	// see Policy-Based Backups (https://docs.cloud.oracle.com/iaas/Content/Block/Tasks/schedulingvolumebackups.htm).
+	ListVolumeBackupPolicies(ctx context.Context, request ListVolumeBackupPoliciesRequest) (response ListVolumeBackupPoliciesResponse, err error)
+
+	// ListVolumeBackups Lists the volume backups in the specified compartment. You can filter the results by volume.
+	ListVolumeBackups(ctx context.Context, request ListVolumeBackupsRequest) (response ListVolumeBackupsResponse, err error)
+
+	// ListVolumeGroupBackups Lists the volume group backups in the specified compartment. You can filter the results by volume group.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	ListVolumeGroupBackups(ctx context.Context, request ListVolumeGroupBackupsRequest) (response ListVolumeGroupBackupsResponse, err error)
+
+	// ListVolumeGroups Lists the volume groups in the specified compartment and availability domain.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	ListVolumeGroups(ctx context.Context, request ListVolumeGroupsRequest) (response ListVolumeGroupsResponse, err error)
+
+	// ListVolumes Lists the volumes in the specified compartment and availability domain.
+	ListVolumes(ctx context.Context, request ListVolumesRequest) (response ListVolumesResponse, err error)
+
+	// UpdateBootVolume Updates the specified boot volume's display name, defined tags, and free-form tags.
+	UpdateBootVolume(ctx context.Context, request UpdateBootVolumeRequest) (response UpdateBootVolumeResponse, err error)
+
+	// UpdateBootVolumeBackup Updates the display name for the specified boot volume backup.
+	// Avoid entering confidential information.
+	UpdateBootVolumeBackup(ctx context.Context, request UpdateBootVolumeBackupRequest) (response UpdateBootVolumeBackupResponse, err error)
+
+	// UpdateBootVolumeKmsKey Updates the specified volume with a new Key Management master encryption key.
+	UpdateBootVolumeKmsKey(ctx context.Context, request UpdateBootVolumeKmsKeyRequest) (response UpdateBootVolumeKmsKeyResponse, err error)
+
+	// UpdateVolume Updates the specified volume's display name.
+	// Avoid entering confidential information.
+	UpdateVolume(ctx context.Context, request UpdateVolumeRequest) (response UpdateVolumeResponse, err error)
+
+	// UpdateVolumeBackup Updates the display name for the specified volume backup.
+	// Avoid entering confidential information.
+	UpdateVolumeBackup(ctx context.Context, request UpdateVolumeBackupRequest) (response UpdateVolumeBackupResponse, err error)
+
+	// UpdateVolumeBackupPolicy Updates a user defined backup policy.
+	//  For more information about user defined backup policies,
+	//  see Policy-Based Backups (https://docs.cloud.oracle.com/iaas/Content/Block/Tasks/schedulingvolumebackups.htm#UserDefinedBackupPolicies).
+	//  Avoid entering confidential information.
+	UpdateVolumeBackupPolicy(ctx context.Context, request UpdateVolumeBackupPolicyRequest) (response UpdateVolumeBackupPolicyResponse, err error)
+
+	// UpdateVolumeGroup Updates the set of volumes in a volume group along with the display name. Use this operation
+	// to add or remove volumes in a volume group. Specify the full list of volume IDs to include in the
+	// volume group. If the volume ID is not specified in the call, it will be removed from the volume group.
+	// Avoid entering confidential information.
+	// For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	UpdateVolumeGroup(ctx context.Context, request UpdateVolumeGroupRequest) (response UpdateVolumeGroupResponse, err error)
+
+	// UpdateVolumeGroupBackup Updates the display name for the specified volume group backup. For more information, see Volume Groups (https://docs.cloud.oracle.com/Content/Block/Concepts/volumegroups.htm).
+	UpdateVolumeGroupBackup(ctx context.Context, request UpdateVolumeGroupBackupRequest) (response UpdateVolumeGroupBackupResponse, err error)
+
+	// UpdateVolumeKmsKey Updates the specified volume with a new Key Management master encryption key.
+	UpdateVolumeKmsKey(ctx context.Context, request UpdateVolumeKmsKeyRequest) (response UpdateVolumeKmsKeyResponse, err error)
+}
+
+// BlockstorageClient implements BlockstorageClientAPI
+var _ BlockstorageClientAPI = BlockstorageClient{}
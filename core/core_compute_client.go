@@ -156,10 +156,12 @@ func (client ComputeClient) AttachBootVolume(ctx context.Context, request Attach
 				response = AttachBootVolumeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachBootVolumeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachBootVolumeResponse")
 	}
@@ -213,10 +215,12 @@ func (client ComputeClient) AttachVnic(ctx context.Context, request AttachVnicRe
 				response = AttachVnicResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachVnicResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachVnicResponse")
 	}
@@ -268,10 +272,12 @@ func (client ComputeClient) AttachVolume(ctx context.Context, request AttachVolu
 				response = AttachVolumeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachVolumeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachVolumeResponse")
 	}
@@ -337,10 +343,12 @@ func (client ComputeClient) CaptureConsoleHistory(ctx context.Context, request C
 				response = CaptureConsoleHistoryResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CaptureConsoleHistoryResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CaptureConsoleHistoryResponse")
 	}
@@ -394,10 +402,12 @@ func (client ComputeClient) ChangeComputeImageCapabilitySchemaCompartment(ctx co
 				response = ChangeComputeImageCapabilitySchemaCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeComputeImageCapabilitySchemaCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeComputeImageCapabilitySchemaCompartmentResponse")
 	}
@@ -449,10 +459,12 @@ func (client ComputeClient) ChangeDedicatedVmHostCompartment(ctx context.Context
 				response = ChangeDedicatedVmHostCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDedicatedVmHostCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDedicatedVmHostCompartmentResponse")
 	}
@@ -506,10 +518,12 @@ func (client ComputeClient) ChangeImageCompartment(ctx context.Context, request
 				response = ChangeImageCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeImageCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeImageCompartmentResponse")
 	}
@@ -565,10 +579,12 @@ func (client ComputeClient) ChangeInstanceCompartment(ctx context.Context, reque
 				response = ChangeInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeInstanceCompartmentResponse")
 	}
@@ -620,10 +636,12 @@ func (client ComputeClient) CreateAppCatalogSubscription(ctx context.Context, re
 				response = CreateAppCatalogSubscriptionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAppCatalogSubscriptionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAppCatalogSubscriptionResponse")
 	}
@@ -675,10 +693,12 @@ func (client ComputeClient) CreateComputeImageCapabilitySchema(ctx context.Conte
 				response = CreateComputeImageCapabilitySchemaResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateComputeImageCapabilitySchemaResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateComputeImageCapabilitySchemaResponse")
 	}
@@ -733,10 +753,12 @@ func (client ComputeClient) CreateDedicatedVmHost(ctx context.Context, request C
 				response = CreateDedicatedVmHostResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDedicatedVmHostResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDedicatedVmHostResponse")
 	}
@@ -804,10 +826,12 @@ func (client ComputeClient) CreateImage(ctx context.Context, request CreateImage
 				response = CreateImageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateImageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateImageResponse")
 	}
@@ -862,10 +886,12 @@ func (client ComputeClient) CreateInstanceConsoleConnection(ctx context.Context,
 				response = CreateInstanceConsoleConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateInstanceConsoleConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateInstanceConsoleConnectionResponse")
 	}
@@ -1387,10 +1413,12 @@ func (client ComputeClient) ExportImage(ctx context.Context, request ExportImage
 				response = ExportImageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExportImageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExportImageResponse")
 	}
@@ -2307,10 +2335,12 @@ func (client ComputeClient) InstanceAction(ctx context.Context, request Instance
 				response = InstanceActionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstanceActionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstanceActionResponse")
 	}
@@ -2393,10 +2423,12 @@ func (client ComputeClient) LaunchInstance(ctx context.Context, request LaunchIn
 				response = LaunchInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(LaunchInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into LaunchInstanceResponse")
 	}
@@ -3688,10 +3720,12 @@ func (client ComputeClient) UpdateDedicatedVmHost(ctx context.Context, request U
 				response = UpdateDedicatedVmHostResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateDedicatedVmHostResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateDedicatedVmHostResponse")
 	}
@@ -3743,10 +3777,12 @@ func (client ComputeClient) UpdateImage(ctx context.Context, request UpdateImage
 				response = UpdateImageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateImageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateImageResponse")
 	}
@@ -3802,10 +3838,12 @@ func (client ComputeClient) UpdateInstance(ctx context.Context, request UpdateIn
 				response = UpdateInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateInstanceResponse")
 	}
@@ -0,0 +1,368 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package core
+
+import (
+	"context"
+)
+
+// ComputeClientAPI is the interface of the operations of ComputeClient, to inject the client and mock it in tests
+type ComputeClientAPI interface {
+	// AddImageShapeCompatibilityEntry Adds a shape to the compatible shapes list for the image.
+	AddImageShapeCompatibilityEntry(ctx context.Context, request AddImageShapeCompatibilityEntryRequest) (response AddImageShapeCompatibilityEntryResponse, err error)
+
+	// AttachBootVolume Attaches the specified boot volume to the specified instance.
+	AttachBootVolume(ctx context.Context, request AttachBootVolumeRequest) (response AttachBootVolumeResponse, err error)
+
+	// AttachVnic Creates a secondary VNIC and attaches it to the specified instance.
+	// For more information about secondary VNICs, see
+	// Virtual Network Interface Cards (VNICs) (https://docs.cloud.oracle.com/Content/Network/Tasks/managingVNICs.htm).
+	AttachVnic(ctx context.Context, request AttachVnicRequest) (response AttachVnicResponse, err error)
+
+	// AttachVolume Attaches the specified storage volume to the specified instance.
+	AttachVolume(ctx context.Context, request AttachVolumeRequest) (response AttachVolumeResponse, err error)
+
+	// CaptureConsoleHistory Captures the most recent serial console data (up to a megabyte) for the
+	// specified instance.
+	// The `CaptureConsoleHistory` operation works with the other console history operations
+	// as described below.
+	// 1. Use `CaptureConsoleHistory` to request the capture of up to a megabyte of the
+	// most recent console history. This call returns a `ConsoleHistory`
+	// object. The object will have a state of REQUESTED.
+	// 2. Wait for the capture operation to succeed by polling `GetConsoleHistory` with
+	// the identifier of the console history metadata. The state of the
+	// `ConsoleHistory` object will go from REQUESTED to GETTING-HISTORY and
+	// then SUCCEEDED (or FAILED).
+	// 3. Use `GetConsoleHistoryContent` to get the actual console history data (not the
+	// metadata).
+	// 4. Optionally, use `DeleteConsoleHistory` to delete the console history metadata
+	// and the console history data.
+	CaptureConsoleHistory(ctx context.Context, request CaptureConsoleHistoryRequest) (response CaptureConsoleHistoryResponse, err error)
+
+	// ChangeComputeImageCapabilitySchemaCompartment Moves a compute image capability schema into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see
+	//         Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeComputeImageCapabilitySchemaCompartment(ctx context.Context, request ChangeComputeImageCapabilitySchemaCompartmentRequest) (response ChangeComputeImageCapabilitySchemaCompartmentResponse, err error)
+
+	// ChangeDedicatedVmHostCompartment Moves a dedicated virtual machine host from one compartment to another.
+	ChangeDedicatedVmHostCompartment(ctx context.Context, request ChangeDedicatedVmHostCompartmentRequest) (response ChangeDedicatedVmHostCompartmentResponse, err error)
+
+	// ChangeImageCompartment Moves an image into a different compartment within the same tenancy. For information about moving
+	// resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeImageCompartment(ctx context.Context, request ChangeImageCompartmentRequest) (response ChangeImageCompartmentResponse, err error)
+
+	// ChangeInstanceCompartment Moves an instance into a different compartment within the same tenancy. For information about
+	// moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When you move an instance to a different compartment, associated resources such as boot volumes and VNICs
+	// are not moved.
+	ChangeInstanceCompartment(ctx context.Context, request ChangeInstanceCompartmentRequest) (response ChangeInstanceCompartmentResponse, err error)
+
+	// CreateAppCatalogSubscription Create a subscription for listing resource version for a compartment. It will take some time to propagate to all regions.
+	CreateAppCatalogSubscription(ctx context.Context, request CreateAppCatalogSubscriptionRequest) (response CreateAppCatalogSubscriptionResponse, err error)
+
+	// CreateComputeImageCapabilitySchema Creates compute image capability schema.
+	CreateComputeImageCapabilitySchema(ctx context.Context, request CreateComputeImageCapabilitySchemaRequest) (response CreateComputeImageCapabilitySchemaResponse, err error)
+
+	// CreateDedicatedVmHost Creates a new dedicated virtual machine host in the specified compartment and the specified availability domain.
+	// Dedicated virtual machine hosts enable you to run your Compute virtual machine (VM) instances on dedicated servers
+	// that are a single tenant and not shared with other customers.
+	// For more information, see Dedicated Virtual Machine Hosts (https://docs.cloud.oracle.com/iaas/Content/Compute/Concepts/dedicatedvmhosts.htm).
+	CreateDedicatedVmHost(ctx context.Context, request CreateDedicatedVmHostRequest) (response CreateDedicatedVmHostResponse, err error)
+
+	// CreateImage Creates a boot disk image for the specified instance or imports an exported image from the Oracle Cloud Infrastructure Object Storage service.
+	// When creating a new image, you must provide the OCID of the instance you want to use as the basis for the image, and
+	// the OCID of the compartment containing that instance. For more information about images,
+	// see Managing Custom Images (https://docs.cloud.oracle.com/Content/Compute/Tasks/managingcustomimages.htm).
+	// When importing an exported image from Object Storage, you specify the source information
+	// in ImageSourceDetails.
+	// When importing an image based on the namespace, bucket name, and object name,
+	// use ImageSourceViaObjectStorageTupleDetails.
+	// When importing an image based on the Object Storage URL, use
+	// ImageSourceViaObjectStorageUriDetails.
+	// See Object Storage URLs (https://docs.cloud.oracle.com/Content/Compute/Tasks/imageimportexport.htm#URLs) and Using Pre-Authenticated Requests (https://docs.cloud.oracle.com/Content/Object/Tasks/usingpreauthenticatedrequests.htm)
+	// for constructing URLs for image import/export.
+	// For more information about importing exported images, see
+	// Image Import/Export (https://docs.cloud.oracle.com/Content/Compute/Tasks/imageimportexport.htm).
+	// You may optionally specify a *display name* for the image, which is simply a friendly name or description.
+	// It does not have to be unique, and you can change it. See UpdateImage.
+	// Avoid entering confidential information.
+	CreateImage(ctx context.Context, request CreateImageRequest) (response CreateImageResponse, err error)
+
+	// CreateInstanceConsoleConnection Creates a new console connection to the specified instance.
+	// After the console connection has been created and is available,
+	// you connect to the console using SSH.
+	// For more information about console access, see Accessing the Console (https://docs.cloud.oracle.com/Content/Compute/References/serialconsole.htm).
+	CreateInstanceConsoleConnection(ctx context.Context, request CreateInstanceConsoleConnectionRequest) (response CreateInstanceConsoleConnectionResponse, err error)
+
+	// DeleteAppCatalogSubscription Delete a subscription for a listing resource version for a compartment.
+	DeleteAppCatalogSubscription(ctx context.Context, request DeleteAppCatalogSubscriptionRequest) (response DeleteAppCatalogSubscriptionResponse, err error)
+
+	// DeleteComputeImageCapabilitySchema Deletes the specified Compute Image Capability Schema
+	DeleteComputeImageCapabilitySchema(ctx context.Context, request DeleteComputeImageCapabilitySchemaRequest) (response DeleteComputeImageCapabilitySchemaResponse, err error)
+
+	// DeleteConsoleHistory Deletes the specified console history metadata and the console history data.
+	DeleteConsoleHistory(ctx context.Context, request DeleteConsoleHistoryRequest) (response DeleteConsoleHistoryResponse, err error)
+
+	// DeleteDedicatedVmHost Deletes the specified dedicated virtual machine host.
+	// If any VM instances are assigned to the dedicated virtual machine host,
+	// the delete operation will fail and the service will return a 409 response code.
+	DeleteDedicatedVmHost(ctx context.Context, request DeleteDedicatedVmHostRequest) (response DeleteDedicatedVmHostResponse, err error)
+
+	// DeleteImage Deletes an image.
+	DeleteImage(ctx context.Context, request DeleteImageRequest) (response DeleteImageResponse, err error)
+
+	// DeleteInstanceConsoleConnection Deletes the specified instance console connection.
+	DeleteInstanceConsoleConnection(ctx context.Context, request DeleteInstanceConsoleConnectionRequest) (response DeleteInstanceConsoleConnectionResponse, err error)
+
+	// DetachBootVolume Detaches a boot volume from an instance. You must specify the OCID of the boot volume attachment.
+	// This is an asynchronous operation. The attachment's `lifecycleState` will change to DETACHING temporarily
+	// until the attachment is completely removed.
+	DetachBootVolume(ctx context.Context, request DetachBootVolumeRequest) (response DetachBootVolumeResponse, err error)
+
+	// DetachVnic Detaches and deletes the specified secondary VNIC.
+	// This operation cannot be used on the instance's primary VNIC.
+	// When you terminate an instance, all attached VNICs (primary
+	// and secondary) are automatically detached and deleted.
+	// **Important:** If the VNIC has a
+	// PrivateIp that is the
+	// target of a route rule (https://docs.cloud.oracle.com/Content/Network/Tasks/managingroutetables.htm#privateip),
+	// deleting the VNIC causes that route rule to blackhole and the traffic
+	// will be dropped.
+	DetachVnic(ctx context.Context, request DetachVnicRequest) (response DetachVnicResponse, err error)
+
+	// DetachVolume Detaches a storage volume from an instance. You must specify the OCID of the volume attachment.
+	// This is an asynchronous operation. The attachment's `lifecycleState` will change to DETACHING temporarily
+	// until the attachment is completely removed.
+	DetachVolume(ctx context.Context, request DetachVolumeRequest) (response DetachVolumeResponse, err error)
+
+	// ExportImage Exports the specified image to the Oracle Cloud Infrastructure Object Storage service. You can use the Object Storage URL,
+	// or the namespace, bucket name, and object name when specifying the location to export to.
+	// For more information about exporting images, see Image Import/Export (https://docs.cloud.oracle.com/Content/Compute/Tasks/imageimportexport.htm).
+	// To perform an image export, you need write access to the Object Storage bucket for the image,
+	// see Let Users Write Objects to Object Storage Buckets (https://docs.cloud.oracle.com/Content/Identity/Concepts/commonpolicies.htm#Let4).
+	// See Object Storage URLs (https://docs.cloud.oracle.com/Content/Compute/Tasks/imageimportexport.htm#URLs) and Using Pre-Authenticated Requests (https://docs.cloud.oracle.com/Content/Object/Tasks/usingpreauthenticatedrequests.htm)
+	// for constructing URLs for image import/export.
+	ExportImage(ctx context.Context, request ExportImageRequest) (response ExportImageResponse, err error)
+
+	// GetAppCatalogListing Gets the specified listing.
+	GetAppCatalogListing(ctx context.Context, request GetAppCatalogListingRequest) (response GetAppCatalogListingResponse, err error)
+
+	// GetAppCatalogListingAgreements Retrieves the agreements for a particular resource version of a listing.
+	GetAppCatalogListingAgreements(ctx context.Context, request GetAppCatalogListingAgreementsRequest) (response GetAppCatalogListingAgreementsResponse, err error)
+
+	// GetAppCatalogListingResourceVersion Gets the specified listing resource version.
+	GetAppCatalogListingResourceVersion(ctx context.Context, request GetAppCatalogListingResourceVersionRequest) (response GetAppCatalogListingResourceVersionResponse, err error)
+
+	// GetBootVolumeAttachment Gets information about the specified boot volume attachment.
+	GetBootVolumeAttachment(ctx context.Context, request GetBootVolumeAttachmentRequest) (response GetBootVolumeAttachmentResponse, err error)
+
+	// GetComputeGlobalImageCapabilitySchema Gets the specified Compute Global Image Capability Schema
+	GetComputeGlobalImageCapabilitySchema(ctx context.Context, request GetComputeGlobalImageCapabilitySchemaRequest) (response GetComputeGlobalImageCapabilitySchemaResponse, err error)
+
+	// GetComputeGlobalImageCapabilitySchemaVersion Gets the specified Compute Global Image Capability Schema Version
+	GetComputeGlobalImageCapabilitySchemaVersion(ctx context.Context, request GetComputeGlobalImageCapabilitySchemaVersionRequest) (response GetComputeGlobalImageCapabilitySchemaVersionResponse, err error)
+
+	// GetComputeImageCapabilitySchema Gets the specified Compute Image Capability Schema
+	GetComputeImageCapabilitySchema(ctx context.Context, request GetComputeImageCapabilitySchemaRequest) (response GetComputeImageCapabilitySchemaResponse, err error)
+
+	// GetConsoleHistory Shows the metadata for the specified console history.
+	// See CaptureConsoleHistory
+	// for details about using the console history operations.
+	GetConsoleHistory(ctx context.Context, request GetConsoleHistoryRequest) (response GetConsoleHistoryResponse, err error)
+
+	// GetConsoleHistoryContent Gets the actual console history data (not the metadata).
+	// See CaptureConsoleHistory
+	// for details about using the console history operations.
+	GetConsoleHistoryContent(ctx context.Context, request GetConsoleHistoryContentRequest) (response GetConsoleHistoryContentResponse, err error)
+
+	// GetDedicatedVmHost Gets information about the specified dedicated virtual machine host.
+	GetDedicatedVmHost(ctx context.Context, request GetDedicatedVmHostRequest) (response GetDedicatedVmHostResponse, err error)
+
+	// GetImage Gets the specified image.
+	GetImage(ctx context.Context, request GetImageRequest) (response GetImageResponse, err error)
+
+	// GetImageShapeCompatibilityEntry Retrieves an image shape compatibility entry.
+	GetImageShapeCompatibilityEntry(ctx context.Context, request GetImageShapeCompatibilityEntryRequest) (response GetImageShapeCompatibilityEntryResponse, err error)
+
+	// GetInstance Gets information about the specified instance.
+	GetInstance(ctx context.Context, request GetInstanceRequest) (response GetInstanceResponse, err error)
+
+	// GetInstanceConsoleConnection Gets the specified instance console connection's information.
+	GetInstanceConsoleConnection(ctx context.Context, request GetInstanceConsoleConnectionRequest) (response GetInstanceConsoleConnectionResponse, err error)
+
+	// GetVnicAttachment Gets the information for the specified VNIC attachment.
+	GetVnicAttachment(ctx context.Context, request GetVnicAttachmentRequest) (response GetVnicAttachmentResponse, err error)
+
+	// GetVolumeAttachment Gets information about the specified volume attachment.
+	GetVolumeAttachment(ctx context.Context, request GetVolumeAttachmentRequest) (response GetVolumeAttachmentResponse, err error)
+
+	// GetWindowsInstanceInitialCredentials Gets the generated credentials for the instance. Only works for instances that require a password to log in, such as Windows.
+	// For certain operating systems, users will be forced to change the initial credentials.
+	GetWindowsInstanceInitialCredentials(ctx context.Context, request GetWindowsInstanceInitialCredentialsRequest) (response GetWindowsInstanceInitialCredentialsResponse, err error)
+
+	// InstanceAction Performs one of the following power actions on the specified instance:
+	// - **START** - Powers on the instance.
+	// - **STOP** - Powers off the instance.
+	// - **RESET** - Powers off the instance and then powers it back on.
+	// - **SOFTSTOP** - Gracefully shuts down the instance by sending a shutdown command to the operating system.
+	// If the applications that run on the instance take a long time to shut down, they could be improperly stopped, resulting
+	// in data corruption. To avoid this, shut down the instance using the commands available in the OS before you softstop the
+	// instance.
+	// - **SOFTRESET** - Gracefully reboots the instance by sending a shutdown command to the operating system, and
+	// then powers the instance back on.
+	// For more information, see Stopping and Starting an Instance (https://docs.cloud.oracle.com/Content/Compute/Tasks/restartinginstance.htm).
+	InstanceAction(ctx context.Context, request InstanceActionRequest) (response InstanceActionResponse, err error)
+
+	// LaunchInstance Creates a new instance in the specified compartment and the specified availability domain.
+	// For general information about instances, see
+	// Overview of the Compute Service (https://docs.cloud.oracle.com/Content/Compute/Concepts/computeoverview.htm).
+	// For information about access control and compartments, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about availability domains, see
+	// Regions and Availability Domains (https://docs.cloud.oracle.com/Content/General/Concepts/regions.htm).
+	// To get a list of availability domains, use the `ListAvailabilityDomains` operation
+	// in the Identity and Access Management Service API.
+	// All Oracle Cloud Infrastructure resources, including instances, get an Oracle-assigned,
+	// unique ID called an Oracle Cloud Identifier (OCID).
+	// When you create a resource, you can find its OCID in the response. You can
+	// also retrieve a resource's OCID by using a List API operation
+	// on that resource type, or by viewing the resource in the Console.
+	// To launch an instance using an image or a boot volume use the `sourceDetails` parameter in LaunchInstanceDetails.
+	// When you launch an instance, it is automatically attached to a virtual
+	// network interface card (VNIC), called the *primary VNIC*. The VNIC
+	// has a private IP address from the subnet's CIDR. You can either assign a
+	// private IP address of your choice or let Oracle automatically assign one.
+	// You can choose whether the instance has a public IP address. To retrieve the
+	// addresses, use the ListVnicAttachments
+	// operation to get the VNIC ID for the instance, and then call
+	// GetVnic with the VNIC ID.
+	// You can later add secondary VNICs to an instance. For more information, see
+	// Virtual Network Interface Cards (VNICs) (https://docs.cloud.oracle.com/Content/Network/Tasks/managingVNICs.htm).
+	// To launch an instance from a Marketplace image listing, you must provide the image ID of the
+	// listing resource version that you want, but you also must subscribe to the listing before you try
+	// to launch the instance. To subscribe to the listing, use the GetAppCatalogListingAgreements
+	// operation to get the signature for the terms of use agreement for the desired listing resource version.
+	// Then, call CreateAppCatalogSubscription
+	// with the signature. To get the image ID for the LaunchInstance operation, call
+	// GetAppCatalogListingResourceVersion.
+	LaunchInstance(ctx context.Context, request LaunchInstanceRequest) (response LaunchInstanceResponse, err error)
+
+	// ListAppCatalogListingResourceVersions Gets all resource versions for a particular listing.
+	ListAppCatalogListingResourceVersions(ctx context.Context, request ListAppCatalogListingResourceVersionsRequest) (response ListAppCatalogListingResourceVersionsResponse, err error)
+
+	// ListAppCatalogListings Lists the published listings.
+	ListAppCatalogListings(ctx context.Context, request ListAppCatalogListingsRequest) (response ListAppCatalogListingsResponse, err error)
+
+	// ListAppCatalogSubscriptions Lists subscriptions for a compartment.
+	ListAppCatalogSubscriptions(ctx context.Context, request ListAppCatalogSubscriptionsRequest) (response ListAppCatalogSubscriptionsResponse, err error)
+
+	// ListBootVolumeAttachments Lists the boot volume attachments in the specified compartment. You can filter the
+	// list by specifying an instance OCID, boot volume OCID, or both.
+	ListBootVolumeAttachments(ctx context.Context, request ListBootVolumeAttachmentsRequest) (response ListBootVolumeAttachmentsResponse, err error)
+
+	// ListComputeGlobalImageCapabilitySchemaVersions Lists Compute Global Image Capability Schema versions in the specified compartment.
+	ListComputeGlobalImageCapabilitySchemaVersions(ctx context.Context, request ListComputeGlobalImageCapabilitySchemaVersionsRequest) (response ListComputeGlobalImageCapabilitySchemaVersionsResponse, err error)
+
+	// ListComputeGlobalImageCapabilitySchemas Lists Compute Global Image Capability Schema in the specified compartment.
+	ListComputeGlobalImageCapabilitySchemas(ctx context.Context, request ListComputeGlobalImageCapabilitySchemasRequest) (response ListComputeGlobalImageCapabilitySchemasResponse, err error)
+
+	// ListComputeImageCapabilitySchemas Lists Compute Image Capability Schema in the specified compartment. You can also query by a specific imageId.
+	ListComputeImageCapabilitySchemas(ctx context.Context, request ListComputeImageCapabilitySchemasRequest) (response ListComputeImageCapabilitySchemasResponse, err error)
+
+	// ListConsoleHistories Lists the console history metadata for the specified compartment or instance.
+	ListConsoleHistories(ctx context.Context, request ListConsoleHistoriesRequest) (response ListConsoleHistoriesResponse, err error)
+
+	// ListDedicatedVmHostInstanceShapes Lists the shapes that can be used to launch a virtual machine instance on a dedicated virtual machine host within the specified compartment.
+	// You can filter the list by compatibility with a specific dedicated virtual machine host shape.
+	ListDedicatedVmHostInstanceShapes(ctx context.Context, request ListDedicatedVmHostInstanceShapesRequest) (response ListDedicatedVmHostInstanceShapesResponse, err error)
+
+	// ListDedicatedVmHostInstances Returns the list of instances on the dedicated virtual machine hosts that match the specified criteria.
+	ListDedicatedVmHostInstances(ctx context.Context, request ListDedicatedVmHostInstancesRequest) (response ListDedicatedVmHostInstancesResponse, err error)
+
+	// ListDedicatedVmHostShapes Lists the shapes that can be used to launch a dedicated virtual machine host within the specified compartment.
+	ListDedicatedVmHostShapes(ctx context.Context, request ListDedicatedVmHostShapesRequest) (response ListDedicatedVmHostShapesResponse, err error)
+
+	// ListDedicatedVmHosts Returns the list of dedicated virtual machine hosts that match the specified criteria in the specified compartment.
+	// You can limit the list by specifying a dedicated virtual machine host display name. The list will include all the identically-named
+	// dedicated virtual machine hosts in the compartment.
+	ListDedicatedVmHosts(ctx context.Context, request ListDedicatedVmHostsRequest) (response ListDedicatedVmHostsResponse, err error)
+
+	// ListImageShapeCompatibilityEntries Lists the compatible shapes for the specified image.
+	ListImageShapeCompatibilityEntries(ctx context.Context, request ListImageShapeCompatibilityEntriesRequest) (response ListImageShapeCompatibilityEntriesResponse, err error)
+
+	// ListImages Lists the available images in the specified compartment, including both
+	// Oracle-provided images (https://docs.cloud.oracle.com/Content/Compute/References/images.htm) and
+	// custom images (https://docs.cloud.oracle.com/Content/Compute/Tasks/managingcustomimages.htm) that have
+	// been created. The list of images returned is ordered to first show all
+	// Oracle-provided images, then all custom images.
+	// The order of images returned may change when new images are released.
+	ListImages(ctx context.Context, request ListImagesRequest) (response ListImagesResponse, err error)
+
+	// ListInstanceConsoleConnections Lists the console connections for the specified compartment or instance.
+	// For more information about console access, see Accessing the Console (https://docs.cloud.oracle.com/Content/Compute/References/serialconsole.htm).
+	ListInstanceConsoleConnections(ctx context.Context, request ListInstanceConsoleConnectionsRequest) (response ListInstanceConsoleConnectionsResponse, err error)
+
+	// ListInstanceDevices Gets a list of all the devices for given instance. You can optionally filter results by device availability.
+	ListInstanceDevices(ctx context.Context, request ListInstanceDevicesRequest) (response ListInstanceDevicesResponse, err error)
+
+	// ListInstances Lists the instances in the specified compartment and the specified availability domain.
+	// You can filter the results by specifying an instance name (the list will include all the identically-named
+	// instances in the compartment).
+	ListInstances(ctx context.Context, request ListInstancesRequest) (response ListInstancesResponse, err error)
+
+	// ListShapes Lists the shapes that can be used to launch an instance within the specified compartment. You can
+	// filter the list by compatibility with a specific image.
+	ListShapes(ctx context.Context, request ListShapesRequest) (response ListShapesResponse, err error)
+
+	// ListVnicAttachments Lists the VNIC attachments in the specified compartment. A VNIC attachment
+	// resides in the same compartment as the attached instance. The list can be
+	// filtered by instance, VNIC, or availability domain.
+	ListVnicAttachments(ctx context.Context, request ListVnicAttachmentsRequest) (response ListVnicAttachmentsResponse, err error)
+
+	// ListVolumeAttachments Lists the volume attachments in the specified compartment. You can filter the
+	// list by specifying an instance OCID, volume OCID, or both.
+	// Currently, the only supported volume attachment type are IScsiVolumeAttachment and
+	// ParavirtualizedVolumeAttachment.
+	ListVolumeAttachments(ctx context.Context, request ListVolumeAttachmentsRequest) (response ListVolumeAttachmentsResponse, err error)
+
+	// RemoveImageShapeCompatibilityEntry Removes a shape from the compatible shapes list for the image.
+	RemoveImageShapeCompatibilityEntry(ctx context.Context, request RemoveImageShapeCompatibilityEntryRequest) (response RemoveImageShapeCompatibilityEntryResponse, err error)
+
+	// TerminateInstance Terminates the specified instance. Any attached VNICs and volumes are automatically detached
+	// when the instance terminates.
+	// To preserve the boot volume associated with the instance, specify `true` for `PreserveBootVolumeQueryParam`.
+	// To delete the boot volume when the instance is deleted, specify `false` or do not specify a value for `PreserveBootVolumeQueryParam`.
+	// This is an asynchronous operation. The instance's `lifecycleState` will change to TERMINATING temporarily
+	// until the instance is completely removed.
+	TerminateInstance(ctx context.Context, request TerminateInstanceRequest) (response TerminateInstanceResponse, err error)
+
+	// UpdateComputeImageCapabilitySchema Updates the specified Compute Image Capability Schema
+	UpdateComputeImageCapabilitySchema(ctx context.Context, request UpdateComputeImageCapabilitySchemaRequest) (response UpdateComputeImageCapabilitySchemaResponse, err error)
+
+	// UpdateConsoleHistory Updates the specified console history metadata.
+	UpdateConsoleHistory(ctx context.Context, request UpdateConsoleHistoryRequest) (response UpdateConsoleHistoryResponse, err error)
+
+	// UpdateDedicatedVmHost Updates the displayName, freeformTags, and definedTags attributes for the specified dedicated virtual machine host.
+	// If an attribute value is not included, it will not be updated.
+	UpdateDedicatedVmHost(ctx context.Context, request UpdateDedicatedVmHostRequest) (response UpdateDedicatedVmHostResponse, err error)
+
+	// UpdateImage Updates the display name of the image. Avoid entering confidential information.
+	UpdateImage(ctx context.Context, request UpdateImageRequest) (response UpdateImageResponse, err error)
+
+	// UpdateInstance Updates certain fields on the specified instance. Fields that are not provided in the
+	// request will not be updated. Avoid entering confidential information.
+	// Changes to metadata fields will be reflected in the instance metadata service (this may take
+	// up to a minute).
+	// The OCID of the instance remains the same.
+	UpdateInstance(ctx context.Context, request UpdateInstanceRequest) (response UpdateInstanceResponse, err error)
+
+	// UpdateInstanceConsoleConnection Updates the defined tags and free-form tags for the specified instance console connection.
+	UpdateInstanceConsoleConnection(ctx context.Context, request UpdateInstanceConsoleConnectionRequest) (response UpdateInstanceConsoleConnectionResponse, err error)
+}
+
+// ComputeClient implements ComputeClientAPI
+var _ ComputeClientAPI = ComputeClient{}
@@ -106,10 +106,12 @@ func (client ComputeManagementClient) AttachLoadBalancer(ctx context.Context, re
 				response = AttachLoadBalancerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachLoadBalancerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachLoadBalancerResponse")
 	}
@@ -165,10 +167,12 @@ func (client ComputeManagementClient) ChangeClusterNetworkCompartment(ctx contex
 				response = ChangeClusterNetworkCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeClusterNetworkCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeClusterNetworkCompartmentResponse")
 	}
@@ -230,10 +234,12 @@ func (client ComputeManagementClient) ChangeInstanceConfigurationCompartment(ctx
 				response = ChangeInstanceConfigurationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeInstanceConfigurationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeInstanceConfigurationCompartmentResponse")
 	}
@@ -289,10 +295,12 @@ func (client ComputeManagementClient) ChangeInstancePoolCompartment(ctx context.
 				response = ChangeInstancePoolCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeInstancePoolCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeInstancePoolCompartmentResponse")
 	}
@@ -345,10 +353,12 @@ func (client ComputeManagementClient) CreateClusterNetwork(ctx context.Context,
 				response = CreateClusterNetworkResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateClusterNetworkResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateClusterNetworkResponse")
 	}
@@ -401,10 +411,12 @@ func (client ComputeManagementClient) CreateInstanceConfiguration(ctx context.Co
 				response = CreateInstanceConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateInstanceConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateInstanceConfigurationResponse")
 	}
@@ -456,10 +468,12 @@ func (client ComputeManagementClient) CreateInstancePool(ctx context.Context, re
 				response = CreateInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateInstancePoolResponse")
 	}
@@ -561,10 +575,12 @@ func (client ComputeManagementClient) DetachLoadBalancer(ctx context.Context, re
 				response = DetachLoadBalancerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DetachLoadBalancerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DetachLoadBalancerResponse")
 	}
@@ -821,10 +837,12 @@ func (client ComputeManagementClient) LaunchInstanceConfiguration(ctx context.Co
 				response = LaunchInstanceConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(LaunchInstanceConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into LaunchInstanceConfigurationResponse")
 	}
@@ -1127,10 +1145,12 @@ func (client ComputeManagementClient) ResetInstancePool(ctx context.Context, req
 				response = ResetInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ResetInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ResetInstancePoolResponse")
 	}
@@ -1183,10 +1203,12 @@ func (client ComputeManagementClient) SoftresetInstancePool(ctx context.Context,
 				response = SoftresetInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(SoftresetInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into SoftresetInstancePoolResponse")
 	}
@@ -1239,10 +1261,12 @@ func (client ComputeManagementClient) StartInstancePool(ctx context.Context, req
 				response = StartInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartInstancePoolResponse")
 	}
@@ -1295,10 +1319,12 @@ func (client ComputeManagementClient) StopInstancePool(ctx context.Context, requ
 				response = StopInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopInstancePoolResponse")
 	}
@@ -1457,10 +1483,12 @@ func (client ComputeManagementClient) UpdateClusterNetwork(ctx context.Context,
 				response = UpdateClusterNetworkResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateClusterNetworkResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateClusterNetworkResponse")
 	}
@@ -1512,10 +1540,12 @@ func (client ComputeManagementClient) UpdateInstanceConfiguration(ctx context.Co
 				response = UpdateInstanceConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateInstanceConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateInstanceConfigurationResponse")
 	}
@@ -1568,10 +1598,12 @@ func (client ComputeManagementClient) UpdateInstancePool(ctx context.Context, re
 				response = UpdateInstancePoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateInstancePoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateInstancePoolResponse")
 	}
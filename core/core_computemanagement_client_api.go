@@ -0,0 +1,136 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package core
+
+import (
+	"context"
+)
+
+// ComputeManagementClientAPI is the interface of the operations of ComputeManagementClient, to inject the client and mock it in tests
+type ComputeManagementClientAPI interface {
+	// AttachLoadBalancer Attach a load balancer to the instance pool.
+	AttachLoadBalancer(ctx context.Context, request AttachLoadBalancerRequest) (response AttachLoadBalancerResponse, err error)
+
+	// ChangeClusterNetworkCompartment Moves a cluster network into a different compartment within the same tenancy. For
+	// information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When you move a cluster network to a different compartment, associated resources such as the instances
+	// in the cluster network, boot volumes, and VNICs are not moved.
+	ChangeClusterNetworkCompartment(ctx context.Context, request ChangeClusterNetworkCompartmentRequest) (response ChangeClusterNetworkCompartmentResponse, err error)
+
+	// ChangeInstanceConfigurationCompartment Moves an instance configuration into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When you move an instance configuration to a different compartment, associated resources such as
+	// instance pools are not moved.
+	// **Important:** Most of the properties for an existing instance configuration, including the compartment,
+	// cannot be modified after you create the instance configuration. Although you can move an instance configuration
+	// to a different compartment, you will not be able to use the instance configuration to manage instance pools
+	// in the new compartment. If you want to update an instance configuration to point to a different compartment,
+	// you should instead create a new instance configuration in the target compartment using
+	// CreateInstanceConfiguration (https://docs.cloud.oracle.com/iaas/api/#/en/iaas/20160918/InstanceConfiguration/CreateInstanceConfiguration).
+	ChangeInstanceConfigurationCompartment(ctx context.Context, request ChangeInstanceConfigurationCompartmentRequest) (response ChangeInstanceConfigurationCompartmentResponse, err error)
+
+	// ChangeInstancePoolCompartment Moves an instance pool into a different compartment within the same tenancy. For
+	// information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When you move an instance pool to a different compartment, associated resources such as the instances in
+	// the pool, boot volumes, VNICs, and autoscaling configurations are not moved.
+	ChangeInstancePoolCompartment(ctx context.Context, request ChangeInstancePoolCompartmentRequest) (response ChangeInstancePoolCompartmentResponse, err error)
+
+	// CreateClusterNetwork Creates a cluster network. For more information about cluster networks, see
+	// Managing Cluster Networks (https://docs.cloud.oracle.com/iaas/Content/Compute/Tasks/managingclusternetworks.htm).
+	CreateClusterNetwork(ctx context.Context, request CreateClusterNetworkRequest) (response CreateClusterNetworkResponse, err error)
+
+	// CreateInstanceConfiguration Creates an instance configuration. An instance configuration is a template that defines the
+	// settings to use when creating Compute instances.
+	CreateInstanceConfiguration(ctx context.Context, request CreateInstanceConfigurationRequest) (response CreateInstanceConfigurationResponse, err error)
+
+	// CreateInstancePool Create an instance pool.
+	CreateInstancePool(ctx context.Context, request CreateInstancePoolRequest) (response CreateInstancePoolResponse, err error)
+
+	// DeleteInstanceConfiguration Deletes an instance configuration.
+	DeleteInstanceConfiguration(ctx context.Context, request DeleteInstanceConfigurationRequest) (response DeleteInstanceConfigurationResponse, err error)
+
+	// DetachLoadBalancer Detach a load balancer from the instance pool.
+	DetachLoadBalancer(ctx context.Context, request DetachLoadBalancerRequest) (response DetachLoadBalancerResponse, err error)
+
+	// GetClusterNetwork Gets information about the specified cluster network.
+	GetClusterNetwork(ctx context.Context, request GetClusterNetworkRequest) (response GetClusterNetworkResponse, err error)
+
+	// GetInstanceConfiguration Gets the specified instance configuration
+	GetInstanceConfiguration(ctx context.Context, request GetInstanceConfigurationRequest) (response GetInstanceConfigurationResponse, err error)
+
+	// GetInstancePool Gets the specified instance pool
+	GetInstancePool(ctx context.Context, request GetInstancePoolRequest) (response GetInstancePoolResponse, err error)
+
+	// GetInstancePoolLoadBalancerAttachment Gets information about a load balancer that is attached to the specified instance pool.
+	GetInstancePoolLoadBalancerAttachment(ctx context.Context, request GetInstancePoolLoadBalancerAttachmentRequest) (response GetInstancePoolLoadBalancerAttachmentResponse, err error)
+
+	// LaunchInstanceConfiguration Launches an instance from an instance configuration.
+	// If the instance configuration does not include all of the parameters that are
+	// required to launch an instance, such as the availability domain and subnet ID, you must
+	// provide these parameters when you launch an instance from the instance configuration.
+	// For more information, see the InstanceConfiguration
+	// resource.
+	LaunchInstanceConfiguration(ctx context.Context, request LaunchInstanceConfigurationRequest) (response LaunchInstanceConfigurationResponse, err error)
+
+	// ListClusterNetworkInstances Lists the instances in the specified cluster network.
+	ListClusterNetworkInstances(ctx context.Context, request ListClusterNetworkInstancesRequest) (response ListClusterNetworkInstancesResponse, err error)
+
+	// ListClusterNetworks Lists the cluster networks in the specified compartment.
+	ListClusterNetworks(ctx context.Context, request ListClusterNetworksRequest) (response ListClusterNetworksResponse, err error)
+
+	// ListInstanceConfigurations Lists the instance configurations in the specified compartment.
+	ListInstanceConfigurations(ctx context.Context, request ListInstanceConfigurationsRequest) (response ListInstanceConfigurationsResponse, err error)
+
+	// ListInstancePoolInstances List the instances in the specified instance pool.
+	ListInstancePoolInstances(ctx context.Context, request ListInstancePoolInstancesRequest) (response ListInstancePoolInstancesResponse, err error)
+
+	// ListInstancePools Lists the instance pools in the specified compartment.
+	ListInstancePools(ctx context.Context, request ListInstancePoolsRequest) (response ListInstancePoolsResponse, err error)
+
+	// ResetInstancePool Performs the reset (power off and power on) action on the specified instance pool,
+	// which performs the action on all the instances in the pool.
+	ResetInstancePool(ctx context.Context, request ResetInstancePoolRequest) (response ResetInstancePoolResponse, err error)
+
+	// SoftresetInstancePool Performs the softreset (ACPI shutdown and power on) action on the specified instance pool,
+	// which performs the action on all the instances in the pool.
+	SoftresetInstancePool(ctx context.Context, request SoftresetInstancePoolRequest) (response SoftresetInstancePoolResponse, err error)
+
+	// StartInstancePool Performs the start (power on) action on the specified instance pool,
+	// which performs the action on all the instances in the pool.
+	StartInstancePool(ctx context.Context, request StartInstancePoolRequest) (response StartInstancePoolResponse, err error)
+
+	// StopInstancePool Performs the stop (power off) action on the specified instance pool,
+	// which performs the action on all the instances in the pool.
+	StopInstancePool(ctx context.Context, request StopInstancePoolRequest) (response StopInstancePoolResponse, err error)
+
+	// TerminateClusterNetwork Terminates the specified cluster network.
+	// When you delete a cluster network, all of its resources are permanently deleted,
+	// including associated instances and instance pools.
+	TerminateClusterNetwork(ctx context.Context, request TerminateClusterNetworkRequest) (response TerminateClusterNetworkResponse, err error)
+
+	// TerminateInstancePool Terminate the specified instance pool.
+	// **Warning:** When you delete an instance pool, the resources that were created by the pool are permanently
+	// deleted, including associated instances, attached boot volumes, and block volumes.
+	// If an autoscaling configuration applies to the instance pool, the autoscaling configuration will be deleted
+	// asynchronously after the pool is deleted. You can also manually delete the autoscaling configuration using
+	// the `DeleteAutoScalingConfiguration` operation in the Autoscaling API.
+	TerminateInstancePool(ctx context.Context, request TerminateInstancePoolRequest) (response TerminateInstancePoolResponse, err error)
+
+	// UpdateClusterNetwork Updates the specified cluster network. The OCID of the cluster network remains the same.
+	UpdateClusterNetwork(ctx context.Context, request UpdateClusterNetworkRequest) (response UpdateClusterNetworkResponse, err error)
+
+	// UpdateInstanceConfiguration Updates the free-form tags, defined tags, and display name of an instance configuration.
+	UpdateInstanceConfiguration(ctx context.Context, request UpdateInstanceConfigurationRequest) (response UpdateInstanceConfigurationResponse, err error)
+
+	// UpdateInstancePool Update the specified instance pool.
+	// The OCID of the instance pool remains the same.
+	UpdateInstancePool(ctx context.Context, request UpdateInstancePoolRequest) (response UpdateInstancePoolResponse, err error)
+}
+
+// ComputeManagementClient implements ComputeManagementClientAPI
+var _ ComputeManagementClientAPI = ComputeManagementClient{}
@@ -0,0 +1,283 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package core
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*AttachVolumeDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &attachvolumedetails{} })
+	common.RegisterPolymorphicModel((*BootVolumeSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &bootvolumesourcedetails{} })
+	common.RegisterPolymorphicModel((*CreateInstanceConfigurationBase)(nil), func() common.PolymorphicJSONUnmarshaler { return &createinstanceconfigurationbase{} })
+	common.RegisterPolymorphicModel((*DhcpOption)(nil), func() common.PolymorphicJSONUnmarshaler { return &dhcpoption{} })
+	common.RegisterPolymorphicModel((*ExportImageDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &exportimagedetails{} })
+	common.RegisterPolymorphicModel((*ImageCapabilitySchemaDescriptor)(nil), func() common.PolymorphicJSONUnmarshaler { return &imagecapabilityschemadescriptor{} })
+	common.RegisterPolymorphicModel((*ImageSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &imagesourcedetails{} })
+	common.RegisterPolymorphicModel((*InstanceConfigurationAttachVolumeDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &instanceconfigurationattachvolumedetails{} })
+	common.RegisterPolymorphicModel((*InstanceConfigurationInstanceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &instanceconfigurationinstancedetails{} })
+	common.RegisterPolymorphicModel((*InstanceConfigurationInstanceSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &instanceconfigurationinstancesourcedetails{} })
+	common.RegisterPolymorphicModel((*InstanceConfigurationVolumeSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &instanceconfigurationvolumesourcedetails{} })
+	common.RegisterPolymorphicModel((*InstanceSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &instancesourcedetails{} })
+	common.RegisterPolymorphicModel((*VolumeAttachment)(nil), func() common.PolymorphicJSONUnmarshaler { return &volumeattachment{} })
+	common.RegisterPolymorphicModel((*VolumeGroupSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &volumegroupsourcedetails{} })
+	common.RegisterPolymorphicModel((*VolumeSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &volumesourcedetails{} })
+}
+
+// MarshalJSON marshals a AttachVolumeDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m attachvolumedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeattachvolumedetails attachvolumedetails
+	s := struct {
+		MarshalTypeattachvolumedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeattachvolumedetails: (MarshalTypeattachvolumedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a BootVolumeSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m bootvolumesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypebootvolumesourcedetails bootvolumesourcedetails
+	s := struct {
+		MarshalTypebootvolumesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypebootvolumesourcedetails: (MarshalTypebootvolumesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateInstanceConfigurationBase whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createinstanceconfigurationbase) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateinstanceconfigurationbase createinstanceconfigurationbase
+	s := struct {
+		MarshalTypecreateinstanceconfigurationbase
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateinstanceconfigurationbase: (MarshalTypecreateinstanceconfigurationbase)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DhcpOption whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dhcpoption) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedhcpoption dhcpoption
+	s := struct {
+		MarshalTypedhcpoption
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedhcpoption: (MarshalTypedhcpoption)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ExportImageDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m exportimagedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeexportimagedetails exportimagedetails
+	s := struct {
+		MarshalTypeexportimagedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeexportimagedetails: (MarshalTypeexportimagedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ImageCapabilitySchemaDescriptor whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m imagecapabilityschemadescriptor) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeimagecapabilityschemadescriptor imagecapabilityschemadescriptor
+	s := struct {
+		MarshalTypeimagecapabilityschemadescriptor
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeimagecapabilityschemadescriptor: (MarshalTypeimagecapabilityschemadescriptor)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ImageSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m imagesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeimagesourcedetails imagesourcedetails
+	s := struct {
+		MarshalTypeimagesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeimagesourcedetails: (MarshalTypeimagesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a InstanceConfigurationAttachVolumeDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m instanceconfigurationattachvolumedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeinstanceconfigurationattachvolumedetails instanceconfigurationattachvolumedetails
+	s := struct {
+		MarshalTypeinstanceconfigurationattachvolumedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeinstanceconfigurationattachvolumedetails: (MarshalTypeinstanceconfigurationattachvolumedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a InstanceConfigurationInstanceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m instanceconfigurationinstancedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeinstanceconfigurationinstancedetails instanceconfigurationinstancedetails
+	s := struct {
+		MarshalTypeinstanceconfigurationinstancedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeinstanceconfigurationinstancedetails: (MarshalTypeinstanceconfigurationinstancedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a InstanceConfigurationInstanceSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m instanceconfigurationinstancesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeinstanceconfigurationinstancesourcedetails instanceconfigurationinstancesourcedetails
+	s := struct {
+		MarshalTypeinstanceconfigurationinstancesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeinstanceconfigurationinstancesourcedetails: (MarshalTypeinstanceconfigurationinstancesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a InstanceConfigurationVolumeSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m instanceconfigurationvolumesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeinstanceconfigurationvolumesourcedetails instanceconfigurationvolumesourcedetails
+	s := struct {
+		MarshalTypeinstanceconfigurationvolumesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeinstanceconfigurationvolumesourcedetails: (MarshalTypeinstanceconfigurationvolumesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a InstanceSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m instancesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeinstancesourcedetails instancesourcedetails
+	s := struct {
+		MarshalTypeinstancesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeinstancesourcedetails: (MarshalTypeinstancesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a VolumeAttachment whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m volumeattachment) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypevolumeattachment volumeattachment
+	s := struct {
+		MarshalTypevolumeattachment
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypevolumeattachment: (MarshalTypevolumeattachment)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a VolumeGroupSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m volumegroupsourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypevolumegroupsourcedetails volumegroupsourcedetails
+	s := struct {
+		MarshalTypevolumegroupsourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypevolumegroupsourcedetails: (MarshalTypevolumegroupsourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a VolumeSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m volumesourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypevolumesourcedetails volumesourcedetails
+	s := struct {
+		MarshalTypevolumesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypevolumesourcedetails: (MarshalTypevolumesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -159,10 +159,12 @@ func (client VirtualNetworkClient) AddPublicIpPoolCapacity(ctx context.Context,
 				response = AddPublicIpPoolCapacityResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddPublicIpPoolCapacityResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddPublicIpPoolCapacityResponse")
 	}
@@ -421,10 +423,12 @@ func (client VirtualNetworkClient) ChangeByoipRangeCompartment(ctx context.Conte
 				response = ChangeByoipRangeCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeByoipRangeCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeByoipRangeCompartmentResponse")
 	}
@@ -478,10 +482,12 @@ func (client VirtualNetworkClient) ChangeCpeCompartment(ctx context.Context, req
 				response = ChangeCpeCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCpeCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCpeCompartmentResponse")
 	}
@@ -535,10 +541,12 @@ func (client VirtualNetworkClient) ChangeCrossConnectCompartment(ctx context.Con
 				response = ChangeCrossConnectCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCrossConnectCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCrossConnectCompartmentResponse")
 	}
@@ -592,10 +600,12 @@ func (client VirtualNetworkClient) ChangeCrossConnectGroupCompartment(ctx contex
 				response = ChangeCrossConnectGroupCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCrossConnectGroupCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCrossConnectGroupCompartmentResponse")
 	}
@@ -649,10 +659,12 @@ func (client VirtualNetworkClient) ChangeDhcpOptionsCompartment(ctx context.Cont
 				response = ChangeDhcpOptionsCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDhcpOptionsCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDhcpOptionsCompartmentResponse")
 	}
@@ -706,10 +718,12 @@ func (client VirtualNetworkClient) ChangeDrgCompartment(ctx context.Context, req
 				response = ChangeDrgCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDrgCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDrgCompartmentResponse")
 	}
@@ -763,10 +777,12 @@ func (client VirtualNetworkClient) ChangeIPSecConnectionCompartment(ctx context.
 				response = ChangeIPSecConnectionCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeIPSecConnectionCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeIPSecConnectionCompartmentResponse")
 	}
@@ -820,10 +836,12 @@ func (client VirtualNetworkClient) ChangeInternetGatewayCompartment(ctx context.
 				response = ChangeInternetGatewayCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeInternetGatewayCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeInternetGatewayCompartmentResponse")
 	}
@@ -877,10 +895,12 @@ func (client VirtualNetworkClient) ChangeLocalPeeringGatewayCompartment(ctx cont
 				response = ChangeLocalPeeringGatewayCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeLocalPeeringGatewayCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeLocalPeeringGatewayCompartmentResponse")
 	}
@@ -934,10 +954,12 @@ func (client VirtualNetworkClient) ChangeNatGatewayCompartment(ctx context.Conte
 				response = ChangeNatGatewayCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeNatGatewayCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeNatGatewayCompartmentResponse")
 	}
@@ -990,10 +1012,12 @@ func (client VirtualNetworkClient) ChangeNetworkSecurityGroupCompartment(ctx con
 				response = ChangeNetworkSecurityGroupCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeNetworkSecurityGroupCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeNetworkSecurityGroupCompartmentResponse")
 	}
@@ -1049,10 +1073,12 @@ func (client VirtualNetworkClient) ChangePublicIpCompartment(ctx context.Context
 				response = ChangePublicIpCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangePublicIpCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangePublicIpCompartmentResponse")
 	}
@@ -1106,10 +1132,12 @@ func (client VirtualNetworkClient) ChangePublicIpPoolCompartment(ctx context.Con
 				response = ChangePublicIpPoolCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangePublicIpPoolCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangePublicIpPoolCompartmentResponse")
 	}
@@ -1163,10 +1191,12 @@ func (client VirtualNetworkClient) ChangeRemotePeeringConnectionCompartment(ctx
 				response = ChangeRemotePeeringConnectionCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeRemotePeeringConnectionCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeRemotePeeringConnectionCompartmentResponse")
 	}
@@ -1220,10 +1250,12 @@ func (client VirtualNetworkClient) ChangeRouteTableCompartment(ctx context.Conte
 				response = ChangeRouteTableCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeRouteTableCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeRouteTableCompartmentResponse")
 	}
@@ -1277,10 +1309,12 @@ func (client VirtualNetworkClient) ChangeSecurityListCompartment(ctx context.Con
 				response = ChangeSecurityListCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSecurityListCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSecurityListCompartmentResponse")
 	}
@@ -1334,10 +1368,12 @@ func (client VirtualNetworkClient) ChangeServiceGatewayCompartment(ctx context.C
 				response = ChangeServiceGatewayCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeServiceGatewayCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeServiceGatewayCompartmentResponse")
 	}
@@ -1391,10 +1427,12 @@ func (client VirtualNetworkClient) ChangeSubnetCompartment(ctx context.Context,
 				response = ChangeSubnetCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSubnetCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSubnetCompartmentResponse")
 	}
@@ -1448,10 +1486,12 @@ func (client VirtualNetworkClient) ChangeVcnCompartment(ctx context.Context, req
 				response = ChangeVcnCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeVcnCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeVcnCompartmentResponse")
 	}
@@ -1505,10 +1545,12 @@ func (client VirtualNetworkClient) ChangeVirtualCircuitCompartment(ctx context.C
 				response = ChangeVirtualCircuitCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeVirtualCircuitCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeVirtualCircuitCompartmentResponse")
 	}
@@ -1562,10 +1604,12 @@ func (client VirtualNetworkClient) ChangeVlanCompartment(ctx context.Context, re
 				response = ChangeVlanCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeVlanCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeVlanCompartmentResponse")
 	}
@@ -1729,10 +1773,12 @@ func (client VirtualNetworkClient) CreateByoipRange(ctx context.Context, request
 				response = CreateByoipRangeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateByoipRangeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateByoipRangeResponse")
 	}
@@ -1795,10 +1841,12 @@ func (client VirtualNetworkClient) CreateCpe(ctx context.Context, request Create
 				response = CreateCpeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCpeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCpeResponse")
 	}
@@ -1865,10 +1913,12 @@ func (client VirtualNetworkClient) CreateCrossConnect(ctx context.Context, reque
 				response = CreateCrossConnectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCrossConnectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCrossConnectResponse")
 	}
@@ -1932,10 +1982,12 @@ func (client VirtualNetworkClient) CreateCrossConnectGroup(ctx context.Context,
 				response = CreateCrossConnectGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCrossConnectGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCrossConnectGroupResponse")
 	}
@@ -1996,10 +2048,12 @@ func (client VirtualNetworkClient) CreateDhcpOptions(ctx context.Context, reques
 				response = CreateDhcpOptionsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDhcpOptionsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDhcpOptionsResponse")
 	}
@@ -2060,10 +2114,12 @@ func (client VirtualNetworkClient) CreateDrg(ctx context.Context, request Create
 				response = CreateDrgResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDrgResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDrgResponse")
 	}
@@ -2123,10 +2179,12 @@ func (client VirtualNetworkClient) CreateDrgAttachment(ctx context.Context, requ
 				response = CreateDrgAttachmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDrgAttachmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDrgAttachmentResponse")
 	}
@@ -2200,10 +2258,12 @@ func (client VirtualNetworkClient) CreateIPSecConnection(ctx context.Context, re
 				response = CreateIPSecConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIPSecConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIPSecConnectionResponse")
 	}
@@ -2271,10 +2331,12 @@ func (client VirtualNetworkClient) CreateInternetGateway(ctx context.Context, re
 				response = CreateInternetGatewayResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateInternetGatewayResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateInternetGatewayResponse")
 	}
@@ -2326,10 +2388,12 @@ func (client VirtualNetworkClient) CreateIpv6(ctx context.Context, request Creat
 				response = CreateIpv6Response{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIpv6Response); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIpv6Response")
 	}
@@ -2381,10 +2445,12 @@ func (client VirtualNetworkClient) CreateLocalPeeringGateway(ctx context.Context
 				response = CreateLocalPeeringGatewayResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLocalPeeringGatewayResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLocalPeeringGatewayResponse")
 	}
@@ -2437,10 +2503,12 @@ func (client VirtualNetworkClient) CreateNatGateway(ctx context.Context, request
 				response = CreateNatGatewayResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNatGatewayResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNatGatewayResponse")
 	}
@@ -2492,10 +2560,12 @@ func (client VirtualNetworkClient) CreateNetworkSecurityGroup(ctx context.Contex
 				response = CreateNetworkSecurityGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNetworkSecurityGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNetworkSecurityGroupResponse")
 	}
@@ -2549,10 +2619,12 @@ func (client VirtualNetworkClient) CreatePrivateIp(ctx context.Context, request
 				response = CreatePrivateIpResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePrivateIpResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePrivateIpResponse")
 	}
@@ -2620,10 +2692,12 @@ func (client VirtualNetworkClient) CreatePublicIp(ctx context.Context, request C
 				response = CreatePublicIpResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePublicIpResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePublicIpResponse")
 	}
@@ -2675,10 +2749,12 @@ func (client VirtualNetworkClient) CreatePublicIpPool(ctx context.Context, reque
 				response = CreatePublicIpPoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePublicIpPoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePublicIpPoolResponse")
 	}
@@ -2730,10 +2806,12 @@ func (client VirtualNetworkClient) CreateRemotePeeringConnection(ctx context.Con
 				response = CreateRemotePeeringConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateRemotePeeringConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateRemotePeeringConnectionResponse")
 	}
@@ -2797,10 +2875,12 @@ func (client VirtualNetworkClient) CreateRouteTable(ctx context.Context, request
 				response = CreateRouteTableResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateRouteTableResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateRouteTableResponse")
 	}
@@ -2863,10 +2943,12 @@ func (client VirtualNetworkClient) CreateSecurityList(ctx context.Context, reque
 				response = CreateSecurityListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSecurityListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSecurityListResponse")
 	}
@@ -2924,10 +3006,12 @@ func (client VirtualNetworkClient) CreateServiceGateway(ctx context.Context, req
 				response = CreateServiceGatewayResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateServiceGatewayResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateServiceGatewayResponse")
 	}
@@ -3003,10 +3087,12 @@ func (client VirtualNetworkClient) CreateSubnet(ctx context.Context, request Cre
 				response = CreateSubnetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSubnetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSubnetResponse")
 	}
@@ -3080,10 +3166,12 @@ func (client VirtualNetworkClient) CreateVcn(ctx context.Context, request Create
 				response = CreateVcnResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVcnResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVcnResponse")
 	}
@@ -3152,10 +3240,12 @@ func (client VirtualNetworkClient) CreateVirtualCircuit(ctx context.Context, req
 				response = CreateVirtualCircuitResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVirtualCircuitResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVirtualCircuitResponse")
 	}
@@ -3207,10 +3297,12 @@ func (client VirtualNetworkClient) CreateVlan(ctx context.Context, request Creat
 				response = CreateVlanResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVlanResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVlanResponse")
 	}
@@ -8763,10 +8855,12 @@ func (client VirtualNetworkClient) RemovePublicIpPoolCapacity(ctx context.Contex
 				response = RemovePublicIpPoolCapacityResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RemovePublicIpPoolCapacityResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RemovePublicIpPoolCapacityResponse")
 	}
@@ -10098,10 +10192,12 @@ func (client VirtualNetworkClient) UpdateTunnelCpeDeviceConfig(ctx context.Conte
 				response = UpdateTunnelCpeDeviceConfigResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateTunnelCpeDeviceConfigResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateTunnelCpeDeviceConfigResponse")
 	}
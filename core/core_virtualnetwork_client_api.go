@@ -0,0 +1,1229 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package core
+
+import (
+	"context"
+)
+
+// VirtualNetworkClientAPI is the interface of the operations of VirtualNetworkClient, to inject the client and mock it in tests
+type VirtualNetworkClientAPI interface {
+	// AddNetworkSecurityGroupSecurityRules Adds one or more security rules to the specified network security group.
+	AddNetworkSecurityGroupSecurityRules(ctx context.Context, request AddNetworkSecurityGroupSecurityRulesRequest) (response AddNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	// AddPublicIpPoolCapacity Adds a Cidr from the named Byoip Range prefix to the referenced Public IP Pool.
+	// The cidr must be a subset of the Byoip Range in question.
+	// The cidr must not overlap with any other cidr already added to this
+	// or any other Public Ip Pool.
+	AddPublicIpPoolCapacity(ctx context.Context, request AddPublicIpPoolCapacityRequest) (response AddPublicIpPoolCapacityResponse, err error)
+
+	// AdvertiseByoipRange initiate route advertisements for the Byoip Range prefix.
+	// the prefix must be in PROVISIONED state
+	AdvertiseByoipRange(ctx context.Context, request AdvertiseByoipRangeRequest) (response AdvertiseByoipRangeResponse, err error)
+
+	// AttachServiceId Adds the specified Service to the list of enabled
+	// `Service` objects for the specified gateway. You must also set up a route rule with the
+	// `cidrBlock` of the `Service` as the rule's destination and the service gateway as the rule's
+	// target. See RouteTable.
+	// **Note:** The `AttachServiceId` operation is an easy way to add an individual `Service` to
+	// the service gateway. Compare it with
+	// UpdateServiceGateway, which replaces
+	// the entire existing list of enabled `Service` objects with the list that you provide in the
+	// `Update` call.
+	AttachServiceId(ctx context.Context, request AttachServiceIdRequest) (response AttachServiceIdResponse, err error)
+
+	// BulkAddVirtualCircuitPublicPrefixes Adds one or more customer public IP prefixes to the specified public virtual circuit.
+	// Use this operation (and not UpdateVirtualCircuit)
+	// to add prefixes to the virtual circuit. Oracle must verify the customer's ownership
+	// of each prefix before traffic for that prefix will flow across the virtual circuit.
+	BulkAddVirtualCircuitPublicPrefixes(ctx context.Context, request BulkAddVirtualCircuitPublicPrefixesRequest) (response BulkAddVirtualCircuitPublicPrefixesResponse, err error)
+
+	// BulkDeleteVirtualCircuitPublicPrefixes Removes one or more customer public IP prefixes from the specified public virtual circuit.
+	// Use this operation (and not UpdateVirtualCircuit)
+	// to remove prefixes from the virtual circuit. When the virtual circuit's state switches
+	// back to PROVISIONED, Oracle stops advertising the specified prefixes across the connection.
+	BulkDeleteVirtualCircuitPublicPrefixes(ctx context.Context, request BulkDeleteVirtualCircuitPublicPrefixesRequest) (response BulkDeleteVirtualCircuitPublicPrefixesResponse, err error)
+
+	// ChangeByoipRangeCompartment Moves a byoip range into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeByoipRangeCompartment(ctx context.Context, request ChangeByoipRangeCompartmentRequest) (response ChangeByoipRangeCompartmentResponse, err error)
+
+	// ChangeCpeCompartment Moves a CPE object into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeCpeCompartment(ctx context.Context, request ChangeCpeCompartmentRequest) (response ChangeCpeCompartmentResponse, err error)
+
+	// ChangeCrossConnectCompartment Moves a cross-connect into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeCrossConnectCompartment(ctx context.Context, request ChangeCrossConnectCompartmentRequest) (response ChangeCrossConnectCompartmentResponse, err error)
+
+	// ChangeCrossConnectGroupCompartment Moves a cross-connect group into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeCrossConnectGroupCompartment(ctx context.Context, request ChangeCrossConnectGroupCompartmentRequest) (response ChangeCrossConnectGroupCompartmentResponse, err error)
+
+	// ChangeDhcpOptionsCompartment Moves a set of DHCP options into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeDhcpOptionsCompartment(ctx context.Context, request ChangeDhcpOptionsCompartmentRequest) (response ChangeDhcpOptionsCompartmentResponse, err error)
+
+	// ChangeDrgCompartment Moves a DRG into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeDrgCompartment(ctx context.Context, request ChangeDrgCompartmentRequest) (response ChangeDrgCompartmentResponse, err error)
+
+	// ChangeIPSecConnectionCompartment Moves an IPSec connection into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeIPSecConnectionCompartment(ctx context.Context, request ChangeIPSecConnectionCompartmentRequest) (response ChangeIPSecConnectionCompartmentResponse, err error)
+
+	// ChangeInternetGatewayCompartment Moves an internet gateway into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeInternetGatewayCompartment(ctx context.Context, request ChangeInternetGatewayCompartmentRequest) (response ChangeInternetGatewayCompartmentResponse, err error)
+
+	// ChangeLocalPeeringGatewayCompartment Moves a local peering gateway into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeLocalPeeringGatewayCompartment(ctx context.Context, request ChangeLocalPeeringGatewayCompartmentRequest) (response ChangeLocalPeeringGatewayCompartmentResponse, err error)
+
+	// ChangeNatGatewayCompartment Moves a NAT gateway into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeNatGatewayCompartment(ctx context.Context, request ChangeNatGatewayCompartmentRequest) (response ChangeNatGatewayCompartmentResponse, err error)
+
+	// ChangeNetworkSecurityGroupCompartment Moves a network security group into a different compartment within the same tenancy. For
+	// information about moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeNetworkSecurityGroupCompartment(ctx context.Context, request ChangeNetworkSecurityGroupCompartmentRequest) (response ChangeNetworkSecurityGroupCompartmentResponse, err error)
+
+	// ChangePublicIpCompartment Moves a public IP into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// This operation applies only to reserved public IPs. Ephemeral public IPs always belong to the
+	// same compartment as their VNIC and move accordingly.
+	ChangePublicIpCompartment(ctx context.Context, request ChangePublicIpCompartmentRequest) (response ChangePublicIpCompartmentResponse, err error)
+
+	// ChangePublicIpPoolCompartment Moves a public IP pool into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangePublicIpPoolCompartment(ctx context.Context, request ChangePublicIpPoolCompartmentRequest) (response ChangePublicIpPoolCompartmentResponse, err error)
+
+	// ChangeRemotePeeringConnectionCompartment Moves a remote peering connection (RPC) into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeRemotePeeringConnectionCompartment(ctx context.Context, request ChangeRemotePeeringConnectionCompartmentRequest) (response ChangeRemotePeeringConnectionCompartmentResponse, err error)
+
+	// ChangeRouteTableCompartment Moves a route table into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeRouteTableCompartment(ctx context.Context, request ChangeRouteTableCompartmentRequest) (response ChangeRouteTableCompartmentResponse, err error)
+
+	// ChangeSecurityListCompartment Moves a security list into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeSecurityListCompartment(ctx context.Context, request ChangeSecurityListCompartmentRequest) (response ChangeSecurityListCompartmentResponse, err error)
+
+	// ChangeServiceGatewayCompartment Moves a service gateway into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeServiceGatewayCompartment(ctx context.Context, request ChangeServiceGatewayCompartmentRequest) (response ChangeServiceGatewayCompartmentResponse, err error)
+
+	// ChangeSubnetCompartment Moves a subnet into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeSubnetCompartment(ctx context.Context, request ChangeSubnetCompartmentRequest) (response ChangeSubnetCompartmentResponse, err error)
+
+	// ChangeVcnCompartment Moves a VCN into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVcnCompartment(ctx context.Context, request ChangeVcnCompartmentRequest) (response ChangeVcnCompartmentResponse, err error)
+
+	// ChangeVirtualCircuitCompartment Moves a virtual circuit into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVirtualCircuitCompartment(ctx context.Context, request ChangeVirtualCircuitCompartmentRequest) (response ChangeVirtualCircuitCompartmentResponse, err error)
+
+	// ChangeVlanCompartment Moves a VLAN into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeVlanCompartment(ctx context.Context, request ChangeVlanCompartmentRequest) (response ChangeVlanCompartmentResponse, err error)
+
+	// ConnectLocalPeeringGateways Connects this local peering gateway (LPG) to another one in the same region.
+	// This operation must be called by the VCN administrator who is designated as
+	// the *requestor* in the peering relationship. The *acceptor* must implement
+	// an Identity and Access Management (IAM) policy that gives the requestor permission
+	// to connect to LPGs in the acceptor's compartment. Without that permission, this
+	// operation will fail. For more information, see
+	// VCN Peering (https://docs.cloud.oracle.com/Content/Network/Tasks/VCNpeering.htm).
+	ConnectLocalPeeringGateways(ctx context.Context, request ConnectLocalPeeringGatewaysRequest) (response ConnectLocalPeeringGatewaysResponse, err error)
+
+	// ConnectRemotePeeringConnections Connects this RPC to another one in a different region.
+	// This operation must be called by the VCN administrator who is designated as
+	// the *requestor* in the peering relationship. The *acceptor* must implement
+	// an Identity and Access Management (IAM) policy that gives the requestor permission
+	// to connect to RPCs in the acceptor's compartment. Without that permission, this
+	// operation will fail. For more information, see
+	// VCN Peering (https://docs.cloud.oracle.com/Content/Network/Tasks/VCNpeering.htm).
+	ConnectRemotePeeringConnections(ctx context.Context, request ConnectRemotePeeringConnectionsRequest) (response ConnectRemotePeeringConnectionsResponse, err error)
+
+	// CreateByoipRange Creates a Byoip Range prefix.
+	CreateByoipRange(ctx context.Context, request CreateByoipRangeRequest) (response CreateByoipRangeResponse, err error)
+
+	// CreateCpe Creates a new virtual customer-premises equipment (CPE) object in the specified compartment. For
+	// more information, see IPSec VPNs (https://docs.cloud.oracle.com/Content/Network/Tasks/managingIPsec.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want
+	// the CPE to reside. Notice that the CPE doesn't have to be in the same compartment as the IPSec
+	// connection or other Networking Service components. If you're not sure which compartment to
+	// use, put the CPE in the same compartment as the DRG. For more information about
+	// compartments and access control, see Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must provide the public IP address of your on-premises router. See
+	// Configuring Your On-Premises Router for an IPSec VPN (https://docs.cloud.oracle.com/Content/Network/Tasks/configuringCPE.htm).
+	// You may optionally specify a *display name* for the CPE, otherwise a default is provided. It does not have to
+	// be unique, and you can change it. Avoid entering confidential information.
+	CreateCpe(ctx context.Context, request CreateCpeRequest) (response CreateCpeResponse, err error)
+
+	// CreateCrossConnect Creates a new cross-connect. Oracle recommends you create each cross-connect in a
+	// CrossConnectGroup so you can use link aggregation
+	// with the connection.
+	// After creating the `CrossConnect` object, you need to go the FastConnect location
+	// and request to have the physical cable installed. For more information, see
+	// FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	// For the purposes of access control, you must provide the OCID of the
+	// compartment where you want the cross-connect to reside. If you're
+	// not sure which compartment to use, put the cross-connect in the
+	// same compartment with your VCN. For more information about
+	// compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the cross-connect.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateCrossConnect(ctx context.Context, request CreateCrossConnectRequest) (response CreateCrossConnectResponse, err error)
+
+	// CreateCrossConnectGroup Creates a new cross-connect group to use with Oracle Cloud Infrastructure
+	// FastConnect. For more information, see
+	// FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	// For the purposes of access control, you must provide the OCID of the
+	// compartment where you want the cross-connect group to reside. If you're
+	// not sure which compartment to use, put the cross-connect group in the
+	// same compartment with your VCN. For more information about
+	// compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the cross-connect group.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateCrossConnectGroup(ctx context.Context, request CreateCrossConnectGroupRequest) (response CreateCrossConnectGroupResponse, err error)
+
+	// CreateDhcpOptions Creates a new set of DHCP options for the specified VCN. For more information, see
+	// DhcpOptions.
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the set of
+	// DHCP options to reside. Notice that the set of options doesn't have to be in the same compartment as the VCN,
+	// subnets, or other Networking Service components. If you're not sure which compartment to use, put the set
+	// of DHCP options in the same compartment as the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the set of DHCP options, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateDhcpOptions(ctx context.Context, request CreateDhcpOptionsRequest) (response CreateDhcpOptionsResponse, err error)
+
+	// CreateDrg Creates a new dynamic routing gateway (DRG) in the specified compartment. For more information,
+	// see Dynamic Routing Gateways (DRGs) (https://docs.cloud.oracle.com/Content/Network/Tasks/managingDRGs.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want
+	// the DRG to reside. Notice that the DRG doesn't have to be in the same compartment as the VCN,
+	// the DRG attachment, or other Networking Service components. If you're not sure which compartment
+	// to use, put the DRG in the same compartment as the VCN. For more information about compartments
+	// and access control, see Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the DRG, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateDrg(ctx context.Context, request CreateDrgRequest) (response CreateDrgResponse, err error)
+
+	// CreateDrgAttachment Attaches the specified DRG to the specified VCN. A VCN can be attached to only one DRG at a time,
+	// and vice versa. The response includes a `DrgAttachment` object with its own OCID. For more
+	// information about DRGs, see
+	// Dynamic Routing Gateways (DRGs) (https://docs.cloud.oracle.com/Content/Network/Tasks/managingDRGs.htm).
+	// You may optionally specify a *display name* for the attachment, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	// For the purposes of access control, the DRG attachment is automatically placed into the same compartment
+	// as the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	CreateDrgAttachment(ctx context.Context, request CreateDrgAttachmentRequest) (response CreateDrgAttachmentResponse, err error)
+
+	// CreateIPSecConnection Creates a new IPSec connection between the specified DRG and CPE. For more information, see
+	// IPSec VPNs (https://docs.cloud.oracle.com/Content/Network/Tasks/managingIPsec.htm).
+	// If you configure at least one tunnel to use static routing, then in the request you must provide
+	// at least one valid static route (you're allowed a maximum of 10). For example: 10.0.0.0/16.
+	// If you configure both tunnels to use BGP dynamic routing, you can provide an empty list for
+	// the static routes. For more information, see the important note in
+	// IPSecConnection.
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the
+	// IPSec connection to reside. Notice that the IPSec connection doesn't have to be in the same compartment
+	// as the DRG, CPE, or other Networking Service components. If you're not sure which compartment to
+	// use, put the IPSec connection in the same compartment as the DRG. For more information about
+	// compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the IPSec connection, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	// After creating the IPSec connection, you need to configure your on-premises router
+	// with tunnel-specific information. For tunnel status and the required configuration information, see:
+	//   * IPSecConnectionTunnel
+	//   * IPSecConnectionTunnelSharedSecret
+	// For each tunnel, you need the IP address of Oracle's VPN headend and the shared secret
+	// (that is, the pre-shared key). For more information, see
+	// Configuring Your On-Premises Router for an IPSec VPN (https://docs.cloud.oracle.com/Content/Network/Tasks/configuringCPE.htm).
+	CreateIPSecConnection(ctx context.Context, request CreateIPSecConnectionRequest) (response CreateIPSecConnectionResponse, err error)
+
+	// CreateInternetGateway Creates a new internet gateway for the specified VCN. For more information, see
+	// Access to the Internet (https://docs.cloud.oracle.com/Content/Network/Tasks/managingIGs.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the Internet
+	// Gateway to reside. Notice that the internet gateway doesn't have to be in the same compartment as the VCN or
+	// other Networking Service components. If you're not sure which compartment to use, put the Internet
+	// Gateway in the same compartment with the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the internet gateway, otherwise a default is provided. It
+	// does not have to be unique, and you can change it. Avoid entering confidential information.
+	// For traffic to flow between a subnet and an internet gateway, you must create a route rule accordingly in
+	// the subnet's route table (for example, 0.0.0.0/0 > internet gateway). See
+	// UpdateRouteTable.
+	// You must specify whether the internet gateway is enabled when you create it. If it's disabled, that means no
+	// traffic will flow to/from the internet even if there's a route rule that enables that traffic. You can later
+	// use UpdateInternetGateway to easily disable/enable
+	// the gateway without changing the route rule.
+	CreateInternetGateway(ctx context.Context, request CreateInternetGatewayRequest) (response CreateInternetGatewayResponse, err error)
+
+	// CreateIpv6 Creates an IPv6 for the specified VNIC.
+	CreateIpv6(ctx context.Context, request CreateIpv6Request) (response CreateIpv6Response, err error)
+
+	// CreateLocalPeeringGateway Creates a new local peering gateway (LPG) for the specified VCN.
+	CreateLocalPeeringGateway(ctx context.Context, request CreateLocalPeeringGatewayRequest) (response CreateLocalPeeringGatewayResponse, err error)
+
+	// CreateNatGateway Creates a new NAT gateway for the specified VCN. You must also set up a route rule with the
+	// NAT gateway as the rule's target. See RouteTable.
+	CreateNatGateway(ctx context.Context, request CreateNatGatewayRequest) (response CreateNatGatewayResponse, err error)
+
+	// CreateNetworkSecurityGroup Creates a new network security group for the specified VCN.
+	CreateNetworkSecurityGroup(ctx context.Context, request CreateNetworkSecurityGroupRequest) (response CreateNetworkSecurityGroupResponse, err error)
+
+	// CreatePrivateIp Creates a secondary private IP for the specified VNIC.
+	// For more information about secondary private IPs, see
+	// IP Addresses (https://docs.cloud.oracle.com/Content/Network/Tasks/managingIPaddresses.htm).
+	CreatePrivateIp(ctx context.Context, request CreatePrivateIpRequest) (response CreatePrivateIpResponse, err error)
+
+	// CreatePublicIp Creates a public IP. Use the `lifetime` property to specify whether it's an ephemeral or
+	// reserved public IP. For information about limits on how many you can create, see
+	// Public IP Addresses (https://docs.cloud.oracle.com/Content/Network/Tasks/managingpublicIPs.htm).
+	// * **For an ephemeral public IP assigned to a private IP:** You must also specify a `privateIpId`
+	// with the OCID of the primary private IP you want to assign the public IP to. The public IP is
+	// created in the same availability domain as the private IP. An ephemeral public IP must always be
+	// assigned to a private IP, and only to the *primary* private IP on a VNIC, not a secondary
+	// private IP. Exception: If you create a NatGateway, Oracle
+	// automatically assigns the NAT gateway a regional ephemeral public IP that you cannot remove.
+	// * **For a reserved public IP:** You may also optionally assign the public IP to a private
+	// IP by specifying `privateIpId`. Or you can later assign the public IP with
+	// UpdatePublicIp.
+	// **Note:** When assigning a public IP to a private IP, the private IP must not already have
+	// a public IP with `lifecycleState` = ASSIGNING or ASSIGNED. If it does, an error is returned.
+	// Also, for reserved public IPs, the optional assignment part of this operation is
+	// asynchronous. Poll the public IP's `lifecycleState` to determine if the assignment
+	// succeeded.
+	CreatePublicIp(ctx context.Context, request CreatePublicIpRequest) (response CreatePublicIpResponse, err error)
+
+	// CreatePublicIpPool Creates a Public Ip Pool
+	CreatePublicIpPool(ctx context.Context, request CreatePublicIpPoolRequest) (response CreatePublicIpPoolResponse, err error)
+
+	// CreateRemotePeeringConnection Creates a new remote peering connection (RPC) for the specified DRG.
+	CreateRemotePeeringConnection(ctx context.Context, request CreateRemotePeeringConnectionRequest) (response CreateRemotePeeringConnectionResponse, err error)
+
+	// CreateRouteTable Creates a new route table for the specified VCN. In the request you must also include at least one route
+	// rule for the new route table. For information on the number of rules you can have in a route table, see
+	// Service Limits (https://docs.cloud.oracle.com/Content/General/Concepts/servicelimits.htm). For general information about route
+	// tables in your VCN and the types of targets you can use in route rules,
+	// see Route Tables (https://docs.cloud.oracle.com/Content/Network/Tasks/managingroutetables.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the route
+	// table to reside. Notice that the route table doesn't have to be in the same compartment as the VCN, subnets,
+	// or other Networking Service components. If you're not sure which compartment to use, put the route
+	// table in the same compartment as the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the route table, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateRouteTable(ctx context.Context, request CreateRouteTableRequest) (response CreateRouteTableResponse, err error)
+
+	// CreateSecurityList Creates a new security list for the specified VCN. For more information
+	// about security lists, see Security Lists (https://docs.cloud.oracle.com/Content/Network/Concepts/securitylists.htm).
+	// For information on the number of rules you can have in a security list, see
+	// Service Limits (https://docs.cloud.oracle.com/Content/General/Concepts/servicelimits.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the security
+	// list to reside. Notice that the security list doesn't have to be in the same compartment as the VCN, subnets,
+	// or other Networking Service components. If you're not sure which compartment to use, put the security
+	// list in the same compartment as the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the security list, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateSecurityList(ctx context.Context, request CreateSecurityListRequest) (response CreateSecurityListResponse, err error)
+
+	// CreateServiceGateway Creates a new service gateway in the specified compartment.
+	// For the purposes of access control, you must provide the OCID of the compartment where you want
+	// the service gateway to reside. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the service gateway, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	CreateServiceGateway(ctx context.Context, request CreateServiceGatewayRequest) (response CreateServiceGatewayResponse, err error)
+
+	// CreateSubnet Creates a new subnet in the specified VCN. You can't change the size of the subnet after creation,
+	// so it's important to think about the size of subnets you need before creating them.
+	// For more information, see VCNs and Subnets (https://docs.cloud.oracle.com/Content/Network/Tasks/managingVCNs.htm).
+	// For information on the number of subnets you can have in a VCN, see
+	// Service Limits (https://docs.cloud.oracle.com/Content/General/Concepts/servicelimits.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the subnet
+	// to reside. Notice that the subnet doesn't have to be in the same compartment as the VCN, route tables, or
+	// other Networking Service components. If you're not sure which compartment to use, put the subnet in
+	// the same compartment as the VCN. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs,
+	// see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally associate a route table with the subnet. If you don't, the subnet will use the
+	// VCN's default route table. For more information about route tables, see
+	// Route Tables (https://docs.cloud.oracle.com/Content/Network/Tasks/managingroutetables.htm).
+	// You may optionally associate a security list with the subnet. If you don't, the subnet will use the
+	// VCN's default security list. For more information about security lists, see
+	// Security Lists (https://docs.cloud.oracle.com/Content/Network/Concepts/securitylists.htm).
+	// You may optionally associate a set of DHCP options with the subnet. If you don't, the subnet will use the
+	// VCN's default set. For more information about DHCP options, see
+	// DHCP Options (https://docs.cloud.oracle.com/Content/Network/Tasks/managingDHCP.htm).
+	// You may optionally specify a *display name* for the subnet, otherwise a default is provided.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	// You can also add a DNS label for the subnet, which is required if you want the Internet and
+	// VCN Resolver to resolve hostnames for instances in the subnet. For more information, see
+	// DNS in Your Virtual Cloud Network (https://docs.cloud.oracle.com/Content/Network/Concepts/dns.htm).
+	CreateSubnet(ctx context.Context, request CreateSubnetRequest) (response CreateSubnetResponse, err error)
+
+	// CreateVcn Creates a new virtual cloud network (VCN). For more information, see
+	// VCNs and Subnets (https://docs.cloud.oracle.com/Content/Network/Tasks/managingVCNs.htm).
+	// For the VCN you must specify a single, contiguous IPv4 CIDR block. Oracle recommends using one of the
+	// private IP address ranges specified in RFC 1918 (https://tools.ietf.org/html/rfc1918) (10.0.0.0/8,
+	// 172.16/12, and 192.168/16). Example: 172.16.0.0/16. The CIDR block can range from /16 to /30, and it
+	// must not overlap with your on-premises network. You can't change the size of the VCN after creation.
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the VCN to
+	// reside. Consult an Oracle Cloud Infrastructure administrator in your organization if you're not sure which
+	// compartment to use. Notice that the VCN doesn't have to be in the same compartment as the subnets or other
+	// Networking Service components. For more information about compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm). For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the VCN, otherwise a default is provided. It does not have to
+	// be unique, and you can change it. Avoid entering confidential information.
+	// You can also add a DNS label for the VCN, which is required if you want the instances to use the
+	// Interent and VCN Resolver option for DNS in the VCN. For more information, see
+	// DNS in Your Virtual Cloud Network (https://docs.cloud.oracle.com/Content/Network/Concepts/dns.htm).
+	// The VCN automatically comes with a default route table, default security list, and default set of DHCP options.
+	// The OCID for each is returned in the response. You can't delete these default objects, but you can change their
+	// contents (that is, change the route rules, security list rules, and so on).
+	// The VCN and subnets you create are not accessible until you attach an internet gateway or set up an IPSec VPN
+	// or FastConnect. For more information, see
+	// Overview of the Networking Service (https://docs.cloud.oracle.com/Content/Network/Concepts/overview.htm).
+	CreateVcn(ctx context.Context, request CreateVcnRequest) (response CreateVcnResponse, err error)
+
+	// CreateVirtualCircuit Creates a new virtual circuit to use with Oracle Cloud
+	// Infrastructure FastConnect. For more information, see
+	// FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	// For the purposes of access control, you must provide the OCID of the
+	// compartment where you want the virtual circuit to reside. If you're
+	// not sure which compartment to use, put the virtual circuit in the
+	// same compartment with the DRG it's using. For more information about
+	// compartments and access control, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You may optionally specify a *display name* for the virtual circuit.
+	// It does not have to be unique, and you can change it. Avoid entering confidential information.
+	// **Important:** When creating a virtual circuit, you specify a DRG for
+	// the traffic to flow through. Make sure you attach the DRG to your
+	// VCN and confirm the VCN's routing sends traffic to the DRG. Otherwise
+	// traffic will not flow. For more information, see
+	// Route Tables (https://docs.cloud.oracle.com/Content/Network/Tasks/managingroutetables.htm).
+	CreateVirtualCircuit(ctx context.Context, request CreateVirtualCircuitRequest) (response CreateVirtualCircuitResponse, err error)
+
+	// CreateVlan Creates a VLAN in the specified VCN and the specified compartment.
+	CreateVlan(ctx context.Context, request CreateVlanRequest) (response CreateVlanResponse, err error)
+
+	// DeleteByoipRange Deletes the specified Byoip Range prefix.
+	// The prefix must be in CREATING, PROVISIONED or FAILED state.
+	// It must not have any subranges allocated to a Public Ip Pool object.
+	// You must specify the object's OCID.
+	// In case the range is currently PROVISIONED, the operation will be asynchronous as it needs to be de-ptovisioned first.
+	DeleteByoipRange(ctx context.Context, request DeleteByoipRangeRequest) (response DeleteByoipRangeResponse, err error)
+
+	// DeleteCpe Deletes the specified CPE object. The CPE must not be connected to a DRG. This is an asynchronous
+	// operation. The CPE's `lifecycleState` will change to TERMINATING temporarily until the CPE is completely
+	// removed.
+	DeleteCpe(ctx context.Context, request DeleteCpeRequest) (response DeleteCpeResponse, err error)
+
+	// DeleteCrossConnect Deletes the specified cross-connect. It must not be mapped to a
+	// VirtualCircuit.
+	DeleteCrossConnect(ctx context.Context, request DeleteCrossConnectRequest) (response DeleteCrossConnectResponse, err error)
+
+	// DeleteCrossConnectGroup Deletes the specified cross-connect group. It must not contain any
+	// cross-connects, and it cannot be mapped to a
+	// VirtualCircuit.
+	DeleteCrossConnectGroup(ctx context.Context, request DeleteCrossConnectGroupRequest) (response DeleteCrossConnectGroupResponse, err error)
+
+	// DeleteDhcpOptions Deletes the specified set of DHCP options, but only if it's not associated with a subnet. You can't delete a
+	// VCN's default set of DHCP options.
+	// This is an asynchronous operation. The state of the set of options will switch to TERMINATING temporarily
+	// until the set is completely removed.
+	DeleteDhcpOptions(ctx context.Context, request DeleteDhcpOptionsRequest) (response DeleteDhcpOptionsResponse, err error)
+
+	// DeleteDrg Deletes the specified DRG. The DRG must not be attached to a VCN or be connected to your on-premise
+	// network. Also, there must not be a route table that lists the DRG as a target. This is an asynchronous
+	// operation. The DRG's `lifecycleState` will change to TERMINATING temporarily until the DRG is completely
+	// removed.
+	DeleteDrg(ctx context.Context, request DeleteDrgRequest) (response DeleteDrgResponse, err error)
+
+	// DeleteDrgAttachment Detaches a DRG from a VCN by deleting the corresponding `DrgAttachment`. This is an asynchronous
+	// operation. The attachment's `lifecycleState` will change to DETACHING temporarily until the attachment
+	// is completely removed.
+	DeleteDrgAttachment(ctx context.Context, request DeleteDrgAttachmentRequest) (response DeleteDrgAttachmentResponse, err error)
+
+	// DeleteIPSecConnection Deletes the specified IPSec connection. If your goal is to disable the IPSec VPN between your VCN and
+	// on-premises network, it's easiest to simply detach the DRG but keep all the IPSec VPN components intact.
+	// If you were to delete all the components and then later need to create an IPSec VPN again, you would
+	// need to configure your on-premises router again with the new information returned from
+	// CreateIPSecConnection.
+	// This is an asynchronous operation. The connection's `lifecycleState` will change to TERMINATING temporarily
+	// until the connection is completely removed.
+	DeleteIPSecConnection(ctx context.Context, request DeleteIPSecConnectionRequest) (response DeleteIPSecConnectionResponse, err error)
+
+	// DeleteInternetGateway Deletes the specified internet gateway. The internet gateway does not have to be disabled, but
+	// there must not be a route table that lists it as a target.
+	// This is an asynchronous operation. The gateway's `lifecycleState` will change to TERMINATING temporarily
+	// until the gateway is completely removed.
+	DeleteInternetGateway(ctx context.Context, request DeleteInternetGatewayRequest) (response DeleteInternetGatewayResponse, err error)
+
+	// DeleteIpv6 Unassigns and deletes the specified IPv6. You must specify the object's OCID.
+	// The IPv6 address is returned to the subnet's pool of available addresses.
+	DeleteIpv6(ctx context.Context, request DeleteIpv6Request) (response DeleteIpv6Response, err error)
+
+	// DeleteLocalPeeringGateway Deletes the specified local peering gateway (LPG).
+	// This is an asynchronous operation; the local peering gateway's `lifecycleState` changes to TERMINATING temporarily
+	// until the local peering gateway is completely removed.
+	DeleteLocalPeeringGateway(ctx context.Context, request DeleteLocalPeeringGatewayRequest) (response DeleteLocalPeeringGatewayResponse, err error)
+
+	// DeleteNatGateway Deletes the specified NAT gateway. The NAT gateway does not have to be disabled, but there
+	// must not be a route rule that lists the NAT gateway as a target.
+	// This is an asynchronous operation. The NAT gateway's `lifecycleState` will change to
+	// TERMINATING temporarily until the NAT gateway is completely removed.
+	DeleteNatGateway(ctx context.Context, request DeleteNatGatewayRequest) (response DeleteNatGatewayResponse, err error)
+
+	// DeleteNetworkSecurityGroup Deletes the specified network security group. The group must not contain any VNICs.
+	// To get a list of the VNICs in a network security group, use
+	// ListNetworkSecurityGroupVnics.
+	// Each returned NetworkSecurityGroupVnic object
+	// contains both the OCID of the VNIC and the OCID of the VNIC's parent resource (for example,
+	// the Compute instance that the VNIC is attached to).
+	DeleteNetworkSecurityGroup(ctx context.Context, request DeleteNetworkSecurityGroupRequest) (response DeleteNetworkSecurityGroupResponse, err error)
+
+	// DeletePrivateIp Unassigns and deletes the specified private IP. You must
+	// specify the object's OCID. The private IP address is returned to
+	// the subnet's pool of available addresses.
+	// This operation cannot be used with primary private IPs, which are
+	// automatically unassigned and deleted when the VNIC is terminated.
+	// **Important:** If a secondary private IP is the
+	// target of a route rule (https://docs.cloud.oracle.com/Content/Network/Tasks/managingroutetables.htm#privateip),
+	// unassigning it from the VNIC causes that route rule to blackhole and the traffic
+	// will be dropped.
+	DeletePrivateIp(ctx context.Context, request DeletePrivateIpRequest) (response DeletePrivateIpResponse, err error)
+
+	// DeletePublicIp Unassigns and deletes the specified public IP (either ephemeral or reserved).
+	// You must specify the object's OCID. The public IP address is returned to the
+	// Oracle Cloud Infrastructure public IP pool.
+	// **Note:** You cannot update, unassign, or delete the public IP that Oracle automatically
+	// assigned to an entity for you (such as a load balancer or NAT gateway). The public IP is
+	// automatically deleted if the assigned entity is terminated.
+	// For an assigned reserved public IP, the initial unassignment portion of this operation
+	// is asynchronous. Poll the public IP's `lifecycleState` to determine
+	// if the operation succeeded.
+	// If you want to simply unassign a reserved public IP and return it to your pool
+	// of reserved public IPs, instead use
+	// UpdatePublicIp.
+	DeletePublicIp(ctx context.Context, request DeletePublicIpRequest) (response DeletePublicIpResponse, err error)
+
+	// DeletePublicIpPool Deletes the specified Public Ip Pool
+	// It must not have any active address allocations
+	// You must specify the object's OCID.
+	DeletePublicIpPool(ctx context.Context, request DeletePublicIpPoolRequest) (response DeletePublicIpPoolResponse, err error)
+
+	// DeleteRemotePeeringConnection Deletes the remote peering connection (RPC).
+	// This is an asynchronous operation; the RPC's `lifecycleState` changes to TERMINATING temporarily
+	// until the RPC is completely removed.
+	DeleteRemotePeeringConnection(ctx context.Context, request DeleteRemotePeeringConnectionRequest) (response DeleteRemotePeeringConnectionResponse, err error)
+
+	// DeleteRouteTable Deletes the specified route table, but only if it's not associated with a subnet. You can't delete a
+	// VCN's default route table.
+	// This is an asynchronous operation. The route table's `lifecycleState` will change to TERMINATING temporarily
+	// until the route table is completely removed.
+	DeleteRouteTable(ctx context.Context, request DeleteRouteTableRequest) (response DeleteRouteTableResponse, err error)
+
+	// DeleteSecurityList Deletes the specified security list, but only if it's not associated with a subnet. You can't delete
+	// a VCN's default security list.
+	// This is an asynchronous operation. The security list's `lifecycleState` will change to TERMINATING temporarily
+	// until the security list is completely removed.
+	DeleteSecurityList(ctx context.Context, request DeleteSecurityListRequest) (response DeleteSecurityListResponse, err error)
+
+	// DeleteServiceGateway Deletes the specified service gateway. There must not be a route table that lists the service
+	// gateway as a target.
+	DeleteServiceGateway(ctx context.Context, request DeleteServiceGatewayRequest) (response DeleteServiceGatewayResponse, err error)
+
+	// DeleteSubnet Deletes the specified subnet, but only if there are no instances in the subnet. This is an asynchronous
+	// operation. The subnet's `lifecycleState` will change to TERMINATING temporarily. If there are any
+	// instances in the subnet, the state will instead change back to AVAILABLE.
+	DeleteSubnet(ctx context.Context, request DeleteSubnetRequest) (response DeleteSubnetResponse, err error)
+
+	// DeleteVcn Deletes the specified VCN. The VCN must be empty and have no attached gateways. This is an asynchronous
+	// operation. The VCN's `lifecycleState` will change to TERMINATING temporarily until the VCN is completely
+	// removed.
+	DeleteVcn(ctx context.Context, request DeleteVcnRequest) (response DeleteVcnResponse, err error)
+
+	// DeleteVirtualCircuit Deletes the specified virtual circuit.
+	// **Important:** If you're using FastConnect via a provider,
+	// make sure to also terminate the connection with
+	// the provider, or else the provider may continue to bill you.
+	DeleteVirtualCircuit(ctx context.Context, request DeleteVirtualCircuitRequest) (response DeleteVirtualCircuitResponse, err error)
+
+	// DeleteVlan Deletes the specified VLAN, but only if there are no VNICs in the VLAN.
+	DeleteVlan(ctx context.Context, request DeleteVlanRequest) (response DeleteVlanResponse, err error)
+
+	// DetachServiceId Removes the specified Service from the list of enabled
+	// `Service` objects for the specified gateway. You do not need to remove any route
+	// rules that specify this `Service` object's `cidrBlock` as the destination CIDR. However, consider
+	// removing the rules if your intent is to permanently disable use of the `Service` through this
+	// service gateway.
+	// **Note:** The `DetachServiceId` operation is an easy way to remove an individual `Service` from
+	// the service gateway. Compare it with
+	// UpdateServiceGateway, which replaces
+	// the entire existing list of enabled `Service` objects with the list that you provide in the
+	// `Update` call. `UpdateServiceGateway` also lets you block all traffic through the service
+	// gateway without having to remove each of the individual `Service` objects.
+	DetachServiceId(ctx context.Context, request DetachServiceIdRequest) (response DetachServiceIdResponse, err error)
+
+	// GetByoipRange Gets the specified Byoip Range object. You must specify the object's OCID.
+	GetByoipRange(ctx context.Context, request GetByoipRangeRequest) (response GetByoipRangeResponse, err error)
+
+	// GetCpe Gets the specified CPE's information.
+	GetCpe(ctx context.Context, request GetCpeRequest) (response GetCpeResponse, err error)
+
+	// GetCpeDeviceConfigContent Renders a set of CPE configuration content that can help a network engineer configure the actual
+	// CPE device (for example, a hardware router) represented by the specified Cpe
+	// object.
+	// The rendered content is specific to the type of CPE device (for example, Cisco ASA). Therefore the
+	// Cpe must have the CPE's device type specified by the `cpeDeviceShapeId`
+	// attribute. The content optionally includes answers that the customer provides (see
+	// UpdateTunnelCpeDeviceConfig),
+	// merged with a template of other information specific to the CPE device type.
+	// The operation returns configuration information for *all* of the
+	// IPSecConnection objects that use the specified CPE.
+	// Here are similar operations:
+	//   * GetIpsecCpeDeviceConfigContent
+	//   returns CPE configuration content for all tunnels in a single IPSec connection.
+	//   * GetTunnelCpeDeviceConfigContent
+	//   returns CPE configuration content for a specific tunnel within an IPSec connection.
+	GetCpeDeviceConfigContent(ctx context.Context, request GetCpeDeviceConfigContentRequest) (response GetCpeDeviceConfigContentResponse, err error)
+
+	// GetCpeDeviceShape Gets the detailed information about the specified CPE device type. This might include a set of questions
+	// that are specific to the particular CPE device type. The customer must supply answers to those questions
+	// (see UpdateTunnelCpeDeviceConfig).
+	// The service merges the answers with a template of other information for the CPE device type. The following
+	// operations return the merged content:
+	//   * GetCpeDeviceConfigContent
+	//   * GetIpsecCpeDeviceConfigContent
+	//   * GetTunnelCpeDeviceConfigContent
+	GetCpeDeviceShape(ctx context.Context, request GetCpeDeviceShapeRequest) (response GetCpeDeviceShapeResponse, err error)
+
+	// GetCrossConnect Gets the specified cross-connect's information.
+	GetCrossConnect(ctx context.Context, request GetCrossConnectRequest) (response GetCrossConnectResponse, err error)
+
+	// GetCrossConnectGroup Gets the specified cross-connect group's information.
+	GetCrossConnectGroup(ctx context.Context, request GetCrossConnectGroupRequest) (response GetCrossConnectGroupResponse, err error)
+
+	// GetCrossConnectLetterOfAuthority Gets the Letter of Authority for the specified cross-connect.
+	GetCrossConnectLetterOfAuthority(ctx context.Context, request GetCrossConnectLetterOfAuthorityRequest) (response GetCrossConnectLetterOfAuthorityResponse, err error)
+
+	// GetCrossConnectStatus Gets the status of the specified cross-connect.
+	GetCrossConnectStatus(ctx context.Context, request GetCrossConnectStatusRequest) (response GetCrossConnectStatusResponse, err error)
+
+	// GetDhcpOptions Gets the specified set of DHCP options.
+	GetDhcpOptions(ctx context.Context, request GetDhcpOptionsRequest) (response GetDhcpOptionsResponse, err error)
+
+	// GetDrg Gets the specified DRG's information.
+	GetDrg(ctx context.Context, request GetDrgRequest) (response GetDrgResponse, err error)
+
+	// GetDrgAttachment Gets the information for the specified `DrgAttachment`.
+	GetDrgAttachment(ctx context.Context, request GetDrgAttachmentRequest) (response GetDrgAttachmentResponse, err error)
+
+	// GetDrgRedundancyStatus Gets the redundancy status for the specified DRG. For more information, see
+	// Redundancy Remedies (https://docs.cloud.oracle.com/Content/Network/Troubleshoot/drgredundancy.htm).
+	GetDrgRedundancyStatus(ctx context.Context, request GetDrgRedundancyStatusRequest) (response GetDrgRedundancyStatusResponse, err error)
+
+	// GetFastConnectProviderService Gets the specified provider service.
+	// For more information, see FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	GetFastConnectProviderService(ctx context.Context, request GetFastConnectProviderServiceRequest) (response GetFastConnectProviderServiceResponse, err error)
+
+	// GetFastConnectProviderServiceKey Gets the specified provider service key's information. Use this operation to validate a
+	// provider service key. An invalid key returns a 404 error.
+	GetFastConnectProviderServiceKey(ctx context.Context, request GetFastConnectProviderServiceKeyRequest) (response GetFastConnectProviderServiceKeyResponse, err error)
+
+	// GetIPSecConnection Gets the specified IPSec connection's basic information, including the static routes for the
+	// on-premises router. If you want the status of the connection (whether it's up or down), use
+	// GetIPSecConnectionTunnel.
+	GetIPSecConnection(ctx context.Context, request GetIPSecConnectionRequest) (response GetIPSecConnectionResponse, err error)
+
+	// GetIPSecConnectionDeviceConfig Deprecated. To get tunnel information, instead use:
+	// * GetIPSecConnectionTunnel
+	// * GetIPSecConnectionTunnelSharedSecret
+	GetIPSecConnectionDeviceConfig(ctx context.Context, request GetIPSecConnectionDeviceConfigRequest) (response GetIPSecConnectionDeviceConfigResponse, err error)
+
+	// GetIPSecConnectionDeviceStatus Deprecated. To get the tunnel status, instead use
+	// GetIPSecConnectionTunnel.
+	GetIPSecConnectionDeviceStatus(ctx context.Context, request GetIPSecConnectionDeviceStatusRequest) (response GetIPSecConnectionDeviceStatusResponse, err error)
+
+	// GetIPSecConnectionTunnel Gets the specified tunnel's information. The resulting object does not include the tunnel's
+	// shared secret (pre-shared key). To retrieve that, use
+	// GetIPSecConnectionTunnelSharedSecret.
+	GetIPSecConnectionTunnel(ctx context.Context, request GetIPSecConnectionTunnelRequest) (response GetIPSecConnectionTunnelResponse, err error)
+
+	// GetIPSecConnectionTunnelSharedSecret Gets the specified tunnel's shared secret (pre-shared key). To get other information
+	// about the tunnel, use GetIPSecConnectionTunnel.
+	GetIPSecConnectionTunnelSharedSecret(ctx context.Context, request GetIPSecConnectionTunnelSharedSecretRequest) (response GetIPSecConnectionTunnelSharedSecretResponse, err error)
+
+	// GetInternetGateway Gets the specified internet gateway's information.
+	GetInternetGateway(ctx context.Context, request GetInternetGatewayRequest) (response GetInternetGatewayResponse, err error)
+
+	// GetIpsecCpeDeviceConfigContent Renders a set of CPE configuration content for the specified IPSec connection (for all the
+	// tunnels in the connection). The content helps a network engineer configure the actual CPE
+	// device (for example, a hardware router) that the specified IPSec connection terminates on.
+	// The rendered content is specific to the type of CPE device (for example, Cisco ASA). Therefore the
+	// Cpe used by the specified IPSecConnection
+	// must have the CPE's device type specified by the `cpeDeviceShapeId` attribute. The content
+	// optionally includes answers that the customer provides (see
+	// UpdateTunnelCpeDeviceConfig),
+	// merged with a template of other information specific to the CPE device type.
+	// The operation returns configuration information for all tunnels in the single specified
+	// IPSecConnection object. Here are other similar
+	// operations:
+	//   * GetTunnelCpeDeviceConfigContent
+	//   returns CPE configuration content for a specific tunnel within an IPSec connection.
+	//   * GetCpeDeviceConfigContent
+	//   returns CPE configuration content for *all* IPSec connections that use a specific CPE.
+	GetIpsecCpeDeviceConfigContent(ctx context.Context, request GetIpsecCpeDeviceConfigContentRequest) (response GetIpsecCpeDeviceConfigContentResponse, err error)
+
+	// GetIpv6 Gets the specified IPv6. You must specify the object's OCID.
+	// Alternatively, you can get the object by using
+	// ListIpv6s
+	// with the IPv6 address (for example, 2001:0db8:0123:1111:98fe:dcba:9876:4321) and subnet OCID.
+	GetIpv6(ctx context.Context, request GetIpv6Request) (response GetIpv6Response, err error)
+
+	// GetLocalPeeringGateway Gets the specified local peering gateway's information.
+	GetLocalPeeringGateway(ctx context.Context, request GetLocalPeeringGatewayRequest) (response GetLocalPeeringGatewayResponse, err error)
+
+	// GetNatGateway Gets the specified NAT gateway's information.
+	GetNatGateway(ctx context.Context, request GetNatGatewayRequest) (response GetNatGatewayResponse, err error)
+
+	// GetNetworkSecurityGroup Gets the specified network security group's information.
+	// To list the VNICs in an NSG, see
+	// ListNetworkSecurityGroupVnics.
+	// To list the security rules in an NSG, see
+	// ListNetworkSecurityGroupSecurityRules.
+	GetNetworkSecurityGroup(ctx context.Context, request GetNetworkSecurityGroupRequest) (response GetNetworkSecurityGroupResponse, err error)
+
+	// GetPrivateIp Gets the specified private IP. You must specify the object's OCID.
+	// Alternatively, you can get the object by using
+	// ListPrivateIps
+	// with the private IP address (for example, 10.0.3.3) and subnet OCID.
+	GetPrivateIp(ctx context.Context, request GetPrivateIpRequest) (response GetPrivateIpResponse, err error)
+
+	// GetPublicIp Gets the specified public IP. You must specify the object's OCID.
+	// Alternatively, you can get the object by using GetPublicIpByIpAddress
+	// with the public IP address (for example, 203.0.113.2).
+	// Or you can use GetPublicIpByPrivateIpId
+	// with the OCID of the private IP that the public IP is assigned to.
+	// **Note:** If you're fetching a reserved public IP that is in the process of being
+	// moved to a different private IP, the service returns the public IP object with
+	// `lifecycleState` = ASSIGNING and `assignedEntityId` = OCID of the target private IP.
+	GetPublicIp(ctx context.Context, request GetPublicIpRequest) (response GetPublicIpResponse, err error)
+
+	// GetPublicIpByIpAddress Gets the public IP based on the public IP address (for example, 203.0.113.2).
+	// **Note:** If you're fetching a reserved public IP that is in the process of being
+	// moved to a different private IP, the service returns the public IP object with
+	// `lifecycleState` = ASSIGNING and `assignedEntityId` = OCID of the target private IP.
+	GetPublicIpByIpAddress(ctx context.Context, request GetPublicIpByIpAddressRequest) (response GetPublicIpByIpAddressResponse, err error)
+
+	// GetPublicIpByPrivateIpId Gets the public IP assigned to the specified private IP. You must specify the OCID
+	// of the private IP. If no public IP is assigned, a 404 is returned.
+	// **Note:** If you're fetching a reserved public IP that is in the process of being
+	// moved to a different private IP, and you provide the OCID of the original private
+	// IP, this operation returns a 404. If you instead provide the OCID of the target
+	// private IP, or if you instead call
+	// GetPublicIp or
+	// GetPublicIpByIpAddress, the
+	// service returns the public IP object with `lifecycleState` = ASSIGNING and
+	// `assignedEntityId` = OCID of the target private IP.
+	GetPublicIpByPrivateIpId(ctx context.Context, request GetPublicIpByPrivateIpIdRequest) (response GetPublicIpByPrivateIpIdResponse, err error)
+
+	// GetPublicIpPool Gets the specified Public Ip Pool object. You must specify the object's OCID.
+	GetPublicIpPool(ctx context.Context, request GetPublicIpPoolRequest) (response GetPublicIpPoolResponse, err error)
+
+	// GetRemotePeeringConnection Get the specified remote peering connection's information.
+	GetRemotePeeringConnection(ctx context.Context, request GetRemotePeeringConnectionRequest) (response GetRemotePeeringConnectionResponse, err error)
+
+	// GetRouteTable Gets the specified route table's information.
+	GetRouteTable(ctx context.Context, request GetRouteTableRequest) (response GetRouteTableResponse, err error)
+
+	// GetSecurityList Gets the specified security list's information.
+	GetSecurityList(ctx context.Context, request GetSecurityListRequest) (response GetSecurityListResponse, err error)
+
+	// GetService Gets the specified Service object.
+	GetService(ctx context.Context, request GetServiceRequest) (response GetServiceResponse, err error)
+
+	// GetServiceGateway Gets the specified service gateway's information.
+	GetServiceGateway(ctx context.Context, request GetServiceGatewayRequest) (response GetServiceGatewayResponse, err error)
+
+	// GetSubnet Gets the specified subnet's information.
+	GetSubnet(ctx context.Context, request GetSubnetRequest) (response GetSubnetResponse, err error)
+
+	// GetTunnelCpeDeviceConfig Gets the set of CPE configuration answers for the tunnel, which the customer provided in
+	// UpdateTunnelCpeDeviceConfig.
+	// To get the full set of content for the tunnel (any answers merged with the template of other
+	// information specific to the CPE device type), use
+	// GetTunnelCpeDeviceConfigContent.
+	GetTunnelCpeDeviceConfig(ctx context.Context, request GetTunnelCpeDeviceConfigRequest) (response GetTunnelCpeDeviceConfigResponse, err error)
+
+	// GetTunnelCpeDeviceConfigContent Renders a set of CPE configuration content for the specified IPSec tunnel. The content helps a
+	// network engineer configure the actual CPE device (for example, a hardware router) that the specified
+	// IPSec tunnel terminates on.
+	// The rendered content is specific to the type of CPE device (for example, Cisco ASA). Therefore the
+	// Cpe used by the specified IPSecConnection
+	// must have the CPE's device type specified by the `cpeDeviceShapeId` attribute. The content
+	// optionally includes answers that the customer provides (see
+	// UpdateTunnelCpeDeviceConfig),
+	// merged with a template of other information specific to the CPE device type.
+	// The operation returns configuration information for only the specified IPSec tunnel.
+	// Here are other similar operations:
+	//   * GetIpsecCpeDeviceConfigContent
+	//   returns CPE configuration content for all tunnels in a single IPSec connection.
+	//   * GetCpeDeviceConfigContent
+	//   returns CPE configuration content for *all* IPSec connections that use a specific CPE.
+	GetTunnelCpeDeviceConfigContent(ctx context.Context, request GetTunnelCpeDeviceConfigContentRequest) (response GetTunnelCpeDeviceConfigContentResponse, err error)
+
+	// GetVcn Gets the specified VCN's information.
+	GetVcn(ctx context.Context, request GetVcnRequest) (response GetVcnResponse, err error)
+
+	// GetVirtualCircuit Gets the specified virtual circuit's information.
+	GetVirtualCircuit(ctx context.Context, request GetVirtualCircuitRequest) (response GetVirtualCircuitResponse, err error)
+
+	// GetVlan Gets the specified VLAN's information.
+	GetVlan(ctx context.Context, request GetVlanRequest) (response GetVlanResponse, err error)
+
+	// GetVnic Gets the information for the specified virtual network interface card (VNIC).
+	// You can get the VNIC OCID from the
+	// ListVnicAttachments
+	// operation.
+	GetVnic(ctx context.Context, request GetVnicRequest) (response GetVnicResponse, err error)
+
+	// ListAllowedPeerRegionsForRemotePeering Lists the regions that support remote VCN peering (which is peering across regions).
+	// For more information, see VCN Peering (https://docs.cloud.oracle.com/Content/Network/Tasks/VCNpeering.htm).
+	ListAllowedPeerRegionsForRemotePeering(ctx context.Context, request ListAllowedPeerRegionsForRemotePeeringRequest) (response ListAllowedPeerRegionsForRemotePeeringResponse, err error)
+
+	// ListByoipAllocatedRanges Lists the ByoipAllocatedRange objects for the ByoipRange.
+	// Each ByoipAllocatedRange object has a CIDR block part of the ByoipRange and the PublicIpPool it is assigned to.
+	ListByoipAllocatedRanges(ctx context.Context, request ListByoipAllocatedRangesRequest) (response ListByoipAllocatedRangesResponse, err error)
+
+	// ListByoipRanges Lists the ByoipRange objects in the specified compartment.
+	// You can filter the list by using query parameters.
+	ListByoipRanges(ctx context.Context, request ListByoipRangesRequest) (response ListByoipRangesResponse, err error)
+
+	// ListCpeDeviceShapes Lists the CPE device types that the Networking service provides CPE configuration
+	// content for (example: Cisco ASA). The content helps a network engineer configure
+	// the actual CPE device represented by a Cpe object.
+	// If you want to generate CPE configuration content for one of the returned CPE device types,
+	// ensure that the Cpe object's `cpeDeviceShapeId` attribute is set
+	// to the CPE device type's OCID (returned by this operation).
+	// For information about generating CPE configuration content, see these operations:
+	//   * GetCpeDeviceConfigContent
+	//   * GetIpsecCpeDeviceConfigContent
+	//   * GetTunnelCpeDeviceConfigContent
+	ListCpeDeviceShapes(ctx context.Context, request ListCpeDeviceShapesRequest) (response ListCpeDeviceShapesResponse, err error)
+
+	// ListCpes Lists the customer-premises equipment objects (CPEs) in the specified compartment.
+	ListCpes(ctx context.Context, request ListCpesRequest) (response ListCpesResponse, err error)
+
+	// ListCrossConnectGroups Lists the cross-connect groups in the specified compartment.
+	ListCrossConnectGroups(ctx context.Context, request ListCrossConnectGroupsRequest) (response ListCrossConnectGroupsResponse, err error)
+
+	// ListCrossConnectLocations Lists the available FastConnect locations for cross-connect installation. You need
+	// this information so you can specify your desired location when you create a cross-connect.
+	ListCrossConnectLocations(ctx context.Context, request ListCrossConnectLocationsRequest) (response ListCrossConnectLocationsResponse, err error)
+
+	// ListCrossConnects Lists the cross-connects in the specified compartment. You can filter the list
+	// by specifying the OCID of a cross-connect group.
+	ListCrossConnects(ctx context.Context, request ListCrossConnectsRequest) (response ListCrossConnectsResponse, err error)
+
+	// ListCrossconnectPortSpeedShapes Lists the available port speeds for cross-connects. You need this information
+	// so you can specify your desired port speed (that is, shape) when you create a
+	// cross-connect.
+	ListCrossconnectPortSpeedShapes(ctx context.Context, request ListCrossconnectPortSpeedShapesRequest) (response ListCrossconnectPortSpeedShapesResponse, err error)
+
+	// ListDhcpOptions Lists the sets of DHCP options in the specified VCN and specified compartment.
+	// If the VCN ID is not provided, then the list includes the sets of DHCP options from all VCNs in the specified compartment.
+	// The response includes the default set of options that automatically comes with each VCN,
+	// plus any other sets you've created.
+	ListDhcpOptions(ctx context.Context, request ListDhcpOptionsRequest) (response ListDhcpOptionsResponse, err error)
+
+	// ListDrgAttachments Lists the `DrgAttachment` objects for the specified compartment. You can filter the
+	// results by VCN or DRG.
+	ListDrgAttachments(ctx context.Context, request ListDrgAttachmentsRequest) (response ListDrgAttachmentsResponse, err error)
+
+	// ListDrgs Lists the DRGs in the specified compartment.
+	ListDrgs(ctx context.Context, request ListDrgsRequest) (response ListDrgsResponse, err error)
+
+	// ListFastConnectProviderServices Lists the service offerings from supported providers. You need this
+	// information so you can specify your desired provider and service
+	// offering when you create a virtual circuit.
+	// For the compartment ID, provide the OCID of your tenancy (the root compartment).
+	// For more information, see FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	ListFastConnectProviderServices(ctx context.Context, request ListFastConnectProviderServicesRequest) (response ListFastConnectProviderServicesResponse, err error)
+
+	// ListFastConnectProviderVirtualCircuitBandwidthShapes Gets the list of available virtual circuit bandwidth levels for a provider.
+	// You need this information so you can specify your desired bandwidth level (shape) when you create a virtual circuit.
+	// For more information about virtual circuits, see FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx context.Context, request ListFastConnectProviderVirtualCircuitBandwidthShapesRequest) (response ListFastConnectProviderVirtualCircuitBandwidthShapesResponse, err error)
+
+	// ListIPSecConnectionTunnels Lists the tunnel information for the specified IPSec connection.
+	ListIPSecConnectionTunnels(ctx context.Context, request ListIPSecConnectionTunnelsRequest) (response ListIPSecConnectionTunnelsResponse, err error)
+
+	// ListIPSecConnections Lists the IPSec connections for the specified compartment. You can filter the
+	// results by DRG or CPE.
+	ListIPSecConnections(ctx context.Context, request ListIPSecConnectionsRequest) (response ListIPSecConnectionsResponse, err error)
+
+	// ListInternetGateways Lists the internet gateways in the specified VCN and the specified compartment.
+	// If the VCN ID is not provided, then the list includes the internet gateways from all VCNs in the specified compartment.
+	ListInternetGateways(ctx context.Context, request ListInternetGatewaysRequest) (response ListInternetGatewaysResponse, err error)
+
+	// ListIpv6s Lists the Ipv6 objects based
+	// on one of these filters:
+	//   * Subnet OCID.
+	//   * VNIC OCID.
+	//   * Both IPv6 address and subnet OCID: This lets you get an `Ipv6` object based on its private
+	//   IPv6 address (for example, 2001:0db8:0123:1111:abcd:ef01:2345:6789) and not its OCID. For comparison,
+	//   GetIpv6 requires the OCID.
+	ListIpv6s(ctx context.Context, request ListIpv6sRequest) (response ListIpv6sResponse, err error)
+
+	// ListLocalPeeringGateways Lists the local peering gateways (LPGs) for the specified VCN and specified compartment.
+	// If the VCN ID is not provided, then the list includes the LPGs from all VCNs in the specified compartment.
+	ListLocalPeeringGateways(ctx context.Context, request ListLocalPeeringGatewaysRequest) (response ListLocalPeeringGatewaysResponse, err error)
+
+	// ListNatGateways Lists the NAT gateways in the specified compartment. You may optionally specify a VCN OCID
+	// to filter the results by VCN.
+	ListNatGateways(ctx context.Context, request ListNatGatewaysRequest) (response ListNatGatewaysResponse, err error)
+
+	// ListNetworkSecurityGroupSecurityRules Lists the security rules in the specified network security group.
+	ListNetworkSecurityGroupSecurityRules(ctx context.Context, request ListNetworkSecurityGroupSecurityRulesRequest) (response ListNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	// ListNetworkSecurityGroupVnics Lists the VNICs in the specified network security group.
+	ListNetworkSecurityGroupVnics(ctx context.Context, request ListNetworkSecurityGroupVnicsRequest) (response ListNetworkSecurityGroupVnicsResponse, err error)
+
+	// ListNetworkSecurityGroups Lists the network security groups in the specified compartment.
+	ListNetworkSecurityGroups(ctx context.Context, request ListNetworkSecurityGroupsRequest) (response ListNetworkSecurityGroupsResponse, err error)
+
+	// ListPrivateIps Lists the PrivateIp objects based
+	// on one of these filters:
+	//   - Subnet OCID.
+	//   - VNIC OCID.
+	//   - Both private IP address and subnet OCID: This lets
+	//   you get a `privateIP` object based on its private IP
+	//   address (for example, 10.0.3.3) and not its OCID. For comparison,
+	//   GetPrivateIp
+	//   requires the OCID.
+	// If you're listing all the private IPs associated with a given subnet
+	// or VNIC, the response includes both primary and secondary private IPs.
+	// If you are an Oracle Cloud VMware Solution customer and have VLANs
+	// in your VCN, you can filter the list by VLAN OCID. See Vlan.
+	ListPrivateIps(ctx context.Context, request ListPrivateIpsRequest) (response ListPrivateIpsResponse, err error)
+
+	// ListPublicIpPools Lists the PublicIpPool objects in the specified compartment.
+	// You can filter the list by using query parameters.
+	ListPublicIpPools(ctx context.Context, request ListPublicIpPoolsRequest) (response ListPublicIpPoolsResponse, err error)
+
+	// ListPublicIps Lists the PublicIp objects
+	// in the specified compartment. You can filter the list by using query parameters.
+	// To list your reserved public IPs:
+	//   * Set `scope` = `REGION`  (required)
+	//   * Leave the `availabilityDomain` parameter empty
+	//   * Set `lifetime` = `RESERVED`
+	// To list the ephemeral public IPs assigned to a regional entity such as a NAT gateway:
+	//   * Set `scope` = `REGION`  (required)
+	//   * Leave the `availabilityDomain` parameter empty
+	//   * Set `lifetime` = `EPHEMERAL`
+	// To list the ephemeral public IPs assigned to private IPs:
+	//   * Set `scope` = `AVAILABILITY_DOMAIN` (required)
+	//   * Set the `availabilityDomain` parameter to the desired availability domain (required)
+	//   * Set `lifetime` = `EPHEMERAL`
+	// **Note:** An ephemeral public IP assigned to a private IP
+	// is always in the same availability domain and compartment as the private IP.
+	ListPublicIps(ctx context.Context, request ListPublicIpsRequest) (response ListPublicIpsResponse, err error)
+
+	// ListRemotePeeringConnections Lists the remote peering connections (RPCs) for the specified DRG and compartment
+	// (the RPC's compartment).
+	ListRemotePeeringConnections(ctx context.Context, request ListRemotePeeringConnectionsRequest) (response ListRemotePeeringConnectionsResponse, err error)
+
+	// ListRouteTables Lists the route tables in the specified VCN and specified compartment.
+	// If the VCN ID is not provided, then the list includes the route tables from all VCNs in the specified compartment.
+	// The response includes the default route table that automatically comes with
+	// each VCN in the specified compartment, plus any route tables you've created.
+	ListRouteTables(ctx context.Context, request ListRouteTablesRequest) (response ListRouteTablesResponse, err error)
+
+	// ListSecurityLists Lists the security lists in the specified VCN and compartment.
+	// If the VCN ID is not provided, then the list includes the security lists from all VCNs in the specified compartment.
+	ListSecurityLists(ctx context.Context, request ListSecurityListsRequest) (response ListSecurityListsResponse, err error)
+
+	// ListServiceGateways Lists the service gateways in the specified compartment. You may optionally specify a VCN OCID
+	// to filter the results by VCN.
+	ListServiceGateways(ctx context.Context, request ListServiceGatewaysRequest) (response ListServiceGatewaysResponse, err error)
+
+	// ListServices Lists the available Service objects that you can enable for a
+	// service gateway in this region.
+	ListServices(ctx context.Context, request ListServicesRequest) (response ListServicesResponse, err error)
+
+	// ListSubnets Lists the subnets in the specified VCN and the specified compartment.
+	// If the VCN ID is not provided, then the list includes the subnets from all VCNs in the specified compartment.
+	ListSubnets(ctx context.Context, request ListSubnetsRequest) (response ListSubnetsResponse, err error)
+
+	// ListVcns Lists the virtual cloud networks (VCNs) in the specified compartment.
+	ListVcns(ctx context.Context, request ListVcnsRequest) (response ListVcnsResponse, err error)
+
+	// ListVirtualCircuitBandwidthShapes The deprecated operation lists available bandwidth levels for virtual circuits. For the compartment ID, provide the OCID of your tenancy (the root compartment).
+	ListVirtualCircuitBandwidthShapes(ctx context.Context, request ListVirtualCircuitBandwidthShapesRequest) (response ListVirtualCircuitBandwidthShapesResponse, err error)
+
+	// ListVirtualCircuitPublicPrefixes Lists the public IP prefixes and their details for the specified
+	// public virtual circuit.
+	ListVirtualCircuitPublicPrefixes(ctx context.Context, request ListVirtualCircuitPublicPrefixesRequest) (response ListVirtualCircuitPublicPrefixesResponse, err error)
+
+	// ListVirtualCircuits Lists the virtual circuits in the specified compartment.
+	ListVirtualCircuits(ctx context.Context, request ListVirtualCircuitsRequest) (response ListVirtualCircuitsResponse, err error)
+
+	// ListVlans Lists the VLANs in the specified VCN and the specified compartment.
+	ListVlans(ctx context.Context, request ListVlansRequest) (response ListVlansResponse, err error)
+
+	// RemoveNetworkSecurityGroupSecurityRules Removes one or more security rules from the specified network security group.
+	RemoveNetworkSecurityGroupSecurityRules(ctx context.Context, request RemoveNetworkSecurityGroupSecurityRulesRequest) (response RemoveNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	// RemovePublicIpPoolCapacity Removes a Cidr from the referenced Public IP Pool.
+	RemovePublicIpPoolCapacity(ctx context.Context, request RemovePublicIpPoolCapacityRequest) (response RemovePublicIpPoolCapacityResponse, err error)
+
+	// UpdateByoipRange Updates the specified Byoip Range.
+	UpdateByoipRange(ctx context.Context, request UpdateByoipRangeRequest) (response UpdateByoipRangeResponse, err error)
+
+	// UpdateCpe Updates the specified CPE's display name or tags.
+	// Avoid entering confidential information.
+	UpdateCpe(ctx context.Context, request UpdateCpeRequest) (response UpdateCpeResponse, err error)
+
+	// UpdateCrossConnect Updates the specified cross-connect.
+	UpdateCrossConnect(ctx context.Context, request UpdateCrossConnectRequest) (response UpdateCrossConnectResponse, err error)
+
+	// UpdateCrossConnectGroup Updates the specified cross-connect group's display name.
+	// Avoid entering confidential information.
+	UpdateCrossConnectGroup(ctx context.Context, request UpdateCrossConnectGroupRequest) (response UpdateCrossConnectGroupResponse, err error)
+
+	// UpdateDhcpOptions Updates the specified set of DHCP options. You can update the display name or the options
+	// themselves. Avoid entering confidential information.
+	// Note that the `options` object you provide replaces the entire existing set of options.
+	UpdateDhcpOptions(ctx context.Context, request UpdateDhcpOptionsRequest) (response UpdateDhcpOptionsResponse, err error)
+
+	// UpdateDrg Updates the specified DRG's display name or tags. Avoid entering confidential information.
+	UpdateDrg(ctx context.Context, request UpdateDrgRequest) (response UpdateDrgResponse, err error)
+
+	// UpdateDrgAttachment Updates the display name for the specified `DrgAttachment`.
+	// Avoid entering confidential information.
+	UpdateDrgAttachment(ctx context.Context, request UpdateDrgAttachmentRequest) (response UpdateDrgAttachmentResponse, err error)
+
+	// UpdateIPSecConnection Updates the specified IPSec connection.
+	// To update an individual IPSec tunnel's attributes, use
+	// UpdateIPSecConnectionTunnel.
+	UpdateIPSecConnection(ctx context.Context, request UpdateIPSecConnectionRequest) (response UpdateIPSecConnectionResponse, err error)
+
+	// UpdateIPSecConnectionTunnel Updates the specified tunnel. This operation lets you change tunnel attributes such as the
+	// routing type (BGP dynamic routing or static routing). Here are some important notes:
+	//   * If you change the tunnel's routing type or BGP session configuration, the tunnel will go
+	//     down while it's reprovisioned.
+	//   * If you want to switch the tunnel's `routing` from `STATIC` to `BGP`, make sure the tunnel's
+	//     BGP session configuration attributes have been set (BgpSessionInfo).
+	//   * If you want to switch the tunnel's `routing` from `BGP` to `STATIC`, make sure the
+	//     IPSecConnection already has at least one valid CIDR
+	//     static route.
+	UpdateIPSecConnectionTunnel(ctx context.Context, request UpdateIPSecConnectionTunnelRequest) (response UpdateIPSecConnectionTunnelResponse, err error)
+
+	// UpdateIPSecConnectionTunnelSharedSecret Updates the shared secret (pre-shared key) for the specified tunnel.
+	// **Important:** If you change the shared secret, the tunnel will go down while it's reprovisioned.
+	UpdateIPSecConnectionTunnelSharedSecret(ctx context.Context, request UpdateIPSecConnectionTunnelSharedSecretRequest) (response UpdateIPSecConnectionTunnelSharedSecretResponse, err error)
+
+	// UpdateInternetGateway Updates the specified internet gateway. You can disable/enable it, or change its display name
+	// or tags. Avoid entering confidential information.
+	// If the gateway is disabled, that means no traffic will flow to/from the internet even if there's
+	// a route rule that enables that traffic.
+	UpdateInternetGateway(ctx context.Context, request UpdateInternetGatewayRequest) (response UpdateInternetGatewayResponse, err error)
+
+	// UpdateIpv6 Updates the specified IPv6. You must specify the object's OCID.
+	// Use this operation if you want to:
+	//   * Move an IPv6 to a different VNIC in the same subnet.
+	//   * Enable/disable internet access for an IPv6.
+	//   * Change the display name for an IPv6.
+	//   * Update resource tags for an IPv6.
+	UpdateIpv6(ctx context.Context, request UpdateIpv6Request) (response UpdateIpv6Response, err error)
+
+	// UpdateLocalPeeringGateway Updates the specified local peering gateway (LPG).
+	UpdateLocalPeeringGateway(ctx context.Context, request UpdateLocalPeeringGatewayRequest) (response UpdateLocalPeeringGatewayResponse, err error)
+
+	// UpdateNatGateway Updates the specified NAT gateway.
+	UpdateNatGateway(ctx context.Context, request UpdateNatGatewayRequest) (response UpdateNatGatewayResponse, err error)
+
+	// UpdateNetworkSecurityGroup Updates the specified network security group.
+	// To add or remove an existing VNIC from the group, use
+	// UpdateVnic.
+	// To add a VNIC to the group *when you create the VNIC*, specify the NSG's OCID during creation.
+	// For example, see the `nsgIds` attribute in CreateVnicDetails.
+	// To add or remove security rules from the group, use
+	// AddNetworkSecurityGroupSecurityRules
+	// or
+	// RemoveNetworkSecurityGroupSecurityRules.
+	// To edit the contents of existing security rules in the group, use
+	// UpdateNetworkSecurityGroupSecurityRules.
+	UpdateNetworkSecurityGroup(ctx context.Context, request UpdateNetworkSecurityGroupRequest) (response UpdateNetworkSecurityGroupResponse, err error)
+
+	// UpdateNetworkSecurityGroupSecurityRules Updates one or more security rules in the specified network security group.
+	UpdateNetworkSecurityGroupSecurityRules(ctx context.Context, request UpdateNetworkSecurityGroupSecurityRulesRequest) (response UpdateNetworkSecurityGroupSecurityRulesResponse, err error)
+
+	// UpdatePrivateIp Updates the specified private IP. You must specify the object's OCID.
+	// Use this operation if you want to:
+	//   - Move a secondary private IP to a different VNIC in the same subnet.
+	//   - Change the display name for a secondary private IP.
+	//   - Change the hostname for a secondary private IP.
+	// This operation cannot be used with primary private IPs.
+	// To update the hostname for the primary IP on a VNIC, use
+	// UpdateVnic.
+	UpdatePrivateIp(ctx context.Context, request UpdatePrivateIpRequest) (response UpdatePrivateIpResponse, err error)
+
+	// UpdatePublicIp Updates the specified public IP. You must specify the object's OCID. Use this operation if you want to:
+	// * Assign a reserved public IP in your pool to a private IP.
+	// * Move a reserved public IP to a different private IP.
+	// * Unassign a reserved public IP from a private IP (which returns it to your pool
+	// of reserved public IPs).
+	// * Change the display name or tags for a public IP.
+	// Assigning, moving, and unassigning a reserved public IP are asynchronous
+	// operations. Poll the public IP's `lifecycleState` to determine if the operation
+	// succeeded.
+	// **Note:** When moving a reserved public IP, the target private IP
+	// must not already have a public IP with `lifecycleState` = ASSIGNING or ASSIGNED. If it
+	// does, an error is returned. Also, the initial unassignment from the original
+	// private IP always succeeds, but the assignment to the target private IP is asynchronous and
+	// could fail silently (for example, if the target private IP is deleted or has a different public IP
+	// assigned to it in the interim). If that occurs, the public IP remains unassigned and its
+	// `lifecycleState` switches to AVAILABLE (it is not reassigned to its original private IP).
+	// You must poll the public IP's `lifecycleState` to determine if the move succeeded.
+	// Regarding ephemeral public IPs:
+	// * If you want to assign an ephemeral public IP to a primary private IP, use
+	// CreatePublicIp.
+	// * You can't move an ephemeral public IP to a different private IP.
+	// * If you want to unassign an ephemeral public IP from its private IP, use
+	// DeletePublicIp, which
+	// unassigns and deletes the ephemeral public IP.
+	// **Note:** If a public IP is assigned to a secondary private
+	// IP (see PrivateIp), and you move that secondary
+	// private IP to another VNIC, the public IP moves with it.
+	// **Note:** There's a limit to the number of PublicIp
+	// a VNIC or instance can have. If you try to move a reserved public IP
+	// to a VNIC or instance that has already reached its public IP limit, an error is
+	// returned. For information about the public IP limits, see
+	// Public IP Addresses (https://docs.cloud.oracle.com/Content/Network/Tasks/managingpublicIPs.htm).
+	UpdatePublicIp(ctx context.Context, request UpdatePublicIpRequest) (response UpdatePublicIpResponse, err error)
+
+	// UpdatePublicIpPool Updates the specified Public Ip Pool.
+	UpdatePublicIpPool(ctx context.Context, request UpdatePublicIpPoolRequest) (response UpdatePublicIpPoolResponse, err error)
+
+	// UpdateRemotePeeringConnection Updates the specified remote peering connection (RPC).
+	UpdateRemotePeeringConnection(ctx context.Context, request UpdateRemotePeeringConnectionRequest) (response UpdateRemotePeeringConnectionResponse, err error)
+
+	// UpdateRouteTable Updates the specified route table's display name or route rules.
+	// Avoid entering confidential information.
+	// Note that the `routeRules` object you provide replaces the entire existing set of rules.
+	UpdateRouteTable(ctx context.Context, request UpdateRouteTableRequest) (response UpdateRouteTableResponse, err error)
+
+	// UpdateSecurityList Updates the specified security list's display name or rules.
+	// Avoid entering confidential information.
+	// Note that the `egressSecurityRules` or `ingressSecurityRules` objects you provide replace the entire
+	// existing objects.
+	UpdateSecurityList(ctx context.Context, request UpdateSecurityListRequest) (response UpdateSecurityListResponse, err error)
+
+	// UpdateServiceGateway Updates the specified service gateway. The information you provide overwrites the existing
+	// attributes of the gateway.
+	UpdateServiceGateway(ctx context.Context, request UpdateServiceGatewayRequest) (response UpdateServiceGatewayResponse, err error)
+
+	// UpdateSubnet Updates the specified subnet.
+	UpdateSubnet(ctx context.Context, request UpdateSubnetRequest) (response UpdateSubnetResponse, err error)
+
+	// UpdateTunnelCpeDeviceConfig Creates or updates the set of CPE configuration answers for the specified tunnel.
+	// The answers correlate to the questions that are specific to the CPE device type (see the
+	// `parameters` attribute of CpeDeviceShapeDetail).
+	UpdateTunnelCpeDeviceConfig(ctx context.Context, request UpdateTunnelCpeDeviceConfigRequest) (response UpdateTunnelCpeDeviceConfigResponse, err error)
+
+	// UpdateVcn Updates the specified VCN.
+	UpdateVcn(ctx context.Context, request UpdateVcnRequest) (response UpdateVcnResponse, err error)
+
+	// UpdateVirtualCircuit Updates the specified virtual circuit. This can be called by
+	// either the customer who owns the virtual circuit, or the
+	// provider (when provisioning or de-provisioning the virtual
+	// circuit from their end). The documentation for
+	// UpdateVirtualCircuitDetails
+	// indicates who can update each property of the virtual circuit.
+	// **Important:** If the virtual circuit is working and in the
+	// PROVISIONED state, updating any of the network-related properties
+	// (such as the DRG being used, the BGP ASN, and so on) will cause the virtual
+	// circuit's state to switch to PROVISIONING and the related BGP
+	// session to go down. After Oracle re-provisions the virtual circuit,
+	// its state will return to PROVISIONED. Make sure you confirm that
+	// the associated BGP session is back up. For more information
+	// about the various states and how to test connectivity, see
+	// FastConnect Overview (https://docs.cloud.oracle.com/Content/Network/Concepts/fastconnect.htm).
+	// To change the list of public IP prefixes for a public virtual circuit,
+	// use BulkAddVirtualCircuitPublicPrefixes
+	// and
+	// BulkDeleteVirtualCircuitPublicPrefixes.
+	// Updating the list of prefixes does NOT cause the BGP session to go down. However,
+	// Oracle must verify the customer's ownership of each added prefix before
+	// traffic for that prefix will flow across the virtual circuit.
+	UpdateVirtualCircuit(ctx context.Context, request UpdateVirtualCircuitRequest) (response UpdateVirtualCircuitResponse, err error)
+
+	// UpdateVlan Updates the specified VLAN. This could result in changes to all
+	// the VNICs in the VLAN, which can take time. During that transition
+	// period, the VLAN will be in the UPDATING state.
+	UpdateVlan(ctx context.Context, request UpdateVlanRequest) (response UpdateVlanResponse, err error)
+
+	// UpdateVnic Updates the specified VNIC.
+	UpdateVnic(ctx context.Context, request UpdateVnicRequest) (response UpdateVnicResponse, err error)
+
+	// ValidateByoipRange submit the Byoip Range for validation. This presumes the user has
+	// updated their IP registry record in accordance to validation requirements
+	ValidateByoipRange(ctx context.Context, request ValidateByoipRangeRequest) (response ValidateByoipRangeResponse, err error)
+
+	// WithdrawByoipRange stop route advertisements for the Byoip Range prefix.
+	WithdrawByoipRange(ctx context.Context, request WithdrawByoipRangeRequest) (response WithdrawByoipRangeResponse, err error)
+}
+
+// VirtualNetworkClient implements VirtualNetworkClientAPI
+var _ VirtualNetworkClientAPI = VirtualNetworkClient{}
@@ -102,10 +102,12 @@ func (client DatabaseClient) ActivateExadataInfrastructure(ctx context.Context,
 				response = ActivateExadataInfrastructureResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ActivateExadataInfrastructureResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ActivateExadataInfrastructureResponse")
 	}
@@ -157,10 +159,12 @@ func (client DatabaseClient) AutonomousDatabaseManualRefresh(ctx context.Context
 				response = AutonomousDatabaseManualRefreshResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AutonomousDatabaseManualRefreshResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AutonomousDatabaseManualRefreshResponse")
 	}
@@ -214,10 +218,12 @@ func (client DatabaseClient) ChangeAutonomousContainerDatabaseCompartment(ctx co
 				response = ChangeAutonomousContainerDatabaseCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAutonomousContainerDatabaseCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAutonomousContainerDatabaseCompartmentResponse")
 	}
@@ -271,10 +277,12 @@ func (client DatabaseClient) ChangeAutonomousDatabaseCompartment(ctx context.Con
 				response = ChangeAutonomousDatabaseCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAutonomousDatabaseCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAutonomousDatabaseCompartmentResponse")
 	}
@@ -328,10 +336,12 @@ func (client DatabaseClient) ChangeAutonomousExadataInfrastructureCompartment(ct
 				response = ChangeAutonomousExadataInfrastructureCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAutonomousExadataInfrastructureCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAutonomousExadataInfrastructureCompartmentResponse")
 	}
@@ -384,10 +394,12 @@ func (client DatabaseClient) ChangeAutonomousVmClusterCompartment(ctx context.Co
 				response = ChangeAutonomousVmClusterCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAutonomousVmClusterCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAutonomousVmClusterCompartmentResponse")
 	}
@@ -441,10 +453,12 @@ func (client DatabaseClient) ChangeBackupDestinationCompartment(ctx context.Cont
 				response = ChangeBackupDestinationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeBackupDestinationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeBackupDestinationCompartmentResponse")
 	}
@@ -497,10 +511,12 @@ func (client DatabaseClient) ChangeCloudExadataInfrastructureCompartment(ctx con
 				response = ChangeCloudExadataInfrastructureCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCloudExadataInfrastructureCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCloudExadataInfrastructureCompartmentResponse")
 	}
@@ -553,10 +569,12 @@ func (client DatabaseClient) ChangeCloudVmClusterCompartment(ctx context.Context
 				response = ChangeCloudVmClusterCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCloudVmClusterCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCloudVmClusterCompartmentResponse")
 	}
@@ -610,10 +628,12 @@ func (client DatabaseClient) ChangeDatabaseSoftwareImageCompartment(ctx context.
 				response = ChangeDatabaseSoftwareImageCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDatabaseSoftwareImageCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDatabaseSoftwareImageCompartmentResponse")
 	}
@@ -667,10 +687,12 @@ func (client DatabaseClient) ChangeDbSystemCompartment(ctx context.Context, requ
 				response = ChangeDbSystemCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeDbSystemCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeDbSystemCompartmentResponse")
 	}
@@ -723,10 +745,12 @@ func (client DatabaseClient) ChangeExadataInfrastructureCompartment(ctx context.
 				response = ChangeExadataInfrastructureCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeExadataInfrastructureCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeExadataInfrastructureCompartmentResponse")
 	}
@@ -779,10 +803,12 @@ func (client DatabaseClient) ChangeVmClusterCompartment(ctx context.Context, req
 				response = ChangeVmClusterCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeVmClusterCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeVmClusterCompartmentResponse")
 	}
@@ -835,10 +861,12 @@ func (client DatabaseClient) CompleteExternalBackupJob(ctx context.Context, requ
 				response = CompleteExternalBackupJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CompleteExternalBackupJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CompleteExternalBackupJobResponse")
 	}
@@ -890,10 +918,12 @@ func (client DatabaseClient) CreateAutonomousContainerDatabase(ctx context.Conte
 				response = CreateAutonomousContainerDatabaseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousContainerDatabaseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousContainerDatabaseResponse")
 	}
@@ -945,10 +975,12 @@ func (client DatabaseClient) CreateAutonomousDataWarehouse(ctx context.Context,
 				response = CreateAutonomousDataWarehouseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousDataWarehouseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousDataWarehouseResponse")
 	}
@@ -1000,10 +1032,12 @@ func (client DatabaseClient) CreateAutonomousDataWarehouseBackup(ctx context.Con
 				response = CreateAutonomousDataWarehouseBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousDataWarehouseBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousDataWarehouseBackupResponse")
 	}
@@ -1055,10 +1089,12 @@ func (client DatabaseClient) CreateAutonomousDatabase(ctx context.Context, reque
 				response = CreateAutonomousDatabaseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousDatabaseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousDatabaseResponse")
 	}
@@ -1110,10 +1146,12 @@ func (client DatabaseClient) CreateAutonomousDatabaseBackup(ctx context.Context,
 				response = CreateAutonomousDatabaseBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousDatabaseBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousDatabaseBackupResponse")
 	}
@@ -1165,10 +1203,12 @@ func (client DatabaseClient) CreateAutonomousVmCluster(ctx context.Context, requ
 				response = CreateAutonomousVmClusterResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAutonomousVmClusterResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAutonomousVmClusterResponse")
 	}
@@ -1220,10 +1260,12 @@ func (client DatabaseClient) CreateBackup(ctx context.Context, request CreateBac
 				response = CreateBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBackupResponse")
 	}
@@ -1275,10 +1317,12 @@ func (client DatabaseClient) CreateBackupDestination(ctx context.Context, reques
 				response = CreateBackupDestinationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBackupDestinationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBackupDestinationResponse")
 	}
@@ -1330,10 +1374,12 @@ func (client DatabaseClient) CreateCloudExadataInfrastructure(ctx context.Contex
 				response = CreateCloudExadataInfrastructureResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCloudExadataInfrastructureResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCloudExadataInfrastructureResponse")
 	}
@@ -1385,10 +1431,12 @@ func (client DatabaseClient) CreateCloudVmCluster(ctx context.Context, request C
 				response = CreateCloudVmClusterResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCloudVmClusterResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCloudVmClusterResponse")
 	}
@@ -1442,10 +1490,12 @@ func (client DatabaseClient) CreateConsoleConnection(ctx context.Context, reques
 				response = CreateConsoleConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConsoleConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConsoleConnectionResponse")
 	}
@@ -1503,10 +1553,12 @@ func (client DatabaseClient) CreateDataGuardAssociation(ctx context.Context, req
 				response = CreateDataGuardAssociationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataGuardAssociationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataGuardAssociationResponse")
 	}
@@ -1558,10 +1610,12 @@ func (client DatabaseClient) CreateDatabase(ctx context.Context, request CreateD
 				response = CreateDatabaseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDatabaseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDatabaseResponse")
 	}
@@ -1613,10 +1667,12 @@ func (client DatabaseClient) CreateDatabaseSoftwareImage(ctx context.Context, re
 				response = CreateDatabaseSoftwareImageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDatabaseSoftwareImageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDatabaseSoftwareImageResponse")
 	}
@@ -1668,10 +1724,12 @@ func (client DatabaseClient) CreateDbHome(ctx context.Context, request CreateDbH
 				response = CreateDbHomeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDbHomeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDbHomeResponse")
 	}
@@ -1723,10 +1781,12 @@ func (client DatabaseClient) CreateExadataInfrastructure(ctx context.Context, re
 				response = CreateExadataInfrastructureResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateExadataInfrastructureResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateExadataInfrastructureResponse")
 	}
@@ -1779,10 +1839,12 @@ func (client DatabaseClient) CreateExternalBackupJob(ctx context.Context, reques
 				response = CreateExternalBackupJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateExternalBackupJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateExternalBackupJobResponse")
 	}
@@ -1834,10 +1896,12 @@ func (client DatabaseClient) CreateVmCluster(ctx context.Context, request Create
 				response = CreateVmClusterResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVmClusterResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVmClusterResponse")
 	}
@@ -1889,10 +1953,12 @@ func (client DatabaseClient) CreateVmClusterNetwork(ctx context.Context, request
 				response = CreateVmClusterNetworkResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVmClusterNetworkResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVmClusterNetworkResponse")
 	}
@@ -1955,10 +2021,12 @@ func (client DatabaseClient) DbNodeAction(ctx context.Context, request DbNodeAct
 				response = DbNodeActionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DbNodeActionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DbNodeActionResponse")
 	}
@@ -2812,10 +2880,12 @@ func (client DatabaseClient) DownloadExadataInfrastructureConfigFile(ctx context
 				response = DownloadExadataInfrastructureConfigFileResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DownloadExadataInfrastructureConfigFileResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DownloadExadataInfrastructureConfigFileResponse")
 	}
@@ -2866,10 +2936,12 @@ func (client DatabaseClient) DownloadVmClusterNetworkConfigFile(ctx context.Cont
 				response = DownloadVmClusterNetworkConfigFileResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DownloadVmClusterNetworkConfigFileResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DownloadVmClusterNetworkConfigFileResponse")
 	}
@@ -2970,10 +3042,12 @@ func (client DatabaseClient) FailOverAutonomousDatabase(ctx context.Context, req
 				response = FailOverAutonomousDatabaseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(FailOverAutonomousDatabaseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into FailOverAutonomousDatabaseResponse")
 	}
@@ -3129,10 +3203,12 @@ func (client DatabaseClient) GenerateAutonomousDataWarehouseWallet(ctx context.C
 				response = GenerateAutonomousDataWarehouseWalletResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GenerateAutonomousDataWarehouseWalletResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GenerateAutonomousDataWarehouseWalletResponse")
 	}
@@ -3183,10 +3259,12 @@ func (client DatabaseClient) GenerateAutonomousDatabaseWallet(ctx context.Contex
 				response = GenerateAutonomousDatabaseWalletResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GenerateAutonomousDatabaseWalletResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GenerateAutonomousDatabaseWalletResponse")
 	}
@@ -3237,10 +3315,12 @@ func (client DatabaseClient) GenerateRecommendedVmClusterNetwork(ctx context.Con
 				response = GenerateRecommendedVmClusterNetworkResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GenerateRecommendedVmClusterNetworkResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GenerateRecommendedVmClusterNetworkResponse")
 	}
@@ -5245,10 +5325,12 @@ func (client DatabaseClient) LaunchAutonomousExadataInfrastructure(ctx context.C
 				response = LaunchAutonomousExadataInfrastructureResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(LaunchAutonomousExadataInfrastructureResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into LaunchAutonomousExadataInfrastructureResponse")
 	}
@@ -5305,10 +5387,12 @@ func (client DatabaseClient) LaunchDbSystem(ctx context.Context, request LaunchD
 				response = LaunchDbSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(LaunchDbSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into LaunchDbSystemResponse")
 	}
@@ -7361,10 +7445,12 @@ func (client DatabaseClient) MigrateExadataDbSystemResourceModel(ctx context.Con
 				response = MigrateExadataDbSystemResourceModelResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(MigrateExadataDbSystemResourceModelResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into MigrateExadataDbSystemResourceModelResponse")
 	}
@@ -7816,10 +7902,12 @@ func (client DatabaseClient) RotateAutonomousContainerDatabaseEncryptionKey(ctx
 				response = RotateAutonomousContainerDatabaseEncryptionKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RotateAutonomousContainerDatabaseEncryptionKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RotateAutonomousContainerDatabaseEncryptionKeyResponse")
 	}
@@ -7871,10 +7959,12 @@ func (client DatabaseClient) RotateAutonomousDatabaseEncryptionKey(ctx context.C
 				response = RotateAutonomousDatabaseEncryptionKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RotateAutonomousDatabaseEncryptionKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RotateAutonomousDatabaseEncryptionKeyResponse")
 	}
@@ -8177,10 +8267,12 @@ func (client DatabaseClient) SwitchoverAutonomousDatabase(ctx context.Context, r
 				response = SwitchoverAutonomousDatabaseResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(SwitchoverAutonomousDatabaseResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into SwitchoverAutonomousDatabaseResponse")
 	}
@@ -9436,10 +9528,12 @@ func (client DatabaseClient) ValidateVmClusterNetwork(ctx context.Context, reque
 				response = ValidateVmClusterNetworkResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidateVmClusterNetworkResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidateVmClusterNetworkResponse")
 	}
@@ -0,0 +1,139 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package database
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*CreateAutonomousDatabaseBase)(nil), func() common.PolymorphicJSONUnmarshaler { return &createautonomousdatabasebase{} })
+	common.RegisterPolymorphicModel((*CreateBackupDestinationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createbackupdestinationdetails{} })
+	common.RegisterPolymorphicModel((*CreateDataGuardAssociationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createdataguardassociationdetails{} })
+	common.RegisterPolymorphicModel((*CreateDatabaseBase)(nil), func() common.PolymorphicJSONUnmarshaler { return &createdatabasebase{} })
+	common.RegisterPolymorphicModel((*CreateDbHomeBase)(nil), func() common.PolymorphicJSONUnmarshaler { return &createdbhomebase{} })
+	common.RegisterPolymorphicModel((*LaunchDbSystemBase)(nil), func() common.PolymorphicJSONUnmarshaler { return &launchdbsystembase{} })
+	common.RegisterPolymorphicModel((*MountTypeDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &mounttypedetails{} })
+}
+
+// MarshalJSON marshals a CreateAutonomousDatabaseBase whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createautonomousdatabasebase) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateautonomousdatabasebase createautonomousdatabasebase
+	s := struct {
+		MarshalTypecreateautonomousdatabasebase
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateautonomousdatabasebase: (MarshalTypecreateautonomousdatabasebase)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateBackupDestinationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createbackupdestinationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatebackupdestinationdetails createbackupdestinationdetails
+	s := struct {
+		MarshalTypecreatebackupdestinationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatebackupdestinationdetails: (MarshalTypecreatebackupdestinationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateDataGuardAssociationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createdataguardassociationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatedataguardassociationdetails createdataguardassociationdetails
+	s := struct {
+		MarshalTypecreatedataguardassociationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatedataguardassociationdetails: (MarshalTypecreatedataguardassociationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateDatabaseBase whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createdatabasebase) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatedatabasebase createdatabasebase
+	s := struct {
+		MarshalTypecreatedatabasebase
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatedatabasebase: (MarshalTypecreatedatabasebase)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateDbHomeBase whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createdbhomebase) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatedbhomebase createdbhomebase
+	s := struct {
+		MarshalTypecreatedbhomebase
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatedbhomebase: (MarshalTypecreatedbhomebase)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a LaunchDbSystemBase whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m launchdbsystembase) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypelaunchdbsystembase launchdbsystembase
+	s := struct {
+		MarshalTypelaunchdbsystembase
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypelaunchdbsystembase: (MarshalTypelaunchdbsystembase)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a MountTypeDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m mounttypedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypemounttypedetails mounttypedetails
+	s := struct {
+		MarshalTypemounttypedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypemounttypedetails: (MarshalTypemounttypedetails)(m),
+	}
+	return json.Marshal(&s)
+}
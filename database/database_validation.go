@@ -0,0 +1,1666 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package database
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetAutonomousContainerDatabaseDataguardAssociationLifecycleStateEnumValues(),
+		GetAutonomousContainerDatabaseDataguardAssociationPeerLifecycleStateEnumValues(),
+		GetAutonomousContainerDatabaseDataguardAssociationPeerRoleEnumValues(),
+		GetAutonomousContainerDatabaseDataguardAssociationProtectionModeEnumValues(),
+		GetAutonomousContainerDatabaseDataguardAssociationRoleEnumValues(),
+		GetAutonomousContainerDatabaseInfrastructureTypeEnumValues(),
+		GetAutonomousContainerDatabaseLifecycleStateEnumValues(),
+		GetAutonomousContainerDatabasePatchModelEnumValues(),
+		GetAutonomousContainerDatabaseRoleEnumValues(),
+		GetAutonomousContainerDatabaseServiceLevelAgreementTypeEnumValues(),
+		GetAutonomousContainerDatabaseSummaryInfrastructureTypeEnumValues(),
+		GetAutonomousContainerDatabaseSummaryLifecycleStateEnumValues(),
+		GetAutonomousContainerDatabaseSummaryPatchModelEnumValues(),
+		GetAutonomousContainerDatabaseSummaryRoleEnumValues(),
+		GetAutonomousContainerDatabaseSummaryServiceLevelAgreementTypeEnumValues(),
+		GetAutonomousDataWarehouseBackupLifecycleStateEnumValues(),
+		GetAutonomousDataWarehouseBackupSummaryLifecycleStateEnumValues(),
+		GetAutonomousDataWarehouseBackupSummaryTypeEnumValues(),
+		GetAutonomousDataWarehouseBackupTypeEnumValues(),
+		GetAutonomousDataWarehouseLicenseModelEnumValues(),
+		GetAutonomousDataWarehouseLifecycleStateEnumValues(),
+		GetAutonomousDataWarehouseSummaryLicenseModelEnumValues(),
+		GetAutonomousDataWarehouseSummaryLifecycleStateEnumValues(),
+		GetAutonomousDatabaseBackupLifecycleStateEnumValues(),
+		GetAutonomousDatabaseBackupSummaryLifecycleStateEnumValues(),
+		GetAutonomousDatabaseBackupSummaryTypeEnumValues(),
+		GetAutonomousDatabaseBackupTypeEnumValues(),
+		GetAutonomousDatabaseDataSafeStatusEnumValues(),
+		GetAutonomousDatabaseDataguardAssociationLifecycleStateEnumValues(),
+		GetAutonomousDatabaseDataguardAssociationPeerAutonomousDatabaseLifeCycleStateEnumValues(),
+		GetAutonomousDatabaseDataguardAssociationPeerRoleEnumValues(),
+		GetAutonomousDatabaseDataguardAssociationProtectionModeEnumValues(),
+		GetAutonomousDatabaseDataguardAssociationRoleEnumValues(),
+		GetAutonomousDatabaseDbWorkloadEnumValues(),
+		GetAutonomousDatabaseInfrastructureTypeEnumValues(),
+		GetAutonomousDatabaseLicenseModelEnumValues(),
+		GetAutonomousDatabaseLifecycleStateEnumValues(),
+		GetAutonomousDatabaseOpenModeEnumValues(),
+		GetAutonomousDatabaseOperationsInsightsStatusEnumValues(),
+		GetAutonomousDatabasePermissionLevelEnumValues(),
+		GetAutonomousDatabaseRefreshableModeEnumValues(),
+		GetAutonomousDatabaseRefreshableStatusEnumValues(),
+		GetAutonomousDatabaseRoleEnumValues(),
+		GetAutonomousDatabaseStandbySummaryLifecycleStateEnumValues(),
+		GetAutonomousDatabaseSummaryDataSafeStatusEnumValues(),
+		GetAutonomousDatabaseSummaryDbWorkloadEnumValues(),
+		GetAutonomousDatabaseSummaryInfrastructureTypeEnumValues(),
+		GetAutonomousDatabaseSummaryLicenseModelEnumValues(),
+		GetAutonomousDatabaseSummaryLifecycleStateEnumValues(),
+		GetAutonomousDatabaseSummaryOpenModeEnumValues(),
+		GetAutonomousDatabaseSummaryOperationsInsightsStatusEnumValues(),
+		GetAutonomousDatabaseSummaryPermissionLevelEnumValues(),
+		GetAutonomousDatabaseSummaryRefreshableModeEnumValues(),
+		GetAutonomousDatabaseSummaryRefreshableStatusEnumValues(),
+		GetAutonomousDatabaseSummaryRoleEnumValues(),
+		GetAutonomousDatabaseWalletLifecycleStateEnumValues(),
+		GetAutonomousDbPreviewVersionSummaryDbWorkloadEnumValues(),
+		GetAutonomousDbVersionSummaryDbWorkloadEnumValues(),
+		GetAutonomousExadataInfrastructureLicenseModelEnumValues(),
+		GetAutonomousExadataInfrastructureLifecycleStateEnumValues(),
+		GetAutonomousExadataInfrastructureSummaryLicenseModelEnumValues(),
+		GetAutonomousExadataInfrastructureSummaryLifecycleStateEnumValues(),
+		GetAutonomousPatchLifecycleStateEnumValues(),
+		GetAutonomousPatchPatchModelEnumValues(),
+		GetAutonomousPatchSummaryLifecycleStateEnumValues(),
+		GetAutonomousPatchSummaryPatchModelEnumValues(),
+		GetAutonomousVmClusterLicenseModelEnumValues(),
+		GetAutonomousVmClusterLifecycleStateEnumValues(),
+		GetAutonomousVmClusterSummaryLicenseModelEnumValues(),
+		GetAutonomousVmClusterSummaryLifecycleStateEnumValues(),
+		GetBackupDatabaseEditionEnumValues(),
+		GetBackupDestinationDetailsTypeEnumValues(),
+		GetBackupDestinationLifecycleStateEnumValues(),
+		GetBackupDestinationNfsMountTypeEnumValues(),
+		GetBackupDestinationSummaryLifecycleStateEnumValues(),
+		GetBackupDestinationSummaryNfsMountTypeEnumValues(),
+		GetBackupDestinationSummaryTypeEnumValues(),
+		GetBackupDestinationTypeEnumValues(),
+		GetBackupLifecycleStateEnumValues(),
+		GetBackupSummaryDatabaseEditionEnumValues(),
+		GetBackupSummaryLifecycleStateEnumValues(),
+		GetBackupSummaryTypeEnumValues(),
+		GetBackupTypeEnumValues(),
+		GetCloudExadataInfrastructureLifecycleStateEnumValues(),
+		GetCloudExadataInfrastructureSummaryLifecycleStateEnumValues(),
+		GetCloudVmClusterDiskRedundancyEnumValues(),
+		GetCloudVmClusterLicenseModelEnumValues(),
+		GetCloudVmClusterLifecycleStateEnumValues(),
+		GetCloudVmClusterSummaryDiskRedundancyEnumValues(),
+		GetCloudVmClusterSummaryLicenseModelEnumValues(),
+		GetCloudVmClusterSummaryLifecycleStateEnumValues(),
+		GetConsoleConnectionLifecycleStateEnumValues(),
+		GetConsoleConnectionSummaryLifecycleStateEnumValues(),
+		GetCreateAutonomousContainerDatabaseDetailsPatchModelEnumValues(),
+		GetCreateAutonomousContainerDatabaseDetailsProtectionModeEnumValues(),
+		GetCreateAutonomousContainerDatabaseDetailsServiceLevelAgreementTypeEnumValues(),
+		GetCreateAutonomousDataWarehouseDetailsLicenseModelEnumValues(),
+		GetCreateAutonomousDatabaseBaseDbWorkloadEnumValues(),
+		GetCreateAutonomousDatabaseBaseLicenseModelEnumValues(),
+		GetCreateAutonomousDatabaseBaseSourceEnumValues(),
+		GetCreateAutonomousDatabaseCloneDetailsCloneTypeEnumValues(),
+		GetCreateAutonomousDatabaseFromBackupDetailsCloneTypeEnumValues(),
+		GetCreateAutonomousDatabaseFromBackupTimestampDetailsCloneTypeEnumValues(),
+		GetCreateAutonomousVmClusterDetailsLicenseModelEnumValues(),
+		GetCreateBackupDestinationDetailsTypeEnumValues(),
+		GetCreateCloudVmClusterDetailsLicenseModelEnumValues(),
+		GetCreateDataGuardAssociationDetailsProtectionModeEnumValues(),
+		GetCreateDataGuardAssociationDetailsTransportTypeEnumValues(),
+		GetCreateDatabaseBaseSourceEnumValues(),
+		GetCreateDatabaseDetailsDbWorkloadEnumValues(),
+		GetCreateDatabaseSoftwareImageDetailsImageShapeFamilyEnumValues(),
+		GetCreateDatabaseSoftwareImageDetailsImageTypeEnumValues(),
+		GetCreateDbHomeBaseSourceEnumValues(),
+		GetCreateExternalBackupJobDetailsDatabaseEditionEnumValues(),
+		GetCreateExternalBackupJobDetailsDatabaseModeEnumValues(),
+		GetCreateRefreshableAutonomousDatabaseCloneDetailsRefreshableModeEnumValues(),
+		GetCreateVmClusterDetailsLicenseModelEnumValues(),
+		GetDataGuardAssociationLifecycleStateEnumValues(),
+		GetDataGuardAssociationPeerRoleEnumValues(),
+		GetDataGuardAssociationProtectionModeEnumValues(),
+		GetDataGuardAssociationRoleEnumValues(),
+		GetDataGuardAssociationSummaryLifecycleStateEnumValues(),
+		GetDataGuardAssociationSummaryPeerRoleEnumValues(),
+		GetDataGuardAssociationSummaryProtectionModeEnumValues(),
+		GetDataGuardAssociationSummaryRoleEnumValues(),
+		GetDataGuardAssociationSummaryTransportTypeEnumValues(),
+		GetDataGuardAssociationTransportTypeEnumValues(),
+		GetDatabaseLifecycleStateEnumValues(),
+		GetDatabaseSoftwareImageImageShapeFamilyEnumValues(),
+		GetDatabaseSoftwareImageImageTypeEnumValues(),
+		GetDatabaseSoftwareImageLifecycleStateEnumValues(),
+		GetDatabaseSoftwareImageSummaryImageShapeFamilyEnumValues(),
+		GetDatabaseSoftwareImageSummaryImageTypeEnumValues(),
+		GetDatabaseSoftwareImageSummaryLifecycleStateEnumValues(),
+		GetDatabaseSummaryLifecycleStateEnumValues(),
+		GetDayOfWeekNameEnumValues(),
+		GetDbBackupConfigAutoBackupWindowEnumValues(),
+		GetDbHomeLifecycleStateEnumValues(),
+		GetDbHomeSummaryLifecycleStateEnumValues(),
+		GetDbNodeActionActionEnumValues(),
+		GetDbNodeLifecycleStateEnumValues(),
+		GetDbNodeMaintenanceTypeEnumValues(),
+		GetDbNodeSummaryLifecycleStateEnumValues(),
+		GetDbNodeSummaryMaintenanceTypeEnumValues(),
+		GetDbSystemDatabaseEditionEnumValues(),
+		GetDbSystemDiskRedundancyEnumValues(),
+		GetDbSystemLicenseModelEnumValues(),
+		GetDbSystemLifecycleStateEnumValues(),
+		GetDbSystemOptionsStorageManagementEnumValues(),
+		GetDbSystemSummaryDatabaseEditionEnumValues(),
+		GetDbSystemSummaryDiskRedundancyEnumValues(),
+		GetDbSystemSummaryLicenseModelEnumValues(),
+		GetDbSystemSummaryLifecycleStateEnumValues(),
+		GetExadataInfrastructureLifecycleStateEnumValues(),
+		GetExadataInfrastructureSummaryLifecycleStateEnumValues(),
+		GetExadataIormConfigLifecycleStateEnumValues(),
+		GetExadataIormConfigObjectiveEnumValues(),
+		GetExadataIormConfigUpdateDetailsObjectiveEnumValues(),
+		GetGenerateAutonomousDatabaseWalletDetailsGenerateTypeEnumValues(),
+		GetInfoForNetworkGenDetailsNetworkTypeEnumValues(),
+		GetLaunchAutonomousExadataInfrastructureDetailsLicenseModelEnumValues(),
+		GetLaunchDbSystemBaseSourceEnumValues(),
+		GetLaunchDbSystemDetailsDatabaseEditionEnumValues(),
+		GetLaunchDbSystemDetailsDiskRedundancyEnumValues(),
+		GetLaunchDbSystemDetailsLicenseModelEnumValues(),
+		GetLaunchDbSystemFromBackupDetailsDatabaseEditionEnumValues(),
+		GetLaunchDbSystemFromBackupDetailsDiskRedundancyEnumValues(),
+		GetLaunchDbSystemFromBackupDetailsLicenseModelEnumValues(),
+		GetLaunchDbSystemFromDatabaseDetailsDatabaseEditionEnumValues(),
+		GetLaunchDbSystemFromDatabaseDetailsDiskRedundancyEnumValues(),
+		GetLaunchDbSystemFromDatabaseDetailsLicenseModelEnumValues(),
+		GetLaunchDbSystemFromDbSystemDetailsLicenseModelEnumValues(),
+		GetListAutonomousContainerDatabasesSortByEnumValues(),
+		GetListAutonomousContainerDatabasesSortOrderEnumValues(),
+		GetListAutonomousDataWarehouseBackupsSortByEnumValues(),
+		GetListAutonomousDataWarehouseBackupsSortOrderEnumValues(),
+		GetListAutonomousDataWarehousesSortByEnumValues(),
+		GetListAutonomousDataWarehousesSortOrderEnumValues(),
+		GetListAutonomousDatabaseBackupsSortByEnumValues(),
+		GetListAutonomousDatabaseBackupsSortOrderEnumValues(),
+		GetListAutonomousDatabaseClonesCloneTypeEnumValues(),
+		GetListAutonomousDatabaseClonesSortByEnumValues(),
+		GetListAutonomousDatabaseClonesSortOrderEnumValues(),
+		GetListAutonomousDatabasesSortByEnumValues(),
+		GetListAutonomousDatabasesSortOrderEnumValues(),
+		GetListAutonomousDbPreviewVersionsSortByEnumValues(),
+		GetListAutonomousDbPreviewVersionsSortOrderEnumValues(),
+		GetListAutonomousDbVersionsSortOrderEnumValues(),
+		GetListAutonomousExadataInfrastructuresSortByEnumValues(),
+		GetListAutonomousExadataInfrastructuresSortOrderEnumValues(),
+		GetListAutonomousVmClustersSortByEnumValues(),
+		GetListAutonomousVmClustersSortOrderEnumValues(),
+		GetListCloudExadataInfrastructuresSortByEnumValues(),
+		GetListCloudExadataInfrastructuresSortOrderEnumValues(),
+		GetListCloudVmClusterUpdateHistoryEntriesUpdateTypeEnumValues(),
+		GetListCloudVmClusterUpdatesUpdateTypeEnumValues(),
+		GetListCloudVmClustersSortByEnumValues(),
+		GetListCloudVmClustersSortOrderEnumValues(),
+		GetListDatabaseSoftwareImagesSortByEnumValues(),
+		GetListDatabaseSoftwareImagesSortOrderEnumValues(),
+		GetListDatabasesSortByEnumValues(),
+		GetListDatabasesSortOrderEnumValues(),
+		GetListDbHomesSortByEnumValues(),
+		GetListDbHomesSortOrderEnumValues(),
+		GetListDbNodesSortByEnumValues(),
+		GetListDbNodesSortOrderEnumValues(),
+		GetListDbSystemsSortByEnumValues(),
+		GetListDbSystemsSortOrderEnumValues(),
+		GetListExadataInfrastructuresSortByEnumValues(),
+		GetListExadataInfrastructuresSortOrderEnumValues(),
+		GetListGiVersionsSortOrderEnumValues(),
+		GetListMaintenanceRunsSortByEnumValues(),
+		GetListMaintenanceRunsSortOrderEnumValues(),
+		GetListVmClusterNetworksSortByEnumValues(),
+		GetListVmClusterNetworksSortOrderEnumValues(),
+		GetListVmClustersSortByEnumValues(),
+		GetListVmClustersSortOrderEnumValues(),
+		GetMaintenanceRunLifecycleStateEnumValues(),
+		GetMaintenanceRunMaintenanceSubtypeEnumValues(),
+		GetMaintenanceRunMaintenanceTypeEnumValues(),
+		GetMaintenanceRunSummaryLifecycleStateEnumValues(),
+		GetMaintenanceRunSummaryMaintenanceSubtypeEnumValues(),
+		GetMaintenanceRunSummaryMaintenanceTypeEnumValues(),
+		GetMaintenanceRunSummaryTargetResourceTypeEnumValues(),
+		GetMaintenanceRunTargetResourceTypeEnumValues(),
+		GetMaintenanceWindowPreferenceEnumValues(),
+		GetMonthNameEnumValues(),
+		GetMountTypeDetailsMountTypeEnumValues(),
+		GetPatchAvailableActionsEnumValues(),
+		GetPatchDetailsActionEnumValues(),
+		GetPatchHistoryEntryActionEnumValues(),
+		GetPatchHistoryEntryLifecycleStateEnumValues(),
+		GetPatchHistoryEntrySummaryActionEnumValues(),
+		GetPatchHistoryEntrySummaryLifecycleStateEnumValues(),
+		GetPatchLastActionEnumValues(),
+		GetPatchLifecycleStateEnumValues(),
+		GetPatchSummaryAvailableActionsEnumValues(),
+		GetPatchSummaryLastActionEnumValues(),
+		GetPatchSummaryLifecycleStateEnumValues(),
+		GetUpdateAutonomousContainerDatabaseDetailsPatchModelEnumValues(),
+		GetUpdateAutonomousDatabaseDetailsDbWorkloadEnumValues(),
+		GetUpdateAutonomousDatabaseDetailsLicenseModelEnumValues(),
+		GetUpdateAutonomousDatabaseDetailsOpenModeEnumValues(),
+		GetUpdateAutonomousDatabaseDetailsPermissionLevelEnumValues(),
+		GetUpdateAutonomousDatabaseDetailsRefreshableModeEnumValues(),
+		GetUpdateAutonomousVmClusterDetailsLicenseModelEnumValues(),
+		GetUpdateAvailableActionsEnumValues(),
+		GetUpdateBackupDestinationDetailsNfsMountTypeEnumValues(),
+		GetUpdateCloudVmClusterDetailsLicenseModelEnumValues(),
+		GetUpdateDbSystemDetailsLicenseModelEnumValues(),
+		GetUpdateDetailsUpdateActionEnumValues(),
+		GetUpdateHistoryEntryLifecycleStateEnumValues(),
+		GetUpdateHistoryEntrySummaryLifecycleStateEnumValues(),
+		GetUpdateHistoryEntrySummaryUpdateActionEnumValues(),
+		GetUpdateHistoryEntrySummaryUpdateTypeEnumValues(),
+		GetUpdateHistoryEntryUpdateActionEnumValues(),
+		GetUpdateHistoryEntryUpdateTypeEnumValues(),
+		GetUpdateLastActionEnumValues(),
+		GetUpdateLifecycleStateEnumValues(),
+		GetUpdateSummaryAvailableActionsEnumValues(),
+		GetUpdateSummaryLastActionEnumValues(),
+		GetUpdateSummaryLifecycleStateEnumValues(),
+		GetUpdateSummaryUpdateTypeEnumValues(),
+		GetUpdateUpdateTypeEnumValues(),
+		GetUpdateVmClusterDetailsLicenseModelEnumValues(),
+		GetVmClusterLicenseModelEnumValues(),
+		GetVmClusterLifecycleStateEnumValues(),
+		GetVmClusterNetworkLifecycleStateEnumValues(),
+		GetVmClusterNetworkSummaryLifecycleStateEnumValues(),
+		GetVmClusterSummaryLicenseModelEnumValues(),
+		GetVmClusterSummaryLifecycleStateEnumValues(),
+		GetVmNetworkDetailsNetworkTypeEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ActivateExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AutonomousDatabaseManualRefreshRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAutonomousContainerDatabaseCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAutonomousDatabaseCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAutonomousExadataInfrastructureCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeAutonomousVmClusterCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeBackupDestinationCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeCloudExadataInfrastructureCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeCloudVmClusterCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeDatabaseSoftwareImageCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeDbSystemCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeExadataInfrastructureCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeVmClusterCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CompleteExternalBackupJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousContainerDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousDataWarehouseBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousDatabaseBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateAutonomousVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateBackupDestinationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateCloudExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateCloudVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateConsoleConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDataGuardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDatabaseSoftwareImageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDbHomeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateExternalBackupJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DbNodeActionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAutonomousVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteBackupDestinationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteCloudExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteCloudVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteConsoleConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDatabaseSoftwareImageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDbHomeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeregisterAutonomousDatabaseDataSafeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DisableAutonomousDatabaseOperationsInsightsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DownloadExadataInfrastructureConfigFileRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DownloadVmClusterNetworkConfigFileRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request EnableAutonomousDatabaseOperationsInsightsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request FailOverAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request FailoverAutonomousContainerDatabaseDataguardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request FailoverDataGuardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GenerateAutonomousDataWarehouseWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GenerateAutonomousDatabaseWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GenerateRecommendedVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousContainerDatabaseDataguardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousContainerDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDataWarehouseBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDatabaseBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDatabaseDataguardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDatabaseRegionalWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousDatabaseWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousPatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAutonomousVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetBackupDestinationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetBackupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCloudExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCloudVmClusterIormConfigRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCloudVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCloudVmClusterUpdateHistoryEntryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCloudVmClusterUpdateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConsoleConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDataGuardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDatabaseSoftwareImageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbHomePatchHistoryEntryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbHomePatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbHomeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbNodeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbSystemPatchHistoryEntryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbSystemPatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDbSystemRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExadataInfrastructureOcpusRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExadataIormConfigRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExternalBackupJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetMaintenanceRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetVmClusterPatchHistoryEntryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetVmClusterPatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request LaunchAutonomousExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request LaunchDbSystemRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousContainerDatabaseDataguardAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousContainerDatabasesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDataWarehouseBackupsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDataWarehousesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDatabaseBackupsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDatabaseClonesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDatabaseDataguardAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDatabasesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDbPreviewVersionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousDbVersionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousExadataInfrastructureShapesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousExadataInfrastructuresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAutonomousVmClustersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListBackupDestinationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListBackupsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListCloudExadataInfrastructuresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListCloudVmClusterUpdateHistoryEntriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListCloudVmClusterUpdatesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListCloudVmClustersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListConsoleConnectionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListContainerDatabasePatchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDataGuardAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDatabaseSoftwareImagesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDatabasesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbHomePatchHistoryEntriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbHomePatchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbHomesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbNodesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbSystemPatchHistoryEntriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbSystemPatchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbSystemShapesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbSystemsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDbVersionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListExadataInfrastructuresRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListGiVersionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListMaintenanceRunsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListVmClusterNetworksRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListVmClusterPatchHistoryEntriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListVmClusterPatchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListVmClustersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request MigrateExadataDbSystemResourceModelRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RegisterAutonomousDatabaseDataSafeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ReinstateAutonomousContainerDatabaseDataguardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ReinstateDataGuardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestartAutonomousContainerDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestartAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestoreAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestoreAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RestoreDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RotateAutonomousContainerDatabaseEncryptionKeyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RotateAutonomousDatabaseEncryptionKeyRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StartAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StartAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StopAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StopAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SwitchoverAutonomousContainerDatabaseDataguardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SwitchoverAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SwitchoverDataGuardAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request TerminateAutonomousContainerDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request TerminateAutonomousExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request TerminateDbSystemRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousContainerDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousDataWarehouseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousDatabaseRegionalWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousDatabaseWalletRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateAutonomousVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateBackupDestinationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateCloudExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateCloudVmClusterIormConfigRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateCloudVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDatabaseRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDatabaseSoftwareImageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDbHomeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDbSystemRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateExadataInfrastructureRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateExadataIormConfigRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateMaintenanceRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateVmClusterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateVmClusterNetworkRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ActivateExadataInfrastructureDetails, see common.ValidateStruct
+func (m ActivateExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AssociatedDatabaseDetails, see common.ValidateStruct
+func (m AssociatedDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AutomatedMountDetails, see common.ValidateStruct
+func (m AutomatedMountDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AutonomousDataWarehouseConsoleTokenDetails, see common.ValidateStruct
+func (m AutonomousDataWarehouseConsoleTokenDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AutonomousDatabaseConsoleTokenDetails, see common.ValidateStruct
+func (m AutonomousDatabaseConsoleTokenDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AutonomousDatabaseManualRefreshDetails, see common.ValidateStruct
+func (m AutonomousDatabaseManualRefreshDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of BackupDestinationDetails, see common.ValidateStruct
+func (m BackupDestinationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeAutonomousVmClusterCompartmentDetails, see common.ValidateStruct
+func (m ChangeAutonomousVmClusterCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCloudExadataInfrastructureCompartmentDetails, see common.ValidateStruct
+func (m ChangeCloudExadataInfrastructureCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCloudVmClusterCompartmentDetails, see common.ValidateStruct
+func (m ChangeCloudVmClusterCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCompartmentDetails, see common.ValidateStruct
+func (m ChangeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeExadataInfrastructureCompartmentDetails, see common.ValidateStruct
+func (m ChangeExadataInfrastructureCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeVmClusterCompartmentDetails, see common.ValidateStruct
+func (m ChangeVmClusterCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CompleteExternalBackupJobDetails, see common.ValidateStruct
+func (m CompleteExternalBackupJobDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousContainerDatabaseDetails, see common.ValidateStruct
+func (m CreateAutonomousContainerDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDataWarehouseBackupDetails, see common.ValidateStruct
+func (m CreateAutonomousDataWarehouseBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDataWarehouseDetails, see common.ValidateStruct
+func (m CreateAutonomousDataWarehouseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDatabaseBackupDetails, see common.ValidateStruct
+func (m CreateAutonomousDatabaseBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDatabaseCloneDetails, see common.ValidateStruct
+func (m CreateAutonomousDatabaseCloneDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDatabaseDetails, see common.ValidateStruct
+func (m CreateAutonomousDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDatabaseFromBackupDetails, see common.ValidateStruct
+func (m CreateAutonomousDatabaseFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousDatabaseFromBackupTimestampDetails, see common.ValidateStruct
+func (m CreateAutonomousDatabaseFromBackupTimestampDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAutonomousVmClusterDetails, see common.ValidateStruct
+func (m CreateAutonomousVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateBackupDetails, see common.ValidateStruct
+func (m CreateBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateCloudExadataInfrastructureDetails, see common.ValidateStruct
+func (m CreateCloudExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateCloudVmClusterDetails, see common.ValidateStruct
+func (m CreateCloudVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateConsoleConnectionDetails, see common.ValidateStruct
+func (m CreateConsoleConnectionDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDataGuardAssociationToExistingDbSystemDetails, see common.ValidateStruct
+func (m CreateDataGuardAssociationToExistingDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDataGuardAssociationToExistingVmClusterDetails, see common.ValidateStruct
+func (m CreateDataGuardAssociationToExistingVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDataGuardAssociationWithNewDbSystemDetails, see common.ValidateStruct
+func (m CreateDataGuardAssociationWithNewDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDatabaseDetails, see common.ValidateStruct
+func (m CreateDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDatabaseFromAnotherDatabaseDetails, see common.ValidateStruct
+func (m CreateDatabaseFromAnotherDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDatabaseFromBackupDetails, see common.ValidateStruct
+func (m CreateDatabaseFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDatabaseFromDbSystemDetails, see common.ValidateStruct
+func (m CreateDatabaseFromDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDatabaseSoftwareImageDetails, see common.ValidateStruct
+func (m CreateDatabaseSoftwareImageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeDetails, see common.ValidateStruct
+func (m CreateDbHomeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeFromBackupDetails, see common.ValidateStruct
+func (m CreateDbHomeFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeFromDatabaseDetails, see common.ValidateStruct
+func (m CreateDbHomeFromDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeFromDbSystemDetails, see common.ValidateStruct
+func (m CreateDbHomeFromDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeWithDbSystemIdDetails, see common.ValidateStruct
+func (m CreateDbHomeWithDbSystemIdDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeWithDbSystemIdFromBackupDetails, see common.ValidateStruct
+func (m CreateDbHomeWithDbSystemIdFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeWithDbSystemIdFromDatabaseDetails, see common.ValidateStruct
+func (m CreateDbHomeWithDbSystemIdFromDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeWithVmClusterIdDetails, see common.ValidateStruct
+func (m CreateDbHomeWithVmClusterIdDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDbHomeWithVmClusterIdFromBackupDetails, see common.ValidateStruct
+func (m CreateDbHomeWithVmClusterIdFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateExadataInfrastructureDetails, see common.ValidateStruct
+func (m CreateExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateExternalBackupJobDetails, see common.ValidateStruct
+func (m CreateExternalBackupJobDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateNewDatabaseDetails, see common.ValidateStruct
+func (m CreateNewDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateNfsBackupDestinationDetails, see common.ValidateStruct
+func (m CreateNfsBackupDestinationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateRecoveryApplianceBackupDestinationDetails, see common.ValidateStruct
+func (m CreateRecoveryApplianceBackupDestinationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateRefreshableAutonomousDatabaseCloneDetails, see common.ValidateStruct
+func (m CreateRefreshableAutonomousDatabaseCloneDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateVmClusterDetails, see common.ValidateStruct
+func (m CreateVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DeregisterAutonomousDatabaseDataSafeDetails, see common.ValidateStruct
+func (m DeregisterAutonomousDatabaseDataSafeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ExadataIormConfigUpdateDetails, see common.ValidateStruct
+func (m ExadataIormConfigUpdateDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of FailoverDataGuardAssociationDetails, see common.ValidateStruct
+func (m FailoverDataGuardAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of GenerateAutonomousDataWarehouseWalletDetails, see common.ValidateStruct
+func (m GenerateAutonomousDataWarehouseWalletDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of GenerateAutonomousDatabaseWalletDetails, see common.ValidateStruct
+func (m GenerateAutonomousDatabaseWalletDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of GenerateRecommendedNetworkDetails, see common.ValidateStruct
+func (m GenerateRecommendedNetworkDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of InfoForNetworkGenDetails, see common.ValidateStruct
+func (m InfoForNetworkGenDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of LaunchAutonomousExadataInfrastructureDetails, see common.ValidateStruct
+func (m LaunchAutonomousExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of LaunchDbSystemDetails, see common.ValidateStruct
+func (m LaunchDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of LaunchDbSystemFromBackupDetails, see common.ValidateStruct
+func (m LaunchDbSystemFromBackupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of LaunchDbSystemFromDatabaseDetails, see common.ValidateStruct
+func (m LaunchDbSystemFromDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of LaunchDbSystemFromDbSystemDetails, see common.ValidateStruct
+func (m LaunchDbSystemFromDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of NodeDetails, see common.ValidateStruct
+func (m NodeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of PatchDetails, see common.ValidateStruct
+func (m PatchDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RegisterAutonomousDatabaseDataSafeDetails, see common.ValidateStruct
+func (m RegisterAutonomousDatabaseDataSafeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ReinstateDataGuardAssociationDetails, see common.ValidateStruct
+func (m ReinstateDataGuardAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RestoreAutonomousDataWarehouseDetails, see common.ValidateStruct
+func (m RestoreAutonomousDataWarehouseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RestoreAutonomousDatabaseDetails, see common.ValidateStruct
+func (m RestoreAutonomousDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RestoreDatabaseDetails, see common.ValidateStruct
+func (m RestoreDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ScanDetails, see common.ValidateStruct
+func (m ScanDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SelfMountDetails, see common.ValidateStruct
+func (m SelfMountDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SwitchoverDataGuardAssociationDetails, see common.ValidateStruct
+func (m SwitchoverDataGuardAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousContainerDatabaseDetails, see common.ValidateStruct
+func (m UpdateAutonomousContainerDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousDataWarehouseDetails, see common.ValidateStruct
+func (m UpdateAutonomousDataWarehouseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousDatabaseDetails, see common.ValidateStruct
+func (m UpdateAutonomousDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousDatabaseWalletDetails, see common.ValidateStruct
+func (m UpdateAutonomousDatabaseWalletDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousExadataInfrastructureDetails, see common.ValidateStruct
+func (m UpdateAutonomousExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateAutonomousVmClusterDetails, see common.ValidateStruct
+func (m UpdateAutonomousVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateBackupDestinationDetails, see common.ValidateStruct
+func (m UpdateBackupDestinationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateCloudExadataInfrastructureDetails, see common.ValidateStruct
+func (m UpdateCloudExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateCloudVmClusterDetails, see common.ValidateStruct
+func (m UpdateCloudVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDatabaseDetails, see common.ValidateStruct
+func (m UpdateDatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDatabaseSoftwareImageDetails, see common.ValidateStruct
+func (m UpdateDatabaseSoftwareImageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDbHomeDetails, see common.ValidateStruct
+func (m UpdateDbHomeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDbSystemDetails, see common.ValidateStruct
+func (m UpdateDbSystemDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDetails, see common.ValidateStruct
+func (m UpdateDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateExadataInfrastructureDetails, see common.ValidateStruct
+func (m UpdateExadataInfrastructureDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateMaintenanceRunDetails, see common.ValidateStruct
+func (m UpdateMaintenanceRunDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateVmClusterDetails, see common.ValidateStruct
+func (m UpdateVmClusterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateVmClusterNetworkDetails, see common.ValidateStruct
+func (m UpdateVmClusterNetworkDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of VmClusterNetworkDetails, see common.ValidateStruct
+func (m VmClusterNetworkDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of VmNetworkDetails, see common.ValidateStruct
+func (m VmNetworkDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
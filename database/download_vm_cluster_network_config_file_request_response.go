@@ -69,6 +69,9 @@ type DownloadVmClusterNetworkConfigFileResponse struct {
 
 	// The date and time the configuration file was created, as described in RFC 3339 (https://tools.ietf.org/rfc/rfc3339), section 14.29.
 	LastModified *common.SDKTime `presentIn:"header" name:"last-modified"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response DownloadVmClusterNetworkConfigFileResponse) String() string {
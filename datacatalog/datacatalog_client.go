@@ -102,10 +102,12 @@ func (client DataCatalogClient) AddDataSelectorPatterns(ctx context.Context, req
 				response = AddDataSelectorPatternsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddDataSelectorPatternsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddDataSelectorPatternsResponse")
 	}
@@ -157,10 +159,12 @@ func (client DataCatalogClient) AssociateCustomProperty(ctx context.Context, req
 				response = AssociateCustomPropertyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AssociateCustomPropertyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AssociateCustomPropertyResponse")
 	}
@@ -212,10 +216,12 @@ func (client DataCatalogClient) AttachCatalogPrivateEndpoint(ctx context.Context
 				response = AttachCatalogPrivateEndpointResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachCatalogPrivateEndpointResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachCatalogPrivateEndpointResponse")
 	}
@@ -367,10 +373,12 @@ func (client DataCatalogClient) CreateAttribute(ctx context.Context, request Cre
 				response = CreateAttributeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAttributeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAttributeResponse")
 	}
@@ -422,10 +430,12 @@ func (client DataCatalogClient) CreateAttributeTag(ctx context.Context, request
 				response = CreateAttributeTagResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAttributeTagResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAttributeTagResponse")
 	}
@@ -478,10 +488,12 @@ func (client DataCatalogClient) CreateCatalog(ctx context.Context, request Creat
 				response = CreateCatalogResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCatalogResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCatalogResponse")
 	}
@@ -533,10 +545,12 @@ func (client DataCatalogClient) CreateCatalogPrivateEndpoint(ctx context.Context
 				response = CreateCatalogPrivateEndpointResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCatalogPrivateEndpointResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCatalogPrivateEndpointResponse")
 	}
@@ -588,10 +602,12 @@ func (client DataCatalogClient) CreateConnection(ctx context.Context, request Cr
 				response = CreateConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConnectionResponse")
 	}
@@ -643,10 +659,12 @@ func (client DataCatalogClient) CreateCustomProperty(ctx context.Context, reques
 				response = CreateCustomPropertyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCustomPropertyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCustomPropertyResponse")
 	}
@@ -698,10 +716,12 @@ func (client DataCatalogClient) CreateDataAsset(ctx context.Context, request Cre
 				response = CreateDataAssetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataAssetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataAssetResponse")
 	}
@@ -753,10 +773,12 @@ func (client DataCatalogClient) CreateDataAssetTag(ctx context.Context, request
 				response = CreateDataAssetTagResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataAssetTagResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataAssetTagResponse")
 	}
@@ -808,10 +830,12 @@ func (client DataCatalogClient) CreateEntity(ctx context.Context, request Create
 				response = CreateEntityResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateEntityResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateEntityResponse")
 	}
@@ -863,10 +887,12 @@ func (client DataCatalogClient) CreateEntityTag(ctx context.Context, request Cre
 				response = CreateEntityTagResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateEntityTagResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateEntityTagResponse")
 	}
@@ -918,10 +944,12 @@ func (client DataCatalogClient) CreateFolder(ctx context.Context, request Create
 				response = CreateFolderResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateFolderResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateFolderResponse")
 	}
@@ -973,10 +1001,12 @@ func (client DataCatalogClient) CreateFolderTag(ctx context.Context, request Cre
 				response = CreateFolderTagResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateFolderTagResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateFolderTagResponse")
 	}
@@ -1028,10 +1058,12 @@ func (client DataCatalogClient) CreateGlossary(ctx context.Context, request Crea
 				response = CreateGlossaryResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateGlossaryResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateGlossaryResponse")
 	}
@@ -1083,10 +1115,12 @@ func (client DataCatalogClient) CreateJob(ctx context.Context, request CreateJob
 				response = CreateJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateJobResponse")
 	}
@@ -1138,10 +1172,12 @@ func (client DataCatalogClient) CreateJobDefinition(ctx context.Context, request
 				response = CreateJobDefinitionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateJobDefinitionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateJobDefinitionResponse")
 	}
@@ -1193,10 +1229,12 @@ func (client DataCatalogClient) CreateJobExecution(ctx context.Context, request
 				response = CreateJobExecutionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateJobExecutionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateJobExecutionResponse")
 	}
@@ -1248,10 +1286,12 @@ func (client DataCatalogClient) CreateNamespace(ctx context.Context, request Cre
 				response = CreateNamespaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNamespaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNamespaceResponse")
 	}
@@ -1303,10 +1343,12 @@ func (client DataCatalogClient) CreatePattern(ctx context.Context, request Creat
 				response = CreatePatternResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePatternResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePatternResponse")
 	}
@@ -1358,10 +1400,12 @@ func (client DataCatalogClient) CreateTerm(ctx context.Context, request CreateTe
 				response = CreateTermResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTermResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTermResponse")
 	}
@@ -1413,10 +1457,12 @@ func (client DataCatalogClient) CreateTermRelationship(ctx context.Context, requ
 				response = CreateTermRelationshipResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTermRelationshipResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTermRelationshipResponse")
 	}
@@ -2468,10 +2514,12 @@ func (client DataCatalogClient) DisassociateCustomProperty(ctx context.Context,
 				response = DisassociateCustomPropertyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DisassociateCustomPropertyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DisassociateCustomPropertyResponse")
 	}
@@ -2523,10 +2571,12 @@ func (client DataCatalogClient) ExpandTreeForGlossary(ctx context.Context, reque
 				response = ExpandTreeForGlossaryResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExpandTreeForGlossaryResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExpandTreeForGlossaryResponse")
 	}
@@ -2578,10 +2628,12 @@ func (client DataCatalogClient) ExportGlossary(ctx context.Context, request Expo
 				response = ExportGlossaryResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExportGlossaryResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExportGlossaryResponse")
 	}
@@ -3833,10 +3885,12 @@ func (client DataCatalogClient) ImportConnection(ctx context.Context, request Im
 				response = ImportConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportConnectionResponse")
 	}
@@ -3888,10 +3942,12 @@ func (client DataCatalogClient) ImportGlossary(ctx context.Context, request Impo
 				response = ImportGlossaryResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportGlossaryResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportGlossaryResponse")
 	}
@@ -4393,10 +4449,12 @@ func (client DataCatalogClient) ListDerivedLogicalEntities(ctx context.Context,
 				response = ListDerivedLogicalEntitiesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ListDerivedLogicalEntitiesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ListDerivedLogicalEntitiesResponse")
 	}
@@ -5448,10 +5506,12 @@ func (client DataCatalogClient) ParseConnection(ctx context.Context, request Par
 				response = ParseConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ParseConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ParseConnectionResponse")
 	}
@@ -5503,10 +5563,12 @@ func (client DataCatalogClient) RemoveDataSelectorPatterns(ctx context.Context,
 				response = RemoveDataSelectorPatternsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RemoveDataSelectorPatternsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RemoveDataSelectorPatternsResponse")
 	}
@@ -5608,10 +5670,12 @@ func (client DataCatalogClient) TestConnection(ctx context.Context, request Test
 				response = TestConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(TestConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into TestConnectionResponse")
 	}
@@ -6413,10 +6477,12 @@ func (client DataCatalogClient) UploadCredentials(ctx context.Context, request U
 				response = UploadCredentialsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UploadCredentialsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UploadCredentialsResponse")
 	}
@@ -6518,10 +6584,12 @@ func (client DataCatalogClient) ValidateConnection(ctx context.Context, request
 				response = ValidateConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidateConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidateConnectionResponse")
 	}
@@ -6573,10 +6641,12 @@ func (client DataCatalogClient) ValidatePattern(ctx context.Context, request Val
 				response = ValidatePatternResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidatePatternResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidatePatternResponse")
 	}
@@ -0,0 +1,397 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package datacatalog
+
+import (
+	"context"
+)
+
+// DataCatalogClientAPI is the interface of the operations of DataCatalogClient, to inject the client and mock it in tests
+type DataCatalogClientAPI interface {
+	// AddDataSelectorPatterns Add data selector pattern to the data asset.
+	AddDataSelectorPatterns(ctx context.Context, request AddDataSelectorPatternsRequest) (response AddDataSelectorPatternsResponse, err error)
+
+	// AssociateCustomProperty Associate the custom property for the given type
+	AssociateCustomProperty(ctx context.Context, request AssociateCustomPropertyRequest) (response AssociateCustomPropertyResponse, err error)
+
+	// AttachCatalogPrivateEndpoint Attaches a private reverse connection endpoint resource to a data catalog resource. When provided, 'If-Match' is checked against 'ETag' values of the resource.
+	AttachCatalogPrivateEndpoint(ctx context.Context, request AttachCatalogPrivateEndpointRequest) (response AttachCatalogPrivateEndpointResponse, err error)
+
+	// ChangeCatalogCompartment Moves a resource into a different compartment. When provided, 'If-Match' is checked against 'ETag' values of the resource.
+	ChangeCatalogCompartment(ctx context.Context, request ChangeCatalogCompartmentRequest) (response ChangeCatalogCompartmentResponse, err error)
+
+	// ChangeCatalogPrivateEndpointCompartment Moves a resource into a different compartment. When provided, 'If-Match' is checked against 'ETag' values of the resource.
+	ChangeCatalogPrivateEndpointCompartment(ctx context.Context, request ChangeCatalogPrivateEndpointCompartmentRequest) (response ChangeCatalogPrivateEndpointCompartmentResponse, err error)
+
+	// CreateAttribute Creates a new entity attribute.
+	CreateAttribute(ctx context.Context, request CreateAttributeRequest) (response CreateAttributeResponse, err error)
+
+	// CreateAttributeTag Creates a new entity attribute tag.
+	CreateAttributeTag(ctx context.Context, request CreateAttributeTagRequest) (response CreateAttributeTagResponse, err error)
+
+	// CreateCatalog Creates a new data catalog instance that includes a console and an API URL for managing metadata operations.
+	// For more information, please see the documentation.
+	CreateCatalog(ctx context.Context, request CreateCatalogRequest) (response CreateCatalogResponse, err error)
+
+	// CreateCatalogPrivateEndpoint Create a new private reverse connection endpoint.
+	CreateCatalogPrivateEndpoint(ctx context.Context, request CreateCatalogPrivateEndpointRequest) (response CreateCatalogPrivateEndpointResponse, err error)
+
+	// CreateConnection Creates a new connection.
+	CreateConnection(ctx context.Context, request CreateConnectionRequest) (response CreateConnectionResponse, err error)
+
+	// CreateCustomProperty Create a new Custom Property
+	CreateCustomProperty(ctx context.Context, request CreateCustomPropertyRequest) (response CreateCustomPropertyResponse, err error)
+
+	// CreateDataAsset Create a new data asset.
+	CreateDataAsset(ctx context.Context, request CreateDataAssetRequest) (response CreateDataAssetResponse, err error)
+
+	// CreateDataAssetTag Creates a new data asset tag.
+	CreateDataAssetTag(ctx context.Context, request CreateDataAssetTagRequest) (response CreateDataAssetTagResponse, err error)
+
+	// CreateEntity Creates a new data entity.
+	CreateEntity(ctx context.Context, request CreateEntityRequest) (response CreateEntityResponse, err error)
+
+	// CreateEntityTag Creates a new entity tag.
+	CreateEntityTag(ctx context.Context, request CreateEntityTagRequest) (response CreateEntityTagResponse, err error)
+
+	// CreateFolder Creates a new folder.
+	CreateFolder(ctx context.Context, request CreateFolderRequest) (response CreateFolderResponse, err error)
+
+	// CreateFolderTag Creates a new folder tag.
+	CreateFolderTag(ctx context.Context, request CreateFolderTagRequest) (response CreateFolderTagResponse, err error)
+
+	// CreateGlossary Creates a new glossary.
+	CreateGlossary(ctx context.Context, request CreateGlossaryRequest) (response CreateGlossaryResponse, err error)
+
+	// CreateJob Creates a new job.
+	CreateJob(ctx context.Context, request CreateJobRequest) (response CreateJobResponse, err error)
+
+	// CreateJobDefinition Creates a new job definition.
+	CreateJobDefinition(ctx context.Context, request CreateJobDefinitionRequest) (response CreateJobDefinitionResponse, err error)
+
+	// CreateJobExecution Creates a new job execution.
+	CreateJobExecution(ctx context.Context, request CreateJobExecutionRequest) (response CreateJobExecutionResponse, err error)
+
+	// CreateNamespace Create a new Namespace to be used by a custom property
+	CreateNamespace(ctx context.Context, request CreateNamespaceRequest) (response CreateNamespaceResponse, err error)
+
+	// CreatePattern Create a new pattern.
+	CreatePattern(ctx context.Context, request CreatePatternRequest) (response CreatePatternResponse, err error)
+
+	// CreateTerm Create a new term within a glossary.
+	CreateTerm(ctx context.Context, request CreateTermRequest) (response CreateTermResponse, err error)
+
+	// CreateTermRelationship Creates a new term relationship for this term within a glossary.
+	CreateTermRelationship(ctx context.Context, request CreateTermRelationshipRequest) (response CreateTermRelationshipResponse, err error)
+
+	// DeleteAttribute Deletes a specific entity attribute.
+	DeleteAttribute(ctx context.Context, request DeleteAttributeRequest) (response DeleteAttributeResponse, err error)
+
+	// DeleteAttributeTag Deletes a specific entity attribute tag.
+	DeleteAttributeTag(ctx context.Context, request DeleteAttributeTagRequest) (response DeleteAttributeTagResponse, err error)
+
+	// DeleteCatalog Deletes a data catalog resource by identifier.
+	DeleteCatalog(ctx context.Context, request DeleteCatalogRequest) (response DeleteCatalogResponse, err error)
+
+	// DeleteCatalogPrivateEndpoint Deletes a private reverse connection endpoint by identifier.
+	DeleteCatalogPrivateEndpoint(ctx context.Context, request DeleteCatalogPrivateEndpointRequest) (response DeleteCatalogPrivateEndpointResponse, err error)
+
+	// DeleteConnection Deletes a specific connection of a data asset.
+	DeleteConnection(ctx context.Context, request DeleteConnectionRequest) (response DeleteConnectionResponse, err error)
+
+	// DeleteCustomProperty Deletes a specific custom property identified by it's key.
+	DeleteCustomProperty(ctx context.Context, request DeleteCustomPropertyRequest) (response DeleteCustomPropertyResponse, err error)
+
+	// DeleteDataAsset Deletes a specific data asset identified by it's key.
+	DeleteDataAsset(ctx context.Context, request DeleteDataAssetRequest) (response DeleteDataAssetResponse, err error)
+
+	// DeleteDataAssetTag Deletes a specific data asset tag.
+	DeleteDataAssetTag(ctx context.Context, request DeleteDataAssetTagRequest) (response DeleteDataAssetTagResponse, err error)
+
+	// DeleteEntity Deletes a specific data entity.
+	DeleteEntity(ctx context.Context, request DeleteEntityRequest) (response DeleteEntityResponse, err error)
+
+	// DeleteEntityTag Deletes a specific entity tag.
+	DeleteEntityTag(ctx context.Context, request DeleteEntityTagRequest) (response DeleteEntityTagResponse, err error)
+
+	// DeleteFolder Deletes a specific folder of a data asset identified by it's key.
+	DeleteFolder(ctx context.Context, request DeleteFolderRequest) (response DeleteFolderResponse, err error)
+
+	// DeleteFolderTag Deletes a specific folder tag.
+	DeleteFolderTag(ctx context.Context, request DeleteFolderTagRequest) (response DeleteFolderTagResponse, err error)
+
+	// DeleteGlossary Deletes a specific glossary identified by it's key.
+	DeleteGlossary(ctx context.Context, request DeleteGlossaryRequest) (response DeleteGlossaryResponse, err error)
+
+	// DeleteJob Deletes a specific job identified by it's key.
+	DeleteJob(ctx context.Context, request DeleteJobRequest) (response DeleteJobResponse, err error)
+
+	// DeleteJobDefinition Deletes a specific job definition identified by it's key.
+	DeleteJobDefinition(ctx context.Context, request DeleteJobDefinitionRequest) (response DeleteJobDefinitionResponse, err error)
+
+	// DeleteNamespace Deletes a specific Namespace identified by it's key.
+	DeleteNamespace(ctx context.Context, request DeleteNamespaceRequest) (response DeleteNamespaceResponse, err error)
+
+	// DeletePattern Deletes a specific pattern identified by it's key.
+	DeletePattern(ctx context.Context, request DeletePatternRequest) (response DeletePatternResponse, err error)
+
+	// DeleteTerm Deletes a specific glossary term.
+	DeleteTerm(ctx context.Context, request DeleteTermRequest) (response DeleteTermResponse, err error)
+
+	// DeleteTermRelationship Deletes a specific glossary term relationship.
+	DeleteTermRelationship(ctx context.Context, request DeleteTermRelationshipRequest) (response DeleteTermRelationshipResponse, err error)
+
+	// DetachCatalogPrivateEndpoint Detaches a private reverse connection endpoint resource to a data catalog resource. When provided, 'If-Match' is checked against 'ETag' values of the resource.
+	DetachCatalogPrivateEndpoint(ctx context.Context, request DetachCatalogPrivateEndpointRequest) (response DetachCatalogPrivateEndpointResponse, err error)
+
+	// DisassociateCustomProperty Remove the custom property for the given type
+	DisassociateCustomProperty(ctx context.Context, request DisassociateCustomPropertyRequest) (response DisassociateCustomPropertyResponse, err error)
+
+	// ExpandTreeForGlossary Returns the fully expanded tree hierarchy of parent and child terms in this glossary.
+	ExpandTreeForGlossary(ctx context.Context, request ExpandTreeForGlossaryRequest) (response ExpandTreeForGlossaryResponse, err error)
+
+	// ExportGlossary Export the glossary and the terms and return the exported glossary as csv or json.
+	ExportGlossary(ctx context.Context, request ExportGlossaryRequest) (response ExportGlossaryResponse, err error)
+
+	// GetAttribute Gets a specific entity attribute by key.
+	GetAttribute(ctx context.Context, request GetAttributeRequest) (response GetAttributeResponse, err error)
+
+	// GetAttributeTag Gets a specific entity attribute tag by key.
+	GetAttributeTag(ctx context.Context, request GetAttributeTagRequest) (response GetAttributeTagResponse, err error)
+
+	// GetCatalog Gets a data catalog by identifier.
+	GetCatalog(ctx context.Context, request GetCatalogRequest) (response GetCatalogResponse, err error)
+
+	// GetCatalogPrivateEndpoint Gets a specific private reverse connection by identifier.
+	GetCatalogPrivateEndpoint(ctx context.Context, request GetCatalogPrivateEndpointRequest) (response GetCatalogPrivateEndpointResponse, err error)
+
+	// GetConnection Gets a specific data asset connection by key.
+	GetConnection(ctx context.Context, request GetConnectionRequest) (response GetConnectionResponse, err error)
+
+	// GetCustomProperty Gets a specific custom property for the given key within a data catalog.
+	GetCustomProperty(ctx context.Context, request GetCustomPropertyRequest) (response GetCustomPropertyResponse, err error)
+
+	// GetDataAsset Gets a specific data asset for the given key within a data catalog.
+	GetDataAsset(ctx context.Context, request GetDataAssetRequest) (response GetDataAssetResponse, err error)
+
+	// GetDataAssetTag Gets a specific data asset tag by key.
+	GetDataAssetTag(ctx context.Context, request GetDataAssetTagRequest) (response GetDataAssetTagResponse, err error)
+
+	// GetEntity Gets a specific data entity by key for a data asset.
+	GetEntity(ctx context.Context, request GetEntityRequest) (response GetEntityResponse, err error)
+
+	// GetEntityTag Gets a specific entity tag by key.
+	GetEntityTag(ctx context.Context, request GetEntityTagRequest) (response GetEntityTagResponse, err error)
+
+	// GetFolder Gets a specific data asset folder by key.
+	GetFolder(ctx context.Context, request GetFolderRequest) (response GetFolderResponse, err error)
+
+	// GetFolderTag Gets a specific folder tag by key.
+	GetFolderTag(ctx context.Context, request GetFolderTagRequest) (response GetFolderTagResponse, err error)
+
+	// GetGlossary Gets a specific glossary by key within a data catalog.
+	GetGlossary(ctx context.Context, request GetGlossaryRequest) (response GetGlossaryResponse, err error)
+
+	// GetJob Gets a specific job by key within a data catalog.
+	GetJob(ctx context.Context, request GetJobRequest) (response GetJobResponse, err error)
+
+	// GetJobDefinition Gets a specific job definition by key within a data catalog.
+	GetJobDefinition(ctx context.Context, request GetJobDefinitionRequest) (response GetJobDefinitionResponse, err error)
+
+	// GetJobExecution Gets a specific job execution by key.
+	GetJobExecution(ctx context.Context, request GetJobExecutionRequest) (response GetJobExecutionResponse, err error)
+
+	// GetJobLog Gets a specific job log by key.
+	GetJobLog(ctx context.Context, request GetJobLogRequest) (response GetJobLogResponse, err error)
+
+	// GetJobMetrics Gets a specific job metric by key.
+	GetJobMetrics(ctx context.Context, request GetJobMetricsRequest) (response GetJobMetricsResponse, err error)
+
+	// GetNamespace Gets a specific namespace for the given key within a data catalog.
+	GetNamespace(ctx context.Context, request GetNamespaceRequest) (response GetNamespaceResponse, err error)
+
+	// GetPattern Gets a specific pattern for the given key within a data catalog.
+	GetPattern(ctx context.Context, request GetPatternRequest) (response GetPatternResponse, err error)
+
+	// GetTerm Gets a specific glossary term by key.
+	GetTerm(ctx context.Context, request GetTermRequest) (response GetTermResponse, err error)
+
+	// GetTermRelationship Gets a specific glossary term relationship by key.
+	GetTermRelationship(ctx context.Context, request GetTermRelationshipRequest) (response GetTermRelationshipResponse, err error)
+
+	// GetType Gets a specific type by key within a data catalog.
+	GetType(ctx context.Context, request GetTypeRequest) (response GetTypeResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given OCID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ImportConnection Import new connection for this data asset.
+	ImportConnection(ctx context.Context, request ImportConnectionRequest) (response ImportConnectionResponse, err error)
+
+	// ImportGlossary Import the glossary and the terms from csv or json files and return the imported glossary resource.
+	ImportGlossary(ctx context.Context, request ImportGlossaryRequest) (response ImportGlossaryResponse, err error)
+
+	// ListAggregatedPhysicalEntities List the physical entities aggregated by this logical entity.
+	ListAggregatedPhysicalEntities(ctx context.Context, request ListAggregatedPhysicalEntitiesRequest) (response ListAggregatedPhysicalEntitiesResponse, err error)
+
+	// ListAttributeTags Returns a list of all tags for an entity attribute.
+	ListAttributeTags(ctx context.Context, request ListAttributeTagsRequest) (response ListAttributeTagsResponse, err error)
+
+	// ListAttributes Returns a list of all attributes of an data entity.
+	ListAttributes(ctx context.Context, request ListAttributesRequest) (response ListAttributesResponse, err error)
+
+	// ListCatalogPrivateEndpoints Returns a list of all the catalog private endpoints in the specified compartment.
+	ListCatalogPrivateEndpoints(ctx context.Context, request ListCatalogPrivateEndpointsRequest) (response ListCatalogPrivateEndpointsResponse, err error)
+
+	// ListCatalogs Returns a list of all the data catalogs in the specified compartment.
+	ListCatalogs(ctx context.Context, request ListCatalogsRequest) (response ListCatalogsResponse, err error)
+
+	// ListConnections Returns a list of all Connections for a data asset.
+	ListConnections(ctx context.Context, request ListConnectionsRequest) (response ListConnectionsResponse, err error)
+
+	// ListCustomProperties Returns a list of custom properties within a data catalog.
+	ListCustomProperties(ctx context.Context, request ListCustomPropertiesRequest) (response ListCustomPropertiesResponse, err error)
+
+	// ListDataAssetTags Returns a list of all tags for a data asset.
+	ListDataAssetTags(ctx context.Context, request ListDataAssetTagsRequest) (response ListDataAssetTagsResponse, err error)
+
+	// ListDataAssets Returns a list of data assets within a data catalog.
+	ListDataAssets(ctx context.Context, request ListDataAssetsRequest) (response ListDataAssetsResponse, err error)
+
+	// ListDerivedLogicalEntities List logical entities derived from this pattern.
+	ListDerivedLogicalEntities(ctx context.Context, request ListDerivedLogicalEntitiesRequest) (response ListDerivedLogicalEntitiesResponse, err error)
+
+	// ListEntities Returns a list of all entities of a data asset.
+	ListEntities(ctx context.Context, request ListEntitiesRequest) (response ListEntitiesResponse, err error)
+
+	// ListEntityTags Returns a list of all tags for a data entity.
+	ListEntityTags(ctx context.Context, request ListEntityTagsRequest) (response ListEntityTagsResponse, err error)
+
+	// ListFolderTags Returns a list of all tags for a folder.
+	ListFolderTags(ctx context.Context, request ListFolderTagsRequest) (response ListFolderTagsResponse, err error)
+
+	// ListFolders Returns a list of all folders.
+	ListFolders(ctx context.Context, request ListFoldersRequest) (response ListFoldersResponse, err error)
+
+	// ListGlossaries Returns a list of all glossaries within a data catalog.
+	ListGlossaries(ctx context.Context, request ListGlossariesRequest) (response ListGlossariesResponse, err error)
+
+	// ListJobDefinitions Returns a list of job definitions within a data catalog.
+	ListJobDefinitions(ctx context.Context, request ListJobDefinitionsRequest) (response ListJobDefinitionsResponse, err error)
+
+	// ListJobExecutions Returns a list of job executions for a job.
+	ListJobExecutions(ctx context.Context, request ListJobExecutionsRequest) (response ListJobExecutionsResponse, err error)
+
+	// ListJobLogs Returns a list of job logs.
+	ListJobLogs(ctx context.Context, request ListJobLogsRequest) (response ListJobLogsResponse, err error)
+
+	// ListJobMetrics Returns a list of job metrics.
+	ListJobMetrics(ctx context.Context, request ListJobMetricsRequest) (response ListJobMetricsResponse, err error)
+
+	// ListJobs Returns a list of jobs within a data catalog.
+	ListJobs(ctx context.Context, request ListJobsRequest) (response ListJobsResponse, err error)
+
+	// ListNamespaces Returns a list of namespaces within a data catalog.
+	ListNamespaces(ctx context.Context, request ListNamespacesRequest) (response ListNamespacesResponse, err error)
+
+	// ListPatterns Returns a list of patterns within a data catalog.
+	ListPatterns(ctx context.Context, request ListPatternsRequest) (response ListPatternsResponse, err error)
+
+	// ListTags Returns a list of all user created tags in the system.
+	ListTags(ctx context.Context, request ListTagsRequest) (response ListTagsResponse, err error)
+
+	// ListTermRelationships Returns a list of all term relationships within a glossary.
+	ListTermRelationships(ctx context.Context, request ListTermRelationshipsRequest) (response ListTermRelationshipsResponse, err error)
+
+	// ListTerms Returns a list of all terms within a glossary.
+	ListTerms(ctx context.Context, request ListTermsRequest) (response ListTermsResponse, err error)
+
+	// ListTypes Returns a list of all types within a data catalog.
+	ListTypes(ctx context.Context, request ListTypesRequest) (response ListTypesResponse, err error)
+
+	// ListWorkRequestErrors Returns a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Returns a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// ObjectStats Returns stats on objects by type in the repository.
+	ObjectStats(ctx context.Context, request ObjectStatsRequest) (response ObjectStatsResponse, err error)
+
+	// ParseConnection Parse data asset references through connections from this data asset.
+	ParseConnection(ctx context.Context, request ParseConnectionRequest) (response ParseConnectionResponse, err error)
+
+	// RemoveDataSelectorPatterns Remove data selector pattern from the data asset.
+	RemoveDataSelectorPatterns(ctx context.Context, request RemoveDataSelectorPatternsRequest) (response RemoveDataSelectorPatternsResponse, err error)
+
+	// SearchCriteria Returns a list of search results within a data catalog.
+	SearchCriteria(ctx context.Context, request SearchCriteriaRequest) (response SearchCriteriaResponse, err error)
+
+	// TestConnection Test the connection by connecting to the data asset using credentials in the metadata.
+	TestConnection(ctx context.Context, request TestConnectionRequest) (response TestConnectionResponse, err error)
+
+	// UpdateAttribute Updates a specific data asset attribute.
+	UpdateAttribute(ctx context.Context, request UpdateAttributeRequest) (response UpdateAttributeResponse, err error)
+
+	// UpdateCatalog Updates the data catalog.
+	UpdateCatalog(ctx context.Context, request UpdateCatalogRequest) (response UpdateCatalogResponse, err error)
+
+	// UpdateCatalogPrivateEndpoint Updates the private reverse connection endpoint.
+	UpdateCatalogPrivateEndpoint(ctx context.Context, request UpdateCatalogPrivateEndpointRequest) (response UpdateCatalogPrivateEndpointResponse, err error)
+
+	// UpdateConnection Updates a specific connection of a data asset.
+	UpdateConnection(ctx context.Context, request UpdateConnectionRequest) (response UpdateConnectionResponse, err error)
+
+	// UpdateCustomProperty Updates a specific custom property identified by the given key.
+	UpdateCustomProperty(ctx context.Context, request UpdateCustomPropertyRequest) (response UpdateCustomPropertyResponse, err error)
+
+	// UpdateDataAsset Updates a specific data asset identified by the given key.
+	UpdateDataAsset(ctx context.Context, request UpdateDataAssetRequest) (response UpdateDataAssetResponse, err error)
+
+	// UpdateEntity Updates a specific data entity.
+	UpdateEntity(ctx context.Context, request UpdateEntityRequest) (response UpdateEntityResponse, err error)
+
+	// UpdateFolder Updates a specific folder of a data asset.
+	UpdateFolder(ctx context.Context, request UpdateFolderRequest) (response UpdateFolderResponse, err error)
+
+	// UpdateGlossary Updates a specific glossary identified by the given key.
+	UpdateGlossary(ctx context.Context, request UpdateGlossaryRequest) (response UpdateGlossaryResponse, err error)
+
+	// UpdateJob Updates a specific job identified by the given key.
+	UpdateJob(ctx context.Context, request UpdateJobRequest) (response UpdateJobResponse, err error)
+
+	// UpdateJobDefinition Update a specific job definition identified by the given key.
+	UpdateJobDefinition(ctx context.Context, request UpdateJobDefinitionRequest) (response UpdateJobDefinitionResponse, err error)
+
+	// UpdateNamespace Updates a specific namespace identified by the given key.
+	UpdateNamespace(ctx context.Context, request UpdateNamespaceRequest) (response UpdateNamespaceResponse, err error)
+
+	// UpdatePattern Updates a specific pattern identified by the given key.
+	UpdatePattern(ctx context.Context, request UpdatePatternRequest) (response UpdatePatternResponse, err error)
+
+	// UpdateTerm Updates a specific glossary term.
+	UpdateTerm(ctx context.Context, request UpdateTermRequest) (response UpdateTermResponse, err error)
+
+	// UpdateTermRelationship Updates a specific glossary term relationship.
+	UpdateTermRelationship(ctx context.Context, request UpdateTermRelationshipRequest) (response UpdateTermRelationshipResponse, err error)
+
+	// UploadCredentials Upload connection credentails and metadata for this connection.
+	UploadCredentials(ctx context.Context, request UploadCredentialsRequest) (response UploadCredentialsResponse, err error)
+
+	// Users Returns active users in the system.
+	Users(ctx context.Context, request UsersRequest) (response UsersResponse, err error)
+
+	// ValidateConnection Validate connection by connecting to the data asset using credentials in metadata.
+	ValidateConnection(ctx context.Context, request ValidateConnectionRequest) (response ValidateConnectionResponse, err error)
+
+	// ValidatePattern Validate pattern by deriving file groups representing logical entities using the expression
+	ValidatePattern(ctx context.Context, request ValidatePatternRequest) (response ValidatePatternResponse, err error)
+}
+
+// DataCatalogClient implements DataCatalogClientAPI
+var _ DataCatalogClientAPI = DataCatalogClient{}
@@ -66,6 +66,9 @@ type CreatePrivateEndpointResponse struct {
 
 	// Provides information about the location of a newly created resource.
 	Location *string `presentIn:"header" name:"location"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreatePrivateEndpointResponse) String() string {
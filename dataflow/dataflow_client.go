@@ -103,10 +103,12 @@ func (client DataFlowClient) ChangeApplicationCompartment(ctx context.Context, r
 				response = ChangeApplicationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeApplicationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeApplicationCompartmentResponse")
 	}
@@ -211,10 +213,12 @@ func (client DataFlowClient) ChangeRunCompartment(ctx context.Context, request C
 				response = ChangeRunCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeRunCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeRunCompartmentResponse")
 	}
@@ -266,10 +270,12 @@ func (client DataFlowClient) CreateApplication(ctx context.Context, request Crea
 				response = CreateApplicationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateApplicationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateApplicationResponse")
 	}
@@ -321,10 +327,12 @@ func (client DataFlowClient) CreatePrivateEndpoint(ctx context.Context, request
 				response = CreatePrivateEndpointResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePrivateEndpointResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePrivateEndpointResponse")
 	}
@@ -376,10 +384,12 @@ func (client DataFlowClient) CreateRun(ctx context.Context, request CreateRunReq
 				response = CreateRunResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateRunResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateRunResponse")
 	}
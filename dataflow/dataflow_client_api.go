@@ -0,0 +1,94 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dataflow
+
+import (
+	"context"
+)
+
+// DataFlowClientAPI is the interface of the operations of DataFlowClient, to inject the client and mock it in tests
+type DataFlowClientAPI interface {
+	// ChangeApplicationCompartment Moves an application into a different compartment. When provided, If-Match is checked against ETag values of the resource.
+	// Associated resources, like runs, will not be automatically moved.
+	ChangeApplicationCompartment(ctx context.Context, request ChangeApplicationCompartmentRequest) (response ChangeApplicationCompartmentResponse, err error)
+
+	// ChangePrivateEndpointCompartment Moves a private endpoint into a different compartment. When provided, If-Match is checked against ETag values of the resource.
+	ChangePrivateEndpointCompartment(ctx context.Context, request ChangePrivateEndpointCompartmentRequest) (response ChangePrivateEndpointCompartmentResponse, err error)
+
+	// ChangeRunCompartment Moves a run into a different compartment. When provided, If-Match is checked against ETag
+	// values of the resource. Associated resources, like historical metrics, will not be
+	// automatically moved. The run must be in a terminal state (CANCELED, FAILED, SUCCEEDED) in
+	// order for it to be moved to a different compartment
+	ChangeRunCompartment(ctx context.Context, request ChangeRunCompartmentRequest) (response ChangeRunCompartmentResponse, err error)
+
+	// CreateApplication Creates an application.
+	CreateApplication(ctx context.Context, request CreateApplicationRequest) (response CreateApplicationResponse, err error)
+
+	// CreatePrivateEndpoint Creates a private endpoint to be used by an application.
+	CreatePrivateEndpoint(ctx context.Context, request CreatePrivateEndpointRequest) (response CreatePrivateEndpointResponse, err error)
+
+	// CreateRun Creates a run for an application.
+	CreateRun(ctx context.Context, request CreateRunRequest) (response CreateRunResponse, err error)
+
+	// DeleteApplication Deletes an application using an `applicationId`.
+	DeleteApplication(ctx context.Context, request DeleteApplicationRequest) (response DeleteApplicationResponse, err error)
+
+	// DeletePrivateEndpoint Deletes a private endpoint using a `privateEndpointId`.
+	DeletePrivateEndpoint(ctx context.Context, request DeletePrivateEndpointRequest) (response DeletePrivateEndpointResponse, err error)
+
+	// DeleteRun Cancels the specified run if it has not already completed or was previously cancelled.
+	// If a run is in progress, the executing job will be killed.
+	DeleteRun(ctx context.Context, request DeleteRunRequest) (response DeleteRunResponse, err error)
+
+	// GetApplication Retrieves an application using an `applicationId`.
+	GetApplication(ctx context.Context, request GetApplicationRequest) (response GetApplicationResponse, err error)
+
+	// GetPrivateEndpoint Retrieves an private endpoint using a `privateEndpointId`.
+	GetPrivateEndpoint(ctx context.Context, request GetPrivateEndpointRequest) (response GetPrivateEndpointResponse, err error)
+
+	// GetRun Retrieves the run for the specified `runId`.
+	GetRun(ctx context.Context, request GetRunRequest) (response GetRunResponse, err error)
+
+	// GetRunLog Retrieves the content of an run log.
+	GetRunLog(ctx context.Context, request GetRunLogRequest) (response GetRunLogResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given OCID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListApplications Lists all applications in the specified compartment.
+	ListApplications(ctx context.Context, request ListApplicationsRequest) (response ListApplicationsResponse, err error)
+
+	// ListPrivateEndpoints Lists all private endpoints in the specified compartment.
+	ListPrivateEndpoints(ctx context.Context, request ListPrivateEndpointsRequest) (response ListPrivateEndpointsResponse, err error)
+
+	// ListRunLogs Retrieves summaries of the run's logs.
+	ListRunLogs(ctx context.Context, request ListRunLogsRequest) (response ListRunLogsResponse, err error)
+
+	// ListRuns Lists all runs of an application in the specified compartment.
+	ListRuns(ctx context.Context, request ListRunsRequest) (response ListRunsResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a paginated list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateApplication Updates an application using an `applicationId`.
+	UpdateApplication(ctx context.Context, request UpdateApplicationRequest) (response UpdateApplicationResponse, err error)
+
+	// UpdatePrivateEndpoint Updates a private endpoint using a `privateEndpointId`.  If changes to a private endpoint match
+	// a previously defined private endpoint, then a 409 status code will be returned.  This indicates
+	// that a conflict has been detected.
+	UpdatePrivateEndpoint(ctx context.Context, request UpdatePrivateEndpointRequest) (response UpdatePrivateEndpointResponse, err error)
+
+	// UpdateRun Updates a run using a `runId`.
+	UpdateRun(ctx context.Context, request UpdateRunRequest) (response UpdateRunResponse, err error)
+}
+
+// DataFlowClient implements DataFlowClientAPI
+var _ DataFlowClientAPI = DataFlowClient{}
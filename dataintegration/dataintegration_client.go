@@ -102,10 +102,12 @@ func (client DataIntegrationClient) ChangeCompartment(ctx context.Context, reque
 				response = ChangeCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client DataIntegrationClient) CreateApplication(ctx context.Context, reque
 				response = CreateApplicationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateApplicationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateApplicationResponse")
 	}
@@ -212,10 +216,12 @@ func (client DataIntegrationClient) CreateConnection(ctx context.Context, reques
 				response = CreateConnectionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConnectionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConnectionResponse")
 	}
@@ -267,10 +273,12 @@ func (client DataIntegrationClient) CreateConnectionValidation(ctx context.Conte
 				response = CreateConnectionValidationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConnectionValidationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConnectionValidationResponse")
 	}
@@ -322,10 +330,12 @@ func (client DataIntegrationClient) CreateDataAsset(ctx context.Context, request
 				response = CreateDataAssetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataAssetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataAssetResponse")
 	}
@@ -377,10 +387,12 @@ func (client DataIntegrationClient) CreateDataFlow(ctx context.Context, request
 				response = CreateDataFlowResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataFlowResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataFlowResponse")
 	}
@@ -432,10 +444,12 @@ func (client DataIntegrationClient) CreateDataFlowValidation(ctx context.Context
 				response = CreateDataFlowValidationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataFlowValidationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataFlowValidationResponse")
 	}
@@ -487,10 +501,12 @@ func (client DataIntegrationClient) CreateEntityShape(ctx context.Context, reque
 				response = CreateEntityShapeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateEntityShapeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateEntityShapeResponse")
 	}
@@ -542,10 +558,12 @@ func (client DataIntegrationClient) CreateExternalPublication(ctx context.Contex
 				response = CreateExternalPublicationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateExternalPublicationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateExternalPublicationResponse")
 	}
@@ -597,10 +615,12 @@ func (client DataIntegrationClient) CreateExternalPublicationValidation(ctx cont
 				response = CreateExternalPublicationValidationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateExternalPublicationValidationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateExternalPublicationValidationResponse")
 	}
@@ -653,10 +673,12 @@ func (client DataIntegrationClient) CreateFolder(ctx context.Context, request Cr
 				response = CreateFolderResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateFolderResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateFolderResponse")
 	}
@@ -708,10 +730,12 @@ func (client DataIntegrationClient) CreatePatch(ctx context.Context, request Cre
 				response = CreatePatchResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePatchResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePatchResponse")
 	}
@@ -763,10 +787,12 @@ func (client DataIntegrationClient) CreateProject(ctx context.Context, request C
 				response = CreateProjectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateProjectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateProjectResponse")
 	}
@@ -818,10 +844,12 @@ func (client DataIntegrationClient) CreateTask(ctx context.Context, request Crea
 				response = CreateTaskResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTaskResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTaskResponse")
 	}
@@ -873,10 +901,12 @@ func (client DataIntegrationClient) CreateTaskRun(ctx context.Context, request C
 				response = CreateTaskRunResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTaskRunResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTaskRunResponse")
 	}
@@ -928,10 +958,12 @@ func (client DataIntegrationClient) CreateTaskValidation(ctx context.Context, re
 				response = CreateTaskValidationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTaskValidationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTaskValidationResponse")
 	}
@@ -983,10 +1015,12 @@ func (client DataIntegrationClient) CreateWorkspace(ctx context.Context, request
 				response = CreateWorkspaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateWorkspaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateWorkspaceResponse")
 	}
@@ -4139,10 +4173,12 @@ func (client DataIntegrationClient) StartWorkspace(ctx context.Context, request
 				response = StartWorkspaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartWorkspaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartWorkspaceResponse")
 	}
@@ -4194,10 +4230,12 @@ func (client DataIntegrationClient) StopWorkspace(ctx context.Context, request S
 				response = StopWorkspaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopWorkspaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopWorkspaceResponse")
 	}
@@ -4599,10 +4637,12 @@ func (client DataIntegrationClient) UpdateReference(ctx context.Context, request
 				response = UpdateReferenceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateReferenceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateReferenceResponse")
 	}
@@ -0,0 +1,293 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dataintegration
+
+import (
+	"context"
+)
+
+// DataIntegrationClientAPI is the interface of the operations of DataIntegrationClient, to inject the client and mock it in tests
+type DataIntegrationClientAPI interface {
+	// ChangeCompartment Moves a workspace to a specified compartment.
+	ChangeCompartment(ctx context.Context, request ChangeCompartmentRequest) (response ChangeCompartmentResponse, err error)
+
+	// CreateApplication Creates an application.
+	CreateApplication(ctx context.Context, request CreateApplicationRequest) (response CreateApplicationResponse, err error)
+
+	// CreateConnection Creates a connection under an existing data asset.
+	CreateConnection(ctx context.Context, request CreateConnectionRequest) (response CreateConnectionResponse, err error)
+
+	// CreateConnectionValidation Creates a connection validation.
+	CreateConnectionValidation(ctx context.Context, request CreateConnectionValidationRequest) (response CreateConnectionValidationResponse, err error)
+
+	// CreateDataAsset Creates a data asset with default connection.
+	CreateDataAsset(ctx context.Context, request CreateDataAssetRequest) (response CreateDataAssetResponse, err error)
+
+	// CreateDataFlow Creates a new data flow in a project or folder ready for performing data integrations.
+	CreateDataFlow(ctx context.Context, request CreateDataFlowRequest) (response CreateDataFlowResponse, err error)
+
+	// CreateDataFlowValidation Accepts the data flow definition in the request payload and creates a data flow validation.
+	CreateDataFlowValidation(ctx context.Context, request CreateDataFlowValidationRequest) (response CreateDataFlowValidationResponse, err error)
+
+	// CreateEntityShape Creates the data entity shape using the shape from the data asset.
+	CreateEntityShape(ctx context.Context, request CreateEntityShapeRequest) (response CreateEntityShapeResponse, err error)
+
+	// CreateExternalPublication Publish a DataFlow in a OCI DataFlow application.
+	CreateExternalPublication(ctx context.Context, request CreateExternalPublicationRequest) (response CreateExternalPublicationResponse, err error)
+
+	// CreateExternalPublicationValidation Validates a specific task.
+	CreateExternalPublicationValidation(ctx context.Context, request CreateExternalPublicationValidationRequest) (response CreateExternalPublicationValidationResponse, err error)
+
+	// CreateFolder Creates a folder in a project or in another folder, limited to two levels of folders. |
+	// Folders are used to organize your design-time resources, such as tasks or data flows.
+	CreateFolder(ctx context.Context, request CreateFolderRequest) (response CreateFolderResponse, err error)
+
+	// CreatePatch Creates a patch in an application.
+	CreatePatch(ctx context.Context, request CreatePatchRequest) (response CreatePatchResponse, err error)
+
+	// CreateProject Creates a project. Projects are organizational constructs within a workspace that you use to organize your design-time resources, such as tasks or data flows. Projects can be organized into folders.
+	CreateProject(ctx context.Context, request CreateProjectRequest) (response CreateProjectResponse, err error)
+
+	// CreateTask Creates a new task ready for performing data integrations. There are specialized types of tasks that include data loader and integration tasks.
+	CreateTask(ctx context.Context, request CreateTaskRequest) (response CreateTaskResponse, err error)
+
+	// CreateTaskRun Creates a data integration task run for the specified task.
+	CreateTaskRun(ctx context.Context, request CreateTaskRunRequest) (response CreateTaskRunResponse, err error)
+
+	// CreateTaskValidation Validates a specific task.
+	CreateTaskValidation(ctx context.Context, request CreateTaskValidationRequest) (response CreateTaskValidationResponse, err error)
+
+	// CreateWorkspace Creates a new Data Integration workspace ready for performing data integration tasks.
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequest) (response CreateWorkspaceResponse, err error)
+
+	// DeleteApplication Removes an application using the specified identifier.
+	DeleteApplication(ctx context.Context, request DeleteApplicationRequest) (response DeleteApplicationResponse, err error)
+
+	// DeleteConnection Removes a connection using the specified identifier.
+	DeleteConnection(ctx context.Context, request DeleteConnectionRequest) (response DeleteConnectionResponse, err error)
+
+	// DeleteConnectionValidation Deletes a connection validation.
+	DeleteConnectionValidation(ctx context.Context, request DeleteConnectionValidationRequest) (response DeleteConnectionValidationResponse, err error)
+
+	// DeleteDataAsset Removes a data asset using the specified identifier.
+	DeleteDataAsset(ctx context.Context, request DeleteDataAssetRequest) (response DeleteDataAssetResponse, err error)
+
+	// DeleteDataFlow Removes a data flow from a project or folder using the specified identifier.
+	DeleteDataFlow(ctx context.Context, request DeleteDataFlowRequest) (response DeleteDataFlowResponse, err error)
+
+	// DeleteDataFlowValidation Removes a data flow validation using the specified identifier.
+	DeleteDataFlowValidation(ctx context.Context, request DeleteDataFlowValidationRequest) (response DeleteDataFlowValidationResponse, err error)
+
+	// DeleteExternalPublication Removes a published object using the specified identifier.
+	DeleteExternalPublication(ctx context.Context, request DeleteExternalPublicationRequest) (response DeleteExternalPublicationResponse, err error)
+
+	// DeleteExternalPublicationValidation Removes a task validation using the specified identifier.
+	DeleteExternalPublicationValidation(ctx context.Context, request DeleteExternalPublicationValidationRequest) (response DeleteExternalPublicationValidationResponse, err error)
+
+	// DeleteFolder Removes a folder from a project using the specified identifier.
+	DeleteFolder(ctx context.Context, request DeleteFolderRequest) (response DeleteFolderResponse, err error)
+
+	// DeletePatch Removes a patch using the specified identifier.
+	DeletePatch(ctx context.Context, request DeletePatchRequest) (response DeletePatchResponse, err error)
+
+	// DeleteProject Removes a project from the workspace using the specified identifier.
+	DeleteProject(ctx context.Context, request DeleteProjectRequest) (response DeleteProjectResponse, err error)
+
+	// DeleteTask Removes a task using the specified identifier.
+	DeleteTask(ctx context.Context, request DeleteTaskRequest) (response DeleteTaskResponse, err error)
+
+	// DeleteTaskRun Deletes a task run using the specified identifier.
+	DeleteTaskRun(ctx context.Context, request DeleteTaskRunRequest) (response DeleteTaskRunResponse, err error)
+
+	// DeleteTaskValidation Removes a task validation using the specified identifier.
+	DeleteTaskValidation(ctx context.Context, request DeleteTaskValidationRequest) (response DeleteTaskValidationResponse, err error)
+
+	// DeleteWorkspace Deletes a Data Integration workspace resource using the specified identifier.
+	DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequest) (response DeleteWorkspaceResponse, err error)
+
+	// GetApplication Retrieves an application using the specified identifier.
+	GetApplication(ctx context.Context, request GetApplicationRequest) (response GetApplicationResponse, err error)
+
+	// GetConnection Retrieves the connection details using the specified identifier.
+	GetConnection(ctx context.Context, request GetConnectionRequest) (response GetConnectionResponse, err error)
+
+	// GetConnectionValidation Retrieves a connection validation using the specified identifier.
+	GetConnectionValidation(ctx context.Context, request GetConnectionValidationRequest) (response GetConnectionValidationResponse, err error)
+
+	// GetCountStatistic Retrieves statistics on a workspace. It returns an object with an array of property values, such as the number of projects, |
+	//        applications, data assets, and so on.
+	GetCountStatistic(ctx context.Context, request GetCountStatisticRequest) (response GetCountStatisticResponse, err error)
+
+	// GetDataAsset Retrieves details of a data asset using the specified identifier.
+	GetDataAsset(ctx context.Context, request GetDataAssetRequest) (response GetDataAssetResponse, err error)
+
+	// GetDataEntity Retrieves the data entity details with the given name from live schema.
+	GetDataEntity(ctx context.Context, request GetDataEntityRequest) (response GetDataEntityResponse, err error)
+
+	// GetDataFlow Retrieves a data flow using the specified identifier.
+	GetDataFlow(ctx context.Context, request GetDataFlowRequest) (response GetDataFlowResponse, err error)
+
+	// GetDataFlowValidation Retrieves a data flow validation using the specified identifier.
+	GetDataFlowValidation(ctx context.Context, request GetDataFlowValidationRequest) (response GetDataFlowValidationResponse, err error)
+
+	// GetDependentObject Retrieves the details of a dependent object from an application.
+	GetDependentObject(ctx context.Context, request GetDependentObjectRequest) (response GetDependentObjectResponse, err error)
+
+	// GetExternalPublication Retrieves a publshed object in an task using the specified identifier.
+	GetExternalPublication(ctx context.Context, request GetExternalPublicationRequest) (response GetExternalPublicationResponse, err error)
+
+	// GetExternalPublicationValidation Retrieves an external publication validation using the specified identifier.
+	GetExternalPublicationValidation(ctx context.Context, request GetExternalPublicationValidationRequest) (response GetExternalPublicationValidationResponse, err error)
+
+	// GetFolder Retrieves a folder using the specified identifier.
+	GetFolder(ctx context.Context, request GetFolderRequest) (response GetFolderResponse, err error)
+
+	// GetPatch Retrieves a patch in an application using the specified identifier.
+	GetPatch(ctx context.Context, request GetPatchRequest) (response GetPatchResponse, err error)
+
+	// GetProject Retrieves a project using the specified identifier.
+	GetProject(ctx context.Context, request GetProjectRequest) (response GetProjectResponse, err error)
+
+	// GetPublishedObject Retrieves the details of a published object from an application.
+	GetPublishedObject(ctx context.Context, request GetPublishedObjectRequest) (response GetPublishedObjectResponse, err error)
+
+	// GetReference Retrieves a reference in an application.
+	GetReference(ctx context.Context, request GetReferenceRequest) (response GetReferenceResponse, err error)
+
+	// GetSchema Retrieves a schema that can be accessed using the specified connection.
+	GetSchema(ctx context.Context, request GetSchemaRequest) (response GetSchemaResponse, err error)
+
+	// GetTask Retrieves a task using the specified identifier.
+	GetTask(ctx context.Context, request GetTaskRequest) (response GetTaskResponse, err error)
+
+	// GetTaskRun Retrieves a task run using the specified identifier.
+	GetTaskRun(ctx context.Context, request GetTaskRunRequest) (response GetTaskRunResponse, err error)
+
+	// GetTaskValidation Retrieves a task validation using the specified identifier.
+	GetTaskValidation(ctx context.Context, request GetTaskValidationRequest) (response GetTaskValidationResponse, err error)
+
+	// GetWorkRequest Retrieves the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// GetWorkspace Retrieves a Data Integration workspace using the specified identifier.
+	GetWorkspace(ctx context.Context, request GetWorkspaceRequest) (response GetWorkspaceResponse, err error)
+
+	// ListApplications Retrieves a list of applications and provides options to filter the list.
+	ListApplications(ctx context.Context, request ListApplicationsRequest) (response ListApplicationsResponse, err error)
+
+	// ListConnectionValidations Retrieves a list of connection validations within the specified workspace.
+	ListConnectionValidations(ctx context.Context, request ListConnectionValidationsRequest) (response ListConnectionValidationsResponse, err error)
+
+	// ListConnections Retrieves a list of all connections.
+	ListConnections(ctx context.Context, request ListConnectionsRequest) (response ListConnectionsResponse, err error)
+
+	// ListDataAssets Retrieves a list of all data asset summaries.
+	ListDataAssets(ctx context.Context, request ListDataAssetsRequest) (response ListDataAssetsResponse, err error)
+
+	// ListDataEntities Lists a summary of data entities from the data asset using the specified connection.
+	ListDataEntities(ctx context.Context, request ListDataEntitiesRequest) (response ListDataEntitiesResponse, err error)
+
+	// ListDataFlowValidations Retrieves a list of data flow validations within the specified workspace.
+	ListDataFlowValidations(ctx context.Context, request ListDataFlowValidationsRequest) (response ListDataFlowValidationsResponse, err error)
+
+	// ListDataFlows Retrieves a list of data flows in a project or folder.
+	ListDataFlows(ctx context.Context, request ListDataFlowsRequest) (response ListDataFlowsResponse, err error)
+
+	// ListDependentObjects Retrieves a list of all dependent objects for a specific application.
+	ListDependentObjects(ctx context.Context, request ListDependentObjectsRequest) (response ListDependentObjectsResponse, err error)
+
+	// ListExternalPublicationValidations Retrieves a lists of external publication validations in a workspace and provides options to filter the list.
+	ListExternalPublicationValidations(ctx context.Context, request ListExternalPublicationValidationsRequest) (response ListExternalPublicationValidationsResponse, err error)
+
+	// ListExternalPublications Retrieves a list of external publications in an application and provides options to filter the list.
+	ListExternalPublications(ctx context.Context, request ListExternalPublicationsRequest) (response ListExternalPublicationsResponse, err error)
+
+	// ListFolders Retrieves a list of folders in a project and provides options to filter the list.
+	ListFolders(ctx context.Context, request ListFoldersRequest) (response ListFoldersResponse, err error)
+
+	// ListPatchChanges Retrieves a list of patches in an application and provides options to filter the list.
+	ListPatchChanges(ctx context.Context, request ListPatchChangesRequest) (response ListPatchChangesResponse, err error)
+
+	// ListPatches Retrieves a list of patches in an application and provides options to filter the list. For listing changes based on a period and logical objects changed, see ListPatchChanges API.
+	ListPatches(ctx context.Context, request ListPatchesRequest) (response ListPatchesResponse, err error)
+
+	// ListProjects Retrieves a lists of projects in a workspace and provides options to filter the list.
+	ListProjects(ctx context.Context, request ListProjectsRequest) (response ListProjectsResponse, err error)
+
+	// ListPublishedObjects Retrieves a list of all the published objects for a specified application.
+	ListPublishedObjects(ctx context.Context, request ListPublishedObjectsRequest) (response ListPublishedObjectsResponse, err error)
+
+	// ListReferences Retrieves a list of references in an application. Reference objects are created when dataflows and tasks use objects, such as data assets and connections.
+	ListReferences(ctx context.Context, request ListReferencesRequest) (response ListReferencesResponse, err error)
+
+	// ListSchemas Retrieves a list of all the schemas that can be accessed using the specified connection.
+	ListSchemas(ctx context.Context, request ListSchemasRequest) (response ListSchemasResponse, err error)
+
+	// ListTaskRunLogs Gets log entries for task runs using its key.
+	ListTaskRunLogs(ctx context.Context, request ListTaskRunLogsRequest) (response ListTaskRunLogsResponse, err error)
+
+	// ListTaskRuns Retrieves a list of task runs and provides options to filter the list.
+	ListTaskRuns(ctx context.Context, request ListTaskRunsRequest) (response ListTaskRunsResponse, err error)
+
+	// ListTaskValidations Retrieves a list of task validations within the specified workspace.
+	ListTaskValidations(ctx context.Context, request ListTaskValidationsRequest) (response ListTaskValidationsResponse, err error)
+
+	// ListTasks Retrieves a list of all tasks in a specified project or folder.
+	ListTasks(ctx context.Context, request ListTasksRequest) (response ListTasksResponse, err error)
+
+	// ListWorkRequestErrors Retrieves a paginated list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Retrieves a paginated list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// ListWorkspaces Retrieves a list of Data Integration workspaces.
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequest) (response ListWorkspacesResponse, err error)
+
+	// StartWorkspace Starts a workspace.
+	StartWorkspace(ctx context.Context, request StartWorkspaceRequest) (response StartWorkspaceResponse, err error)
+
+	// StopWorkspace Stops a workspace.
+	StopWorkspace(ctx context.Context, request StopWorkspaceRequest) (response StopWorkspaceResponse, err error)
+
+	// UpdateApplication Updates an application.
+	UpdateApplication(ctx context.Context, request UpdateApplicationRequest) (response UpdateApplicationResponse, err error)
+
+	// UpdateConnection Updates a connection under a data asset.
+	UpdateConnection(ctx context.Context, request UpdateConnectionRequest) (response UpdateConnectionResponse, err error)
+
+	// UpdateDataAsset Updates a specific data asset with default connection.
+	UpdateDataAsset(ctx context.Context, request UpdateDataAssetRequest) (response UpdateDataAssetResponse, err error)
+
+	// UpdateDataFlow Updates a specific data flow.
+	UpdateDataFlow(ctx context.Context, request UpdateDataFlowRequest) (response UpdateDataFlowResponse, err error)
+
+	// UpdateExternalPublication Updates the external publication object.
+	UpdateExternalPublication(ctx context.Context, request UpdateExternalPublicationRequest) (response UpdateExternalPublicationResponse, err error)
+
+	// UpdateFolder Updates a specific folder.
+	UpdateFolder(ctx context.Context, request UpdateFolderRequest) (response UpdateFolderResponse, err error)
+
+	// UpdateProject Updates a specific project.
+	UpdateProject(ctx context.Context, request UpdateProjectRequest) (response UpdateProjectResponse, err error)
+
+	// UpdateReference Updates the application references. For example, to map a data asset to a different target object.
+	UpdateReference(ctx context.Context, request UpdateReferenceRequest) (response UpdateReferenceResponse, err error)
+
+	// UpdateTask Updates a specific task. For example, you can update the task description or move the task to a different folder by changing the `aggregatorKey` to a different folder in the registry.
+	UpdateTask(ctx context.Context, request UpdateTaskRequest) (response UpdateTaskResponse, err error)
+
+	// UpdateTaskRun Updates the status of the task run. For example, aborts a task run.
+	UpdateTaskRun(ctx context.Context, request UpdateTaskRunRequest) (response UpdateTaskRunResponse, err error)
+
+	// UpdateWorkspace Updates the specified Data Integration workspace.
+	UpdateWorkspace(ctx context.Context, request UpdateWorkspaceRequest) (response UpdateWorkspaceResponse, err error)
+}
+
+// DataIntegrationClient implements DataIntegrationClientAPI
+var _ DataIntegrationClientAPI = DataIntegrationClient{}
@@ -0,0 +1,643 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dataintegration
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*AbstractDataOperationConfig)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractdataoperationconfig{} })
+	common.RegisterPolymorphicModel((*AbstractFormatAttribute)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractformatattribute{} })
+	common.RegisterPolymorphicModel((*AbstractReadAttribute)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractreadattribute{} })
+	common.RegisterPolymorphicModel((*AbstractWriteAttribute)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractwriteattribute{} })
+	common.RegisterPolymorphicModel((*BaseType)(nil), func() common.PolymorphicJSONUnmarshaler { return &basetype{} })
+	common.RegisterPolymorphicModel((*Connection)(nil), func() common.PolymorphicJSONUnmarshaler { return &connection{} })
+	common.RegisterPolymorphicModel((*ConnectionDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &connectiondetails{} })
+	common.RegisterPolymorphicModel((*ConnectionSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &connectionsummary{} })
+	common.RegisterPolymorphicModel((*CreateConnectionDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createconnectiondetails{} })
+	common.RegisterPolymorphicModel((*CreateDataAssetDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createdataassetdetails{} })
+	common.RegisterPolymorphicModel((*CreateEntityShapeDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createentityshapedetails{} })
+	common.RegisterPolymorphicModel((*CreateTaskDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createtaskdetails{} })
+	common.RegisterPolymorphicModel((*CreateTaskValidationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createtaskvalidationdetails{} })
+	common.RegisterPolymorphicModel((*DataAsset)(nil), func() common.PolymorphicJSONUnmarshaler { return &dataasset{} })
+	common.RegisterPolymorphicModel((*DataAssetSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &dataassetsummary{} })
+	common.RegisterPolymorphicModel((*DataEntity)(nil), func() common.PolymorphicJSONUnmarshaler { return &dataentity{} })
+	common.RegisterPolymorphicModel((*DataEntityDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &dataentitydetails{} })
+	common.RegisterPolymorphicModel((*DataEntitySummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &dataentitysummary{} })
+	common.RegisterPolymorphicModel((*DynamicTypeHandler)(nil), func() common.PolymorphicJSONUnmarshaler { return &dynamictypehandler{} })
+	common.RegisterPolymorphicModel((*EntityShape)(nil), func() common.PolymorphicJSONUnmarshaler { return &entityshape{} })
+	common.RegisterPolymorphicModel((*FieldMap)(nil), func() common.PolymorphicJSONUnmarshaler { return &fieldmap{} })
+	common.RegisterPolymorphicModel((*FlowPortLink)(nil), func() common.PolymorphicJSONUnmarshaler { return &flowportlink{} })
+	common.RegisterPolymorphicModel((*Key)(nil), func() common.PolymorphicJSONUnmarshaler { return &key{} })
+	common.RegisterPolymorphicModel((*Operator)(nil), func() common.PolymorphicJSONUnmarshaler { return &operator{} })
+	common.RegisterPolymorphicModel((*PartitionConfig)(nil), func() common.PolymorphicJSONUnmarshaler { return &partitionconfig{} })
+	common.RegisterPolymorphicModel((*ProjectionRule)(nil), func() common.PolymorphicJSONUnmarshaler { return &projectionrule{} })
+	common.RegisterPolymorphicModel((*PublishedObject)(nil), func() common.PolymorphicJSONUnmarshaler { return &publishedobject{} })
+	common.RegisterPolymorphicModel((*PublishedObjectSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &publishedobjectsummary{} })
+	common.RegisterPolymorphicModel((*PushDownOperation)(nil), func() common.PolymorphicJSONUnmarshaler { return &pushdownoperation{} })
+	common.RegisterPolymorphicModel((*Task)(nil), func() common.PolymorphicJSONUnmarshaler { return &task{} })
+	common.RegisterPolymorphicModel((*TaskSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &tasksummary{} })
+	common.RegisterPolymorphicModel((*TypedObject)(nil), func() common.PolymorphicJSONUnmarshaler { return &typedobject{} })
+	common.RegisterPolymorphicModel((*UpdateConnectionDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateconnectiondetails{} })
+	common.RegisterPolymorphicModel((*UpdateDataAssetDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updatedataassetdetails{} })
+	common.RegisterPolymorphicModel((*UpdateTaskDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updatetaskdetails{} })
+}
+
+// MarshalJSON marshals a AbstractDataOperationConfig whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractdataoperationconfig) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractdataoperationconfig abstractdataoperationconfig
+	s := struct {
+		MarshalTypeabstractdataoperationconfig
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractdataoperationconfig: (MarshalTypeabstractdataoperationconfig)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AbstractFormatAttribute whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractformatattribute) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractformatattribute abstractformatattribute
+	s := struct {
+		MarshalTypeabstractformatattribute
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractformatattribute: (MarshalTypeabstractformatattribute)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AbstractReadAttribute whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractreadattribute) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractreadattribute abstractreadattribute
+	s := struct {
+		MarshalTypeabstractreadattribute
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractreadattribute: (MarshalTypeabstractreadattribute)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AbstractWriteAttribute whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractwriteattribute) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractwriteattribute abstractwriteattribute
+	s := struct {
+		MarshalTypeabstractwriteattribute
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractwriteattribute: (MarshalTypeabstractwriteattribute)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a BaseType whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m basetype) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypebasetype basetype
+	s := struct {
+		MarshalTypebasetype
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypebasetype: (MarshalTypebasetype)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Connection whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m connection) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconnection connection
+	s := struct {
+		MarshalTypeconnection
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconnection: (MarshalTypeconnection)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ConnectionDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m connectiondetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconnectiondetails connectiondetails
+	s := struct {
+		MarshalTypeconnectiondetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconnectiondetails: (MarshalTypeconnectiondetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ConnectionSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m connectionsummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconnectionsummary connectionsummary
+	s := struct {
+		MarshalTypeconnectionsummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconnectionsummary: (MarshalTypeconnectionsummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateConnectionDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createconnectiondetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateconnectiondetails createconnectiondetails
+	s := struct {
+		MarshalTypecreateconnectiondetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateconnectiondetails: (MarshalTypecreateconnectiondetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateDataAssetDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createdataassetdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatedataassetdetails createdataassetdetails
+	s := struct {
+		MarshalTypecreatedataassetdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatedataassetdetails: (MarshalTypecreatedataassetdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateEntityShapeDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createentityshapedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateentityshapedetails createentityshapedetails
+	s := struct {
+		MarshalTypecreateentityshapedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateentityshapedetails: (MarshalTypecreateentityshapedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateTaskDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createtaskdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatetaskdetails createtaskdetails
+	s := struct {
+		MarshalTypecreatetaskdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatetaskdetails: (MarshalTypecreatetaskdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateTaskValidationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createtaskvalidationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatetaskvalidationdetails createtaskvalidationdetails
+	s := struct {
+		MarshalTypecreatetaskvalidationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatetaskvalidationdetails: (MarshalTypecreatetaskvalidationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DataAsset whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dataasset) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedataasset dataasset
+	s := struct {
+		MarshalTypedataasset
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedataasset: (MarshalTypedataasset)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DataAssetSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dataassetsummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedataassetsummary dataassetsummary
+	s := struct {
+		MarshalTypedataassetsummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedataassetsummary: (MarshalTypedataassetsummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DataEntity whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dataentity) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedataentity dataentity
+	s := struct {
+		MarshalTypedataentity
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedataentity: (MarshalTypedataentity)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DataEntityDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dataentitydetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedataentitydetails dataentitydetails
+	s := struct {
+		MarshalTypedataentitydetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedataentitydetails: (MarshalTypedataentitydetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DataEntitySummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dataentitysummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedataentitysummary dataentitysummary
+	s := struct {
+		MarshalTypedataentitysummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedataentitysummary: (MarshalTypedataentitysummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DynamicTypeHandler whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dynamictypehandler) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedynamictypehandler dynamictypehandler
+	s := struct {
+		MarshalTypedynamictypehandler
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedynamictypehandler: (MarshalTypedynamictypehandler)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a EntityShape whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m entityshape) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeentityshape entityshape
+	s := struct {
+		MarshalTypeentityshape
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeentityshape: (MarshalTypeentityshape)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a FieldMap whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m fieldmap) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypefieldmap fieldmap
+	s := struct {
+		MarshalTypefieldmap
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypefieldmap: (MarshalTypefieldmap)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a FlowPortLink whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m flowportlink) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeflowportlink flowportlink
+	s := struct {
+		MarshalTypeflowportlink
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeflowportlink: (MarshalTypeflowportlink)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Key whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m key) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypekey key
+	s := struct {
+		MarshalTypekey
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypekey: (MarshalTypekey)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Operator whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m operator) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeoperator operator
+	s := struct {
+		MarshalTypeoperator
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeoperator: (MarshalTypeoperator)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a PartitionConfig whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m partitionconfig) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypepartitionconfig partitionconfig
+	s := struct {
+		MarshalTypepartitionconfig
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypepartitionconfig: (MarshalTypepartitionconfig)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ProjectionRule whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m projectionrule) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeprojectionrule projectionrule
+	s := struct {
+		MarshalTypeprojectionrule
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeprojectionrule: (MarshalTypeprojectionrule)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a PublishedObject whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m publishedobject) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypepublishedobject publishedobject
+	s := struct {
+		MarshalTypepublishedobject
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypepublishedobject: (MarshalTypepublishedobject)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a PublishedObjectSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m publishedobjectsummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypepublishedobjectsummary publishedobjectsummary
+	s := struct {
+		MarshalTypepublishedobjectsummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypepublishedobjectsummary: (MarshalTypepublishedobjectsummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a PushDownOperation whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m pushdownoperation) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypepushdownoperation pushdownoperation
+	s := struct {
+		MarshalTypepushdownoperation
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypepushdownoperation: (MarshalTypepushdownoperation)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Task whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m task) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypetask task
+	s := struct {
+		MarshalTypetask
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypetask: (MarshalTypetask)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a TaskSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m tasksummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypetasksummary tasksummary
+	s := struct {
+		MarshalTypetasksummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypetasksummary: (MarshalTypetasksummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a TypedObject whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m typedobject) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypetypedobject typedobject
+	s := struct {
+		MarshalTypetypedobject
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypetypedobject: (MarshalTypetypedobject)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateConnectionDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateconnectiondetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateconnectiondetails updateconnectiondetails
+	s := struct {
+		MarshalTypeupdateconnectiondetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateconnectiondetails: (MarshalTypeupdateconnectiondetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateDataAssetDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updatedataassetdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdatedataassetdetails updatedataassetdetails
+	s := struct {
+		MarshalTypeupdatedataassetdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdatedataassetdetails: (MarshalTypeupdatedataassetdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateTaskDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updatetaskdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdatetaskdetails updatetaskdetails
+	s := struct {
+		MarshalTypeupdatetaskdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdatetaskdetails: (MarshalTypeupdatetaskdetails)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -0,0 +1,842 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dataintegration
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetAbstractDataOperationConfigModelTypeEnumValues(),
+		GetAbstractFormatAttributeModelTypeEnumValues(),
+		GetAbstractReadAttributeModelTypeEnumValues(),
+		GetAbstractWriteAttributeModelTypeEnumValues(),
+		GetBaseTypeModelTypeEnumValues(),
+		GetChildReferenceTypeEnumValues(),
+		GetConnectionDetailsModelTypeEnumValues(),
+		GetConnectionModelTypeEnumValues(),
+		GetConnectionSummaryModelTypeEnumValues(),
+		GetCountStatisticSummaryObjectTypeEnumValues(),
+		GetCreateApplicationDetailsModelTypeEnumValues(),
+		GetCreateConnectionDetailsModelTypeEnumValues(),
+		GetCreateDataAssetDetailsModelTypeEnumValues(),
+		GetCreateEntityShapeDetailsModelTypeEnumValues(),
+		GetCreateEntityShapeFromFileEntityTypeEnumValues(),
+		GetCreatePatchDetailsPatchTypeEnumValues(),
+		GetCreateTaskDetailsModelTypeEnumValues(),
+		GetCreateTaskValidationDetailsModelTypeEnumValues(),
+		GetDataAssetModelTypeEnumValues(),
+		GetDataAssetSummaryModelTypeEnumValues(),
+		GetDataEntityDetailsModelTypeEnumValues(),
+		GetDataEntityFromFileEntityDetailsEntityTypeEnumValues(),
+		GetDataEntityFromFileEntityTypeEnumValues(),
+		GetDataEntityFromTableEntityDetailsEntityTypeEnumValues(),
+		GetDataEntityFromTableEntityTypeEnumValues(),
+		GetDataEntityFromViewEntityDetailsEntityTypeEnumValues(),
+		GetDataEntityFromViewEntityTypeEnumValues(),
+		GetDataEntityModelTypeEnumValues(),
+		GetDataEntitySummaryFromFileEntityTypeEnumValues(),
+		GetDataEntitySummaryFromTableEntityTypeEnumValues(),
+		GetDataEntitySummaryFromViewEntityTypeEnumValues(),
+		GetDataEntitySummaryModelTypeEnumValues(),
+		GetDataFormatTypeEnumValues(),
+		GetDataTypeDtTypeEnumValues(),
+		GetDynamicTypeHandlerModelTypeEnumValues(),
+		GetEntityShapeFromFileEntityTypeEnumValues(),
+		GetEntityShapeModelTypeEnumValues(),
+		GetExternalPublicationStatusEnumValues(),
+		GetExternalPublicationSummaryStatusEnumValues(),
+		GetFieldMapModelTypeEnumValues(),
+		GetFlowPortLinkModelTypeEnumValues(),
+		GetInputPortPortTypeEnumValues(),
+		GetJoinPolicyEnumValues(),
+		GetJoinerJoinTypeEnumValues(),
+		GetKeyModelTypeEnumValues(),
+		GetListApplicationsSortByEnumValues(),
+		GetListApplicationsSortOrderEnumValues(),
+		GetListConnectionValidationsSortByEnumValues(),
+		GetListConnectionValidationsSortOrderEnumValues(),
+		GetListConnectionsSortByEnumValues(),
+		GetListConnectionsSortOrderEnumValues(),
+		GetListDataAssetsSortByEnumValues(),
+		GetListDataAssetsSortOrderEnumValues(),
+		GetListDataEntitiesSortByEnumValues(),
+		GetListDataEntitiesSortOrderEnumValues(),
+		GetListDataFlowValidationsSortByEnumValues(),
+		GetListDataFlowValidationsSortOrderEnumValues(),
+		GetListDataFlowsSortByEnumValues(),
+		GetListDataFlowsSortOrderEnumValues(),
+		GetListDependentObjectsSortByEnumValues(),
+		GetListDependentObjectsSortOrderEnumValues(),
+		GetListExternalPublicationValidationsSortByEnumValues(),
+		GetListExternalPublicationValidationsSortOrderEnumValues(),
+		GetListExternalPublicationsSortByEnumValues(),
+		GetListExternalPublicationsSortOrderEnumValues(),
+		GetListFoldersSortByEnumValues(),
+		GetListFoldersSortOrderEnumValues(),
+		GetListPatchChangesSortByEnumValues(),
+		GetListPatchChangesSortOrderEnumValues(),
+		GetListPatchesSortByEnumValues(),
+		GetListPatchesSortOrderEnumValues(),
+		GetListProjectsSortByEnumValues(),
+		GetListProjectsSortOrderEnumValues(),
+		GetListPublishedObjectsSortByEnumValues(),
+		GetListPublishedObjectsSortOrderEnumValues(),
+		GetListReferencesSortByEnumValues(),
+		GetListReferencesSortOrderEnumValues(),
+		GetListSchemasSortByEnumValues(),
+		GetListSchemasSortOrderEnumValues(),
+		GetListTaskRunLogsSortByEnumValues(),
+		GetListTaskRunLogsSortOrderEnumValues(),
+		GetListTaskRunsSortByEnumValues(),
+		GetListTaskRunsSortOrderEnumValues(),
+		GetListTaskValidationsSortByEnumValues(),
+		GetListTaskValidationsSortOrderEnumValues(),
+		GetListTasksSortByEnumValues(),
+		GetListTasksSortOrderEnumValues(),
+		GetListWorkRequestErrorsSortByEnumValues(),
+		GetListWorkRequestErrorsSortOrderEnumValues(),
+		GetListWorkRequestLogsSortByEnumValues(),
+		GetListWorkRequestLogsSortOrderEnumValues(),
+		GetListWorkRequestsSortByEnumValues(),
+		GetListWorkRequestsSortOrderEnumValues(),
+		GetListWorkRequestsWorkRequestStatusEnumValues(),
+		GetListWorkspacesSortByEnumValues(),
+		GetListWorkspacesSortOrderEnumValues(),
+		GetMessageTypeEnumValues(),
+		GetNameListRuleMatchingStrategyEnumValues(),
+		GetNameListRuleRuleTypeEnumValues(),
+		GetNamePatternRuleMatchingStrategyEnumValues(),
+		GetNamePatternRuleRuleTypeEnumValues(),
+		GetOperatorModelTypeEnumValues(),
+		GetOracleAdwcWriteAttributesModelTypeEnumValues(),
+		GetOracleAtpWriteAttributesModelTypeEnumValues(),
+		GetOracleReadAttributesModelTypeEnumValues(),
+		GetOracleWriteAttributesModelTypeEnumValues(),
+		GetOutputPortPortTypeEnumValues(),
+		GetParameterOutputAggregationTypeEnumValues(),
+		GetPartitionConfigModelTypeEnumValues(),
+		GetPatchChangeSummaryActionEnumValues(),
+		GetPatchChangeSummaryTypeEnumValues(),
+		GetPatchObjectMetadataActionEnumValues(),
+		GetPatchObjectMetadataTypeEnumValues(),
+		GetPatchPatchStatusEnumValues(),
+		GetPatchPatchTypeEnumValues(),
+		GetPatchSummaryPatchStatusEnumValues(),
+		GetPatchSummaryPatchTypeEnumValues(),
+		GetPrimaryKeyModelTypeEnumValues(),
+		GetProjectionRuleModelTypeEnumValues(),
+		GetPublishedObjectModelTypeEnumValues(),
+		GetPublishedObjectSummaryModelTypeEnumValues(),
+		GetPushDownOperationModelTypeEnumValues(),
+		GetReferenceSummaryTypeEnumValues(),
+		GetReferenceTypeEnumValues(),
+		GetRuleBasedFieldMapMapTypeEnumValues(),
+		GetSortClauseOrderEnumValues(),
+		GetStructuredTypeDtTypeEnumValues(),
+		GetStructuredTypeModelTypeEnumValues(),
+		GetTargetDataPropertyEnumValues(),
+		GetTaskModelTypeEnumValues(),
+		GetTaskRunDetailsStatusEnumValues(),
+		GetTaskRunDetailsTaskTypeEnumValues(),
+		GetTaskRunStatusEnumValues(),
+		GetTaskRunSummaryStatusEnumValues(),
+		GetTaskRunSummaryTaskTypeEnumValues(),
+		GetTaskRunTaskTypeEnumValues(),
+		GetTaskSummaryModelTypeEnumValues(),
+		GetTypeListRuleMatchingStrategyEnumValues(),
+		GetTypeListRuleRuleTypeEnumValues(),
+		GetTypedNamePatternRuleMatchingStrategyEnumValues(),
+		GetTypedNamePatternRuleRuleTypeEnumValues(),
+		GetTypedObjectModelTypeEnumValues(),
+		GetUpdateConnectionDetailsModelTypeEnumValues(),
+		GetUpdateDataAssetDetailsModelTypeEnumValues(),
+		GetUpdateTaskDetailsModelTypeEnumValues(),
+		GetUpdateTaskRunDetailsStatusEnumValues(),
+		GetWorkRequestOperationTypeEnumValues(),
+		GetWorkRequestResourceActionTypeEnumValues(),
+		GetWorkRequestStatusEnumValues(),
+		GetWorkRequestSummaryOperationTypeEnumValues(),
+		GetWorkRequestSummaryStatusEnumValues(),
+		GetWorkspaceLifecycleStateEnumValues(),
+		GetWriteOperationConfigWriteModeEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateApplicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateConnectionValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDataAssetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDataFlowRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateDataFlowValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateEntityShapeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateExternalPublicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateExternalPublicationValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateFolderRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreatePatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateProjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTaskRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateTaskValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteApplicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteConnectionValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDataAssetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDataFlowRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteDataFlowValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteExternalPublicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteExternalPublicationValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteFolderRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeletePatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteProjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTaskRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteTaskValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetApplicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConnectionValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetCountStatisticRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDataAssetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDataEntityRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDataFlowRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDataFlowValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetDependentObjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExternalPublicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetExternalPublicationValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetFolderRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetPatchRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetProjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetPublishedObjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetReferenceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSchemaRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTaskRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetTaskValidationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListApplicationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListConnectionValidationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListConnectionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDataAssetsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDataEntitiesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDataFlowValidationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDataFlowsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDependentObjectsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListExternalPublicationValidationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListExternalPublicationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListFoldersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListPatchChangesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListPatchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListProjectsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListPublishedObjectsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListReferencesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSchemasRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTaskRunLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTaskRunsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTaskValidationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListTasksRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkspacesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StartWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request StopWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateApplicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateConnectionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDataAssetRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateDataFlowRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateExternalPublicationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateFolderRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateProjectRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateReferenceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateTaskRunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateWorkspaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ApplicationDetails, see common.ValidateStruct
+func (m ApplicationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeCompartmentDetails, see common.ValidateStruct
+func (m ChangeCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConfigurationDetails, see common.ValidateStruct
+func (m ConfigurationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromAdwcDetails, see common.ValidateStruct
+func (m ConnectionFromAdwcDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromAtpDetails, see common.ValidateStruct
+func (m ConnectionFromAtpDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromJdbcDetails, see common.ValidateStruct
+func (m ConnectionFromJdbcDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromMySqlDetails, see common.ValidateStruct
+func (m ConnectionFromMySqlDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromObjectStorageDetails, see common.ValidateStruct
+func (m ConnectionFromObjectStorageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ConnectionFromOracleDetails, see common.ValidateStruct
+func (m ConnectionFromOracleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateApplicationDetails, see common.ValidateStruct
+func (m CreateApplicationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateConnectionValidationDetails, see common.ValidateStruct
+func (m CreateConnectionValidationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDataFlowDetails, see common.ValidateStruct
+func (m CreateDataFlowDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateDataFlowValidationDetails, see common.ValidateStruct
+func (m CreateDataFlowValidationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateExternalPublicationDetails, see common.ValidateStruct
+func (m CreateExternalPublicationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateExternalPublicationValidationDetails, see common.ValidateStruct
+func (m CreateExternalPublicationValidationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateFolderDetails, see common.ValidateStruct
+func (m CreateFolderDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreatePatchDetails, see common.ValidateStruct
+func (m CreatePatchDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateProjectDetails, see common.ValidateStruct
+func (m CreateProjectDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateTaskRunDetails, see common.ValidateStruct
+func (m CreateTaskRunDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateWorkspaceDetails, see common.ValidateStruct
+func (m CreateWorkspaceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataAssetFromAdwcDetails, see common.ValidateStruct
+func (m DataAssetFromAdwcDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataAssetFromAtpDetails, see common.ValidateStruct
+func (m DataAssetFromAtpDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataAssetFromObjectStorageDetails, see common.ValidateStruct
+func (m DataAssetFromObjectStorageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataAssetFromOracleDetails, see common.ValidateStruct
+func (m DataAssetFromOracleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataEntityFromFileEntityDetails, see common.ValidateStruct
+func (m DataEntityFromFileEntityDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataEntityFromTableEntityDetails, see common.ValidateStruct
+func (m DataEntityFromTableEntityDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataEntityFromViewEntityDetails, see common.ValidateStruct
+func (m DataEntityFromViewEntityDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DataFlowDetails, see common.ValidateStruct
+func (m DataFlowDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ErrorDetails, see common.ValidateStruct
+func (m ErrorDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of FolderDetails, see common.ValidateStruct
+func (m FolderDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ProjectDetails, see common.ValidateStruct
+func (m ProjectDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TaskFromDataLoaderTaskDetails, see common.ValidateStruct
+func (m TaskFromDataLoaderTaskDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TaskFromIntegrationTaskDetails, see common.ValidateStruct
+func (m TaskFromIntegrationTaskDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TaskRunDetails, see common.ValidateStruct
+func (m TaskRunDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateApplicationDetails, see common.ValidateStruct
+func (m UpdateApplicationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateDataFlowDetails, see common.ValidateStruct
+func (m UpdateDataFlowDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateExternalPublicationDetails, see common.ValidateStruct
+func (m UpdateExternalPublicationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateFolderDetails, see common.ValidateStruct
+func (m UpdateFolderDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateProjectDetails, see common.ValidateStruct
+func (m UpdateProjectDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateReferenceDetails, see common.ValidateStruct
+func (m UpdateReferenceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateTaskRunDetails, see common.ValidateStruct
+func (m UpdateTaskRunDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateWorkspaceDetails, see common.ValidateStruct
+func (m UpdateWorkspaceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
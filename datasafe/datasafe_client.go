@@ -152,10 +152,12 @@ func (client DataSafeClient) CreateDataSafePrivateEndpoint(ctx context.Context,
 				response = CreateDataSafePrivateEndpointResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDataSafePrivateEndpointResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDataSafePrivateEndpointResponse")
 	}
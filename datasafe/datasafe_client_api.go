@@ -0,0 +1,51 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package datasafe
+
+import (
+	"context"
+)
+
+// DataSafeClientAPI is the interface of the operations of DataSafeClient, to inject the client and mock it in tests
+type DataSafeClientAPI interface {
+	// ChangeDataSafePrivateEndpointCompartment Moves the Data Safe private endpoint and its dependent resources to the specified compartment.
+	ChangeDataSafePrivateEndpointCompartment(ctx context.Context, request ChangeDataSafePrivateEndpointCompartmentRequest) (response ChangeDataSafePrivateEndpointCompartmentResponse, err error)
+
+	// CreateDataSafePrivateEndpoint Creates a new Data Safe private endpoint.
+	CreateDataSafePrivateEndpoint(ctx context.Context, request CreateDataSafePrivateEndpointRequest) (response CreateDataSafePrivateEndpointResponse, err error)
+
+	// DeleteDataSafePrivateEndpoint Deletes the specified Data Safe private endpoint.
+	DeleteDataSafePrivateEndpoint(ctx context.Context, request DeleteDataSafePrivateEndpointRequest) (response DeleteDataSafePrivateEndpointResponse, err error)
+
+	// EnableDataSafeConfiguration Enables Data Safe in the tenancy and region.
+	EnableDataSafeConfiguration(ctx context.Context, request EnableDataSafeConfigurationRequest) (response EnableDataSafeConfigurationResponse, err error)
+
+	// GetDataSafeConfiguration Gets the details of the Data Safe configuration.
+	GetDataSafeConfiguration(ctx context.Context, request GetDataSafeConfigurationRequest) (response GetDataSafeConfigurationResponse, err error)
+
+	// GetDataSafePrivateEndpoint Gets the details of the specified Data Safe private endpoint.
+	GetDataSafePrivateEndpoint(ctx context.Context, request GetDataSafePrivateEndpointRequest) (response GetDataSafePrivateEndpointResponse, err error)
+
+	// GetWorkRequest Gets the details of the specified work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListDataSafePrivateEndpoints Gets a list of Data Safe private endpoints.
+	ListDataSafePrivateEndpoints(ctx context.Context, request ListDataSafePrivateEndpointsRequest) (response ListDataSafePrivateEndpointsResponse, err error)
+
+	// ListWorkRequestErrors Gets a list of errors for the specified work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Gets a list of log entries for the specified work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Gets a list of work requests.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateDataSafePrivateEndpoint Updates one or more attributes of the specified Data Safe private endpoint.
+	UpdateDataSafePrivateEndpoint(ctx context.Context, request UpdateDataSafePrivateEndpointRequest) (response UpdateDataSafePrivateEndpointResponse, err error)
+}
+
+// DataSafeClient implements DataSafeClientAPI
+var _ DataSafeClientAPI = DataSafeClient{}
@@ -252,10 +252,12 @@ func (client DataScienceClient) ChangeModelCompartment(ctx context.Context, requ
 				response = ChangeModelCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeModelCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeModelCompartmentResponse")
 	}
@@ -307,10 +309,12 @@ func (client DataScienceClient) ChangeNotebookSessionCompartment(ctx context.Con
 				response = ChangeNotebookSessionCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeNotebookSessionCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeNotebookSessionCompartmentResponse")
 	}
@@ -362,10 +366,12 @@ func (client DataScienceClient) ChangeProjectCompartment(ctx context.Context, re
 				response = ChangeProjectCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeProjectCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeProjectCompartmentResponse")
 	}
@@ -417,10 +423,12 @@ func (client DataScienceClient) CreateModel(ctx context.Context, request CreateM
 				response = CreateModelResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateModelResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateModelResponse")
 	}
@@ -472,10 +480,12 @@ func (client DataScienceClient) CreateModelArtifact(ctx context.Context, request
 				response = CreateModelArtifactResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateModelArtifactResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateModelArtifactResponse")
 	}
@@ -537,10 +547,12 @@ func (client DataScienceClient) CreateModelProvenance(ctx context.Context, reque
 				response = CreateModelProvenanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateModelProvenanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateModelProvenanceResponse")
 	}
@@ -592,10 +604,12 @@ func (client DataScienceClient) CreateNotebookSession(ctx context.Context, reque
 				response = CreateNotebookSessionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNotebookSessionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNotebookSessionResponse")
 	}
@@ -647,10 +661,12 @@ func (client DataScienceClient) CreateProject(ctx context.Context, request Creat
 				response = CreateProjectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateProjectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateProjectResponse")
 	}
@@ -0,0 +1,119 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package datascience
+
+import (
+	"context"
+)
+
+// DataScienceClientAPI is the interface of the operations of DataScienceClient, to inject the client and mock it in tests
+type DataScienceClientAPI interface {
+	// ActivateModel Activates the model.
+	ActivateModel(ctx context.Context, request ActivateModelRequest) (response ActivateModelResponse, err error)
+
+	// ActivateNotebookSession Activates the notebook session.
+	ActivateNotebookSession(ctx context.Context, request ActivateNotebookSessionRequest) (response ActivateNotebookSessionResponse, err error)
+
+	// CancelWorkRequest Cancels a work request that has not started.
+	CancelWorkRequest(ctx context.Context, request CancelWorkRequestRequest) (response CancelWorkRequestResponse, err error)
+
+	// ChangeModelCompartment Moves a model resource into a different compartment.
+	ChangeModelCompartment(ctx context.Context, request ChangeModelCompartmentRequest) (response ChangeModelCompartmentResponse, err error)
+
+	// ChangeNotebookSessionCompartment Moves a notebook session resource into a different compartment.
+	ChangeNotebookSessionCompartment(ctx context.Context, request ChangeNotebookSessionCompartmentRequest) (response ChangeNotebookSessionCompartmentResponse, err error)
+
+	// ChangeProjectCompartment Moves a project resource into a different compartment.
+	ChangeProjectCompartment(ctx context.Context, request ChangeProjectCompartmentRequest) (response ChangeProjectCompartmentResponse, err error)
+
+	// CreateModel Creates a new model.
+	CreateModel(ctx context.Context, request CreateModelRequest) (response CreateModelResponse, err error)
+
+	// CreateModelArtifact Creates model artifact for specified model.
+	CreateModelArtifact(ctx context.Context, request CreateModelArtifactRequest) (response CreateModelArtifactResponse, err error)
+
+	// CreateModelProvenance Creates provenance information for the specified model.
+	CreateModelProvenance(ctx context.Context, request CreateModelProvenanceRequest) (response CreateModelProvenanceResponse, err error)
+
+	// CreateNotebookSession Creates a new notebook session.
+	CreateNotebookSession(ctx context.Context, request CreateNotebookSessionRequest) (response CreateNotebookSessionResponse, err error)
+
+	// CreateProject Creates a new project.
+	CreateProject(ctx context.Context, request CreateProjectRequest) (response CreateProjectResponse, err error)
+
+	// DeactivateModel Deactivates the model.
+	DeactivateModel(ctx context.Context, request DeactivateModelRequest) (response DeactivateModelResponse, err error)
+
+	// DeactivateNotebookSession Deactivates the notebook session.
+	DeactivateNotebookSession(ctx context.Context, request DeactivateNotebookSessionRequest) (response DeactivateNotebookSessionResponse, err error)
+
+	// DeleteModel Deletes the specified model.
+	DeleteModel(ctx context.Context, request DeleteModelRequest) (response DeleteModelResponse, err error)
+
+	// DeleteNotebookSession Deletes the specified notebook session. Any unsaved work in this notebook session will be lost.
+	DeleteNotebookSession(ctx context.Context, request DeleteNotebookSessionRequest) (response DeleteNotebookSessionResponse, err error)
+
+	// DeleteProject Deletes the specified project. This operation will fail unless all associated resources (such as notebook sessions or models) are in a DELETED state. You must delete all associated resources before deleting a project.
+	DeleteProject(ctx context.Context, request DeleteProjectRequest) (response DeleteProjectResponse, err error)
+
+	// GetModel Gets the specified model's information.
+	GetModel(ctx context.Context, request GetModelRequest) (response GetModelResponse, err error)
+
+	// GetModelArtifactContent Downloads model artifact content for specified model.
+	GetModelArtifactContent(ctx context.Context, request GetModelArtifactContentRequest) (response GetModelArtifactContentResponse, err error)
+
+	// GetModelProvenance Gets provenance information for specified model.
+	GetModelProvenance(ctx context.Context, request GetModelProvenanceRequest) (response GetModelProvenanceResponse, err error)
+
+	// GetNotebookSession Gets the specified notebook session's information.
+	GetNotebookSession(ctx context.Context, request GetNotebookSessionRequest) (response GetNotebookSessionResponse, err error)
+
+	// GetProject Gets the specified project's information.
+	GetProject(ctx context.Context, request GetProjectRequest) (response GetProjectResponse, err error)
+
+	// GetWorkRequest Gets the specified work request's information.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// HeadModelArtifact Gets model artifact metadata for specified model.
+	HeadModelArtifact(ctx context.Context, request HeadModelArtifactRequest) (response HeadModelArtifactResponse, err error)
+
+	// ListModels Lists models in the specified compartment.
+	ListModels(ctx context.Context, request ListModelsRequest) (response ListModelsResponse, err error)
+
+	// ListNotebookSessionShapes Lists the valid notebook session shapes.
+	ListNotebookSessionShapes(ctx context.Context, request ListNotebookSessionShapesRequest) (response ListNotebookSessionShapesResponse, err error)
+
+	// ListNotebookSessions Lists notebook sessions in the specified compartment.
+	ListNotebookSessions(ctx context.Context, request ListNotebookSessionsRequest) (response ListNotebookSessionsResponse, err error)
+
+	// ListProjects Lists projects in the specified compartment.
+	ListProjects(ctx context.Context, request ListProjectsRequest) (response ListProjectsResponse, err error)
+
+	// ListWorkRequestErrors Lists work request errors for the specified work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Lists work request logs for the specified work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists work requests in the specified compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateModel Updates the properties of a model. You can update the `displayName`, `description`, `freeformTags`, and `definedTags` properties.
+	UpdateModel(ctx context.Context, request UpdateModelRequest) (response UpdateModelResponse, err error)
+
+	// UpdateModelProvenance Updates provenance information for the specified model.
+	UpdateModelProvenance(ctx context.Context, request UpdateModelProvenanceRequest) (response UpdateModelProvenanceResponse, err error)
+
+	// UpdateNotebookSession Updates the properties of a notebook session. You can update the `displayName`, `freeformTags`, and `definedTags` properties.
+	// When the notebook session is in the INACTIVE lifecycle state, you can update `notebookSessionConfigurationDetails` and change `shape`, `subnetId`, and `blockStorageSizeInGBs`.
+	// Changes to the `notebookSessionConfigurationDetails` will take effect the next time the `ActivateNotebookSession` action is invoked on the notebook session resource.
+	UpdateNotebookSession(ctx context.Context, request UpdateNotebookSessionRequest) (response UpdateNotebookSessionResponse, err error)
+
+	// UpdateProject Updates the properties of a project. You can update the `displayName`, `description`, `freeformTags`, and `definedTags` properties.
+	UpdateProject(ctx context.Context, request UpdateProjectRequest) (response UpdateProjectResponse, err error)
+}
+
+// DataScienceClient implements DataScienceClientAPI
+var _ DataScienceClientAPI = DataScienceClient{}
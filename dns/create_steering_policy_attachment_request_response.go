@@ -65,6 +65,9 @@ type CreateSteeringPolicyAttachmentResponse struct {
 	// representation-specific suffix. This value may be used in If-Match
 	// and If-None-Match headers for later requests of the same resource.
 	ETag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateSteeringPolicyAttachmentResponse) String() string {
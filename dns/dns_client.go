@@ -103,10 +103,12 @@ func (client DnsClient) ChangeSteeringPolicyCompartment(ctx context.Context, req
 				response = ChangeSteeringPolicyCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSteeringPolicyCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSteeringPolicyCompartmentResponse")
 	}
@@ -158,10 +160,12 @@ func (client DnsClient) ChangeTsigKeyCompartment(ctx context.Context, request Ch
 				response = ChangeTsigKeyCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeTsigKeyCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeTsigKeyCompartmentResponse")
 	}
@@ -214,10 +218,12 @@ func (client DnsClient) ChangeZoneCompartment(ctx context.Context, request Chang
 				response = ChangeZoneCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeZoneCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeZoneCompartmentResponse")
 	}
@@ -270,10 +276,12 @@ func (client DnsClient) CreateSteeringPolicy(ctx context.Context, request Create
 				response = CreateSteeringPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSteeringPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSteeringPolicyResponse")
 	}
@@ -329,10 +337,12 @@ func (client DnsClient) CreateSteeringPolicyAttachment(ctx context.Context, requ
 				response = CreateSteeringPolicyAttachmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSteeringPolicyAttachmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSteeringPolicyAttachmentResponse")
 	}
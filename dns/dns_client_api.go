@@ -0,0 +1,155 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dns
+
+import (
+	"context"
+)
+
+// DnsClientAPI is the interface of the operations of DnsClient, to inject the client and mock it in tests
+type DnsClientAPI interface {
+	// ChangeSteeringPolicyCompartment Moves a steering policy into a different compartment.
+	ChangeSteeringPolicyCompartment(ctx context.Context, request ChangeSteeringPolicyCompartmentRequest) (response ChangeSteeringPolicyCompartmentResponse, err error)
+
+	// ChangeTsigKeyCompartment Moves a TSIG key into a different compartment.
+	ChangeTsigKeyCompartment(ctx context.Context, request ChangeTsigKeyCompartmentRequest) (response ChangeTsigKeyCompartmentResponse, err error)
+
+	// ChangeZoneCompartment Moves a zone into a different compartment.
+	// **Note:** All SteeringPolicyAttachment objects associated with this zone will also be moved into the provided compartment.
+	ChangeZoneCompartment(ctx context.Context, request ChangeZoneCompartmentRequest) (response ChangeZoneCompartmentResponse, err error)
+
+	// CreateSteeringPolicy Creates a new steering policy in the specified compartment. For more information on
+	// creating policies with templates, see Traffic Management API Guide (https://docs.cloud.oracle.com/iaas/Content/TrafficManagement/Concepts/trafficmanagementapi.htm).
+	CreateSteeringPolicy(ctx context.Context, request CreateSteeringPolicyRequest) (response CreateSteeringPolicyResponse, err error)
+
+	// CreateSteeringPolicyAttachment Creates a new attachment between a steering policy and a domain, giving the
+	// policy permission to answer queries for the specified domain. A steering policy must
+	// be attached to a domain for the policy to answer DNS queries for that domain.
+	// For the purposes of access control, the attachment is automatically placed
+	// into the same compartment as the domain's zone.
+	CreateSteeringPolicyAttachment(ctx context.Context, request CreateSteeringPolicyAttachmentRequest) (response CreateSteeringPolicyAttachmentResponse, err error)
+
+	// CreateTsigKey Creates a new TSIG key in the specified compartment. There is no
+	// `opc-retry-token` header since TSIG key names must be globally unique.
+	CreateTsigKey(ctx context.Context, request CreateTsigKeyRequest) (response CreateTsigKeyResponse, err error)
+
+	// CreateZone Creates a new zone in the specified compartment. The `compartmentId`
+	// query parameter is required if the `Content-Type` header for the
+	// request is `text/dns`.
+	CreateZone(ctx context.Context, request CreateZoneRequest) (response CreateZoneResponse, err error)
+
+	// DeleteDomainRecords Deletes all records at the specified zone and domain.
+	DeleteDomainRecords(ctx context.Context, request DeleteDomainRecordsRequest) (response DeleteDomainRecordsResponse, err error)
+
+	// DeleteRRSet Deletes all records in the specified RRSet.
+	DeleteRRSet(ctx context.Context, request DeleteRRSetRequest) (response DeleteRRSetResponse, err error)
+
+	// DeleteSteeringPolicy Deletes the specified steering policy.
+	// A `204` response indicates that the delete has been successful.
+	// Deletion will fail if the policy is attached to any zones. To detach a
+	// policy from a zone, see `DeleteSteeringPolicyAttachment`.
+	DeleteSteeringPolicy(ctx context.Context, request DeleteSteeringPolicyRequest) (response DeleteSteeringPolicyResponse, err error)
+
+	// DeleteSteeringPolicyAttachment Deletes the specified steering policy attachment.
+	// A `204` response indicates that the delete has been successful.
+	DeleteSteeringPolicyAttachment(ctx context.Context, request DeleteSteeringPolicyAttachmentRequest) (response DeleteSteeringPolicyAttachmentResponse, err error)
+
+	// DeleteTsigKey Deletes the specified TSIG key.
+	DeleteTsigKey(ctx context.Context, request DeleteTsigKeyRequest) (response DeleteTsigKeyResponse, err error)
+
+	// DeleteZone Deletes the specified zone and all its steering policy attachments.
+	// A `204` response indicates that zone has been successfully deleted.
+	DeleteZone(ctx context.Context, request DeleteZoneRequest) (response DeleteZoneResponse, err error)
+
+	// GetDomainRecords Gets a list of all records at the specified zone and domain.
+	// The results are sorted by `rtype` in alphabetical order by default. You
+	// can optionally filter and/or sort the results using the listed parameters.
+	GetDomainRecords(ctx context.Context, request GetDomainRecordsRequest) (response GetDomainRecordsResponse, err error)
+
+	// GetRRSet Gets a list of all records in the specified RRSet. The results are
+	// sorted by `recordHash` by default.
+	GetRRSet(ctx context.Context, request GetRRSetRequest) (response GetRRSetResponse, err error)
+
+	// GetSteeringPolicy Gets information about the specified steering policy.
+	GetSteeringPolicy(ctx context.Context, request GetSteeringPolicyRequest) (response GetSteeringPolicyResponse, err error)
+
+	// GetSteeringPolicyAttachment Gets information about the specified steering policy attachment.
+	GetSteeringPolicyAttachment(ctx context.Context, request GetSteeringPolicyAttachmentRequest) (response GetSteeringPolicyAttachmentResponse, err error)
+
+	// GetTsigKey Gets information about the specified TSIG key.
+	GetTsigKey(ctx context.Context, request GetTsigKeyRequest) (response GetTsigKeyResponse, err error)
+
+	// GetZone Gets information about the specified zone, including its creation date,
+	// zone type, and serial.
+	GetZone(ctx context.Context, request GetZoneRequest) (response GetZoneResponse, err error)
+
+	// GetZoneRecords Gets all records in the specified zone. The results are
+	// sorted by `domain` in alphabetical order by default. For more
+	// information about records, see Resource Record (RR) TYPEs (https://www.iana.org/assignments/dns-parameters/dns-parameters.xhtml#dns-parameters-4).
+	GetZoneRecords(ctx context.Context, request GetZoneRecordsRequest) (response GetZoneRecordsResponse, err error)
+
+	// ListSteeringPolicies Gets a list of all steering policies in the specified compartment.
+	ListSteeringPolicies(ctx context.Context, request ListSteeringPoliciesRequest) (response ListSteeringPoliciesResponse, err error)
+
+	// ListSteeringPolicyAttachments Lists the steering policy attachments in the specified compartment.
+	ListSteeringPolicyAttachments(ctx context.Context, request ListSteeringPolicyAttachmentsRequest) (response ListSteeringPolicyAttachmentsResponse, err error)
+
+	// ListTsigKeys Gets a list of all TSIG keys in the specified compartment.
+	ListTsigKeys(ctx context.Context, request ListTsigKeysRequest) (response ListTsigKeysResponse, err error)
+
+	// ListZones Gets a list of all zones in the specified compartment. The collection
+	// can be filtered by name, time created, and zone type.
+	ListZones(ctx context.Context, request ListZonesRequest) (response ListZonesResponse, err error)
+
+	// PatchDomainRecords Updates records in the specified zone at a domain. You can update
+	// one record or all records for the specified zone depending on the changes
+	// provided in the request body. You can also add or remove records using this
+	// function.
+	PatchDomainRecords(ctx context.Context, request PatchDomainRecordsRequest) (response PatchDomainRecordsResponse, err error)
+
+	// PatchRRSet Updates records in the specified RRSet.
+	PatchRRSet(ctx context.Context, request PatchRRSetRequest) (response PatchRRSetResponse, err error)
+
+	// PatchZoneRecords Updates a collection of records in the specified zone. You can update
+	// one record or all records for the specified zone depending on the
+	// changes provided in the request body. You can also add or remove records
+	// using this function.
+	PatchZoneRecords(ctx context.Context, request PatchZoneRecordsRequest) (response PatchZoneRecordsResponse, err error)
+
+	// UpdateDomainRecords Replaces records in the specified zone at a domain with the records
+	// specified in the request body. If a specified record does not exist,
+	// it will be created. If the record exists, then it will be updated to
+	// represent the record in the body of the request. If a record in the zone
+	// does not exist in the request body, the record will be removed from the
+	// zone.
+	UpdateDomainRecords(ctx context.Context, request UpdateDomainRecordsRequest) (response UpdateDomainRecordsResponse, err error)
+
+	// UpdateRRSet Replaces records in the specified RRSet.
+	UpdateRRSet(ctx context.Context, request UpdateRRSetRequest) (response UpdateRRSetResponse, err error)
+
+	// UpdateSteeringPolicy Updates the configuration of the specified steering policy.
+	UpdateSteeringPolicy(ctx context.Context, request UpdateSteeringPolicyRequest) (response UpdateSteeringPolicyResponse, err error)
+
+	// UpdateSteeringPolicyAttachment Updates the specified steering policy attachment with your new information.
+	UpdateSteeringPolicyAttachment(ctx context.Context, request UpdateSteeringPolicyAttachmentRequest) (response UpdateSteeringPolicyAttachmentResponse, err error)
+
+	// UpdateTsigKey Updates the specified TSIG key.
+	UpdateTsigKey(ctx context.Context, request UpdateTsigKeyRequest) (response UpdateTsigKeyResponse, err error)
+
+	// UpdateZone Updates the specified secondary zone with your new external master
+	// server information. For more information about secondary zone, see
+	// Manage DNS Service Zone (https://docs.cloud.oracle.com/iaas/Content/DNS/Tasks/managingdnszones.htm).
+	UpdateZone(ctx context.Context, request UpdateZoneRequest) (response UpdateZoneResponse, err error)
+
+	// UpdateZoneRecords Replaces records in the specified zone with the records specified in the
+	// request body. If a specified record does not exist, it will be created.
+	// If the record exists, then it will be updated to represent the record in
+	// the body of the request. If a record in the zone does not exist in the
+	// request body, the record will be removed from the zone.
+	UpdateZoneRecords(ctx context.Context, request UpdateZoneRecordsRequest) (response UpdateZoneRecordsResponse, err error)
+}
+
+// DnsClient implements DnsClientAPI
+var _ DnsClientAPI = DnsClient{}
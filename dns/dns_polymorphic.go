@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dns
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*CreateZoneBaseDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createzonebasedetails{} })
+	common.RegisterPolymorphicModel((*SteeringPolicyRule)(nil), func() common.PolymorphicJSONUnmarshaler { return &steeringpolicyrule{} })
+}
+
+// MarshalJSON marshals a CreateZoneBaseDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createzonebasedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatezonebasedetails createzonebasedetails
+	s := struct {
+		MarshalTypecreatezonebasedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatezonebasedetails: (MarshalTypecreatezonebasedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a SteeringPolicyRule whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m steeringpolicyrule) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesteeringpolicyrule steeringpolicyrule
+	s := struct {
+		MarshalTypesteeringpolicyrule
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesteeringpolicyrule: (MarshalTypesteeringpolicyrule)(m),
+	}
+	return json.Marshal(&s)
+}
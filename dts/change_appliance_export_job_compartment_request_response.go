@@ -59,6 +59,9 @@ type ChangeApplianceExportJobCompartmentResponse struct {
 	RawResponse *http.Response
 
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangeApplianceExportJobCompartmentResponse) String() string {
@@ -62,6 +62,9 @@ type ChangeTransferJobCompartmentResponse struct {
 
 	// For optimistic concurrency control. See 'if-match'.
 	Etag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangeTransferJobCompartmentResponse) String() string {
@@ -55,6 +55,9 @@ type CreateTransferApplianceResponse struct {
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
 
 	Etag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateTransferApplianceResponse) String() string {
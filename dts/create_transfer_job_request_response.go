@@ -52,6 +52,9 @@ type CreateTransferJobResponse struct {
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
 
 	Etag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateTransferJobResponse) String() string {
@@ -102,10 +102,12 @@ func (client ApplianceExportJobClient) ChangeApplianceExportJobCompartment(ctx c
 				response = ChangeApplianceExportJobCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeApplianceExportJobCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeApplianceExportJobCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client ApplianceExportJobClient) CreateApplianceExportJob(ctx context.Cont
 				response = CreateApplianceExportJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateApplianceExportJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateApplianceExportJobResponse")
 	}
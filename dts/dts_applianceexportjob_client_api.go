@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// ApplianceExportJobClientAPI is the interface of the operations of ApplianceExportJobClient, to inject the client and mock it in tests
+type ApplianceExportJobClientAPI interface {
+	// ChangeApplianceExportJobCompartment Moves a ApplianceExportJob into a different compartment.
+	ChangeApplianceExportJobCompartment(ctx context.Context, request ChangeApplianceExportJobCompartmentRequest) (response ChangeApplianceExportJobCompartmentResponse, err error)
+
+	// CreateApplianceExportJob Creates a new Appliance Export Job that corresponds with customer's logical dataset
+	CreateApplianceExportJob(ctx context.Context, request CreateApplianceExportJobRequest) (response CreateApplianceExportJobResponse, err error)
+
+	// DeleteApplianceExportJob deletes a Appliance Export Job
+	DeleteApplianceExportJob(ctx context.Context, request DeleteApplianceExportJobRequest) (response DeleteApplianceExportJobResponse, err error)
+
+	// GetApplianceExportJob Describes a Appliance Export Job in detail
+	GetApplianceExportJob(ctx context.Context, request GetApplianceExportJobRequest) (response GetApplianceExportJobResponse, err error)
+
+	// ListApplianceExportJobs Lists Appliance Export Jobs in a given compartment
+	ListApplianceExportJobs(ctx context.Context, request ListApplianceExportJobsRequest) (response ListApplianceExportJobsResponse, err error)
+
+	// UpdateApplianceExportJob Updates a Appliance Export Job that corresponds with customer's logical dataset.
+	UpdateApplianceExportJob(ctx context.Context, request UpdateApplianceExportJobRequest) (response UpdateApplianceExportJobResponse, err error)
+}
+
+// ApplianceExportJobClient implements ApplianceExportJobClientAPI
+var _ ApplianceExportJobClientAPI = ApplianceExportJobClient{}
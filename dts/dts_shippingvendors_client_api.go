@@ -0,0 +1,18 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// ShippingVendorsClientAPI is the interface of the operations of ShippingVendorsClient, to inject the client and mock it in tests
+type ShippingVendorsClientAPI interface {
+	// ListShippingVendors Lists available shipping vendors for Transfer Package delivery
+	ListShippingVendors(ctx context.Context, request ListShippingVendorsRequest) (response ListShippingVendorsResponse, err error)
+}
+
+// ShippingVendorsClient implements ShippingVendorsClientAPI
+var _ ShippingVendorsClientAPI = ShippingVendorsClient{}
@@ -102,10 +102,12 @@ func (client TransferApplianceClient) CreateTransferAppliance(ctx context.Contex
 				response = CreateTransferApplianceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTransferApplianceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTransferApplianceResponse")
 	}
@@ -0,0 +1,39 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// TransferApplianceClientAPI is the interface of the operations of TransferApplianceClient, to inject the client and mock it in tests
+type TransferApplianceClientAPI interface {
+	// CreateTransferAppliance Create a new Transfer Appliance
+	CreateTransferAppliance(ctx context.Context, request CreateTransferApplianceRequest) (response CreateTransferApplianceResponse, err error)
+
+	// CreateTransferApplianceAdminCredentials Creates an X.509 certificate from a public key
+	CreateTransferApplianceAdminCredentials(ctx context.Context, request CreateTransferApplianceAdminCredentialsRequest) (response CreateTransferApplianceAdminCredentialsResponse, err error)
+
+	// DeleteTransferAppliance deletes a transfer Appliance
+	DeleteTransferAppliance(ctx context.Context, request DeleteTransferApplianceRequest) (response DeleteTransferApplianceResponse, err error)
+
+	// GetTransferAppliance Describes a transfer appliance in detail
+	GetTransferAppliance(ctx context.Context, request GetTransferApplianceRequest) (response GetTransferApplianceResponse, err error)
+
+	// GetTransferApplianceCertificateAuthorityCertificate Gets the x.509 certificate for the Transfer Appliance's dedicated Certificate Authority (CA)
+	GetTransferApplianceCertificateAuthorityCertificate(ctx context.Context, request GetTransferApplianceCertificateAuthorityCertificateRequest) (response GetTransferApplianceCertificateAuthorityCertificateResponse, err error)
+
+	// GetTransferApplianceEncryptionPassphrase Describes a transfer appliance encryptionPassphrase in detail
+	GetTransferApplianceEncryptionPassphrase(ctx context.Context, request GetTransferApplianceEncryptionPassphraseRequest) (response GetTransferApplianceEncryptionPassphraseResponse, err error)
+
+	// ListTransferAppliances Lists Transfer Appliances associated with a transferJob
+	ListTransferAppliances(ctx context.Context, request ListTransferAppliancesRequest) (response ListTransferAppliancesResponse, err error)
+
+	// UpdateTransferAppliance Updates a Transfer Appliance
+	UpdateTransferAppliance(ctx context.Context, request UpdateTransferApplianceRequest) (response UpdateTransferApplianceResponse, err error)
+}
+
+// TransferApplianceClient implements TransferApplianceClientAPI
+var _ TransferApplianceClientAPI = TransferApplianceClient{}
@@ -102,10 +102,12 @@ func (client TransferApplianceEntitlementClient) CreateTransferApplianceEntitlem
 				response = CreateTransferApplianceEntitlementResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTransferApplianceEntitlementResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTransferApplianceEntitlementResponse")
 	}
@@ -157,10 +159,12 @@ func (client TransferApplianceEntitlementClient) GetTransferApplianceEntitlement
 				response = GetTransferApplianceEntitlementResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GetTransferApplianceEntitlementResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GetTransferApplianceEntitlementResponse")
 	}
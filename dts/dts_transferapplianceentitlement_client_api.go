@@ -0,0 +1,24 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// TransferApplianceEntitlementClientAPI is the interface of the operations of TransferApplianceEntitlementClient, to inject the client and mock it in tests
+type TransferApplianceEntitlementClientAPI interface {
+	// CreateTransferApplianceEntitlement Create the Entitlement to use a Transfer Appliance. It requires some offline process of review and signatures before request is granted.
+	CreateTransferApplianceEntitlement(ctx context.Context, request CreateTransferApplianceEntitlementRequest) (response CreateTransferApplianceEntitlementResponse, err error)
+
+	// GetTransferApplianceEntitlement Describes the Transfer Appliance Entitlement in detail
+	GetTransferApplianceEntitlement(ctx context.Context, request GetTransferApplianceEntitlementRequest) (response GetTransferApplianceEntitlementResponse, err error)
+
+	// ListTransferApplianceEntitlement Lists Transfer Transfer Appliance Entitlement
+	ListTransferApplianceEntitlement(ctx context.Context, request ListTransferApplianceEntitlementRequest) (response ListTransferApplianceEntitlementResponse, err error)
+}
+
+// TransferApplianceEntitlementClient implements TransferApplianceEntitlementClientAPI
+var _ TransferApplianceEntitlementClientAPI = TransferApplianceEntitlementClient{}
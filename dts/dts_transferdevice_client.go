@@ -102,10 +102,12 @@ func (client TransferDeviceClient) CreateTransferDevice(ctx context.Context, req
 				response = CreateTransferDeviceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTransferDeviceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTransferDeviceResponse")
 	}
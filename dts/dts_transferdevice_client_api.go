@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// TransferDeviceClientAPI is the interface of the operations of TransferDeviceClient, to inject the client and mock it in tests
+type TransferDeviceClientAPI interface {
+	// CreateTransferDevice Create a new Transfer Device
+	CreateTransferDevice(ctx context.Context, request CreateTransferDeviceRequest) (response CreateTransferDeviceResponse, err error)
+
+	// DeleteTransferDevice deletes a transfer Device
+	DeleteTransferDevice(ctx context.Context, request DeleteTransferDeviceRequest) (response DeleteTransferDeviceResponse, err error)
+
+	// GetTransferDevice Describes a transfer package in detail
+	GetTransferDevice(ctx context.Context, request GetTransferDeviceRequest) (response GetTransferDeviceResponse, err error)
+
+	// ListTransferDevices Lists Transfer Devices associated with a transferJob
+	ListTransferDevices(ctx context.Context, request ListTransferDevicesRequest) (response ListTransferDevicesResponse, err error)
+
+	// UpdateTransferDevice Updates a Transfer Device
+	UpdateTransferDevice(ctx context.Context, request UpdateTransferDeviceRequest) (response UpdateTransferDeviceResponse, err error)
+}
+
+// TransferDeviceClient implements TransferDeviceClientAPI
+var _ TransferDeviceClientAPI = TransferDeviceClient{}
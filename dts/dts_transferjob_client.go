@@ -102,10 +102,12 @@ func (client TransferJobClient) ChangeTransferJobCompartment(ctx context.Context
 				response = ChangeTransferJobCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeTransferJobCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeTransferJobCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client TransferJobClient) CreateTransferJob(ctx context.Context, request C
 				response = CreateTransferJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTransferJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTransferJobResponse")
 	}
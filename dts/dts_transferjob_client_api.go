@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// TransferJobClientAPI is the interface of the operations of TransferJobClient, to inject the client and mock it in tests
+type TransferJobClientAPI interface {
+	// ChangeTransferJobCompartment Moves a TransferJob into a different compartment.
+	ChangeTransferJobCompartment(ctx context.Context, request ChangeTransferJobCompartmentRequest) (response ChangeTransferJobCompartmentResponse, err error)
+
+	// CreateTransferJob Create a new Transfer Job that corresponds with customer's logical dataset e.g. a DB or a filesystem.
+	CreateTransferJob(ctx context.Context, request CreateTransferJobRequest) (response CreateTransferJobResponse, err error)
+
+	// DeleteTransferJob deletes a transfer job
+	DeleteTransferJob(ctx context.Context, request DeleteTransferJobRequest) (response DeleteTransferJobResponse, err error)
+
+	// GetTransferJob Describes a transfer job in detail
+	GetTransferJob(ctx context.Context, request GetTransferJobRequest) (response GetTransferJobResponse, err error)
+
+	// ListTransferJobs Lists Transfer Jobs in a given compartment
+	ListTransferJobs(ctx context.Context, request ListTransferJobsRequest) (response ListTransferJobsResponse, err error)
+
+	// UpdateTransferJob Updates a Transfer Job that corresponds with customer's logical dataset e.g. a DB or a filesystem.
+	UpdateTransferJob(ctx context.Context, request UpdateTransferJobRequest) (response UpdateTransferJobResponse, err error)
+}
+
+// TransferJobClient implements TransferJobClientAPI
+var _ TransferJobClientAPI = TransferJobClient{}
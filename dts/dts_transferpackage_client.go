@@ -152,10 +152,12 @@ func (client TransferPackageClient) CreateTransferPackage(ctx context.Context, r
 				response = CreateTransferPackageResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTransferPackageResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTransferPackageResponse")
 	}
@@ -0,0 +1,36 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package dts
+
+import (
+	"context"
+)
+
+// TransferPackageClientAPI is the interface of the operations of TransferPackageClient, to inject the client and mock it in tests
+type TransferPackageClientAPI interface {
+	// AttachDevicesToTransferPackage Attaches Devices to a Transfer Package
+	AttachDevicesToTransferPackage(ctx context.Context, request AttachDevicesToTransferPackageRequest) (response AttachDevicesToTransferPackageResponse, err error)
+
+	// CreateTransferPackage Create a new Transfer Package
+	CreateTransferPackage(ctx context.Context, request CreateTransferPackageRequest) (response CreateTransferPackageResponse, err error)
+
+	// DeleteTransferPackage deletes a transfer Package
+	DeleteTransferPackage(ctx context.Context, request DeleteTransferPackageRequest) (response DeleteTransferPackageResponse, err error)
+
+	// DetachDevicesFromTransferPackage Detaches Devices from a Transfer Package
+	DetachDevicesFromTransferPackage(ctx context.Context, request DetachDevicesFromTransferPackageRequest) (response DetachDevicesFromTransferPackageResponse, err error)
+
+	// GetTransferPackage Describes a transfer package in detail
+	GetTransferPackage(ctx context.Context, request GetTransferPackageRequest) (response GetTransferPackageResponse, err error)
+
+	// ListTransferPackages Lists Transfer Packages associated with a transferJob
+	ListTransferPackages(ctx context.Context, request ListTransferPackagesRequest) (response ListTransferPackagesResponse, err error)
+
+	// UpdateTransferPackage Updates a Transfer Package
+	UpdateTransferPackage(ctx context.Context, request UpdateTransferPackageRequest) (response UpdateTransferPackageResponse, err error)
+}
+
+// TransferPackageClient implements TransferPackageClientAPI
+var _ TransferPackageClientAPI = TransferPackageClient{}
@@ -55,6 +55,9 @@ type GetTransferApplianceEntitlementResponse struct {
 	TransferApplianceEntitlement `presentIn:"body"`
 
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response GetTransferApplianceEntitlementResponse) String() string {
@@ -0,0 +1,56 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package email
+
+import (
+	"context"
+)
+
+// EmailClientAPI is the interface of the operations of EmailClient, to inject the client and mock it in tests
+type EmailClientAPI interface {
+	// ChangeSenderCompartment Moves a sender into a different compartment. When provided, If-Match is checked against ETag values of the resource.
+	ChangeSenderCompartment(ctx context.Context, request ChangeSenderCompartmentRequest) (response ChangeSenderCompartmentResponse, err error)
+
+	// CreateSender Creates a sender for a tenancy in a given compartment.
+	CreateSender(ctx context.Context, request CreateSenderRequest) (response CreateSenderResponse, err error)
+
+	// CreateSuppression Adds recipient email addresses to the suppression list for a tenancy.
+	// Addresses added to the suppression list via the API are denoted as
+	// "MANUAL" in the `reason` field. *Note:* All email addresses added to the
+	// suppression list are normalized to include only lowercase letters.
+	CreateSuppression(ctx context.Context, request CreateSuppressionRequest) (response CreateSuppressionResponse, err error)
+
+	// DeleteSender Deletes an approved sender for a tenancy in a given compartment for a
+	// provided `senderId`.
+	DeleteSender(ctx context.Context, request DeleteSenderRequest) (response DeleteSenderResponse, err error)
+
+	// DeleteSuppression Removes a suppressed recipient email address from the suppression list
+	// for a tenancy in a given compartment for a provided `suppressionId`.
+	DeleteSuppression(ctx context.Context, request DeleteSuppressionRequest) (response DeleteSuppressionResponse, err error)
+
+	// GetSender Gets an approved sender for a given `senderId`.
+	GetSender(ctx context.Context, request GetSenderRequest) (response GetSenderResponse, err error)
+
+	// GetSuppression Gets the details of a suppressed recipient email address for a given
+	// `suppressionId`. Each suppression is given a unique OCID.
+	GetSuppression(ctx context.Context, request GetSuppressionRequest) (response GetSuppressionResponse, err error)
+
+	// ListSenders Gets a collection of approved sender email addresses and sender IDs.
+	ListSenders(ctx context.Context, request ListSendersRequest) (response ListSendersResponse, err error)
+
+	// ListSuppressions Gets a list of suppressed recipient email addresses for a user. The
+	// `compartmentId` for suppressions must be a tenancy OCID. The returned list
+	// is sorted by creation time in descending order.
+	ListSuppressions(ctx context.Context, request ListSuppressionsRequest) (response ListSuppressionsResponse, err error)
+
+	// UpdateSender Replaces the set of tags for a sender with the tags provided. If either freeform
+	// or defined tags are omitted, the tags for that set remain the same. Each set must
+	// include the full set of tags for the sender, partial updates are not permitted.
+	// For more information about tagging, see Resource Tags (https://docs.cloud.oracle.com/Content/General/Concepts/resourcetags.htm).
+	UpdateSender(ctx context.Context, request UpdateSenderRequest) (response UpdateSenderResponse, err error)
+}
+
+// EmailClient implements EmailClientAPI
+var _ EmailClientAPI = EmailClient{}
@@ -104,10 +104,12 @@ func (client EventsClient) ChangeRuleCompartment(ctx context.Context, request Ch
 				response = ChangeRuleCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeRuleCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeRuleCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client EventsClient) CreateRule(ctx context.Context, request CreateRuleReq
 				response = CreateRuleResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateRuleResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateRuleResponse")
 	}
@@ -0,0 +1,34 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package events
+
+import (
+	"context"
+)
+
+// EventsClientAPI is the interface of the operations of EventsClient, to inject the client and mock it in tests
+type EventsClientAPI interface {
+	// ChangeRuleCompartment Moves a rule into a different compartment within the same tenancy. For information about moving
+	// resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeRuleCompartment(ctx context.Context, request ChangeRuleCompartmentRequest) (response ChangeRuleCompartmentResponse, err error)
+
+	// CreateRule Creates a new rule.
+	CreateRule(ctx context.Context, request CreateRuleRequest) (response CreateRuleResponse, err error)
+
+	// DeleteRule Deletes a rule.
+	DeleteRule(ctx context.Context, request DeleteRuleRequest) (response DeleteRuleResponse, err error)
+
+	// GetRule Retrieves a rule.
+	GetRule(ctx context.Context, request GetRuleRequest) (response GetRuleResponse, err error)
+
+	// ListRules Lists rules for this compartment.
+	ListRules(ctx context.Context, request ListRulesRequest) (response ListRulesResponse, err error)
+
+	// UpdateRule Updates a rule.
+	UpdateRule(ctx context.Context, request UpdateRuleRequest) (response UpdateRuleResponse, err error)
+}
+
+// EventsClient implements EventsClientAPI
+var _ EventsClientAPI = EventsClient{}
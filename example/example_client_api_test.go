@@ -0,0 +1,47 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Example code for mocking the service clients with their interfaces
+
+package example
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+)
+
+// instanceState returns the state of an instance with any implementation of the compute client
+func instanceState(ctx context.Context, client core.ComputeClientAPI, instanceID string) (core.InstanceLifecycleStateEnum, error) {
+	response, err := client.GetInstance(ctx, core.GetInstanceRequest{InstanceId: common.String(instanceID)})
+	if err != nil {
+		return "", err
+	}
+	return response.LifecycleState, nil
+}
+
+// mockComputeClient implements the operations used by the code under test, embedding the interface for the others
+type mockComputeClient struct {
+	core.ComputeClientAPI
+	instances map[string]core.Instance
+}
+
+func (client mockComputeClient) GetInstance(ctx context.Context, request core.GetInstanceRequest) (core.GetInstanceResponse, error) {
+	return core.GetInstanceResponse{Instance: client.instances[*request.InstanceId]}, nil
+}
+
+// ExampleComputeClientAPI shows how to inject a mock of a client in the code using it. The code depends on the
+// interface of the client, core.ComputeClientAPI, which core.ComputeClient implements.
+func ExampleComputeClientAPI() {
+	client := mockComputeClient{instances: map[string]core.Instance{
+		"instance1": {LifecycleState: core.InstanceLifecycleStateRunning},
+	}}
+
+	state, err := instanceState(context.Background(), client, "instance1")
+	fmt.Println(state, err)
+
+	// Output:
+	// RUNNING <nil>
+}
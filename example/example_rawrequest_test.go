@@ -6,6 +6,7 @@
 package example
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v27/common"
 	"github.com/oracle/oci-go-sdk/v27/example/helpers"
+	"github.com/oracle/oci-go-sdk/v27/identity"
 )
 
 // ExampleRawRequest compose a request, sign it and send to server
@@ -59,3 +61,22 @@ func ExampleListUsers_RawRequest() {
 	// send request
 	// receive response
 }
+
+// ExampleListUsers_Invoke sends a request to an endpoint with the signing, the retries and the error handling of the
+// service clients, such as an endpoint not supported by the clients yet
+func ExampleListUsers_Invoke() {
+	client, err := identity.NewIdentityClientWithConfigurationProvider(common.DefaultConfigProvider())
+	helpers.FatalIfError(err)
+
+	var users []identity.User
+	response, err := client.Invoke(context.Background(), common.InvokeRequest{
+		Method:          http.MethodGet,
+		Path:            "/users",
+		QueryParams:     map[string][]string{"compartmentId": {*helpers.RootCompartmentID()}, "limit": {"10"}},
+		RequestMetadata: helpers.GetRequestMetadataWithDefaultRetryPolicy(),
+	}, &users)
+	helpers.FatalIfError(err)
+
+	log.Println("opc-request-id:", *response.OpcRequestId)
+	log.Println("users:", len(users))
+}
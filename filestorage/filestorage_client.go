@@ -203,10 +203,12 @@ func (client FileStorageClient) CreateExport(ctx context.Context, request Create
 				response = CreateExportResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateExportResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateExportResponse")
 	}
@@ -281,10 +283,12 @@ func (client FileStorageClient) CreateFileSystem(ctx context.Context, request Cr
 				response = CreateFileSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateFileSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateFileSystemResponse")
 	}
@@ -363,10 +367,12 @@ func (client FileStorageClient) CreateMountTarget(ctx context.Context, request C
 				response = CreateMountTargetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateMountTargetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateMountTargetResponse")
 	}
@@ -419,10 +425,12 @@ func (client FileStorageClient) CreateSnapshot(ctx context.Context, request Crea
 				response = CreateSnapshotResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSnapshotResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSnapshotResponse")
 	}
@@ -0,0 +1,148 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package filestorage
+
+import (
+	"context"
+)
+
+// FileStorageClientAPI is the interface of the operations of FileStorageClient, to inject the client and mock it in tests
+type FileStorageClientAPI interface {
+	// ChangeFileSystemCompartment Moves a file system and its associated snapshots into a different compartment within the same tenancy. For information about moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes)
+	ChangeFileSystemCompartment(ctx context.Context, request ChangeFileSystemCompartmentRequest) (response ChangeFileSystemCompartmentResponse, err error)
+
+	// ChangeMountTargetCompartment Moves a mount target and its associated export set into a different compartment within the same tenancy. For information about moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes)
+	ChangeMountTargetCompartment(ctx context.Context, request ChangeMountTargetCompartmentRequest) (response ChangeMountTargetCompartmentResponse, err error)
+
+	// CreateExport Creates a new export in the specified export set, path, and
+	// file system.
+	CreateExport(ctx context.Context, request CreateExportRequest) (response CreateExportResponse, err error)
+
+	// CreateFileSystem Creates a new file system in the specified compartment and
+	// availability domain. Instances can mount file systems in
+	// another availability domain, but doing so might increase
+	// latency when compared to mounting instances in the same
+	// availability domain.
+	// After you create a file system, you can associate it with a mount
+	// target. Instances can then mount the file system by connecting to the
+	// mount target's IP address. You can associate a file system with
+	// more than one mount target at a time.
+	// For information about access control and compartments, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about Network Security Groups access control, see
+	// Network Security Groups (https://docs.cloud.oracle.com/Content/Network/Concepts/networksecuritygroups.htm).
+	// For information about availability domains, see Regions and
+	// Availability Domains (https://docs.cloud.oracle.com/Content/General/Concepts/regions.htm).
+	// To get a list of availability domains, use the
+	// `ListAvailabilityDomains` operation in the Identity and Access
+	// Management Service API.
+	// All Oracle Cloud Infrastructure resources, including
+	// file systems, get an Oracle-assigned, unique ID called an Oracle
+	// Cloud Identifier (OCID).  When you create a resource, you can
+	// find its OCID in the response. You can also retrieve a
+	// resource's OCID by using a List API operation on that resource
+	// type or by viewing the resource in the Console.
+	CreateFileSystem(ctx context.Context, request CreateFileSystemRequest) (response CreateFileSystemResponse, err error)
+
+	// CreateMountTarget Creates a new mount target in the specified compartment and
+	// subnet. You can associate a file system with a mount
+	// target only when they exist in the same availability domain. Instances
+	// can connect to mount targets in another availablity domain, but
+	// you might see higher latency than with instances in the same
+	// availability domain as the mount target.
+	// Mount targets have one or more private IP addresses that you can
+	// provide as the host portion of remote target parameters in
+	// client mount commands. These private IP addresses are listed
+	// in the privateIpIds property of the mount target and are highly available. Mount
+	// targets also consume additional IP addresses in their subnet.
+	// Do not use /30 or smaller subnets for mount target creation because they
+	// do not have sufficient available IP addresses.
+	// Allow at least three IP addresses for each mount target.
+	// For information about access control and compartments, see
+	// Overview of the IAM
+	// Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// For information about availability domains, see Regions and
+	// Availability Domains (https://docs.cloud.oracle.com/Content/General/Concepts/regions.htm).
+	// To get a list of availability domains, use the
+	// `ListAvailabilityDomains` operation in the Identity and Access
+	// Management Service API.
+	// All Oracle Cloud Infrastructure Services resources, including
+	// mount targets, get an Oracle-assigned, unique ID called an
+	// Oracle Cloud Identifier (OCID).  When you create a resource,
+	// you can find its OCID in the response. You can also retrieve a
+	// resource's OCID by using a List API operation on that resource
+	// type, or by viewing the resource in the Console.
+	CreateMountTarget(ctx context.Context, request CreateMountTargetRequest) (response CreateMountTargetResponse, err error)
+
+	// CreateSnapshot Creates a new snapshot of the specified file system. You
+	// can access the snapshot at `.snapshot/<name>`.
+	CreateSnapshot(ctx context.Context, request CreateSnapshotRequest) (response CreateSnapshotResponse, err error)
+
+	// DeleteExport Deletes the specified export.
+	DeleteExport(ctx context.Context, request DeleteExportRequest) (response DeleteExportResponse, err error)
+
+	// DeleteFileSystem Deletes the specified file system. Before you delete the file system,
+	// verify that no remaining export resources still reference it. Deleting a
+	// file system also deletes all of its snapshots.
+	DeleteFileSystem(ctx context.Context, request DeleteFileSystemRequest) (response DeleteFileSystemResponse, err error)
+
+	// DeleteMountTarget Deletes the specified mount target. This operation also deletes the
+	// mount target's VNICs.
+	DeleteMountTarget(ctx context.Context, request DeleteMountTargetRequest) (response DeleteMountTargetResponse, err error)
+
+	// DeleteSnapshot Deletes the specified snapshot.
+	DeleteSnapshot(ctx context.Context, request DeleteSnapshotRequest) (response DeleteSnapshotResponse, err error)
+
+	// GetExport Gets the specified export's information.
+	GetExport(ctx context.Context, request GetExportRequest) (response GetExportResponse, err error)
+
+	// GetExportSet Gets the specified export set's information.
+	GetExportSet(ctx context.Context, request GetExportSetRequest) (response GetExportSetResponse, err error)
+
+	// GetFileSystem Gets the specified file system's information.
+	GetFileSystem(ctx context.Context, request GetFileSystemRequest) (response GetFileSystemResponse, err error)
+
+	// GetMountTarget Gets the specified mount target's information.
+	GetMountTarget(ctx context.Context, request GetMountTargetRequest) (response GetMountTargetResponse, err error)
+
+	// GetSnapshot Gets the specified snapshot's information.
+	GetSnapshot(ctx context.Context, request GetSnapshotRequest) (response GetSnapshotResponse, err error)
+
+	// ListExportSets Lists the export set resources in the specified compartment.
+	ListExportSets(ctx context.Context, request ListExportSetsRequest) (response ListExportSetsResponse, err error)
+
+	// ListExports Lists export resources by compartment, file system, or export
+	// set. You must specify an export set ID, a file system ID, and
+	// / or a compartment ID.
+	ListExports(ctx context.Context, request ListExportsRequest) (response ListExportsResponse, err error)
+
+	// ListFileSystems Lists the file system resources in the specified compartment.
+	ListFileSystems(ctx context.Context, request ListFileSystemsRequest) (response ListFileSystemsResponse, err error)
+
+	// ListMountTargets Lists the mount target resources in the specified compartment.
+	ListMountTargets(ctx context.Context, request ListMountTargetsRequest) (response ListMountTargetsResponse, err error)
+
+	// ListSnapshots Lists snapshots of the specified file system.
+	ListSnapshots(ctx context.Context, request ListSnapshotsRequest) (response ListSnapshotsResponse, err error)
+
+	// UpdateExport Updates the specified export's information.
+	UpdateExport(ctx context.Context, request UpdateExportRequest) (response UpdateExportResponse, err error)
+
+	// UpdateExportSet Updates the specified export set's information.
+	UpdateExportSet(ctx context.Context, request UpdateExportSetRequest) (response UpdateExportSetResponse, err error)
+
+	// UpdateFileSystem Updates the specified file system's information.
+	// You can use this operation to rename a file system.
+	UpdateFileSystem(ctx context.Context, request UpdateFileSystemRequest) (response UpdateFileSystemResponse, err error)
+
+	// UpdateMountTarget Updates the specified mount target's information.
+	UpdateMountTarget(ctx context.Context, request UpdateMountTargetRequest) (response UpdateMountTargetResponse, err error)
+
+	// UpdateSnapshot Updates the specified snapshot's information.
+	UpdateSnapshot(ctx context.Context, request UpdateSnapshotRequest) (response UpdateSnapshotResponse, err error)
+}
+
+// FileStorageClient implements FileStorageClientAPI
+var _ FileStorageClientAPI = FileStorageClient{}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package functions
+
+import (
+	"context"
+)
+
+// FunctionsManagementClientAPI is the interface of the operations of FunctionsManagementClient, to inject the client and mock it in tests
+type FunctionsManagementClientAPI interface {
+	// ChangeApplicationCompartment Moves an application into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see Moving Resources Between Compartments (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeApplicationCompartment(ctx context.Context, request ChangeApplicationCompartmentRequest) (response ChangeApplicationCompartmentResponse, err error)
+
+	// CreateApplication Creates a new application.
+	CreateApplication(ctx context.Context, request CreateApplicationRequest) (response CreateApplicationResponse, err error)
+
+	// CreateFunction Creates a new function.
+	CreateFunction(ctx context.Context, request CreateFunctionRequest) (response CreateFunctionResponse, err error)
+
+	// DeleteApplication Deletes an application.
+	DeleteApplication(ctx context.Context, request DeleteApplicationRequest) (response DeleteApplicationResponse, err error)
+
+	// DeleteFunction Deletes a function.
+	DeleteFunction(ctx context.Context, request DeleteFunctionRequest) (response DeleteFunctionResponse, err error)
+
+	// GetApplication Retrieves an application.
+	GetApplication(ctx context.Context, request GetApplicationRequest) (response GetApplicationResponse, err error)
+
+	// GetFunction Retrieves a function.
+	GetFunction(ctx context.Context, request GetFunctionRequest) (response GetFunctionResponse, err error)
+
+	// ListApplications Lists applications for a compartment.
+	ListApplications(ctx context.Context, request ListApplicationsRequest) (response ListApplicationsResponse, err error)
+
+	// ListFunctions Lists functions for an application.
+	ListFunctions(ctx context.Context, request ListFunctionsRequest) (response ListFunctionsResponse, err error)
+
+	// UpdateApplication Modifies an application
+	UpdateApplication(ctx context.Context, request UpdateApplicationRequest) (response UpdateApplicationResponse, err error)
+
+	// UpdateFunction Modifies a function
+	UpdateFunction(ctx context.Context, request UpdateFunctionRequest) (response UpdateFunctionResponse, err error)
+}
+
+// FunctionsManagementClient implements FunctionsManagementClientAPI
+var _ FunctionsManagementClientAPI = FunctionsManagementClient{}
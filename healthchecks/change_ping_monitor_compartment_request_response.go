@@ -64,6 +64,9 @@ type ChangePingMonitorCompartmentResponse struct {
 	// contact Oracle about a particular request, please provide
 	// the request ID.
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangePingMonitorCompartmentResponse) String() string {
@@ -64,6 +64,9 @@ type CreatePingMonitorResponse struct {
 
 	// An entity tag that uniquely identifies a version of the resource.
 	Etag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreatePingMonitorResponse) String() string {
@@ -105,10 +105,12 @@ func (client HealthChecksClient) ChangeHttpMonitorCompartment(ctx context.Contex
 				response = ChangeHttpMonitorCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeHttpMonitorCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeHttpMonitorCompartmentResponse")
 	}
@@ -161,10 +163,12 @@ func (client HealthChecksClient) ChangePingMonitorCompartment(ctx context.Contex
 				response = ChangePingMonitorCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangePingMonitorCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangePingMonitorCompartmentResponse")
 	}
@@ -218,10 +222,12 @@ func (client HealthChecksClient) CreateHttpMonitor(ctx context.Context, request
 				response = CreateHttpMonitorResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateHttpMonitorResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateHttpMonitorResponse")
 	}
@@ -379,10 +385,12 @@ func (client HealthChecksClient) CreatePingMonitor(ctx context.Context, request
 				response = CreatePingMonitorResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePingMonitorResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePingMonitorResponse")
 	}
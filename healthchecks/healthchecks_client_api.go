@@ -0,0 +1,91 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package healthchecks
+
+import (
+	"context"
+)
+
+// HealthChecksClientAPI is the interface of the operations of HealthChecksClient, to inject the client and mock it in tests
+type HealthChecksClientAPI interface {
+	// ChangeHttpMonitorCompartment Moves a monitor into a different compartment. When provided, `If-Match` is checked
+	// against ETag values of the resource.
+	ChangeHttpMonitorCompartment(ctx context.Context, request ChangeHttpMonitorCompartmentRequest) (response ChangeHttpMonitorCompartmentResponse, err error)
+
+	// ChangePingMonitorCompartment Moves a monitor into a different compartment. When provided, `If-Match` is checked
+	// against ETag values of the resource.
+	ChangePingMonitorCompartment(ctx context.Context, request ChangePingMonitorCompartmentRequest) (response ChangePingMonitorCompartmentResponse, err error)
+
+	// CreateHttpMonitor Creates an HTTP monitor. Vantage points will be automatically selected if not specified,
+	// and probes will be initiated from each vantage point to each of the targets at the frequency
+	// specified by `intervalInSeconds`.
+	CreateHttpMonitor(ctx context.Context, request CreateHttpMonitorRequest) (response CreateHttpMonitorResponse, err error)
+
+	// CreateOnDemandHttpProbe Creates an on-demand HTTP probe. The location response header contains the URL for
+	// fetching the probe results.
+	// *Note:* On-demand probe configurations are not saved.
+	CreateOnDemandHttpProbe(ctx context.Context, request CreateOnDemandHttpProbeRequest) (response CreateOnDemandHttpProbeResponse, err error)
+
+	// CreateOnDemandPingProbe Creates an on-demand ping probe. The location response header contains the URL for
+	// fetching probe results.
+	// *Note:* The on-demand probe configuration is not saved.
+	CreateOnDemandPingProbe(ctx context.Context, request CreateOnDemandPingProbeRequest) (response CreateOnDemandPingProbeResponse, err error)
+
+	// CreatePingMonitor Creates a ping monitor. Vantage points will be automatically selected if not specified,
+	// and probes will be initiated from each vantage point to each of the targets at the frequency
+	// specified by `intervalInSeconds`.
+	CreatePingMonitor(ctx context.Context, request CreatePingMonitorRequest) (response CreatePingMonitorResponse, err error)
+
+	// DeleteHttpMonitor Deletes the HTTP monitor and its configuration. All future probes of this
+	// monitor are stopped. Results associated with the monitor are not deleted.
+	DeleteHttpMonitor(ctx context.Context, request DeleteHttpMonitorRequest) (response DeleteHttpMonitorResponse, err error)
+
+	// DeletePingMonitor Deletes the ping monitor and its configuration. All future probes of this
+	// monitor are stopped. Results associated with the monitor are not deleted.
+	DeletePingMonitor(ctx context.Context, request DeletePingMonitorRequest) (response DeletePingMonitorResponse, err error)
+
+	// GetHttpMonitor Gets the configuration for the specified monitor.
+	GetHttpMonitor(ctx context.Context, request GetHttpMonitorRequest) (response GetHttpMonitorResponse, err error)
+
+	// GetPingMonitor Gets the configuration for the specified ping monitor.
+	GetPingMonitor(ctx context.Context, request GetPingMonitorRequest) (response GetPingMonitorResponse, err error)
+
+	// ListHealthChecksVantagePoints Gets information about all vantage points available to the user.
+	ListHealthChecksVantagePoints(ctx context.Context, request ListHealthChecksVantagePointsRequest) (response ListHealthChecksVantagePointsResponse, err error)
+
+	// ListHttpMonitors Gets a list of HTTP monitors.
+	ListHttpMonitors(ctx context.Context, request ListHttpMonitorsRequest) (response ListHttpMonitorsResponse, err error)
+
+	// ListHttpProbeResults Gets the HTTP probe results for the specified probe or monitor, where
+	// the `probeConfigurationId` is the OCID of either a monitor or an
+	// on-demand probe.
+	ListHttpProbeResults(ctx context.Context, request ListHttpProbeResultsRequest) (response ListHttpProbeResultsResponse, err error)
+
+	// ListPingMonitors Gets a list of configured ping monitors.
+	// Results are paginated based on `page` and `limit`.  The `opc-next-page` header provides
+	// a URL for fetching the next page.
+	ListPingMonitors(ctx context.Context, request ListPingMonitorsRequest) (response ListPingMonitorsResponse, err error)
+
+	// ListPingProbeResults Returns the results for the specified probe, where the `probeConfigurationId`
+	// is the OCID of either a monitor or an on-demand probe.
+	// Results are paginated based on `page` and `limit`.  The `opc-next-page` header provides
+	// a URL for fetching the next page.  Use `sortOrder` to set the order of the
+	// results.  If `sortOrder` is unspecified, results are sorted in ascending order by
+	// `startTime`.
+	ListPingProbeResults(ctx context.Context, request ListPingProbeResultsRequest) (response ListPingProbeResultsResponse, err error)
+
+	// UpdateHttpMonitor Updates the configuration of the specified HTTP monitor. Only the fields
+	// specified in the request body will be updated; all other configuration
+	// properties will remain unchanged.
+	UpdateHttpMonitor(ctx context.Context, request UpdateHttpMonitorRequest) (response UpdateHttpMonitorResponse, err error)
+
+	// UpdatePingMonitor Updates the configuration of the specified ping monitor. Only the fields
+	// specified in the request body will be updated; all other configuration properties
+	// will remain unchanged.
+	UpdatePingMonitor(ctx context.Context, request UpdatePingMonitorRequest) (response UpdatePingMonitorResponse, err error)
+}
+
+// HealthChecksClient implements HealthChecksClientAPI
+var _ HealthChecksClientAPI = HealthChecksClient{}
@@ -102,10 +102,12 @@ func (client IdentityClient) ActivateMfaTotpDevice(ctx context.Context, request
 				response = ActivateMfaTotpDeviceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ActivateMfaTotpDeviceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ActivateMfaTotpDeviceResponse")
 	}
@@ -159,10 +161,12 @@ func (client IdentityClient) AddUserToGroup(ctx context.Context, request AddUser
 				response = AddUserToGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddUserToGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddUserToGroupResponse")
 	}
@@ -271,10 +275,12 @@ func (client IdentityClient) BulkDeleteResources(ctx context.Context, request Bu
 				response = BulkDeleteResourcesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(BulkDeleteResourcesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into BulkDeleteResourcesResponse")
 	}
@@ -341,10 +347,12 @@ func (client IdentityClient) BulkDeleteTags(ctx context.Context, request BulkDel
 				response = BulkDeleteTagsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(BulkDeleteTagsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into BulkDeleteTagsResponse")
 	}
@@ -400,10 +408,12 @@ func (client IdentityClient) BulkMoveResources(ctx context.Context, request Bulk
 				response = BulkMoveResourcesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(BulkMoveResourcesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into BulkMoveResourcesResponse")
 	}
@@ -467,10 +477,12 @@ func (client IdentityClient) CascadeDeleteTagNamespace(ctx context.Context, requ
 				response = CascadeDeleteTagNamespaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CascadeDeleteTagNamespaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CascadeDeleteTagNamespaceResponse")
 	}
@@ -525,10 +537,12 @@ func (client IdentityClient) ChangeTagNamespaceCompartment(ctx context.Context,
 				response = ChangeTagNamespaceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeTagNamespaceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeTagNamespaceCompartmentResponse")
 	}
@@ -587,10 +601,12 @@ func (client IdentityClient) CreateAuthToken(ctx context.Context, request Create
 				response = CreateAuthTokenResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAuthTokenResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAuthTokenResponse")
 	}
@@ -655,10 +671,12 @@ func (client IdentityClient) CreateCompartment(ctx context.Context, request Crea
 				response = CreateCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCompartmentResponse")
 	}
@@ -718,10 +736,12 @@ func (client IdentityClient) CreateCustomerSecretKey(ctx context.Context, reques
 				response = CreateCustomerSecretKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCustomerSecretKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCustomerSecretKeyResponse")
 	}
@@ -786,10 +806,12 @@ func (client IdentityClient) CreateDynamicGroup(ctx context.Context, request Cre
 				response = CreateDynamicGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDynamicGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDynamicGroupResponse")
 	}
@@ -856,10 +878,12 @@ func (client IdentityClient) CreateGroup(ctx context.Context, request CreateGrou
 				response = CreateGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateGroupResponse")
 	}
@@ -924,10 +948,12 @@ func (client IdentityClient) CreateIdentityProvider(ctx context.Context, request
 				response = CreateIdentityProviderResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIdentityProviderResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIdentityProviderResponse")
 	}
@@ -980,10 +1006,12 @@ func (client IdentityClient) CreateIdpGroupMapping(ctx context.Context, request
 				response = CreateIdpGroupMappingResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIdpGroupMappingResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIdpGroupMappingResponse")
 	}
@@ -1035,10 +1063,12 @@ func (client IdentityClient) CreateMfaTotpDevice(ctx context.Context, request Cr
 				response = CreateMfaTotpDeviceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateMfaTotpDeviceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateMfaTotpDeviceResponse")
 	}
@@ -1105,10 +1135,12 @@ func (client IdentityClient) CreateNetworkSource(ctx context.Context, request Cr
 				response = CreateNetworkSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateNetworkSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateNetworkSourceResponse")
 	}
@@ -1160,10 +1192,12 @@ func (client IdentityClient) CreateOAuthClientCredential(ctx context.Context, re
 				response = CreateOAuthClientCredentialResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateOAuthClientCredentialResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateOAuthClientCredentialResponse")
 	}
@@ -1223,10 +1257,12 @@ func (client IdentityClient) CreateOrResetUIPassword(ctx context.Context, reques
 				response = CreateOrResetUIPasswordResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateOrResetUIPasswordResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateOrResetUIPasswordResponse")
 	}
@@ -1289,10 +1325,12 @@ func (client IdentityClient) CreatePolicy(ctx context.Context, request CreatePol
 				response = CreatePolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePolicyResponse")
 	}
@@ -1344,10 +1382,12 @@ func (client IdentityClient) CreateRegionSubscription(ctx context.Context, reque
 				response = CreateRegionSubscriptionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateRegionSubscriptionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateRegionSubscriptionResponse")
 	}
@@ -1402,10 +1442,12 @@ func (client IdentityClient) CreateSmtpCredential(ctx context.Context, request C
 				response = CreateSmtpCredentialResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSmtpCredentialResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSmtpCredentialResponse")
 	}
@@ -1465,10 +1507,12 @@ func (client IdentityClient) CreateSwiftPassword(ctx context.Context, request Cr
 				response = CreateSwiftPasswordResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSwiftPasswordResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSwiftPasswordResponse")
 	}
@@ -1536,10 +1580,12 @@ func (client IdentityClient) CreateTag(ctx context.Context, request CreateTagReq
 				response = CreateTagResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTagResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTagResponse")
 	}
@@ -1596,10 +1642,12 @@ func (client IdentityClient) CreateTagDefault(ctx context.Context, request Creat
 				response = CreateTagDefaultResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTagDefaultResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTagDefaultResponse")
 	}
@@ -1661,10 +1709,12 @@ func (client IdentityClient) CreateTagNamespace(ctx context.Context, request Cre
 				response = CreateTagNamespaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTagNamespaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTagNamespaceResponse")
 	}
@@ -1744,10 +1794,12 @@ func (client IdentityClient) CreateUser(ctx context.Context, request CreateUserR
 				response = CreateUserResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateUserResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateUserResponse")
 	}
@@ -5256,10 +5308,12 @@ func (client IdentityClient) MoveCompartment(ctx context.Context, request MoveCo
 				response = MoveCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(MoveCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into MoveCompartmentResponse")
 	}
@@ -6441,10 +6495,12 @@ func (client IdentityClient) UploadApiKey(ctx context.Context, request UploadApi
 				response = UploadApiKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UploadApiKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UploadApiKeyResponse")
 	}
@@ -0,0 +1,713 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package identity
+
+import (
+	"context"
+)
+
+// IdentityClientAPI is the interface of the operations of IdentityClient, to inject the client and mock it in tests
+type IdentityClientAPI interface {
+	// ActivateMfaTotpDevice Activates the specified MFA TOTP device for the user. Activation requires manual interaction with the Console.
+	ActivateMfaTotpDevice(ctx context.Context, request ActivateMfaTotpDeviceRequest) (response ActivateMfaTotpDeviceResponse, err error)
+
+	// AddUserToGroup Adds the specified user to the specified group and returns a `UserGroupMembership` object with its own OCID.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	AddUserToGroup(ctx context.Context, request AddUserToGroupRequest) (response AddUserToGroupResponse, err error)
+
+	// AssembleEffectiveTagSet Assembles tag defaults in the specified compartment and any parent compartments to determine
+	// the tags to apply. Tag defaults from parent compartments do not override tag defaults
+	// referencing the same tag in a compartment lower down the hierarchy. This set of tag defaults
+	// includes all tag defaults from the current compartment back to the root compartment.
+	AssembleEffectiveTagSet(ctx context.Context, request AssembleEffectiveTagSetRequest) (response AssembleEffectiveTagSetResponse, err error)
+
+	// BulkDeleteResources Deletes multiple resources in the compartment. All resources must be in the same compartment. You must have the appropriate
+	// permissions to delete the resources in the request. This API can only be invoked from the tenancy's
+	// home region (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingregions.htm#Home). This operation creates a
+	// WorkRequest. Use the GetWorkRequest
+	// API to monitor the status of the bulk action.
+	BulkDeleteResources(ctx context.Context, request BulkDeleteResourcesRequest) (response BulkDeleteResourcesResponse, err error)
+
+	// BulkDeleteTags Deletes the specified tag key definitions. This operation triggers a process that removes the
+	// tags from all resources in your tenancy.
+	// The following actions happen immediately:
+	//
+	//   * If the tag is a cost-tracking tag, the tag no longer counts against your
+	//   10 cost-tracking tags limit, even if you do not disable the tag before running this operation.
+	//   * If the tag is used with dynamic groups, the rules that contain the tag are no longer
+	//   evaluated against the tag.
+	// After you start this operation, the state of the tag changes to DELETING, and tag removal
+	// from resources begins. This process can take up to 48 hours depending on the number of resources that
+	// are tagged and the regions in which those resources reside.
+	// When all tags have been removed, the state changes to DELETED. You cannot restore a deleted tag. After the tag state
+	// changes to DELETED, you can use the same tag name again.
+	// After you start this operation, you cannot start either the DeleteTag or the CascadeDeleteTagNamespace operation until this process completes.
+	// In order to delete tags, you must first retire the tags. Use UpdateTag
+	// to retire a tag.
+	BulkDeleteTags(ctx context.Context, request BulkDeleteTagsRequest) (response BulkDeleteTagsResponse, err error)
+
+	// BulkMoveResources Moves multiple resources from one compartment to another. All resources must be in the same compartment.
+	// This API can only be invoked from the tenancy's home region (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingregions.htm#Home).
+	// To move resources, you must have the appropriate permissions to move the resource in both the source and target
+	// compartments. This operation creates a WorkRequest.
+	// Use the GetWorkRequest API to monitor the status of the bulk action.
+	BulkMoveResources(ctx context.Context, request BulkMoveResourcesRequest) (response BulkMoveResourcesResponse, err error)
+
+	// CascadeDeleteTagNamespace Deletes the specified tag namespace. This operation triggers a process that removes all of the tags
+	// defined in the specified tag namespace from all resources in your tenancy and then deletes the tag namespace.
+	// After you start the delete operation:
+	//   * New tag key definitions cannot be created under the namespace.
+	//   * The state of the tag namespace changes to DELETING.
+	//   * Tag removal from the resources begins.
+	// This process can take up to 48 hours depending on the number of tag definitions in the namespace, the number of resources
+	// that are tagged, and the locations of the regions in which those resources reside.
+	// After all tags are removed, the state changes to DELETED. You cannot restore a deleted tag namespace. After the deleted tag namespace
+	// changes its state to DELETED, you can use the name of the deleted tag namespace again.
+	// After you start this operation, you cannot start either the DeleteTag or the BulkDeleteTags operation until this process completes.
+	// To delete a tag namespace, you must first retire it. Use UpdateTagNamespace
+	// to retire a tag namespace.
+	CascadeDeleteTagNamespace(ctx context.Context, request CascadeDeleteTagNamespaceRequest) (response CascadeDeleteTagNamespaceResponse, err error)
+
+	// ChangeTagNamespaceCompartment Moves the specified tag namespace to the specified compartment within the same tenancy.
+	// To move the tag namespace, you must have the manage tag-namespaces permission on both compartments.
+	// For more information about IAM policies, see Details for IAM (https://docs.cloud.oracle.com/Content/Identity/Reference/iampolicyreference.htm).
+	// Moving a tag namespace moves all the tag key definitions contained in the tag namespace.
+	ChangeTagNamespaceCompartment(ctx context.Context, request ChangeTagNamespaceCompartmentRequest) (response ChangeTagNamespaceCompartmentResponse, err error)
+
+	// CreateAuthToken Creates a new auth token for the specified user. For information about what auth tokens are for, see
+	// Managing User Credentials (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcredentials.htm).
+	// You must specify a *description* for the auth token (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with
+	// UpdateAuthToken.
+	// Every user has permission to create an auth token for *their own user ID*. An administrator in your organization
+	// does not need to write a policy to give users this ability. To compare, administrators who have permission to the
+	// tenancy can use this operation to create an auth token for any user, including themselves.
+	CreateAuthToken(ctx context.Context, request CreateAuthTokenRequest) (response CreateAuthTokenResponse, err error)
+
+	// CreateCompartment Creates a new compartment in the specified compartment.
+	// **Important:** Compartments cannot be deleted.
+	// Specify the parent compartment's OCID as the compartment ID in the request object. Remember that the tenancy
+	// is simply the root compartment. For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the compartment, which must be unique across all compartments in
+	// your tenancy. You can use this name or the OCID when writing policies that apply
+	// to the compartment. For more information about policies, see
+	// How Policies Work (https://docs.cloud.oracle.com/Content/Identity/Concepts/policies.htm).
+	// You must also specify a *description* for the compartment (although it can be an empty string). It does
+	// not have to be unique, and you can change it anytime with
+	// UpdateCompartment.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	CreateCompartment(ctx context.Context, request CreateCompartmentRequest) (response CreateCompartmentResponse, err error)
+
+	// CreateCustomerSecretKey Creates a new secret key for the specified user. Secret keys are used for authentication with the Object Storage Service's Amazon S3
+	// compatible API. For information, see
+	// Managing User Credentials (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcredentials.htm).
+	// You must specify a *description* for the secret key (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with
+	// UpdateCustomerSecretKey.
+	// Every user has permission to create a secret key for *their own user ID*. An administrator in your organization
+	// does not need to write a policy to give users this ability. To compare, administrators who have permission to the
+	// tenancy can use this operation to create a secret key for any user, including themselves.
+	CreateCustomerSecretKey(ctx context.Context, request CreateCustomerSecretKeyRequest) (response CreateCustomerSecretKeyResponse, err error)
+
+	// CreateDynamicGroup Creates a new dynamic group in your tenancy.
+	// You must specify your tenancy's OCID as the compartment ID in the request object (remember that the tenancy
+	// is simply the root compartment). Notice that IAM resources (users, groups, compartments, and some policies)
+	// reside within the tenancy itself, unlike cloud resources such as compute instances, which typically
+	// reside within compartments inside the tenancy. For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the dynamic group, which must be unique across all dynamic groups in your
+	// tenancy, and cannot be changed. Note that this name has to be also unique across all groups in your tenancy.
+	// You can use this name or the OCID when writing policies that apply to the dynamic group. For more information
+	// about policies, see How Policies Work (https://docs.cloud.oracle.com/Content/Identity/Concepts/policies.htm).
+	// You must also specify a *description* for the dynamic group (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with UpdateDynamicGroup.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	CreateDynamicGroup(ctx context.Context, request CreateDynamicGroupRequest) (response CreateDynamicGroupResponse, err error)
+
+	// CreateGroup Creates a new group in your tenancy.
+	// You must specify your tenancy's OCID as the compartment ID in the request object (remember that the tenancy
+	// is simply the root compartment). Notice that IAM resources (users, groups, compartments, and some policies)
+	// reside within the tenancy itself, unlike cloud resources such as compute instances, which typically
+	// reside within compartments inside the tenancy. For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the group, which must be unique across all groups in your tenancy and
+	// cannot be changed. You can use this name or the OCID when writing policies that apply to the group. For more
+	// information about policies, see How Policies Work (https://docs.cloud.oracle.com/Content/Identity/Concepts/policies.htm).
+	// You must also specify a *description* for the group (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with UpdateGroup.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	// After creating the group, you need to put users in it and write policies for it.
+	// See AddUserToGroup and
+	// CreatePolicy.
+	CreateGroup(ctx context.Context, request CreateGroupRequest) (response CreateGroupResponse, err error)
+
+	// CreateIdentityProvider Creates a new identity provider in your tenancy. For more information, see
+	// Identity Providers and Federation (https://docs.cloud.oracle.com/Content/Identity/Concepts/federation.htm).
+	// You must specify your tenancy's OCID as the compartment ID in the request object.
+	// Remember that the tenancy is simply the root compartment. For information about
+	// OCIDs, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the `IdentityProvider`, which must be unique
+	// across all `IdentityProvider` objects in your tenancy and cannot be changed.
+	// You must also specify a *description* for the `IdentityProvider` (although
+	// it can be an empty string). It does not have to be unique, and you can change
+	// it anytime with
+	// UpdateIdentityProvider.
+	// After you send your request, the new object's `lifecycleState` will temporarily
+	// be CREATING. Before using the object, first make sure its `lifecycleState` has
+	// changed to ACTIVE.
+	CreateIdentityProvider(ctx context.Context, request CreateIdentityProviderRequest) (response CreateIdentityProviderResponse, err error)
+
+	// CreateIdpGroupMapping Creates a single mapping between an IdP group and an IAM Service
+	// Group.
+	CreateIdpGroupMapping(ctx context.Context, request CreateIdpGroupMappingRequest) (response CreateIdpGroupMappingResponse, err error)
+
+	// CreateMfaTotpDevice Creates a new MFA TOTP device for the user. A user can have one MFA TOTP device.
+	CreateMfaTotpDevice(ctx context.Context, request CreateMfaTotpDeviceRequest) (response CreateMfaTotpDeviceResponse, err error)
+
+	// CreateNetworkSource Creates a new network source in your tenancy.
+	// You must specify your tenancy's OCID as the compartment ID in the request object (remember that the tenancy
+	// is simply the root compartment). Notice that IAM resources (users, groups, compartments, and some policies)
+	// reside within the tenancy itself, unlike cloud resources such as compute instances, which typically
+	// reside within compartments inside the tenancy. For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the network source, which must be unique across all network sources in your
+	// tenancy, and cannot be changed.
+	// You can use this name or the OCID when writing policies that apply to the network source. For more information
+	// about policies, see How Policies Work (https://docs.cloud.oracle.com/Content/Identity/Concepts/policies.htm).
+	// You must also specify a *description* for the network source (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with UpdateNetworkSource.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	// After your network resource is created, you can use it in policy to restrict access to only requests made from an allowed
+	// IP address specified in your network source. For more information, see Managing Network Sources (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingnetworksources.htm).
+	CreateNetworkSource(ctx context.Context, request CreateNetworkSourceRequest) (response CreateNetworkSourceResponse, err error)
+
+	// CreateOAuthClientCredential Creates Oauth token for the user
+	CreateOAuthClientCredential(ctx context.Context, request CreateOAuthClientCredentialRequest) (response CreateOAuthClientCredentialResponse, err error)
+
+	// CreateOrResetUIPassword Creates a new Console one-time password for the specified user. For more information about user
+	// credentials, see User Credentials (https://docs.cloud.oracle.com/Content/Identity/Concepts/usercredentials.htm).
+	// Use this operation after creating a new user, or if a user forgets their password. The new one-time
+	// password is returned to you in the response, and you must securely deliver it to the user. They'll
+	// be prompted to change this password the next time they sign in to the Console. If they don't change
+	// it within 7 days, the password will expire and you'll need to create a new one-time password for the
+	// user.
+	// **Note:** The user's Console login is the unique name you specified when you created the user
+	// (see CreateUser).
+	CreateOrResetUIPassword(ctx context.Context, request CreateOrResetUIPasswordRequest) (response CreateOrResetUIPasswordResponse, err error)
+
+	// CreatePolicy Creates a new policy in the specified compartment (either the tenancy or another of your compartments).
+	// If you're new to policies, see Getting Started with Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/policygetstarted.htm).
+	// You must specify a *name* for the policy, which must be unique across all policies in your tenancy
+	// and cannot be changed.
+	// You must also specify a *description* for the policy (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with UpdatePolicy.
+	// You must specify one or more policy statements in the statements array. For information about writing
+	// policies, see How Policies Work (https://docs.cloud.oracle.com/Content/Identity/Concepts/policies.htm) and
+	// Common Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/commonpolicies.htm).
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using the
+	// object, first make sure its `lifecycleState` has changed to ACTIVE.
+	// New policies take effect typically within 10 seconds.
+	CreatePolicy(ctx context.Context, request CreatePolicyRequest) (response CreatePolicyResponse, err error)
+
+	// CreateRegionSubscription Creates a subscription to a region for a tenancy.
+	CreateRegionSubscription(ctx context.Context, request CreateRegionSubscriptionRequest) (response CreateRegionSubscriptionResponse, err error)
+
+	// CreateSmtpCredential Creates a new SMTP credential for the specified user. An SMTP credential has an SMTP user name and an SMTP password.
+	// You must specify a *description* for the SMTP credential (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with
+	// UpdateSmtpCredential.
+	CreateSmtpCredential(ctx context.Context, request CreateSmtpCredentialRequest) (response CreateSmtpCredentialResponse, err error)
+
+	// CreateSwiftPassword **Deprecated. Use CreateAuthToken instead.**
+	// Creates a new Swift password for the specified user. For information about what Swift passwords are for, see
+	// Managing User Credentials (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcredentials.htm).
+	// You must specify a *description* for the Swift password (although it can be an empty string). It does not
+	// have to be unique, and you can change it anytime with
+	// UpdateSwiftPassword.
+	// Every user has permission to create a Swift password for *their own user ID*. An administrator in your organization
+	// does not need to write a policy to give users this ability. To compare, administrators who have permission to the
+	// tenancy can use this operation to create a Swift password for any user, including themselves.
+	CreateSwiftPassword(ctx context.Context, request CreateSwiftPasswordRequest) (response CreateSwiftPasswordResponse, err error)
+
+	// CreateTag Creates a new tag in the specified tag namespace.
+	// The tag requires either the OCID or the name of the tag namespace that will contain this
+	// tag definition.
+	// You must specify a *name* for the tag, which must be unique across all tags in the tag namespace
+	// and cannot be changed. The name can contain any ASCII character except the space (_) or period (.) characters.
+	// Names are case insensitive. That means, for example, "myTag" and "mytag" are not allowed in the same namespace.
+	// If you specify a name that's already in use in the tag namespace, a 409 error is returned.
+	// The tag must have a *description*. It does not have to be unique, and you can change it with
+	// UpdateTag.
+	// The tag must have a value type, which is specified with a validator. Tags can use either a
+	// static value or a list of possible values. Static values are entered by a user applying the tag
+	// to a resource. Lists are created by you and the user must apply a value from the list. Lists
+	// are validiated.
+	// * If no `validator` is set, the user applying the tag to a resource can type in a static
+	// value or leave the tag value empty.
+	// * If a `validator` is set, the user applying the tag to a resource must select from a list
+	// of values that you supply with EnumTagDefinitionValidator.
+	CreateTag(ctx context.Context, request CreateTagRequest) (response CreateTagResponse, err error)
+
+	// CreateTagDefault Creates a new tag default in the specified compartment for the specified tag definition.
+	// If you specify that a value is required, a value is set during resource creation (either by
+	// the user creating the resource or another tag defualt). If no value is set, resource creation
+	// is blocked.
+	// * If the `isRequired` flag is set to "true", the value is set during resource creation.
+	// * If the `isRequired` flag is set to "false", the value you enter is set during resource creation.
+	CreateTagDefault(ctx context.Context, request CreateTagDefaultRequest) (response CreateTagDefaultResponse, err error)
+
+	// CreateTagNamespace Creates a new tag namespace in the specified compartment.
+	// You must specify the compartment ID in the request object (remember that the tenancy is simply the root
+	// compartment).
+	// You must also specify a *name* for the namespace, which must be unique across all namespaces in your tenancy
+	// and cannot be changed. The name can contain any ASCII character except the space (_) or period (.).
+	// Names are case insensitive. That means, for example, "myNamespace" and "mynamespace" are not allowed
+	// in the same tenancy. Once you created a namespace, you cannot change the name.
+	// If you specify a name that's already in use in the tenancy, a 409 error is returned.
+	// You must also specify a *description* for the namespace.
+	// It does not have to be unique, and you can change it with
+	// UpdateTagNamespace.
+	CreateTagNamespace(ctx context.Context, request CreateTagNamespaceRequest) (response CreateTagNamespaceResponse, err error)
+
+	// CreateUser Creates a new user in your tenancy. For conceptual information about users, your tenancy, and other
+	// IAM Service components, see Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// You must specify your tenancy's OCID as the compartment ID in the request object (remember that the
+	// tenancy is simply the root compartment). Notice that IAM resources (users, groups, compartments, and
+	// some policies) reside within the tenancy itself, unlike cloud resources such as compute instances,
+	// which typically reside within compartments inside the tenancy. For information about OCIDs, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// You must also specify a *name* for the user, which must be unique across all users in your tenancy
+	// and cannot be changed. Allowed characters: No spaces. Only letters, numerals, hyphens, periods,
+	// underscores, +, and @. If you specify a name that's already in use, you'll get a 409 error.
+	// This name will be the user's login to the Console. You might want to pick a
+	// name that your company's own identity system (e.g., Active Directory, LDAP, etc.) already uses.
+	// If you delete a user and then create a new user with the same name, they'll be considered different
+	// users because they have different OCIDs.
+	// You must also specify a *description* for the user (although it can be an empty string).
+	// It does not have to be unique, and you can change it anytime with
+	// UpdateUser. You can use the field to provide the user's
+	// full name, a description, a nickname, or other information to generally identify the user.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before
+	// using the object, first make sure its `lifecycleState` has changed to ACTIVE.
+	// A new user has no permissions until you place the user in one or more groups (see
+	// AddUserToGroup). If the user needs to
+	// access the Console, you need to provide the user a password (see
+	// CreateOrResetUIPassword).
+	// If the user needs to access the Oracle Cloud Infrastructure REST API, you need to upload a
+	// public API signing key for that user (see
+	// Required Keys and OCIDs (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm) and also
+	// UploadApiKey).
+	// **Important:** Make sure to inform the new user which compartment(s) they have access to.
+	CreateUser(ctx context.Context, request CreateUserRequest) (response CreateUserResponse, err error)
+
+	// DeleteApiKey Deletes the specified API signing key for the specified user.
+	// Every user has permission to use this operation to delete a key for *their own user ID*. An
+	// administrator in your organization does not need to write a policy to give users this ability.
+	// To compare, administrators who have permission to the tenancy can use this operation to delete
+	// a key for any user, including themselves.
+	DeleteApiKey(ctx context.Context, request DeleteApiKeyRequest) (response DeleteApiKeyResponse, err error)
+
+	// DeleteAuthToken Deletes the specified auth token for the specified user.
+	DeleteAuthToken(ctx context.Context, request DeleteAuthTokenRequest) (response DeleteAuthTokenResponse, err error)
+
+	// DeleteCompartment Deletes the specified compartment. The compartment must be empty.
+	DeleteCompartment(ctx context.Context, request DeleteCompartmentRequest) (response DeleteCompartmentResponse, err error)
+
+	// DeleteCustomerSecretKey Deletes the specified secret key for the specified user.
+	DeleteCustomerSecretKey(ctx context.Context, request DeleteCustomerSecretKeyRequest) (response DeleteCustomerSecretKeyResponse, err error)
+
+	// DeleteDynamicGroup Deletes the specified dynamic group.
+	DeleteDynamicGroup(ctx context.Context, request DeleteDynamicGroupRequest) (response DeleteDynamicGroupResponse, err error)
+
+	// DeleteGroup Deletes the specified group. The group must be empty.
+	DeleteGroup(ctx context.Context, request DeleteGroupRequest) (response DeleteGroupResponse, err error)
+
+	// DeleteIdentityProvider Deletes the specified identity provider. The identity provider must not have
+	// any group mappings (see IdpGroupMapping).
+	DeleteIdentityProvider(ctx context.Context, request DeleteIdentityProviderRequest) (response DeleteIdentityProviderResponse, err error)
+
+	// DeleteIdpGroupMapping Deletes the specified group mapping.
+	DeleteIdpGroupMapping(ctx context.Context, request DeleteIdpGroupMappingRequest) (response DeleteIdpGroupMappingResponse, err error)
+
+	// DeleteMfaTotpDevice Deletes the specified MFA TOTP device for the specified user.
+	DeleteMfaTotpDevice(ctx context.Context, request DeleteMfaTotpDeviceRequest) (response DeleteMfaTotpDeviceResponse, err error)
+
+	// DeleteNetworkSource Deletes the specified network source
+	DeleteNetworkSource(ctx context.Context, request DeleteNetworkSourceRequest) (response DeleteNetworkSourceResponse, err error)
+
+	// DeleteOAuthClientCredential Delete Oauth token for the user
+	DeleteOAuthClientCredential(ctx context.Context, request DeleteOAuthClientCredentialRequest) (response DeleteOAuthClientCredentialResponse, err error)
+
+	// DeletePolicy Deletes the specified policy. The deletion takes effect typically within 10 seconds.
+	DeletePolicy(ctx context.Context, request DeletePolicyRequest) (response DeletePolicyResponse, err error)
+
+	// DeleteSmtpCredential Deletes the specified SMTP credential for the specified user.
+	DeleteSmtpCredential(ctx context.Context, request DeleteSmtpCredentialRequest) (response DeleteSmtpCredentialResponse, err error)
+
+	// DeleteSwiftPassword **Deprecated. Use DeleteAuthToken instead.**
+	// Deletes the specified Swift password for the specified user.
+	DeleteSwiftPassword(ctx context.Context, request DeleteSwiftPasswordRequest) (response DeleteSwiftPasswordResponse, err error)
+
+	// DeleteTag Deletes the specified tag definition. This operation triggers a process that removes the
+	// tag from all resources in your tenancy.
+	// These things happen immediately:
+	//
+	//   * If the tag was a cost-tracking tag, it no longer counts against your 10 cost-tracking
+	//   tags limit, whether you first disabled it or not.
+	//   * If the tag was used with dynamic groups, none of the rules that contain the tag will
+	//   be evaluated against the tag.
+	// When you start the delete operation, the state of the tag changes to DELETING and tag removal
+	// from resources begins. This can take up to 48 hours depending on the number of resources that
+	// were tagged as well as the regions in which those resources reside.
+	// When all tags have been removed, the state changes to DELETED. You cannot restore a deleted tag. Once the deleted tag
+	// changes its state to DELETED, you can use the same tag name again.
+	// After you start this operation, you cannot start either the BulkDeleteTags or the CascadeDeleteTagNamespace operation until this process completes.
+	// To delete a tag, you must first retire it. Use UpdateTag
+	// to retire a tag.
+	DeleteTag(ctx context.Context, request DeleteTagRequest) (response DeleteTagResponse, err error)
+
+	// DeleteTagDefault Deletes the the specified tag default.
+	DeleteTagDefault(ctx context.Context, request DeleteTagDefaultRequest) (response DeleteTagDefaultResponse, err error)
+
+	// DeleteTagNamespace Deletes the specified tag namespace. Only an empty tag namespace can be deleted with this operation. To use this operation
+	// to delete a tag namespace that contains tag definitions, first delete all of its tag definitions.
+	// Use CascadeDeleteTagNamespace to delete a tag namespace along with all of
+	// the tag definitions contained within that namespace.
+	// Use DeleteTag to delete a tag definition.
+	DeleteTagNamespace(ctx context.Context, request DeleteTagNamespaceRequest) (response DeleteTagNamespaceResponse, err error)
+
+	// DeleteUser Deletes the specified user. The user must not be in any groups.
+	DeleteUser(ctx context.Context, request DeleteUserRequest) (response DeleteUserResponse, err error)
+
+	// GenerateTotpSeed Generate seed for the MFA TOTP device.
+	GenerateTotpSeed(ctx context.Context, request GenerateTotpSeedRequest) (response GenerateTotpSeedResponse, err error)
+
+	// GetAuthenticationPolicy Gets the authentication policy for the given tenancy. You must specify your tenant’s OCID as the value for
+	// the compartment ID (remember that the tenancy is simply the root compartment).
+	GetAuthenticationPolicy(ctx context.Context, request GetAuthenticationPolicyRequest) (response GetAuthenticationPolicyResponse, err error)
+
+	// GetCompartment Gets the specified compartment's information.
+	// This operation does not return a list of all the resources inside the compartment. There is no single
+	// API operation that does that. Compartments can contain multiple types of resources (instances, block
+	// storage volumes, etc.). To find out what's in a compartment, you must call the "List" operation for
+	// each resource type and specify the compartment's OCID as a query parameter in the request. For example,
+	// call the ListInstances operation in the Cloud Compute
+	// Service or the ListVolumes operation in Cloud Block Storage.
+	GetCompartment(ctx context.Context, request GetCompartmentRequest) (response GetCompartmentResponse, err error)
+
+	// GetDynamicGroup Gets the specified dynamic group's information.
+	GetDynamicGroup(ctx context.Context, request GetDynamicGroupRequest) (response GetDynamicGroupResponse, err error)
+
+	// GetGroup Gets the specified group's information.
+	// This operation does not return a list of all the users in the group. To do that, use
+	// ListUserGroupMemberships and
+	// provide the group's OCID as a query parameter in the request.
+	GetGroup(ctx context.Context, request GetGroupRequest) (response GetGroupResponse, err error)
+
+	// GetIdentityProvider Gets the specified identity provider's information.
+	GetIdentityProvider(ctx context.Context, request GetIdentityProviderRequest) (response GetIdentityProviderResponse, err error)
+
+	// GetIdpGroupMapping Gets the specified group mapping.
+	GetIdpGroupMapping(ctx context.Context, request GetIdpGroupMappingRequest) (response GetIdpGroupMappingResponse, err error)
+
+	// GetMfaTotpDevice Get the specified MFA TOTP device for the specified user.
+	GetMfaTotpDevice(ctx context.Context, request GetMfaTotpDeviceRequest) (response GetMfaTotpDeviceResponse, err error)
+
+	// GetNetworkSource Gets the specified network source's information.
+	GetNetworkSource(ctx context.Context, request GetNetworkSourceRequest) (response GetNetworkSourceResponse, err error)
+
+	// GetPolicy Gets the specified policy's information.
+	GetPolicy(ctx context.Context, request GetPolicyRequest) (response GetPolicyResponse, err error)
+
+	// GetTag Gets the specified tag's information.
+	GetTag(ctx context.Context, request GetTagRequest) (response GetTagResponse, err error)
+
+	// GetTagDefault Retrieves the specified tag default.
+	GetTagDefault(ctx context.Context, request GetTagDefaultRequest) (response GetTagDefaultResponse, err error)
+
+	// GetTagNamespace Gets the specified tag namespace's information.
+	GetTagNamespace(ctx context.Context, request GetTagNamespaceRequest) (response GetTagNamespaceResponse, err error)
+
+	// GetTaggingWorkRequest Gets details on a specified work request. The workRequestID is returned in the opc-workrequest-id header
+	// for any asynchronous operation in the Identity and Access Management service.
+	GetTaggingWorkRequest(ctx context.Context, request GetTaggingWorkRequestRequest) (response GetTaggingWorkRequestResponse, err error)
+
+	// GetTenancy Get the specified tenancy's information.
+	GetTenancy(ctx context.Context, request GetTenancyRequest) (response GetTenancyResponse, err error)
+
+	// GetUser Gets the specified user's information.
+	GetUser(ctx context.Context, request GetUserRequest) (response GetUserResponse, err error)
+
+	// GetUserGroupMembership Gets the specified UserGroupMembership's information.
+	GetUserGroupMembership(ctx context.Context, request GetUserGroupMembershipRequest) (response GetUserGroupMembershipResponse, err error)
+
+	// GetUserUIPasswordInformation Gets the specified user's console password information. The returned object contains the user's OCID,
+	// but not the password itself. The actual password is returned only when created or reset.
+	GetUserUIPasswordInformation(ctx context.Context, request GetUserUIPasswordInformationRequest) (response GetUserUIPasswordInformationResponse, err error)
+
+	// GetWorkRequest Gets details on a specified work request. The workRequestID is returned in the opc-workrequest-id header
+	// for any asynchronous operation in the Identity and Access Management service.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListApiKeys Lists the API signing keys for the specified user. A user can have a maximum of three keys.
+	// Every user has permission to use this API call for *their own user ID*.  An administrator in your
+	// organization does not need to write a policy to give users this ability.
+	ListApiKeys(ctx context.Context, request ListApiKeysRequest) (response ListApiKeysResponse, err error)
+
+	// ListAuthTokens Lists the auth tokens for the specified user. The returned object contains the token's OCID, but not
+	// the token itself. The actual token is returned only upon creation.
+	ListAuthTokens(ctx context.Context, request ListAuthTokensRequest) (response ListAuthTokensResponse, err error)
+
+	// ListAvailabilityDomains Lists the availability domains in your tenancy. Specify the OCID of either the tenancy or another
+	// of your compartments as the value for the compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	// Note that the order of the results returned can change if availability domains are added or removed; therefore, do not
+	// create a dependency on the list order.
+	ListAvailabilityDomains(ctx context.Context, request ListAvailabilityDomainsRequest) (response ListAvailabilityDomainsResponse, err error)
+
+	// ListBulkActionResourceTypes Lists the resource-types supported by compartment bulk actions. Use this API to help you provide the correct
+	// resource-type information to the BulkDeleteResources
+	// and BulkMoveResources operations. The returned list of
+	// resource-types provides the appropriate resource-type names to use with the bulk action operations along with
+	// the type of identifying information you'll need to provide for each resource-type. Most resource-types just
+	// require an OCID (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm) to identify a specific resource, but some resource-types,
+	// such as buckets, require you to provide other identifying information.
+	ListBulkActionResourceTypes(ctx context.Context, request ListBulkActionResourceTypesRequest) (response ListBulkActionResourceTypesResponse, err error)
+
+	// ListCompartments Lists the compartments in a specified compartment. The members of the list
+	// returned depends on the values set for several parameters.
+	// With the exception of the tenancy (root compartment), the ListCompartments operation
+	// returns only the first-level child compartments in the parent compartment specified in
+	// `compartmentId`. The list does not include any subcompartments of the child
+	// compartments (grandchildren).
+	// The parameter `accessLevel` specifies whether to return only those compartments for which the
+	// requestor has INSPECT permissions on at least one resource directly
+	// or indirectly (the resource can be in a subcompartment).
+	// The parameter `compartmentIdInSubtree` applies only when you perform ListCompartments on the
+	// tenancy (root compartment). When set to true, the entire hierarchy of compartments can be returned.
+	// To get a full list of all compartments and subcompartments in the tenancy (root compartment),
+	// set the parameter `compartmentIdInSubtree` to true and `accessLevel` to ANY.
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListCompartments(ctx context.Context, request ListCompartmentsRequest) (response ListCompartmentsResponse, err error)
+
+	// ListCostTrackingTags Lists all the tags enabled for cost-tracking in the specified tenancy. For information about
+	// cost-tracking tags, see Using Cost-tracking Tags (https://docs.cloud.oracle.com/Content/Identity/Concepts/taggingoverview.htm#costs).
+	ListCostTrackingTags(ctx context.Context, request ListCostTrackingTagsRequest) (response ListCostTrackingTagsResponse, err error)
+
+	// ListCustomerSecretKeys Lists the secret keys for the specified user. The returned object contains the secret key's OCID, but not
+	// the secret key itself. The actual secret key is returned only upon creation.
+	ListCustomerSecretKeys(ctx context.Context, request ListCustomerSecretKeysRequest) (response ListCustomerSecretKeysResponse, err error)
+
+	// ListDynamicGroups Lists the dynamic groups in your tenancy. You must specify your tenancy's OCID as the value for
+	// the compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListDynamicGroups(ctx context.Context, request ListDynamicGroupsRequest) (response ListDynamicGroupsResponse, err error)
+
+	// ListFaultDomains Lists the Fault Domains in your tenancy. Specify the OCID of either the tenancy or another
+	// of your compartments as the value for the compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListFaultDomains(ctx context.Context, request ListFaultDomainsRequest) (response ListFaultDomainsResponse, err error)
+
+	// ListGroups Lists the groups in your tenancy. You must specify your tenancy's OCID as the value for
+	// the compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListGroups(ctx context.Context, request ListGroupsRequest) (response ListGroupsResponse, err error)
+
+	// ListIdentityProviderGroups Lists the identity provider groups.
+	ListIdentityProviderGroups(ctx context.Context, request ListIdentityProviderGroupsRequest) (response ListIdentityProviderGroupsResponse, err error)
+
+	// ListIdentityProviders Lists all the identity providers in your tenancy. You must specify the identity provider type (e.g., `SAML2` for
+	// identity providers using the SAML2.0 protocol). You must specify your tenancy's OCID as the value for the
+	// compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListIdentityProviders(ctx context.Context, request ListIdentityProvidersRequest) (response ListIdentityProvidersResponse, err error)
+
+	// ListIdpGroupMappings Lists the group mappings for the specified identity provider.
+	ListIdpGroupMappings(ctx context.Context, request ListIdpGroupMappingsRequest) (response ListIdpGroupMappingsResponse, err error)
+
+	// ListMfaTotpDevices Lists the MFA TOTP devices for the specified user. The returned object contains the device's OCID, but not
+	// the seed. The seed is returned only upon creation or when the IAM service regenerates the MFA seed for the device.
+	ListMfaTotpDevices(ctx context.Context, request ListMfaTotpDevicesRequest) (response ListMfaTotpDevicesResponse, err error)
+
+	// ListNetworkSources Lists the network sources in your tenancy. You must specify your tenancy's OCID as the value for
+	// the compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListNetworkSources(ctx context.Context, request ListNetworkSourcesRequest) (response ListNetworkSourcesResponse, err error)
+
+	// ListOAuthClientCredentials List of Oauth tokens for the user
+	ListOAuthClientCredentials(ctx context.Context, request ListOAuthClientCredentialsRequest) (response ListOAuthClientCredentialsResponse, err error)
+
+	// ListPolicies Lists the policies in the specified compartment (either the tenancy or another of your compartments).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	// To determine which policies apply to a particular group or compartment, you must view the individual
+	// statements inside all your policies. There isn't a way to automatically obtain that information via the API.
+	ListPolicies(ctx context.Context, request ListPoliciesRequest) (response ListPoliciesResponse, err error)
+
+	// ListRegionSubscriptions Lists the region subscriptions for the specified tenancy.
+	ListRegionSubscriptions(ctx context.Context, request ListRegionSubscriptionsRequest) (response ListRegionSubscriptionsResponse, err error)
+
+	// ListSmtpCredentials Lists the SMTP credentials for the specified user. The returned object contains the credential's OCID,
+	// the SMTP user name but not the SMTP password. The SMTP password is returned only upon creation.
+	ListSmtpCredentials(ctx context.Context, request ListSmtpCredentialsRequest) (response ListSmtpCredentialsResponse, err error)
+
+	// ListSwiftPasswords **Deprecated. Use ListAuthTokens instead.**
+	// Lists the Swift passwords for the specified user. The returned object contains the password's OCID, but not
+	// the password itself. The actual password is returned only upon creation.
+	ListSwiftPasswords(ctx context.Context, request ListSwiftPasswordsRequest) (response ListSwiftPasswordsResponse, err error)
+
+	// ListTagDefaults Lists the tag defaults for tag definitions in the specified compartment.
+	ListTagDefaults(ctx context.Context, request ListTagDefaultsRequest) (response ListTagDefaultsResponse, err error)
+
+	// ListTagNamespaces Lists the tag namespaces in the specified compartment.
+	ListTagNamespaces(ctx context.Context, request ListTagNamespacesRequest) (response ListTagNamespacesResponse, err error)
+
+	// ListTaggingWorkRequestErrors Gets the errors for a work request.
+	ListTaggingWorkRequestErrors(ctx context.Context, request ListTaggingWorkRequestErrorsRequest) (response ListTaggingWorkRequestErrorsResponse, err error)
+
+	// ListTaggingWorkRequestLogs Gets the logs for a work request.
+	ListTaggingWorkRequestLogs(ctx context.Context, request ListTaggingWorkRequestLogsRequest) (response ListTaggingWorkRequestLogsResponse, err error)
+
+	// ListTaggingWorkRequests Lists the tagging work requests in compartment.
+	ListTaggingWorkRequests(ctx context.Context, request ListTaggingWorkRequestsRequest) (response ListTaggingWorkRequestsResponse, err error)
+
+	// ListTags Lists the tag definitions in the specified tag namespace.
+	ListTags(ctx context.Context, request ListTagsRequest) (response ListTagsResponse, err error)
+
+	// ListUserGroupMemberships Lists the `UserGroupMembership` objects in your tenancy. You must specify your tenancy's OCID
+	// as the value for the compartment ID
+	// (see Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five)).
+	// You must also then filter the list in one of these ways:
+	// - You can limit the results to just the memberships for a given user by specifying a `userId`.
+	// - Similarly, you can limit the results to just the memberships for a given group by specifying a `groupId`.
+	// - You can set both the `userId` and `groupId` to determine if the specified user is in the specified group.
+	// If the answer is no, the response is an empty list.
+	// - Although`userId` and `groupId` are not individually required, you must set one of them.
+	ListUserGroupMemberships(ctx context.Context, request ListUserGroupMembershipsRequest) (response ListUserGroupMembershipsResponse, err error)
+
+	// ListUsers Lists the users in your tenancy. You must specify your tenancy's OCID as the value for the
+	// compartment ID (remember that the tenancy is simply the root compartment).
+	// See Where to Get the Tenancy's OCID and User's OCID (https://docs.cloud.oracle.com/Content/API/Concepts/apisigningkey.htm#five).
+	ListUsers(ctx context.Context, request ListUsersRequest) (response ListUsersResponse, err error)
+
+	// ListWorkRequests Lists the work requests in compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// MoveCompartment Move the compartment to a different parent compartment in the same tenancy. When you move a
+	// compartment, all its contents (subcompartments and resources) are moved with it. Note that
+	// the `CompartmentId` that you specify in the path is the compartment that you want to move.
+	// **IMPORTANT**: After you move a compartment to a new parent compartment, the access policies of
+	// the new parent take effect and the policies of the previous parent no longer apply. Ensure that you
+	// are aware of the implications for the compartment contents before you move it. For more
+	// information, see Moving a Compartment (https://docs.cloud.oracle.com/Content/Identity/Tasks/managingcompartments.htm#MoveCompartment).
+	MoveCompartment(ctx context.Context, request MoveCompartmentRequest) (response MoveCompartmentResponse, err error)
+
+	// RecoverCompartment Recover the compartment from DELETED state to ACTIVE state.
+	RecoverCompartment(ctx context.Context, request RecoverCompartmentRequest) (response RecoverCompartmentResponse, err error)
+
+	// RemoveUserFromGroup Removes a user from a group by deleting the corresponding `UserGroupMembership`.
+	RemoveUserFromGroup(ctx context.Context, request RemoveUserFromGroupRequest) (response RemoveUserFromGroupResponse, err error)
+
+	// ResetIdpScimClient Resets the OAuth2 client credentials for the SCIM client associated with this identity provider.
+	ResetIdpScimClient(ctx context.Context, request ResetIdpScimClientRequest) (response ResetIdpScimClientResponse, err error)
+
+	// UpdateAuthToken Updates the specified auth token's description.
+	UpdateAuthToken(ctx context.Context, request UpdateAuthTokenRequest) (response UpdateAuthTokenResponse, err error)
+
+	// UpdateAuthenticationPolicy Updates authentication policy for the specified tenancy
+	UpdateAuthenticationPolicy(ctx context.Context, request UpdateAuthenticationPolicyRequest) (response UpdateAuthenticationPolicyResponse, err error)
+
+	// UpdateCompartment Updates the specified compartment's description or name. You can't update the root compartment.
+	UpdateCompartment(ctx context.Context, request UpdateCompartmentRequest) (response UpdateCompartmentResponse, err error)
+
+	// UpdateCustomerSecretKey Updates the specified secret key's description.
+	UpdateCustomerSecretKey(ctx context.Context, request UpdateCustomerSecretKeyRequest) (response UpdateCustomerSecretKeyResponse, err error)
+
+	// UpdateDynamicGroup Updates the specified dynamic group.
+	UpdateDynamicGroup(ctx context.Context, request UpdateDynamicGroupRequest) (response UpdateDynamicGroupResponse, err error)
+
+	// UpdateGroup Updates the specified group.
+	UpdateGroup(ctx context.Context, request UpdateGroupRequest) (response UpdateGroupResponse, err error)
+
+	// UpdateIdentityProvider Updates the specified identity provider.
+	UpdateIdentityProvider(ctx context.Context, request UpdateIdentityProviderRequest) (response UpdateIdentityProviderResponse, err error)
+
+	// UpdateIdpGroupMapping Updates the specified group mapping.
+	UpdateIdpGroupMapping(ctx context.Context, request UpdateIdpGroupMappingRequest) (response UpdateIdpGroupMappingResponse, err error)
+
+	// UpdateNetworkSource Updates the specified network source.
+	UpdateNetworkSource(ctx context.Context, request UpdateNetworkSourceRequest) (response UpdateNetworkSourceResponse, err error)
+
+	// UpdateOAuthClientCredential Updates Oauth token for the user
+	UpdateOAuthClientCredential(ctx context.Context, request UpdateOAuthClientCredentialRequest) (response UpdateOAuthClientCredentialResponse, err error)
+
+	// UpdatePolicy Updates the specified policy. You can update the description or the policy statements themselves.
+	// Policy changes take effect typically within 10 seconds.
+	UpdatePolicy(ctx context.Context, request UpdatePolicyRequest) (response UpdatePolicyResponse, err error)
+
+	// UpdateSmtpCredential Updates the specified SMTP credential's description.
+	UpdateSmtpCredential(ctx context.Context, request UpdateSmtpCredentialRequest) (response UpdateSmtpCredentialResponse, err error)
+
+	// UpdateSwiftPassword **Deprecated. Use UpdateAuthToken instead.**
+	// Updates the specified Swift password's description.
+	UpdateSwiftPassword(ctx context.Context, request UpdateSwiftPasswordRequest) (response UpdateSwiftPasswordResponse, err error)
+
+	// UpdateTag Updates the specified tag definition.
+	// Setting `validator` determines the value type. Tags can use either a static value or a
+	// list of possible values. Static values are entered by a user applying the tag to a resource.
+	// Lists are created by you and the user must apply a value from the list. On update, any values
+	// in a list that were previously set do not change, but new values must pass validation. Values
+	// already applied to a resource do not change.
+	// You cannot remove list values that appear in a TagDefault. To remove a list value that
+	// appears in a TagDefault, first update the TagDefault to use a different value.
+	UpdateTag(ctx context.Context, request UpdateTagRequest) (response UpdateTagResponse, err error)
+
+	// UpdateTagDefault Updates the specified tag default. If you specify that a value is required, a value is set
+	// during resource creation (either by the user creating the resource or another tag defualt).
+	// If no value is set, resource creation is blocked.
+	// * If the `isRequired` flag is set to "true", the value is set during resource creation.
+	// * If the `isRequired` flag is set to "false", the value you enter is set during resource creation.
+	UpdateTagDefault(ctx context.Context, request UpdateTagDefaultRequest) (response UpdateTagDefaultResponse, err error)
+
+	// UpdateTagNamespace Updates the the specified tag namespace. You can't update the namespace name.
+	// Updating `isRetired` to 'true' retires the namespace and all the tag definitions in the namespace. Reactivating a
+	// namespace (changing `isRetired` from 'true' to 'false') does not reactivate tag definitions.
+	// To reactivate the tag definitions, you must reactivate each one individually *after* you reactivate the namespace,
+	// using UpdateTag. For more information about retiring tag namespaces, see
+	// Retiring Key Definitions and Namespace Definitions (https://docs.cloud.oracle.com/Content/Identity/Concepts/taggingoverview.htm#Retiring).
+	// You can't add a namespace with the same name as a retired namespace in the same tenancy.
+	UpdateTagNamespace(ctx context.Context, request UpdateTagNamespaceRequest) (response UpdateTagNamespaceResponse, err error)
+
+	// UpdateUser Updates the description of the specified user.
+	UpdateUser(ctx context.Context, request UpdateUserRequest) (response UpdateUserResponse, err error)
+
+	// UpdateUserCapabilities Updates the capabilities of the specified user.
+	UpdateUserCapabilities(ctx context.Context, request UpdateUserCapabilitiesRequest) (response UpdateUserCapabilitiesResponse, err error)
+
+	// UpdateUserState Updates the state of the specified user.
+	UpdateUserState(ctx context.Context, request UpdateUserStateRequest) (response UpdateUserStateResponse, err error)
+
+	// UploadApiKey Uploads an API signing key for the specified user.
+	// Every user has permission to use this operation to upload a key for *their own user ID*. An
+	// administrator in your organization does not need to write a policy to give users this ability.
+	// To compare, administrators who have permission to the tenancy can use this operation to upload a
+	// key for any user, including themselves.
+	// **Important:** Even though you have permission to upload an API key, you might not yet
+	// have permission to do much else. If you try calling an operation unrelated to your own credential
+	// management (e.g., `ListUsers`, `LaunchInstance`) and receive an "unauthorized" error,
+	// check with an administrator to confirm which IAM Service group(s) you're in and what access
+	// you have. Also confirm you're working in the correct compartment.
+	// After you send your request, the new object's `lifecycleState` will temporarily be CREATING. Before using
+	// the object, first make sure its `lifecycleState` has changed to ACTIVE.
+	UploadApiKey(ctx context.Context, request UploadApiKeyRequest) (response UploadApiKeyResponse, err error)
+}
+
+// IdentityClient implements IdentityClientAPI
+var _ IdentityClientAPI = IdentityClient{}
@@ -0,0 +1,85 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package identity
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*BaseTagDefinitionValidator)(nil), func() common.PolymorphicJSONUnmarshaler { return &basetagdefinitionvalidator{} })
+	common.RegisterPolymorphicModel((*CreateIdentityProviderDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createidentityproviderdetails{} })
+	common.RegisterPolymorphicModel((*IdentityProvider)(nil), func() common.PolymorphicJSONUnmarshaler { return &identityprovider{} })
+	common.RegisterPolymorphicModel((*UpdateIdentityProviderDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateidentityproviderdetails{} })
+}
+
+// MarshalJSON marshals a BaseTagDefinitionValidator whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m basetagdefinitionvalidator) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypebasetagdefinitionvalidator basetagdefinitionvalidator
+	s := struct {
+		MarshalTypebasetagdefinitionvalidator
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypebasetagdefinitionvalidator: (MarshalTypebasetagdefinitionvalidator)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateIdentityProviderDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createidentityproviderdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateidentityproviderdetails createidentityproviderdetails
+	s := struct {
+		MarshalTypecreateidentityproviderdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateidentityproviderdetails: (MarshalTypecreateidentityproviderdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a IdentityProvider whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m identityprovider) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeidentityprovider identityprovider
+	s := struct {
+		MarshalTypeidentityprovider
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeidentityprovider: (MarshalTypeidentityprovider)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateIdentityProviderDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateidentityproviderdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateidentityproviderdetails updateidentityproviderdetails
+	s := struct {
+		MarshalTypeupdateidentityproviderdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateidentityproviderdetails: (MarshalTypeupdateidentityproviderdetails)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -69,6 +69,9 @@ type ChangeIntegrationInstanceCompartmentResponse struct {
 	// you need to contact Oracle about a particular request,
 	// please provide the request ID.
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangeIntegrationInstanceCompartmentResponse) String() string {
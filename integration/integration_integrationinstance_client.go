@@ -102,10 +102,12 @@ func (client IntegrationInstanceClient) ChangeIntegrationInstanceCompartment(ctx
 				response = ChangeIntegrationInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeIntegrationInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeIntegrationInstanceCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client IntegrationInstanceClient) CreateIntegrationInstance(ctx context.Co
 				response = CreateIntegrationInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIntegrationInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIntegrationInstanceResponse")
 	}
@@ -562,10 +566,12 @@ func (client IntegrationInstanceClient) StartIntegrationInstance(ctx context.Con
 				response = StartIntegrationInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartIntegrationInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartIntegrationInstanceResponse")
 	}
@@ -617,10 +623,12 @@ func (client IntegrationInstanceClient) StopIntegrationInstance(ctx context.Cont
 				response = StopIntegrationInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopIntegrationInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopIntegrationInstanceResponse")
 	}
@@ -0,0 +1,51 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package integration
+
+import (
+	"context"
+)
+
+// IntegrationInstanceClientAPI is the interface of the operations of IntegrationInstanceClient, to inject the client and mock it in tests
+type IntegrationInstanceClientAPI interface {
+	// ChangeIntegrationInstanceCompartment Change the compartment for an integration instance
+	ChangeIntegrationInstanceCompartment(ctx context.Context, request ChangeIntegrationInstanceCompartmentRequest) (response ChangeIntegrationInstanceCompartmentResponse, err error)
+
+	// CreateIntegrationInstance Creates a new Integration Instance.
+	CreateIntegrationInstance(ctx context.Context, request CreateIntegrationInstanceRequest) (response CreateIntegrationInstanceResponse, err error)
+
+	// DeleteIntegrationInstance Deletes an Integration Instance resource by identifier.
+	DeleteIntegrationInstance(ctx context.Context, request DeleteIntegrationInstanceRequest) (response DeleteIntegrationInstanceResponse, err error)
+
+	// GetIntegrationInstance Gets a IntegrationInstance by identifier
+	GetIntegrationInstance(ctx context.Context, request GetIntegrationInstanceRequest) (response GetIntegrationInstanceResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListIntegrationInstances Returns a list of Integration Instances.
+	ListIntegrationInstances(ctx context.Context, request ListIntegrationInstancesRequest) (response ListIntegrationInstancesResponse, err error)
+
+	// ListWorkRequestErrors Get the errors of a work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Get the logs of a work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// StartIntegrationInstance Start an integration instance that was previously in an INACTIVE state
+	StartIntegrationInstance(ctx context.Context, request StartIntegrationInstanceRequest) (response StartIntegrationInstanceResponse, err error)
+
+	// StopIntegrationInstance Stop an integration instance that was previously in an ACTIVE state
+	StopIntegrationInstance(ctx context.Context, request StopIntegrationInstanceRequest) (response StopIntegrationInstanceResponse, err error)
+
+	// UpdateIntegrationInstance Updates the Integration Instance.
+	UpdateIntegrationInstance(ctx context.Context, request UpdateIntegrationInstanceRequest) (response UpdateIntegrationInstanceResponse, err error)
+}
+
+// IntegrationInstanceClient implements IntegrationInstanceClientAPI
+var _ IntegrationInstanceClientAPI = IntegrationInstanceClient{}
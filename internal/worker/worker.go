@@ -0,0 +1,89 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package worker holds the helpers shared by the background workers of the SDK, such as the log shipper, the
+// streaming producer and consumers, and the metrics publisher.
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// IsTransientError returns true for throttling, server side errors and network errors, such as timeouts and
+// refused or broken connections. The other errors, such as the failures to sign a request, the invalid requests
+// or responses, the names which do not resolve and the errors of the contexts, are permanent.
+func IsTransientError(err error) bool {
+	if serviceError, ok := common.IsServiceError(err); ok {
+		statusCode := serviceError.GetHTTPStatusCode()
+		return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+	}
+
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) {
+		return dnsError.IsTimeout || dnsError.IsTemporary
+	}
+
+	// the errors of the http client are url errors, which are network errors whatever the error they wrap
+	if urlError, ok := err.(*url.Error); ok {
+		err = urlError.Err
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	return err == io.ErrUnexpectedEOF
+}
+
+// Queue is a buffered channel fed by concurrent callers and drained by a single worker, which can be closed while
+// callers are blocked on a full buffer
+type Queue struct {
+	items     chan interface{}
+	errClosed error
+	mutex     sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+}
+
+// NewQueue returns a queue buffering up to size items, whose Put returns errClosed once it is closed
+func NewQueue(size int, errClosed error) *Queue {
+	return &Queue{items: make(chan interface{}, size), errClosed: errClosed}
+}
+
+// Put adds an item to the queue, it blocks while the buffer is full until ctx is done
+func (queue *Queue) Put(ctx context.Context, item interface{}) error {
+	// the read lock keeps Close from closing the channel during the send
+	queue.mutex.RLock()
+	defer queue.mutex.RUnlock()
+	if queue.closed {
+		return queue.errClosed
+	}
+
+	select {
+	case queue.items <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Items returns the channel of the items put in the queue, closed once the queue is closed and drained
+func (queue *Queue) Items() <-chan interface{} {
+	return queue.items
+}
+
+// Close stops accepting items, the items buffered are still received from Items
+func (queue *Queue) Close() {
+	queue.closeOnce.Do(func() {
+		queue.mutex.Lock()
+		queue.closed = true
+		close(queue.items)
+		queue.mutex.Unlock()
+	})
+}
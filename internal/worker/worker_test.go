@@ -0,0 +1,94 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package worker
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+func TestIsTransientError(t *testing.T) {
+	statusCodes := map[int]bool{
+		http.StatusBadRequest:          false,
+		http.StatusNotFound:            false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusServiceUnavailable:  true,
+	}
+	for statusCode, transient := range statusCodes {
+		server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+			w.Write([]byte(`{"code":"Code","message":"message"}`))
+		}))
+		_, err := server.BaseClient().Invoke(context.Background(), common.InvokeRequest{Method: http.MethodGet, Path: "/"}, nil)
+		server.Close()
+		assert.Equal(t, transient, IsTransientError(err), "status code %d", statusCode)
+	}
+
+	transientErrors := []error{
+		io.ErrUnexpectedEOF,
+		&url.Error{Op: "Get", URL: "/", Err: io.ErrUnexpectedEOF},
+		&url.Error{Op: "Get", URL: "/", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}},
+		&url.Error{Op: "Get", URL: "/", Err: context.DeadlineExceeded},
+		&url.Error{Op: "Get", URL: "/", Err: &net.DNSError{Err: "timeout", Name: "host", IsTimeout: true}},
+	}
+	for _, err := range transientErrors {
+		assert.True(t, IsTransientError(err), "%v", err)
+	}
+
+	permanentErrors := []error{
+		errors.New("can not read the private key"),
+		common.ValidationError{Type: "Request"},
+		&json.SyntaxError{},
+		context.Canceled,
+		&url.Error{Op: "Get", URL: "/", Err: context.Canceled},
+		&url.Error{Op: "Get", URL: "/", Err: x509.UnknownAuthorityError{}},
+		&url.Error{Op: "Get", URL: "/", Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "host", IsNotFound: true}}},
+	}
+	for _, err := range permanentErrors {
+		assert.False(t, IsTransientError(err), "%v", err)
+	}
+
+	// the connections to a closed server are refused
+	server := ocitest.NewServer(http.NotFoundHandler())
+	client := server.BaseClient()
+	server.Close()
+	_, err := client.Invoke(context.Background(), common.InvokeRequest{Method: http.MethodGet, Path: "/"}, nil)
+	assert.True(t, IsTransientError(err), "%v", err)
+}
+
+func TestQueue(t *testing.T) {
+	errClosed := errors.New("closed")
+	queue := NewQueue(1, errClosed)
+	assert.NoError(t, queue.Put(context.Background(), 1))
+
+	// a full queue blocks until ctx is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, queue.Put(ctx, 2))
+
+	queue.Close()
+	queue.Close()
+	assert.Equal(t, errClosed, queue.Put(context.Background(), 3))
+
+	// the items buffered are still received
+	var items []interface{}
+	for item := range queue.Items() {
+		items = append(items, item)
+	}
+	assert.Equal(t, []interface{}{1}, items)
+}
@@ -76,6 +76,9 @@ type BackupVaultResponse struct {
 	// Unique Oracle-assigned identifier for the work request, used to track the progress of the
 	// backup operation.
 	OpcWorkRequestId *string `presentIn:"header" name:"opc-work-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response BackupVaultResponse) String() string {
@@ -0,0 +1,208 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+)
+
+var errorDecryptionFailed = errors.New("the envelope could not be authenticated")
+
+// CryptoClients returns the client of the crypto endpoint of a vault
+type CryptoClients func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error)
+
+// NewCryptoClients returns the clients of the crypto endpoints created with the configuration provider, each created
+// once
+func NewCryptoClients(configProvider common.ConfigurationProvider) CryptoClients {
+	var mutex sync.Mutex
+	clients := make(map[string]keymanagement.KmsCryptoClient)
+	return func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if client, ok := clients[cryptoEndpoint]; ok {
+			return client, nil
+		}
+
+		client, err := keymanagement.NewKmsCryptoClientWithConfigurationProvider(configProvider, cryptoEndpoint)
+		if err != nil {
+			return client, err
+		}
+		clients[cryptoEndpoint] = client
+		return client, nil
+	}
+}
+
+// cachedKey is an unwrapped data encryption key
+type cachedKey struct {
+	aead    cipher.AEAD
+	expires time.Time
+}
+
+// Decryptor decrypts the envelopes of the allowed master keys and vaults. Decryptor is safe for concurrent use.
+type Decryptor struct {
+	clients CryptoClients
+	config  DecryptorConfiguration
+
+	allowedEndpoints map[string]bool
+	allowedKeys      map[string]bool
+
+	mutex sync.Mutex
+	keys  map[string]cachedKey
+}
+
+// NewDecryptor returns a decryptor unwrapping the data encryption keys with the clients of the crypto endpoints
+// recorded in the envelopes, see NewCryptoClients. Only the endpoints and keys allowed by config are used.
+func NewDecryptor(clients CryptoClients, config DecryptorConfiguration) (*Decryptor, error) {
+	if clients == nil {
+		return nil, errorInvalidClientsFunc
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+
+	decryptor := &Decryptor{clients: clients, config: config, keys: make(map[string]cachedKey), allowedEndpoints: make(map[string]bool)}
+	for _, endpoint := range config.AllowedCryptoEndpoints {
+		decryptor.allowedEndpoints[normalizeEndpoint(endpoint)] = true
+	}
+	if len(config.AllowedKeyIds) > 0 {
+		decryptor.allowedKeys = make(map[string]bool)
+		for _, keyID := range config.AllowedKeyIds {
+			decryptor.allowedKeys[keyID] = true
+		}
+	}
+	return decryptor, nil
+}
+
+// Decrypt decrypts a serialized envelope and returns its plaintext, the additional authenticated data is available
+// with ParseEnvelope. The stream envelopes are decrypted with NewStreamReader.
+func (decryptor *Decryptor) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	envelope, header, err := parseEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if envelope.Algorithm != AlgorithmAES256GCM {
+		return nil, errorStreamEnvelope
+	}
+
+	if err = decryptor.checkAllowed(envelope); err != nil {
+		return nil, err
+	}
+
+	aead, err := decryptor.dataKey(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.IV) != aead.NonceSize() {
+		return nil, errorDecryptionFailed
+	}
+
+	plaintext, err := aead.Open(nil, envelope.IV, envelope.Ciphertext, header)
+	if err != nil {
+		return nil, errorDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// checkAllowed rejects the envelopes of a crypto endpoint or master key outside the allow-lists
+func (decryptor *Decryptor) checkAllowed(envelope *Envelope) error {
+	if !decryptor.allowedEndpoints[normalizeEndpoint(envelope.CryptoEndpoint)] {
+		return fmt.Errorf("the crypto endpoint %q of the envelope is not allowed", envelope.CryptoEndpoint)
+	}
+
+	if decryptor.allowedKeys != nil && !decryptor.allowedKeys[envelope.KeyId] {
+		return fmt.Errorf("the master key %q of the envelope is not allowed", envelope.KeyId)
+	}
+	return nil
+}
+
+// normalizeEndpoint ignores the case and the trailing slash of an endpoint
+func normalizeEndpoint(endpoint string) string {
+	return strings.ToLower(strings.TrimSuffix(endpoint, "/"))
+}
+
+// dataKey returns the data encryption key of the envelope, from the cache or unwrapped by the vault
+func (decryptor *Decryptor) dataKey(ctx context.Context, envelope *Envelope) (cipher.AEAD, error) {
+	cacheKey := envelope.KeyId + "|" + envelope.WrappedKey
+	decryptor.mutex.Lock()
+	cached, ok := decryptor.keys[cacheKey]
+	decryptor.mutex.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.aead, nil
+	}
+
+	client, err := decryptor.clients(envelope.CryptoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Decrypt(ctx, keymanagement.DecryptRequest{
+		DecryptDataDetails: keymanagement.DecryptDataDetails{
+			Ciphertext: common.String(envelope.WrappedKey),
+			KeyId:      common.String(envelope.KeyId),
+		},
+		RequestMetadata: decryptor.config.RequestMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Plaintext == nil {
+		return nil, errors.New("the decrypted data encryption key is missing its plaintext")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(*resp.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	decryptor.cache(cacheKey, aead)
+	return aead, nil
+}
+
+// cache keeps an unwrapped key, evicting the expired keys and then the keys expiring first when the cache is full
+func (decryptor *Decryptor) cache(cacheKey string, aead cipher.AEAD) {
+	if *decryptor.config.MaxCachedKeys == 0 {
+		return
+	}
+
+	decryptor.mutex.Lock()
+	defer decryptor.mutex.Unlock()
+
+	now := time.Now()
+	for len(decryptor.keys) >= *decryptor.config.MaxCachedKeys {
+		oldest := ""
+		for key, cached := range decryptor.keys {
+			if !now.Before(cached.expires) {
+				delete(decryptor.keys, key)
+				continue
+			}
+			if oldest == "" || cached.expires.Before(decryptor.keys[oldest].expires) {
+				oldest = key
+			}
+		}
+		if len(decryptor.keys) >= *decryptor.config.MaxCachedKeys && oldest != "" {
+			delete(decryptor.keys, oldest)
+		}
+	}
+	decryptor.keys[cacheKey] = cachedKey{aead: aead, expires: now.Add(*decryptor.config.CacheTTL)}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+)
+
+const dataKeyLength = 32 // AES-256
+
+// dataKey is a data encryption key in use by an encryptor
+type dataKey struct {
+	aead       cipher.AEAD
+	wrappedKey string
+	created    time.Time
+	uses       int64
+}
+
+// Encryptor encrypts data into envelopes. Encryptor is safe for concurrent use.
+type Encryptor struct {
+	client keymanagement.KmsCryptoClient
+	config EncryptorConfiguration
+
+	mutex   sync.Mutex
+	current *dataKey
+}
+
+// NewEncryptor returns an encryptor with the master key of the configuration. client must be created for the crypto
+// endpoint of the vault of the key, the endpoint is recorded in the envelopes.
+func NewEncryptor(client keymanagement.KmsCryptoClient, config EncryptorConfiguration) (*Encryptor, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+	return &Encryptor{client: client, config: config}, nil
+}
+
+// Encrypt encrypts the plaintext and returns the serialized envelope. The additional authenticated data aad is
+// stored in the envelope in plaintext, it can not be altered without failing the decryption. It may be nil.
+func (encryptor *Encryptor) Encrypt(ctx context.Context, plaintext, aad []byte) ([]byte, error) {
+	key, err := encryptor.dataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := encryptor.newEnvelope(key, AlgorithmAES256GCM, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := envelope.header()
+	if err != nil {
+		return nil, err
+	}
+	return key.aead.Seal(header, envelope.IV, plaintext, header), nil
+}
+
+// newEnvelope returns the envelope of data encrypted with key, with a random IV
+func (encryptor *Encryptor) newEnvelope(key *dataKey, algorithm string, aad []byte) (*Envelope, error) {
+	envelope := &Envelope{
+		KeyId:          *encryptor.config.KeyId,
+		CryptoEndpoint: encryptor.client.Host,
+		WrappedKey:     key.wrappedKey,
+		Algorithm:      algorithm,
+		IV:             make([]byte, key.aead.NonceSize()),
+		AAD:            aad,
+	}
+	if encryptor.config.KeyVersionId != nil {
+		envelope.KeyVersionId = *encryptor.config.KeyVersionId
+	}
+
+	if _, err := rand.Read(envelope.IV); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// Rotate discards the data encryption key in use, the next envelope is encrypted with a new key
+func (encryptor *Encryptor) Rotate() {
+	encryptor.mutex.Lock()
+	encryptor.current = nil
+	encryptor.mutex.Unlock()
+}
+
+// dataKey returns the data encryption key in use, or generates a new one if it reached its limits
+func (encryptor *Encryptor) dataKey(ctx context.Context) (*dataKey, error) {
+	encryptor.mutex.Lock()
+	defer encryptor.mutex.Unlock()
+
+	current := encryptor.current
+	if current == nil || current.uses >= *encryptor.config.MaxKeyUses || time.Since(current.created) >= *encryptor.config.MaxKeyAge {
+		var err error
+		if current, err = encryptor.generateDataKey(ctx); err != nil {
+			return nil, err
+		}
+		encryptor.current = current
+	}
+
+	current.uses++
+	return current, nil
+}
+
+func (encryptor *Encryptor) generateDataKey(ctx context.Context) (*dataKey, error) {
+	resp, err := encryptor.client.GenerateDataEncryptionKey(ctx, keymanagement.GenerateDataEncryptionKeyRequest{
+		GenerateKeyDetails: keymanagement.GenerateKeyDetails{
+			IncludePlaintextKey: common.Bool(true),
+			KeyId:               encryptor.config.KeyId,
+			KeyShape: &keymanagement.KeyShape{
+				Algorithm: keymanagement.KeyShapeAlgorithmAes,
+				Length:    common.Int(dataKeyLength),
+			},
+		},
+		RequestMetadata: encryptor.config.RequestMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Plaintext == nil || resp.Ciphertext == nil {
+		return nil, errors.New("the generated data encryption key is missing its plaintext or ciphertext")
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(*resp.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(plaintext)
+
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return &dataKey{aead: aead, wrappedKey: *resp.Ciphertext, created: time.Now()}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != dataKeyLength {
+		return nil, errors.New("the data encryption key is not a 256-bit key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// zero overwrites a plaintext data encryption key, once the cipher built from it holds the key on its own
+func zero(key []byte) {
+	for i := range key {
+		key[i] = 0
+	}
+}
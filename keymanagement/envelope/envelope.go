@@ -0,0 +1,133 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package envelope implements envelope encryption with the keys of the Vault service. The Encryptor encrypts data
+// of any size locally with AES-256-GCM, using data encryption keys (DEK) generated and wrapped by a master
+// encryption key of a vault, and returns a self-describing envelope holding the wrapped DEK and everything needed to
+// decrypt the data. The Decryptor unwraps the DEK with the crypto endpoint of the vault recorded in the envelope,
+// once the endpoint and the master key are found in its allow-lists. Both cache the DEKs, so that the vault is not called for every message.
+// Streams too large to be held in memory are encrypted in chunks into stream envelopes, with Encryptor.EncryptStream
+// and Decryptor.NewStreamReader.
+package envelope
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// AlgorithmAES256GCM is the algorithm of the envelopes, AES-256-GCM with a 96-bit random IV
+	AlgorithmAES256GCM = "AES-256-GCM"
+
+	// AlgorithmAES256GCMChunked is the algorithm of the stream envelopes, AES-256-GCM in chunks, see
+	// Encryptor.EncryptStream
+	AlgorithmAES256GCMChunked = "AES-256-GCM-CHUNKED"
+
+	// magic starts every serialized envelope
+	magic         = "OCIENV"
+	formatVersion = 1
+	headerSize    = len(magic) + 1 + 4
+)
+
+var errorNotAnEnvelope = errors.New("the data is not an envelope")
+
+// Envelope is data encrypted with a data encryption key, and the description of the key and the encryption. It is
+// serialized as the magic "OCIENV", the format version, the length of the JSON description as a 32-bit big-endian
+// integer, the JSON description and the ciphertext. The JSON description is authenticated as additional data of
+// the encryption, so that it can not be altered.
+type Envelope struct {
+	// The OCID of the master encryption key which wrapped the data encryption key.
+	KeyId string `json:"keyId"`
+
+	// The OCID of the version of the master key, if known.
+	KeyVersionId string `json:"keyVersionId,omitempty"`
+
+	// The crypto endpoint of the vault of the master key.
+	CryptoEndpoint string `json:"cryptoEndpoint"`
+
+	// The data encryption key wrapped by the master key, as returned by the vault.
+	WrappedKey string `json:"wrappedKey"`
+
+	// The encryption algorithm, AES-256-GCM or AES-256-GCM-CHUNKED for the stream envelopes.
+	Algorithm string `json:"algorithm"`
+
+	// The initialization vector of the encryption, the chunks of a stream envelope derive their nonces from it.
+	IV []byte `json:"iv"`
+
+	// The size of the plaintext of the chunks of a stream envelope.
+	ChunkSize int `json:"chunkSize,omitempty"`
+
+	// The additional authenticated data given to Encrypt, not encrypted.
+	AAD []byte `json:"aad,omitempty"`
+
+	// The encrypted data and its authentication tag.
+	Ciphertext []byte `json:"-"`
+}
+
+// header returns the serialized envelope without its ciphertext
+func (envelope *Envelope) header() ([]byte, error) {
+	description, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize, headerSize+len(description))
+	copy(header, magic)
+	header[len(magic)] = formatVersion
+	binary.BigEndian.PutUint32(header[len(magic)+1:], uint32(len(description)))
+	return append(header, description...), nil
+}
+
+// Marshal serializes the envelope
+func (envelope *Envelope) Marshal() ([]byte, error) {
+	header, err := envelope.header()
+	if err != nil {
+		return nil, err
+	}
+	return append(header, envelope.Ciphertext...), nil
+}
+
+// ParseEnvelope deserializes an envelope, to inspect it without decrypting it
+func ParseEnvelope(data []byte) (*Envelope, error) {
+	envelope, _, err := parseEnvelope(data)
+	return envelope, err
+}
+
+// descriptionLength returns the length of the JSON description of the serialized envelope starting with data
+func descriptionLength(data []byte) (int64, error) {
+	if len(data) < headerSize || string(data[:len(magic)]) != magic {
+		return 0, errorNotAnEnvelope
+	}
+
+	if version := data[len(magic)]; version != formatVersion {
+		return 0, fmt.Errorf("unsupported envelope format version %d", version)
+	}
+
+	return int64(binary.BigEndian.Uint32(data[len(magic)+1 : headerSize])), nil
+}
+
+// parseEnvelope returns the envelope and its serialized header
+func parseEnvelope(data []byte) (*Envelope, []byte, error) {
+	length, err := descriptionLength(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if length > int64(len(data)-headerSize) {
+		return nil, nil, errorNotAnEnvelope
+	}
+
+	end := headerSize + int(length)
+	envelope := &Envelope{}
+	if err := json.Unmarshal(data[headerSize:end], envelope); err != nil {
+		return nil, nil, fmt.Errorf("invalid envelope description: %v", err)
+	}
+
+	if envelope.Algorithm != AlgorithmAES256GCM && envelope.Algorithm != AlgorithmAES256GCMChunked {
+		return nil, nil, fmt.Errorf("unsupported envelope algorithm %s", envelope.Algorithm)
+	}
+	envelope.Ciphertext = data[end:]
+	return envelope, data[:end], nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// EncryptorConfiguration defines the input parameters of NewEncryptor
+type EncryptorConfiguration struct {
+	// The OCID of the master encryption key wrapping the data encryption keys.
+	KeyId *string `mandatory:"true"`
+
+	// [Optional] The OCID of the current version of the master key, recorded in the envelopes. The vault finds the
+	// version from the wrapped key itself, this is for auditing only.
+	KeyVersionId *string `mandatory:"false"`
+
+	// [Optional] The number of envelopes a data encryption key encrypts before a new one is generated.
+	// Defaults to 10,000, the max is 2^32, the limit of AES-GCM with random IVs.
+	MaxKeyUses *int64 `mandatory:"false"`
+
+	// [Optional] How long a data encryption key is used before a new one is generated. Defaults to 1 hour.
+	MaxKeyAge *time.Duration `mandatory:"false"`
+
+	// [Optional] The retry policy of the GenerateDataEncryptionKey requests, sent when a new data encryption key is
+	// needed.
+	RequestMetadata common.RequestMetadata
+}
+
+// DecryptorConfiguration defines the input parameters of NewDecryptor
+type DecryptorConfiguration struct {
+	// The crypto endpoints the envelopes may be decrypted with, for example
+	// https://<vault>-crypto.kms.us-ashburn-1.oraclecloud.com. The envelopes are not trusted, the other endpoints are
+	// rejected without any request, so that a forged envelope can not send the request to another host.
+	AllowedCryptoEndpoints []string `mandatory:"true"`
+
+	// [Optional] The OCIDs of the master keys the envelopes may be decrypted with. Defaults to any key of the
+	// allowed crypto endpoints.
+	AllowedKeyIds []string `mandatory:"false"`
+
+	// [Optional] The maximum number of unwrapped data encryption keys cached. Defaults to 100, 0 disables the cache.
+	MaxCachedKeys *int `mandatory:"false"`
+
+	// [Optional] How long an unwrapped data encryption key is cached. Defaults to 1 hour.
+	CacheTTL *time.Duration `mandatory:"false"`
+
+	// [Optional] The retry policy of the Decrypt requests unwrapping the data encryption keys missing from the cache.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidKeyID       = errors.New("keyId is required")
+	errorInvalidMaxKeyUses  = errors.New("maxKeyUses must be between 1 and 2^32")
+	errorInvalidMaxKeyAge   = errors.New("maxKeyAge must be greater than 0")
+	errorInvalidCachedKeys  = errors.New("maxCachedKeys must not be negative")
+	errorInvalidCacheTTL    = errors.New("cacheTTL must be greater than 0")
+	errorInvalidClientsFunc = errors.New("a function returning the client of a crypto endpoint is required")
+	errorInvalidEndpoints   = errors.New("at least one allowed crypto endpoint is required")
+)
+
+const (
+	maxKeyUses = 1 << 32
+
+	defaultMaxKeyUses    = 10000
+	defaultMaxKeyAge     = time.Hour
+	defaultMaxCachedKeys = 100
+	defaultCacheTTL      = time.Hour
+)
+
+func (config EncryptorConfiguration) validate() error {
+	if config.KeyId == nil {
+		return errorInvalidKeyID
+	}
+
+	if config.MaxKeyUses != nil && (*config.MaxKeyUses <= 0 || *config.MaxKeyUses > maxKeyUses) {
+		return errorInvalidMaxKeyUses
+	}
+
+	if config.MaxKeyAge != nil && *config.MaxKeyAge <= 0 {
+		return errorInvalidMaxKeyAge
+	}
+
+	return nil
+}
+
+func (config *EncryptorConfiguration) initDefaultValues() {
+	if config.MaxKeyUses == nil {
+		config.MaxKeyUses = common.Int64(defaultMaxKeyUses)
+	}
+
+	if config.MaxKeyAge == nil {
+		maxKeyAge := defaultMaxKeyAge
+		config.MaxKeyAge = &maxKeyAge
+	}
+}
+
+func (config DecryptorConfiguration) validate() error {
+	if len(config.AllowedCryptoEndpoints) == 0 {
+		return errorInvalidEndpoints
+	}
+
+	if config.MaxCachedKeys != nil && *config.MaxCachedKeys < 0 {
+		return errorInvalidCachedKeys
+	}
+
+	if config.CacheTTL != nil && *config.CacheTTL <= 0 {
+		return errorInvalidCacheTTL
+	}
+
+	return nil
+}
+
+func (config *DecryptorConfiguration) initDefaultValues() {
+	if config.MaxCachedKeys == nil {
+		config.MaxCachedKeys = common.Int(defaultMaxCachedKeys)
+	}
+
+	if config.CacheTTL == nil {
+		cacheTTL := defaultCacheTTL
+		config.CacheTTL = &cacheTTL
+	}
+}
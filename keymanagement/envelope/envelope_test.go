@@ -0,0 +1,224 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// createTestKey creates an AES master key in a fake vault and returns its OCID
+func createTestKey(t *testing.T, server *ocitest.VaultServer) string {
+	client := keymanagement.KmsManagementClient{BaseClient: server.BaseClient()}
+	resp, err := client.CreateKey(context.Background(), keymanagement.CreateKeyRequest{CreateKeyDetails: keymanagement.CreateKeyDetails{
+		CompartmentId: common.String("compartment1"),
+		DisplayName:   common.String("key"),
+		KeyShape:      &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(32)},
+	}})
+	assert.NoError(t, err)
+	return *resp.Id
+}
+
+// cryptoCounts counts the data encryption keys a fake vault generated and unwrapped
+type cryptoCounts struct {
+	generated int32
+	decrypted int32
+}
+
+// count serves the requests with the handler of a fake vault, counting the GenerateDataEncryptionKey and Decrypt
+// requests
+func (counts *cryptoCounts) count(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/generateDataEncryptionKey"):
+			atomic.AddInt32(&counts.generated, 1)
+		case strings.HasSuffix(r.URL.Path, "/decrypt"):
+			atomic.AddInt32(&counts.decrypted, 1)
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func TestEnvelope_RoundTrip(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	counts := &cryptoCounts{}
+	server := ocitest.NewServer(counts.count(vault.Config.Handler))
+	defer server.Close()
+	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	clients := func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error) {
+		assert.Equal(t, client.Host, cryptoEndpoint)
+		return client, nil
+	}
+	ctx := context.Background()
+
+	encryptor, err := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID), KeyVersionId: common.String("version1")})
+	assert.NoError(t, err)
+	decryptor, err := NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}})
+	assert.NoError(t, err)
+
+	data, err := encryptor.Encrypt(ctx, []byte("hello world"), []byte("context"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "hello world")
+
+	envelope, err := ParseEnvelope(data)
+	assert.NoError(t, err)
+	assert.Equal(t, keyID, envelope.KeyId)
+	assert.Equal(t, "version1", envelope.KeyVersionId)
+	assert.Equal(t, client.Host, envelope.CryptoEndpoint)
+	assert.Equal(t, AlgorithmAES256GCM, envelope.Algorithm)
+	assert.Equal(t, []byte("context"), envelope.AAD)
+	assert.Len(t, envelope.IV, 12)
+
+	plaintext, err := decryptor.Decrypt(ctx, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(plaintext))
+
+	// the unwrapped key is cached
+	other, _ := encryptor.Encrypt(ctx, []byte("other"), nil)
+	plaintext, err = decryptor.Decrypt(ctx, other)
+	assert.NoError(t, err)
+	assert.Equal(t, "other", string(plaintext))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.generated))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.decrypted))
+
+	// the re-serialized envelope is identical
+	marshalled, err := envelope.Marshal()
+	assert.NoError(t, err)
+	assert.Equal(t, data, marshalled)
+}
+
+func TestEnvelope_KeyRotation(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	counts := &cryptoCounts{}
+	server := ocitest.NewServer(counts.count(vault.Config.Handler))
+	defer server.Close()
+	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	ctx := context.Background()
+
+	encryptor, err := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID), MaxKeyUses: common.Int64(2)})
+	assert.NoError(t, err)
+
+	wrappedKeys := map[string]bool{}
+	for i := 0; i < 5; i++ {
+		data, err := encryptor.Encrypt(ctx, []byte("data"), nil)
+		assert.NoError(t, err)
+		envelope, _ := ParseEnvelope(data)
+		wrappedKeys[envelope.WrappedKey] = true
+	}
+	assert.Len(t, wrappedKeys, 3)
+
+	encryptor.Rotate()
+	encryptor.Encrypt(ctx, []byte("data"), nil)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&counts.generated))
+
+	_, err = NewEncryptor(client, EncryptorConfiguration{})
+	assert.Equal(t, errorInvalidKeyID, err)
+	_, err = NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID), MaxKeyUses: common.Int64(0)})
+	assert.Equal(t, errorInvalidMaxKeyUses, err)
+}
+
+func TestEnvelope_Tampering(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	counts := &cryptoCounts{}
+	server := ocitest.NewServer(counts.count(vault.Config.Handler))
+	defer server.Close()
+	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	clients := func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error) {
+		assert.Equal(t, client.Host, cryptoEndpoint)
+		return client, nil
+	}
+	ctx := context.Background()
+
+	encryptor, _ := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID)})
+	decryptor, _ := NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host + "/"}, MaxCachedKeys: common.Int(0)})
+	data, err := encryptor.Encrypt(ctx, []byte("hello world"), []byte("context"))
+	assert.NoError(t, err)
+
+	// altered ciphertext
+	altered := append([]byte{}, data...)
+	altered[len(altered)-1] ^= 1
+	_, err = decryptor.Decrypt(ctx, altered)
+	assert.Equal(t, errorDecryptionFailed, err)
+
+	// altered additional data
+	envelope, _ := ParseEnvelope(data)
+	envelope.AAD = []byte("other context")
+	altered, _ = envelope.Marshal()
+	_, err = decryptor.Decrypt(ctx, altered)
+	assert.Equal(t, errorDecryptionFailed, err)
+
+	// wrapped key of another master key, rejected by the vault
+	envelope, _ = ParseEnvelope(data)
+	envelope.KeyId = createTestKey(t, vault)
+	altered, _ = envelope.Marshal()
+	_, err = decryptor.Decrypt(ctx, altered)
+	_, ok := common.IsServiceError(err)
+	assert.True(t, ok)
+
+	_, err = decryptor.Decrypt(ctx, []byte("hello world"))
+	assert.Equal(t, errorNotAnEnvelope, err)
+	_, err = decryptor.Decrypt(ctx, data[:20])
+	assert.Equal(t, errorNotAnEnvelope, err)
+
+	// without cache, every decryption unwraps the key
+	decryptor.Decrypt(ctx, data)
+	decryptor.Decrypt(ctx, data)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&counts.decrypted))
+}
+
+func TestEnvelope_AllowLists(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	counts := &cryptoCounts{}
+	server := ocitest.NewServer(counts.count(vault.Config.Handler))
+	defer server.Close()
+	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	clients := func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error) {
+		assert.Equal(t, client.Host, cryptoEndpoint)
+		return client, nil
+	}
+	ctx := context.Background()
+
+	_, err := NewDecryptor(clients, DecryptorConfiguration{})
+	assert.Equal(t, errorInvalidEndpoints, err)
+
+	encryptor, _ := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID)})
+	data, err := encryptor.Encrypt(ctx, []byte("hello world"), nil)
+	assert.NoError(t, err)
+
+	// an envelope naming a foreign endpoint is refused before any request
+	envelope, _ := ParseEnvelope(data)
+	envelope.CryptoEndpoint = "https://attacker.example.com"
+	forged, _ := envelope.Marshal()
+	decryptor, _ := NewDecryptor(func(cryptoEndpoint string) (keymanagement.KmsCryptoClient, error) {
+		t.Fatalf("the client of %s was requested", cryptoEndpoint)
+		return client, nil
+	}, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}})
+	_, err = decryptor.Decrypt(ctx, forged)
+	assert.EqualError(t, err, `the crypto endpoint "https://attacker.example.com" of the envelope is not allowed`)
+
+	// the master keys are restricted too once listed
+	decryptor, _ = NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}, AllowedKeyIds: []string{"key2"}})
+	_, err = decryptor.Decrypt(ctx, data)
+	assert.EqualError(t, err, fmt.Sprintf("the master key %q of the envelope is not allowed", keyID))
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counts.decrypted))
+}
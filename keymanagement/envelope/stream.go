@@ -0,0 +1,382 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"bufio"
+	"context"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The ciphertext of a stream envelope is a sequence of chunks, each of them is a chunk of plaintext encrypted with
+// AES-256-GCM. The nonce of a chunk is the IV of the envelope xor'ed with the index of the chunk, and the additional
+// data of a chunk is the header of the envelope followed by a byte telling whether the chunk is the last one, so
+// chunks can neither be altered, reordered nor truncated. There is always at least one chunk, the ciphertext of an
+// empty plaintext is a single empty chunk. Since the size of every chunk but the last one is fixed, any range of the
+// plaintext maps to a range of whole chunks of the ciphertext.
+
+const (
+	// streamChunkSize is the size of the plaintext encrypted in a single chunk
+	streamChunkSize = 64 * 1024 // 64KB
+
+	// tagSize is the number of bytes AES-GCM adds to each chunk
+	tagSize = 16
+)
+
+var (
+	errorInvalidStream  = errors.New("the stream envelope is truncated or corrupted")
+	errorInvalidOffset  = errors.New("seek to a negative offset")
+	errorNotAStream     = errors.New("the envelope is not a stream envelope, use Decrypt to decrypt it")
+	errorStreamEnvelope = errors.New("the envelope is a stream envelope, use NewStreamReader to decrypt it")
+)
+
+// EncryptStream returns a reader of the serialized stream envelope of the plaintext read from plaintext. The plaintext
+// is encrypted in chunks as it is read, so that streams of any size can be encrypted, and ranges of the plaintext can
+// be decrypted without reading the whole envelope, see Decryptor.NewStreamReader. The additional authenticated data
+// aad is stored in the header of the envelope, as with Encrypt. A stream counts as a single use of the data
+// encryption key. The reader never returns bytes along with io.EOF.
+func (encryptor *Encryptor) EncryptStream(ctx context.Context, plaintext io.Reader, aad []byte) (io.Reader, error) {
+	key, err := encryptor.dataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := encryptor.newEnvelope(key, AlgorithmAES256GCMChunked, aad)
+	if err != nil {
+		return nil, err
+	}
+	envelope.ChunkSize = streamChunkSize
+
+	header, err := envelope.header()
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingReader{
+		plaintext: bufio.NewReader(plaintext),
+		aead:      key.aead,
+		iv:        envelope.IV,
+		chunkAAD:  chunkAdditionalData(header),
+		chunk:     make([]byte, streamChunkSize),
+		buffer:    make([]byte, 0, streamChunkSize+tagSize),
+		sealed:    header,
+	}, nil
+}
+
+// chunkNonce returns the nonce of the chunk at index
+func chunkNonce(iv []byte, index int64) []byte {
+	nonce := make([]byte, len(iv))
+	copy(nonce, iv)
+	counter := binary.BigEndian.Uint64(nonce[len(nonce)-8:]) ^ uint64(index)
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], counter)
+	return nonce
+}
+
+// chunkAdditionalData returns the additional data of the chunks, the header followed by the last chunk flag
+func chunkAdditionalData(header []byte) []byte {
+	return append(append([]byte{}, header...), 0)
+}
+
+// setLastChunk sets the last chunk flag of the additional data of a chunk
+func setLastChunk(chunkAAD []byte, isLast bool) []byte {
+	chunkAAD[len(chunkAAD)-1] = 0
+	if isLast {
+		chunkAAD[len(chunkAAD)-1] = 1
+	}
+	return chunkAAD
+}
+
+// encryptedSize returns the size of the chunks of a plaintext of plaintextSize bytes
+func encryptedSize(plaintextSize int64, chunkSize int) int64 {
+	chunks := (plaintextSize + int64(chunkSize) - 1) / int64(chunkSize)
+	if chunks == 0 {
+		chunks = 1
+	}
+	return plaintextSize + chunks*tagSize
+}
+
+// plaintextSize returns the size of the plaintext of chunks of encryptedSize bytes
+func plaintextSize(encryptedSize int64, chunkSize int) (int64, error) {
+	encryptedChunkSize := int64(chunkSize + tagSize)
+	chunks := (encryptedSize + encryptedChunkSize - 1) / encryptedChunkSize
+	if chunks == 0 || encryptedSize-(chunks-1)*encryptedChunkSize < tagSize {
+		return 0, errorInvalidStream
+	}
+	return encryptedSize - chunks*tagSize, nil
+}
+
+// encryptingReader returns the header of a stream envelope, then encrypts the plaintext read from a reader chunk
+// by chunk
+type encryptingReader struct {
+	plaintext *bufio.Reader
+	aead      cipher.AEAD
+	iv        []byte
+	chunkAAD  []byte
+
+	index  int64
+	chunk  []byte
+	buffer []byte // encrypted current chunk
+	sealed []byte // bytes of the header or of the current chunk not read yet
+	done   bool
+}
+
+// Read never returns bytes along with io.EOF, as expected by the stream uploader of Object Storage
+func (reader *encryptingReader) Read(p []byte) (n int, err error) {
+	if len(reader.sealed) == 0 {
+		if reader.done {
+			return 0, io.EOF
+		}
+
+		if err = reader.sealNextChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n = copy(p, reader.sealed)
+	reader.sealed = reader.sealed[n:]
+	return n, nil
+}
+
+func (reader *encryptingReader) sealNextChunk() error {
+	n, err := io.ReadFull(reader.plaintext, reader.chunk)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	// the chunk is the last one if there is nothing left to read after it
+	isLast := err != nil
+	if !isLast {
+		if _, peekErr := reader.plaintext.Peek(1); peekErr == io.EOF {
+			isLast = true
+		} else if peekErr != nil {
+			return peekErr
+		}
+	}
+
+	nonce := chunkNonce(reader.iv, reader.index)
+	reader.buffer = reader.aead.Seal(reader.buffer[:0], nonce, reader.chunk[:n], setLastChunk(reader.chunkAAD, isLast))
+	reader.sealed = reader.buffer
+	reader.index++
+	reader.done = isLast
+	return nil
+}
+
+// EncryptedStream is a serialized stream envelope read by a StreamReader, for example an object read from Object
+// Storage with transfer.ObjectReader
+type EncryptedStream interface {
+	io.ReadSeeker
+	io.ReaderAt
+	Size() int64
+}
+
+// StreamReader reads the plaintext of a stream envelope. It implements io.ReadSeeker and io.ReaderAt, ranges of
+// the plaintext are read by reading only the chunks they span. StreamReader is not safe for concurrent use, except
+// for ReadAt.
+type StreamReader struct {
+	encrypted    EncryptedStream
+	envelope     *Envelope
+	aead         cipher.AEAD
+	header       []byte
+	size         int64
+	lastChunkIdx int64
+	offset       int64
+
+	cachedIndex   int64
+	cachedChunk   []byte
+	chunkAAD      []byte
+	encryptedBuff []byte
+}
+
+// NewStreamReader returns a reader of the plaintext of the stream envelope read from encrypted. It reads the header
+// of the envelope and unwraps its data encryption key, once the crypto endpoint and the master key of the envelope
+// are found in the allow-lists.
+func (decryptor *Decryptor) NewStreamReader(ctx context.Context, encrypted EncryptedStream) (*StreamReader, error) {
+	header, err := readHeader(encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, _, err := parseEnvelope(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if envelope.Algorithm != AlgorithmAES256GCMChunked {
+		return nil, errorNotAStream
+	}
+
+	if envelope.ChunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d of the stream envelope", envelope.ChunkSize)
+	}
+
+	if err = decryptor.checkAllowed(envelope); err != nil {
+		return nil, err
+	}
+
+	aead, err := decryptor.dataKey(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.IV) != aead.NonceSize() {
+		return nil, errorDecryptionFailed
+	}
+
+	encryptedSize := encrypted.Size() - int64(len(header))
+	size, err := plaintextSize(encryptedSize, envelope.ChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedChunkSize := int64(envelope.ChunkSize + tagSize)
+	return &StreamReader{
+		encrypted:     encrypted,
+		envelope:      envelope,
+		aead:          aead,
+		header:        header,
+		size:          size,
+		lastChunkIdx:  (encryptedSize - 1) / encryptedChunkSize,
+		cachedIndex:   -1,
+		chunkAAD:      chunkAdditionalData(header),
+		encryptedBuff: make([]byte, encryptedChunkSize),
+	}, nil
+}
+
+// readHeader reads the serialized header of a stream envelope
+func readHeader(encrypted EncryptedStream) ([]byte, error) {
+	prefix := make([]byte, headerSize)
+	if _, err := encrypted.ReadAt(prefix, 0); err == io.EOF {
+		return nil, errorNotAnEnvelope
+	} else if err != nil {
+		return nil, err
+	}
+
+	length, err := descriptionLength(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if length > encrypted.Size()-int64(headerSize) {
+		return nil, errorNotAnEnvelope
+	}
+
+	header := make([]byte, int64(headerSize)+length)
+	if _, err = encrypted.ReadAt(header, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return header, nil
+}
+
+// Envelope returns the envelope, without its ciphertext
+func (reader *StreamReader) Envelope() Envelope {
+	return *reader.envelope
+}
+
+// Size returns the size of the plaintext
+func (reader *StreamReader) Size() int64 {
+	return reader.size
+}
+
+// Read reads up to len(p) bytes of plaintext at the current offset
+func (reader *StreamReader) Read(p []byte) (n int, err error) {
+	if reader.offset >= reader.size {
+		return 0, io.EOF
+	}
+
+	index := reader.offset / int64(reader.envelope.ChunkSize)
+	if index != reader.cachedIndex {
+		// Seek is a no-op for most readers when reading sequentially
+		if _, err = reader.encrypted.Seek(reader.chunkOffset(index), io.SeekStart); err != nil {
+			return 0, err
+		}
+
+		encryptedChunk := reader.encryptedBuff[:reader.encryptedChunkLength(index)]
+		if _, err = io.ReadFull(reader.encrypted, encryptedChunk); err != nil {
+			return 0, err
+		}
+
+		nonce := chunkNonce(reader.envelope.IV, index)
+		plaintext, err := reader.aead.Open(reader.cachedChunk[:0], nonce, encryptedChunk, setLastChunk(reader.chunkAAD, index == reader.lastChunkIdx))
+		if err != nil {
+			reader.cachedIndex = -1
+			return 0, errorInvalidStream
+		}
+		reader.cachedChunk = plaintext
+		reader.cachedIndex = index
+	}
+
+	n = copy(p, reader.cachedChunk[reader.offset-index*int64(reader.envelope.ChunkSize):])
+	reader.offset += int64(n)
+	return n, nil
+}
+
+// Seek sets the offset of the plaintext for the next Read, interpreted according to whence, see io.Seeker
+func (reader *StreamReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += reader.offset
+	case io.SeekEnd:
+		offset += reader.size
+	default:
+		return 0, fmt.Errorf("invalid whence %v", whence)
+	}
+
+	if offset < 0 {
+		return 0, errorInvalidOffset
+	}
+
+	reader.offset = offset
+	return offset, nil
+}
+
+// ReadAt reads len(p) bytes of plaintext starting at off, see io.ReaderAt. It does not change the offset of Read.
+func (reader *StreamReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errorInvalidOffset
+	}
+
+	chunkAAD := chunkAdditionalData(reader.header)
+	for n < len(p) && off < reader.size {
+		index := off / int64(reader.envelope.ChunkSize)
+		encryptedChunk := make([]byte, reader.encryptedChunkLength(index))
+		if _, err = reader.encrypted.ReadAt(encryptedChunk, reader.chunkOffset(index)); err != nil && err != io.EOF {
+			return n, err
+		}
+
+		var plaintext []byte
+		nonce := chunkNonce(reader.envelope.IV, index)
+		plaintext, err = reader.aead.Open(encryptedChunk[:0], nonce, encryptedChunk, setLastChunk(chunkAAD, index == reader.lastChunkIdx))
+		if err != nil {
+			return n, errorInvalidStream
+		}
+
+		copied := copy(p[n:], plaintext[off-index*int64(reader.envelope.ChunkSize):])
+		n += copied
+		off += int64(copied)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// chunkOffset returns the offset of the chunk at index in the serialized envelope
+func (reader *StreamReader) chunkOffset(index int64) int64 {
+	return int64(len(reader.header)) + index*int64(reader.envelope.ChunkSize+tagSize)
+}
+
+// encryptedChunkLength returns the size of the encrypted chunk at index
+func (reader *StreamReader) encryptedChunkLength(index int64) int64 {
+	encryptedChunkSize := int64(reader.envelope.ChunkSize + tagSize)
+	if remaining := reader.encrypted.Size() - reader.chunkOffset(index); remaining < encryptedChunkSize {
+		return remaining
+	}
+	return encryptedChunkSize
+}
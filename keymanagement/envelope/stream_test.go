@@ -0,0 +1,164 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// memoryStream is a serialized stream envelope held in memory
+type memoryStream struct {
+	*bytes.Reader
+}
+
+func TestStream_RoundTrip(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	counts := &cryptoCounts{}
+	server := ocitest.NewServer(counts.count(vault.Config.Handler))
+	defer server.Close()
+	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+	clients := func(string) (keymanagement.KmsCryptoClient, error) { return client, nil }
+	ctx := context.Background()
+
+	encryptor, _ := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID)})
+	decryptor, _ := NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}})
+
+	plaintext := make([]byte, 3*streamChunkSize+10)
+	rand.Read(plaintext)
+	reader, err := encryptor.EncryptStream(ctx, bytes.NewReader(plaintext), []byte("context"))
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.False(t, bytes.Contains(data, plaintext[:64]))
+
+	envelope, err := ParseEnvelope(data)
+	assert.NoError(t, err)
+	assert.Equal(t, AlgorithmAES256GCMChunked, envelope.Algorithm)
+	assert.Equal(t, streamChunkSize, envelope.ChunkSize)
+	assert.Equal(t, []byte("context"), envelope.AAD)
+	assert.Equal(t, encryptedSize(int64(len(plaintext)), streamChunkSize), int64(len(envelope.Ciphertext)))
+
+	stream, err := decryptor.NewStreamReader(ctx, memoryStream{bytes.NewReader(data)})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(plaintext)), stream.Size())
+	decrypted, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(plaintext, decrypted))
+
+	// ranges spanning several chunks
+	buffer := make([]byte, streamChunkSize+20)
+	n, err := stream.ReadAt(buffer, streamChunkSize-10)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext[streamChunkSize-10:2*streamChunkSize+10], buffer[:n])
+
+	n, err = stream.ReadAt(buffer, int64(len(plaintext)-5))
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, plaintext[len(plaintext)-5:], buffer[:n])
+
+	_, err = stream.Seek(-5, io.SeekEnd)
+	assert.NoError(t, err)
+	tail, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext[len(plaintext)-5:], tail)
+
+	// the stream envelopes and the envelopes share the data key and the cache of the unwrapped keys
+	single, _ := encryptor.Encrypt(ctx, []byte("hello world"), nil)
+	_, err = decryptor.Decrypt(ctx, single)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.generated))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counts.decrypted))
+
+	_, err = decryptor.Decrypt(ctx, data)
+	assert.Equal(t, errorStreamEnvelope, err)
+	_, err = decryptor.NewStreamReader(ctx, memoryStream{bytes.NewReader(single)})
+	assert.Equal(t, errorNotAStream, err)
+}
+
+func TestStream_Empty(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	client := keymanagement.KmsCryptoClient{BaseClient: vault.BaseClient()}
+	clients := func(string) (keymanagement.KmsCryptoClient, error) { return client, nil }
+	ctx := context.Background()
+
+	encryptor, _ := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID)})
+	decryptor, _ := NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}})
+
+	reader, _ := encryptor.EncryptStream(ctx, bytes.NewReader(nil), nil)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	envelope, _ := ParseEnvelope(data)
+	assert.Len(t, envelope.Ciphertext, tagSize)
+
+	stream, err := decryptor.NewStreamReader(ctx, memoryStream{bytes.NewReader(data)})
+	assert.NoError(t, err)
+	decrypted, err := ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.Empty(t, decrypted)
+}
+
+func TestStream_Tampering(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	keyID := createTestKey(t, vault)
+	client := keymanagement.KmsCryptoClient{BaseClient: vault.BaseClient()}
+	clients := func(string) (keymanagement.KmsCryptoClient, error) { return client, nil }
+	ctx := context.Background()
+
+	encryptor, _ := NewEncryptor(client, EncryptorConfiguration{KeyId: common.String(keyID)})
+	decryptor, _ := NewDecryptor(clients, DecryptorConfiguration{AllowedCryptoEndpoints: []string{client.Host}})
+
+	plaintext := []byte(strings.Repeat("0123456789", streamChunkSize/5))
+	reader, _ := encryptor.EncryptStream(ctx, bytes.NewReader(plaintext), []byte("context"))
+	data, _ := ioutil.ReadAll(reader)
+	envelope, _ := ParseEnvelope(data)
+	headerLength := len(data) - len(envelope.Ciphertext)
+
+	readAll := func(data []byte) error {
+		stream, err := decryptor.NewStreamReader(ctx, memoryStream{bytes.NewReader(data)})
+		if err != nil {
+			return err
+		}
+		_, err = ioutil.ReadAll(stream)
+		return err
+	}
+
+	// altered chunk
+	altered := append([]byte{}, data...)
+	altered[headerLength+10] ^= 1
+	assert.Equal(t, errorInvalidStream, readAll(altered))
+
+	// dropping the last chunk is detected as well
+	assert.Equal(t, errorInvalidStream, readAll(data[:headerLength+2*(streamChunkSize+tagSize)]))
+
+	// altered additional data
+	envelope.AAD = []byte("other context")
+	altered, _ = envelope.Marshal()
+	assert.Equal(t, errorInvalidStream, readAll(altered))
+
+	// the allow-lists apply to the stream envelopes
+	envelope, _ = ParseEnvelope(data)
+	envelope.CryptoEndpoint = "https://attacker.example.com"
+	altered, _ = envelope.Marshal()
+	assert.EqualError(t, readAll(altered), `the crypto endpoint "https://attacker.example.com" of the envelope is not allowed`)
+
+	assert.Equal(t, errorNotAnEnvelope, readAll(data[:20]))
+	assert.Equal(t, errorNotAnEnvelope, readAll([]byte("hello world")))
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package keymanagement
+
+import (
+	"context"
+)
+
+// KmsCryptoClientAPI is the interface of the operations of KmsCryptoClient, to inject the client and mock it in tests
+type KmsCryptoClientAPI interface {
+	// Decrypt Decrypts data using the given DecryptDataDetails (https://docs.cloud.oracle.com/api/#/en/key/latest/datatypes/DecryptDataDetails) resource.
+	Decrypt(ctx context.Context, request DecryptRequest) (response DecryptResponse, err error)
+
+	// Encrypt Encrypts data using the given EncryptDataDetails (https://docs.cloud.oracle.com/api/#/en/key/latest/datatypes/EncryptDataDetails) resource.
+	// Plaintext included in the example request is a base64-encoded value of a UTF-8 string.
+	Encrypt(ctx context.Context, request EncryptRequest) (response EncryptResponse, err error)
+
+	// ExportKey Exports a specific version of a master encryption key according to the details of the request. For their protection,
+	// keys that you create and store on a hardware security module (HSM) can never leave the HSM. You can only export keys
+	// stored on the server. For export, the key version is encrypted by an RSA public key that you provide.
+	ExportKey(ctx context.Context, request ExportKeyRequest) (response ExportKeyResponse, err error)
+
+	// GenerateDataEncryptionKey Generates a key that you can use to encrypt or decrypt data.
+	GenerateDataEncryptionKey(ctx context.Context, request GenerateDataEncryptionKeyRequest) (response GenerateDataEncryptionKeyResponse, err error)
+}
+
+// KmsCryptoClient implements KmsCryptoClientAPI
+var _ KmsCryptoClientAPI = KmsCryptoClient{}
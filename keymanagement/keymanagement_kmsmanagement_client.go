@@ -97,10 +97,12 @@ func (client KmsManagementClient) BackupKey(ctx context.Context, request BackupK
 				response = BackupKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(BackupKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into BackupKeyResponse")
 	}
@@ -158,10 +160,12 @@ func (client KmsManagementClient) CancelKeyDeletion(ctx context.Context, request
 				response = CancelKeyDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CancelKeyDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CancelKeyDeletionResponse")
 	}
@@ -219,10 +223,12 @@ func (client KmsManagementClient) CancelKeyVersionDeletion(ctx context.Context,
 				response = CancelKeyVersionDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CancelKeyVersionDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CancelKeyVersionDeletionResponse")
 	}
@@ -280,10 +286,12 @@ func (client KmsManagementClient) ChangeKeyCompartment(ctx context.Context, requ
 				response = ChangeKeyCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeKeyCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeKeyCompartmentResponse")
 	}
@@ -339,10 +347,12 @@ func (client KmsManagementClient) CreateKey(ctx context.Context, request CreateK
 				response = CreateKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateKeyResponse")
 	}
@@ -399,10 +409,12 @@ func (client KmsManagementClient) CreateKeyVersion(ctx context.Context, request
 				response = CreateKeyVersionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateKeyVersionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateKeyVersionResponse")
 	}
@@ -459,10 +471,12 @@ func (client KmsManagementClient) DisableKey(ctx context.Context, request Disabl
 				response = DisableKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DisableKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DisableKeyResponse")
 	}
@@ -519,10 +533,12 @@ func (client KmsManagementClient) EnableKey(ctx context.Context, request EnableK
 				response = EnableKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(EnableKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into EnableKeyResponse")
 	}
@@ -735,10 +751,12 @@ func (client KmsManagementClient) ImportKey(ctx context.Context, request ImportK
 				response = ImportKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportKeyResponse")
 	}
@@ -794,10 +812,12 @@ func (client KmsManagementClient) ImportKeyVersion(ctx context.Context, request
 				response = ImportKeyVersionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportKeyVersionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportKeyVersionResponse")
 	}
@@ -960,10 +980,12 @@ func (client KmsManagementClient) RestoreKeyFromFile(ctx context.Context, reques
 				response = RestoreKeyFromFileResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestoreKeyFromFileResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestoreKeyFromFileResponse")
 	}
@@ -1017,10 +1039,12 @@ func (client KmsManagementClient) RestoreKeyFromObjectStore(ctx context.Context,
 				response = RestoreKeyFromObjectStoreResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestoreKeyFromObjectStoreResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestoreKeyFromObjectStoreResponse")
 	}
@@ -1077,10 +1101,12 @@ func (client KmsManagementClient) ScheduleKeyDeletion(ctx context.Context, reque
 				response = ScheduleKeyDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ScheduleKeyDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ScheduleKeyDeletionResponse")
 	}
@@ -1137,10 +1163,12 @@ func (client KmsManagementClient) ScheduleKeyVersionDeletion(ctx context.Context
 				response = ScheduleKeyVersionDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ScheduleKeyVersionDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ScheduleKeyVersionDeletionResponse")
 	}
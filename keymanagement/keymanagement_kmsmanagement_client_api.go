@@ -0,0 +1,157 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package keymanagement
+
+import (
+	"context"
+)
+
+// KmsManagementClientAPI is the interface of the operations of KmsManagementClient, to inject the client and mock it in tests
+type KmsManagementClientAPI interface {
+	// BackupKey Backs up an encrypted file that contains all key versions and metadata of the specified key so that you can restore
+	// the key later. The file also contains the metadata of the vault that the key belonged to.
+	BackupKey(ctx context.Context, request BackupKeyRequest) (response BackupKeyResponse, err error)
+
+	// CancelKeyDeletion Cancels the scheduled deletion of the specified key. Canceling
+	// a scheduled deletion restores the key's lifecycle state to what
+	// it was before its scheduled deletion.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	CancelKeyDeletion(ctx context.Context, request CancelKeyDeletionRequest) (response CancelKeyDeletionResponse, err error)
+
+	// CancelKeyVersionDeletion Cancels the scheduled deletion of the specified key version. Canceling
+	// a scheduled deletion restores the key version to its lifecycle state from
+	// before its scheduled deletion.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	CancelKeyVersionDeletion(ctx context.Context, request CancelKeyVersionDeletionRequest) (response CancelKeyVersionDeletionResponse, err error)
+
+	// ChangeKeyCompartment Moves a key into a different compartment within the same tenancy. For information about
+	// moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When provided, if-match is checked against the ETag values of the key.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	ChangeKeyCompartment(ctx context.Context, request ChangeKeyCompartmentRequest) (response ChangeKeyCompartmentResponse, err error)
+
+	// CreateKey Creates a new master encryption key.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total
+	// number of requests across all management write operations. Key Management might throttle this call
+	// to reject an otherwise valid request when the total rate of management write operations exceeds 10
+	// requests per second for a given tenancy.
+	CreateKey(ctx context.Context, request CreateKeyRequest) (response CreateKeyResponse, err error)
+
+	// CreateKeyVersion Generates a new KeyVersion (https://docs.cloud.oracle.com/api/#/en/key/latest/KeyVersion/) resource that provides new cryptographic
+	// material for a master encryption key. The key must be in an `ENABLED` state to be rotated.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all  management write operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management write operations exceeds 10 requests per second
+	// for a given tenancy.
+	CreateKeyVersion(ctx context.Context, request CreateKeyVersionRequest) (response CreateKeyVersionResponse, err error)
+
+	// DisableKey Disables a master encryption key so it can no longer be used for encryption, decryption, or
+	// generating new data encryption keys.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management write operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management write operations exceeds 10 requests per second
+	// for a given tenancy.
+	DisableKey(ctx context.Context, request DisableKeyRequest) (response DisableKeyResponse, err error)
+
+	// EnableKey Enables a master encryption key so it can be used for encryption, decryption, or
+	// generating new data encryption keys.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management write operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management write operations exceeds 10 requests per second
+	// for a given tenancy.
+	EnableKey(ctx context.Context, request EnableKeyRequest) (response EnableKeyResponse, err error)
+
+	// GetKey Gets information about the specified master encryption key.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management read operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management read operations exceeds 10 requests per second for
+	// a given tenancy.
+	GetKey(ctx context.Context, request GetKeyRequest) (response GetKeyResponse, err error)
+
+	// GetKeyVersion Gets information about the specified key version.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management read operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management read operations exceeds 10 requests per second
+	// for a given tenancy.
+	GetKeyVersion(ctx context.Context, request GetKeyVersionRequest) (response GetKeyVersionResponse, err error)
+
+	// GetWrappingKey Gets details about the public RSA wrapping key associated with the vault in the endpoint. Each vault has an RSA key-pair that wraps and
+	// unwraps AES key material for import into Key Management.
+	GetWrappingKey(ctx context.Context, request GetWrappingKeyRequest) (response GetWrappingKeyResponse, err error)
+
+	// ImportKey Imports AES key material to create a new key with. The key material must be base64-encoded and
+	// wrapped by the vault's public RSA wrapping key before you can import it. Key Management supports AES symmetric keys
+	// that are exactly 16, 24, or 32 bytes. Furthermore, the key length must match what you specify at the time of import.
+	ImportKey(ctx context.Context, request ImportKeyRequest) (response ImportKeyResponse, err error)
+
+	// ImportKeyVersion Imports AES key material to create a new key version with, and then rotates the key to begin using the new
+	// key version. The key material must be base64-encoded and wrapped by the vault's public RSA wrapping key
+	// before you can import it. Key Management supports AES symmetric keys that are exactly 16, 24, or 32 bytes.
+	// Furthermore, the key length must match the length of the specified key and what you specify as the length
+	// at the time of import.
+	ImportKeyVersion(ctx context.Context, request ImportKeyVersionRequest) (response ImportKeyVersionResponse, err error)
+
+	// ListKeyVersions Lists all KeyVersion (https://docs.cloud.oracle.com/api/#/en/key/latest/KeyVersion/) resources for the specified
+	// master encryption key.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management read operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management read operations exceeds 10 requests per second
+	// for a given tenancy.
+	ListKeyVersions(ctx context.Context, request ListKeyVersionsRequest) (response ListKeyVersionsResponse, err error)
+
+	// ListKeys Lists the master encryption keys in the specified vault and compartment.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management read operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management read operations exceeds 10 requests per second
+	// for a given tenancy.
+	ListKeys(ctx context.Context, request ListKeysRequest) (response ListKeysResponse, err error)
+
+	// RestoreKeyFromFile Restores the specified key to the specified vault, based on information in the backup file provided.
+	// If the vault doesn't exist, the operation returns a response with a 404 HTTP status error code. You
+	// need to first restore the vault associated with the key.
+	RestoreKeyFromFile(ctx context.Context, request RestoreKeyFromFileRequest) (response RestoreKeyFromFileResponse, err error)
+
+	// RestoreKeyFromObjectStore Restores the specified key to the specified vault from an Oracle Cloud Infrastructure
+	// Object Storage location. If the vault doesn't exist, the operation returns a response with a
+	// 404 HTTP status error code. You need to first restore the vault associated with the key.
+	RestoreKeyFromObjectStore(ctx context.Context, request RestoreKeyFromObjectStoreRequest) (response RestoreKeyFromObjectStoreResponse, err error)
+
+	// ScheduleKeyDeletion Schedules the deletion of the specified key. This sets the lifecycle state of the key
+	// to `PENDING_DELETION` and then deletes it after the specified retention period ends.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	ScheduleKeyDeletion(ctx context.Context, request ScheduleKeyDeletionRequest) (response ScheduleKeyDeletionResponse, err error)
+
+	// ScheduleKeyVersionDeletion Schedules the deletion of the specified key version. This sets the lifecycle state of the key version
+	// to `PENDING_DELETION` and then deletes it after the specified retention period ends.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	ScheduleKeyVersionDeletion(ctx context.Context, request ScheduleKeyVersionDeletionRequest) (response ScheduleKeyVersionDeletionResponse, err error)
+
+	// UpdateKey Updates the properties of a master encryption key. Specifically, you can update the
+	// `displayName`, `freeformTags`, and `definedTags` properties. Furthermore,
+	// the key must in an ENABLED or CREATING state to be updated.
+	// As a management operation, this call is subject to a Key Management limit that applies to the total number
+	// of requests across all management write operations. Key Management might throttle this call to reject an
+	// otherwise valid request when the total rate of management write operations exceeds 10 requests per second
+	// for a given tenancy.
+	UpdateKey(ctx context.Context, request UpdateKeyRequest) (response UpdateKeyResponse, err error)
+}
+
+// KmsManagementClient implements KmsManagementClientAPI
+var _ KmsManagementClientAPI = KmsManagementClient{}
@@ -105,10 +105,12 @@ func (client KmsVaultClient) BackupVault(ctx context.Context, request BackupVaul
 				response = BackupVaultResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(BackupVaultResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into BackupVaultResponse")
 	}
@@ -167,10 +169,12 @@ func (client KmsVaultClient) CancelVaultDeletion(ctx context.Context, request Ca
 				response = CancelVaultDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CancelVaultDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CancelVaultDeletionResponse")
 	}
@@ -228,10 +232,12 @@ func (client KmsVaultClient) ChangeVaultCompartment(ctx context.Context, request
 				response = ChangeVaultCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeVaultCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeVaultCompartmentResponse")
 	}
@@ -290,10 +296,12 @@ func (client KmsVaultClient) CreateVault(ctx context.Context, request CreateVaul
 				response = CreateVaultResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateVaultResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateVaultResponse")
 	}
@@ -505,10 +513,12 @@ func (client KmsVaultClient) RestoreVaultFromFile(ctx context.Context, request R
 				response = RestoreVaultFromFileResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestoreVaultFromFileResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestoreVaultFromFileResponse")
 	}
@@ -562,10 +572,12 @@ func (client KmsVaultClient) RestoreVaultFromObjectStore(ctx context.Context, re
 				response = RestoreVaultFromObjectStoreResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestoreVaultFromObjectStoreResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestoreVaultFromObjectStoreResponse")
 	}
@@ -625,10 +637,12 @@ func (client KmsVaultClient) ScheduleVaultDeletion(ctx context.Context, request
 				response = ScheduleVaultDeletionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ScheduleVaultDeletionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ScheduleVaultDeletionResponse")
 	}
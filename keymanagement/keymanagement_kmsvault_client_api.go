@@ -0,0 +1,96 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package keymanagement
+
+import (
+	"context"
+)
+
+// KmsVaultClientAPI is the interface of the operations of KmsVaultClient, to inject the client and mock it in tests
+type KmsVaultClientAPI interface {
+	// BackupVault Backs up an encrypted file that contains all the metadata of a vault so that you can restore the vault later.
+	// You can backup a vault whether or not it contains keys. This operation only backs up the
+	// metadata of the vault, and does not include key metadata.
+	BackupVault(ctx context.Context, request BackupVaultRequest) (response BackupVaultResponse, err error)
+
+	// CancelVaultDeletion Cancels the scheduled deletion of the specified vault. Canceling a scheduled deletion
+	// restores the vault and all keys in it to their respective states from before their
+	// scheduled deletion. All keys that were scheduled for deletion prior to vault
+	// deletion retain their lifecycle state and time of deletion.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	CancelVaultDeletion(ctx context.Context, request CancelVaultDeletionRequest) (response CancelVaultDeletionResponse, err error)
+
+	// ChangeVaultCompartment Moves a vault into a different compartment within the same tenancy. For information about
+	// moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When provided, if-match is checked against the ETag values of the resource.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	ChangeVaultCompartment(ctx context.Context, request ChangeVaultCompartmentRequest) (response ChangeVaultCompartmentResponse, err error)
+
+	// CreateVault Creates a new vault. The type of vault you create determines key placement, pricing, and
+	// available options. Options include storage isolation, a dedicated service endpoint instead
+	// of a shared service endpoint for API calls, and either a dedicated hardware security module
+	// (HSM) or a multitenant HSM.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	CreateVault(ctx context.Context, request CreateVaultRequest) (response CreateVaultResponse, err error)
+
+	// GetVault Gets the specified vault's configuration information.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning read operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// read operations exceeds 10 requests per second for a given tenancy.
+	GetVault(ctx context.Context, request GetVaultRequest) (response GetVaultResponse, err error)
+
+	// GetVaultUsage Gets the count of keys and key versions in the specified vault to calculate usage against service limits.
+	GetVaultUsage(ctx context.Context, request GetVaultUsageRequest) (response GetVaultUsageResponse, err error)
+
+	// ListVaults Lists the vaults in the specified compartment.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning read operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// read operations exceeds 10 requests per second for a given tenancy.
+	ListVaults(ctx context.Context, request ListVaultsRequest) (response ListVaultsResponse, err error)
+
+	// RestoreVaultFromFile Restores a vault from an encrypted backup file. If a vault
+	// with the same OCID already exists, this operation returns a response with a
+	// 409 HTTP status error code.
+	RestoreVaultFromFile(ctx context.Context, request RestoreVaultFromFileRequest) (response RestoreVaultFromFileResponse, err error)
+
+	// RestoreVaultFromObjectStore Restores a vault from an encrypted backup file stored in Oracle Cloud Infrastructure Object
+	// Storage. If a vault with the same OCID already exists, this operation returns
+	// a response with a 409 HTTP status error code.
+	RestoreVaultFromObjectStore(ctx context.Context, request RestoreVaultFromObjectStoreRequest) (response RestoreVaultFromObjectStoreResponse, err error)
+
+	// ScheduleVaultDeletion Schedules the deletion of the specified vault. This sets the lifecycle state of the vault and all keys in it
+	// that are not already scheduled for deletion to `PENDING_DELETION` and then deletes them after the
+	// retention period ends. The lifecycle state and time of deletion for keys already scheduled for deletion won't
+	// change. If any keys in the vault are scheduled to be deleted after the specified time of
+	// deletion for the vault, the call is rejected with the error code 409.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	ScheduleVaultDeletion(ctx context.Context, request ScheduleVaultDeletionRequest) (response ScheduleVaultDeletionResponse, err error)
+
+	// UpdateVault Updates the properties of a vault. Specifically, you can update the
+	// `displayName`, `freeformTags`, and `definedTags` properties. Furthermore,
+	// the vault must be in an ACTIVE or CREATING state to be updated.
+	// As a provisioning operation, this call is subject to a Key Management limit that applies to
+	// the total number of requests across all provisioning write operations. Key Management might
+	// throttle this call to reject an otherwise valid request when the total rate of provisioning
+	// write operations exceeds 10 requests per second for a given tenancy.
+	UpdateVault(ctx context.Context, request UpdateVaultRequest) (response UpdateVaultResponse, err error)
+}
+
+// KmsVaultClient implements KmsVaultClientAPI
+var _ KmsVaultClientAPI = KmsVaultClient{}
@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package limits
+
+import (
+	"context"
+)
+
+// LimitsClientAPI is the interface of the operations of LimitsClient, to inject the client and mock it in tests
+type LimitsClientAPI interface {
+	// GetResourceAvailability For a given compartmentId, resource limit name, and scope, returns the following:
+	//   - the number of available resources associated with the given limit
+	//   - the usage in the selected compartment for the given limit
+	//   Note: not all resource limits support this API. If the value is not available, the API will return 404.
+	GetResourceAvailability(ctx context.Context, request GetResourceAvailabilityRequest) (response GetResourceAvailabilityResponse, err error)
+
+	// ListLimitDefinitions Includes a list of resource limits that are currently supported.
+	// If the 'areQuotasSupported' property is true, you can create quota policies on top of this limit at the
+	// compartment level.
+	ListLimitDefinitions(ctx context.Context, request ListLimitDefinitionsRequest) (response ListLimitDefinitionsResponse, err error)
+
+	// ListLimitValues Includes a full list of resource limits belonging to a given service.
+	ListLimitValues(ctx context.Context, request ListLimitValuesRequest) (response ListLimitValuesResponse, err error)
+
+	// ListServices Returns the list of supported services.
+	// This will include the programmatic service name, along with the friendly service name.
+	ListServices(ctx context.Context, request ListServicesRequest) (response ListServicesResponse, err error)
+}
+
+// LimitsClient implements LimitsClientAPI
+var _ LimitsClientAPI = LimitsClient{}
@@ -102,10 +102,12 @@ func (client QuotasClient) CreateQuota(ctx context.Context, request CreateQuotaR
 				response = CreateQuotaResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateQuotaResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateQuotaResponse")
 	}
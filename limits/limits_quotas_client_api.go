@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package limits
+
+import (
+	"context"
+)
+
+// QuotasClientAPI is the interface of the operations of QuotasClient, to inject the client and mock it in tests
+type QuotasClientAPI interface {
+	// CreateQuota Creates a new quota with the details supplied.
+	CreateQuota(ctx context.Context, request CreateQuotaRequest) (response CreateQuotaResponse, err error)
+
+	// DeleteQuota Deletes the quota corresponding to the given OCID.
+	DeleteQuota(ctx context.Context, request DeleteQuotaRequest) (response DeleteQuotaResponse, err error)
+
+	// GetQuota Gets the quota for the OCID specified.
+	GetQuota(ctx context.Context, request GetQuotaRequest) (response GetQuotaResponse, err error)
+
+	// ListQuotas Lists all quotas on resources from the given compartment
+	ListQuotas(ctx context.Context, request ListQuotasRequest) (response ListQuotasResponse, err error)
+
+	// UpdateQuota Updates the quota corresponding to given OCID with the details supplied.
+	UpdateQuota(ctx context.Context, request UpdateQuotaRequest) (response UpdateQuotaResponse, err error)
+}
+
+// QuotasClient implements QuotasClientAPI
+var _ QuotasClientAPI = QuotasClient{}
@@ -104,10 +104,12 @@ func (client LoadBalancerClient) ChangeLoadBalancerCompartment(ctx context.Conte
 				response = ChangeLoadBalancerCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeLoadBalancerCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeLoadBalancerCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client LoadBalancerClient) CreateBackend(ctx context.Context, request Crea
 				response = CreateBackendResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBackendResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBackendResponse")
 	}
@@ -214,10 +218,12 @@ func (client LoadBalancerClient) CreateBackendSet(ctx context.Context, request C
 				response = CreateBackendSetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBackendSetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBackendSetResponse")
 	}
@@ -269,10 +275,12 @@ func (client LoadBalancerClient) CreateCertificate(ctx context.Context, request
 				response = CreateCertificateResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCertificateResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCertificateResponse")
 	}
@@ -325,10 +333,12 @@ func (client LoadBalancerClient) CreateHostname(ctx context.Context, request Cre
 				response = CreateHostnameResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateHostnameResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateHostnameResponse")
 	}
@@ -380,10 +390,12 @@ func (client LoadBalancerClient) CreateListener(ctx context.Context, request Cre
 				response = CreateListenerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateListenerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateListenerResponse")
 	}
@@ -455,10 +467,12 @@ func (client LoadBalancerClient) CreateLoadBalancer(ctx context.Context, request
 				response = CreateLoadBalancerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLoadBalancerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLoadBalancerResponse")
 	}
@@ -511,10 +525,12 @@ func (client LoadBalancerClient) CreatePathRouteSet(ctx context.Context, request
 				response = CreatePathRouteSetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreatePathRouteSetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreatePathRouteSetResponse")
 	}
@@ -617,10 +633,12 @@ func (client LoadBalancerClient) CreateSSLCipherSuite(ctx context.Context, reque
 				response = CreateSSLCipherSuiteResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSSLCipherSuiteResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSSLCipherSuiteResponse")
 	}
@@ -2432,10 +2450,12 @@ func (client LoadBalancerClient) UpdateBackend(ctx context.Context, request Upda
 				response = UpdateBackendResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateBackendResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateBackendResponse")
 	}
@@ -2487,10 +2507,12 @@ func (client LoadBalancerClient) UpdateBackendSet(ctx context.Context, request U
 				response = UpdateBackendSetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateBackendSetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateBackendSetResponse")
 	}
@@ -2542,10 +2564,12 @@ func (client LoadBalancerClient) UpdateHealthChecker(ctx context.Context, reques
 				response = UpdateHealthCheckerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateHealthCheckerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateHealthCheckerResponse")
 	}
@@ -2648,10 +2672,12 @@ func (client LoadBalancerClient) UpdateListener(ctx context.Context, request Upd
 				response = UpdateListenerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateListenerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateListenerResponse")
 	}
@@ -2703,10 +2729,12 @@ func (client LoadBalancerClient) UpdateLoadBalancer(ctx context.Context, request
 				response = UpdateLoadBalancerResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateLoadBalancerResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateLoadBalancerResponse")
 	}
@@ -2763,10 +2791,12 @@ func (client LoadBalancerClient) UpdateLoadBalancerShape(ctx context.Context, re
 				response = UpdateLoadBalancerShapeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateLoadBalancerShapeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateLoadBalancerShapeResponse")
 	}
@@ -2818,10 +2848,12 @@ func (client LoadBalancerClient) UpdateNetworkSecurityGroups(ctx context.Context
 				response = UpdateNetworkSecurityGroupsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateNetworkSecurityGroupsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateNetworkSecurityGroupsResponse")
 	}
@@ -2877,10 +2909,12 @@ func (client LoadBalancerClient) UpdatePathRouteSet(ctx context.Context, request
 				response = UpdatePathRouteSetResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdatePathRouteSetResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdatePathRouteSetResponse")
 	}
@@ -2984,10 +3018,12 @@ func (client LoadBalancerClient) UpdateSSLCipherSuite(ctx context.Context, reque
 				response = UpdateSSLCipherSuiteResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateSSLCipherSuiteResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateSSLCipherSuiteResponse")
 	}
@@ -0,0 +1,229 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loadbalancer
+
+import (
+	"context"
+)
+
+// LoadBalancerClientAPI is the interface of the operations of LoadBalancerClient, to inject the client and mock it in tests
+type LoadBalancerClientAPI interface {
+	// ChangeLoadBalancerCompartment Moves a load balancer into a different compartment within the same tenancy. For information about moving resources
+	// between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeLoadBalancerCompartment(ctx context.Context, request ChangeLoadBalancerCompartmentRequest) (response ChangeLoadBalancerCompartmentResponse, err error)
+
+	// CreateBackend Adds a backend server to a backend set.
+	CreateBackend(ctx context.Context, request CreateBackendRequest) (response CreateBackendResponse, err error)
+
+	// CreateBackendSet Adds a backend set to a load balancer.
+	CreateBackendSet(ctx context.Context, request CreateBackendSetRequest) (response CreateBackendSetResponse, err error)
+
+	// CreateCertificate Creates an asynchronous request to add an SSL certificate bundle.
+	CreateCertificate(ctx context.Context, request CreateCertificateRequest) (response CreateCertificateResponse, err error)
+
+	// CreateHostname Adds a hostname resource to the specified load balancer. For more information, see
+	// Managing Request Routing (https://docs.cloud.oracle.com/Content/Balance/Tasks/managingrequest.htm).
+	CreateHostname(ctx context.Context, request CreateHostnameRequest) (response CreateHostnameResponse, err error)
+
+	// CreateListener Adds a listener to a load balancer.
+	CreateListener(ctx context.Context, request CreateListenerRequest) (response CreateListenerResponse, err error)
+
+	// CreateLoadBalancer Creates a new load balancer in the specified compartment. For general information about load balancers,
+	// see Overview of the Load Balancing Service (https://docs.cloud.oracle.com/Content/Balance/Concepts/balanceoverview.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want
+	// the load balancer to reside. Notice that the load balancer doesn't have to be in the same compartment as the VCN
+	// or backend set. If you're not sure which compartment to use, put the load balancer in the same compartment as the VCN.
+	// For information about access control and compartments, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// You must specify a display name for the load balancer. It does not have to be unique, and you can change it.
+	// For information about Availability Domains, see
+	// Regions and Availability Domains (https://docs.cloud.oracle.com/Content/General/Concepts/regions.htm).
+	// To get a list of Availability Domains, use the `ListAvailabilityDomains` operation
+	// in the Identity and Access Management Service API.
+	// All Oracle Cloud Infrastructure resources, including load balancers, get an Oracle-assigned,
+	// unique ID called an Oracle Cloud Identifier (OCID). When you create a resource, you can find its OCID
+	// in the response. You can also retrieve a resource's OCID by using a List API operation on that resource type,
+	// or by viewing the resource in the Console. Fore more information, see
+	// Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// After you send your request, the new object's state will temporarily be PROVISIONING. Before using the
+	// object, first make sure its state has changed to RUNNING.
+	// When you create a load balancer, the system assigns an IP address.
+	// To get the IP address, use the GetLoadBalancer operation.
+	CreateLoadBalancer(ctx context.Context, request CreateLoadBalancerRequest) (response CreateLoadBalancerResponse, err error)
+
+	// CreatePathRouteSet Adds a path route set to a load balancer. For more information, see
+	// Managing Request Routing (https://docs.cloud.oracle.com/Content/Balance/Tasks/managingrequest.htm).
+	CreatePathRouteSet(ctx context.Context, request CreatePathRouteSetRequest) (response CreatePathRouteSetResponse, err error)
+
+	// CreateRuleSet Creates a new rule set associated with the specified load balancer. For more information, see
+	// Managing Rule Sets (https://docs.cloud.oracle.com/Content/Balance/Tasks/managingrulesets.htm).
+	CreateRuleSet(ctx context.Context, request CreateRuleSetRequest) (response CreateRuleSetResponse, err error)
+
+	// CreateSSLCipherSuite Creates a custom SSL cipher suite.
+	CreateSSLCipherSuite(ctx context.Context, request CreateSSLCipherSuiteRequest) (response CreateSSLCipherSuiteResponse, err error)
+
+	// DeleteBackend Removes a backend server from a given load balancer and backend set.
+	DeleteBackend(ctx context.Context, request DeleteBackendRequest) (response DeleteBackendResponse, err error)
+
+	// DeleteBackendSet Deletes the specified backend set. Note that deleting a backend set removes its backend servers from the load balancer.
+	// Before you can delete a backend set, you must remove it from any active listeners.
+	DeleteBackendSet(ctx context.Context, request DeleteBackendSetRequest) (response DeleteBackendSetResponse, err error)
+
+	// DeleteCertificate Deletes an SSL certificate bundle from a load balancer.
+	DeleteCertificate(ctx context.Context, request DeleteCertificateRequest) (response DeleteCertificateResponse, err error)
+
+	// DeleteHostname Deletes a hostname resource from the specified load balancer.
+	DeleteHostname(ctx context.Context, request DeleteHostnameRequest) (response DeleteHostnameResponse, err error)
+
+	// DeleteListener Deletes a listener from a load balancer.
+	DeleteListener(ctx context.Context, request DeleteListenerRequest) (response DeleteListenerResponse, err error)
+
+	// DeleteLoadBalancer Stops a load balancer and removes it from service.
+	DeleteLoadBalancer(ctx context.Context, request DeleteLoadBalancerRequest) (response DeleteLoadBalancerResponse, err error)
+
+	// DeletePathRouteSet Deletes a path route set from the specified load balancer.
+	// To delete a path route rule from a path route set, use the
+	// UpdatePathRouteSet operation.
+	DeletePathRouteSet(ctx context.Context, request DeletePathRouteSetRequest) (response DeletePathRouteSetResponse, err error)
+
+	// DeleteRuleSet Deletes a rule set from the specified load balancer.
+	// To delete a rule from a rule set, use the
+	// UpdateRuleSet operation.
+	DeleteRuleSet(ctx context.Context, request DeleteRuleSetRequest) (response DeleteRuleSetResponse, err error)
+
+	// DeleteSSLCipherSuite Deletes an SSL cipher suite from a load balancer.
+	DeleteSSLCipherSuite(ctx context.Context, request DeleteSSLCipherSuiteRequest) (response DeleteSSLCipherSuiteResponse, err error)
+
+	// GetBackend Gets the specified backend server's configuration information.
+	GetBackend(ctx context.Context, request GetBackendRequest) (response GetBackendResponse, err error)
+
+	// GetBackendHealth Gets the current health status of the specified backend server.
+	GetBackendHealth(ctx context.Context, request GetBackendHealthRequest) (response GetBackendHealthResponse, err error)
+
+	// GetBackendSet Gets the specified backend set's configuration information.
+	GetBackendSet(ctx context.Context, request GetBackendSetRequest) (response GetBackendSetResponse, err error)
+
+	// GetBackendSetHealth Gets the health status for the specified backend set.
+	GetBackendSetHealth(ctx context.Context, request GetBackendSetHealthRequest) (response GetBackendSetHealthResponse, err error)
+
+	// GetHealthChecker Gets the health check policy information for a given load balancer and backend set.
+	GetHealthChecker(ctx context.Context, request GetHealthCheckerRequest) (response GetHealthCheckerResponse, err error)
+
+	// GetHostname Gets the specified hostname resource's configuration information.
+	GetHostname(ctx context.Context, request GetHostnameRequest) (response GetHostnameResponse, err error)
+
+	// GetLoadBalancer Gets the specified load balancer's configuration information.
+	GetLoadBalancer(ctx context.Context, request GetLoadBalancerRequest) (response GetLoadBalancerResponse, err error)
+
+	// GetLoadBalancerHealth Gets the health status for the specified load balancer.
+	GetLoadBalancerHealth(ctx context.Context, request GetLoadBalancerHealthRequest) (response GetLoadBalancerHealthResponse, err error)
+
+	// GetPathRouteSet Gets the specified path route set's configuration information.
+	GetPathRouteSet(ctx context.Context, request GetPathRouteSetRequest) (response GetPathRouteSetResponse, err error)
+
+	// GetRuleSet Gets the specified set of rules.
+	GetRuleSet(ctx context.Context, request GetRuleSetRequest) (response GetRuleSetResponse, err error)
+
+	// GetSSLCipherSuite Gets the specified SSL cipher suite's configuration information.
+	GetSSLCipherSuite(ctx context.Context, request GetSSLCipherSuiteRequest) (response GetSSLCipherSuiteResponse, err error)
+
+	// GetWorkRequest Gets the details of a work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListBackendSets Lists all backend sets associated with a given load balancer.
+	ListBackendSets(ctx context.Context, request ListBackendSetsRequest) (response ListBackendSetsResponse, err error)
+
+	// ListBackends Lists the backend servers for a given load balancer and backend set.
+	ListBackends(ctx context.Context, request ListBackendsRequest) (response ListBackendsResponse, err error)
+
+	// ListCertificates Lists all SSL certificates bundles associated with a given load balancer.
+	ListCertificates(ctx context.Context, request ListCertificatesRequest) (response ListCertificatesResponse, err error)
+
+	// ListHostnames Lists all hostname resources associated with the specified load balancer.
+	ListHostnames(ctx context.Context, request ListHostnamesRequest) (response ListHostnamesResponse, err error)
+
+	// ListListenerRules Lists all of the rules from all of the rule sets associated with the specified listener. The response organizes
+	// the rules in the following order:
+	// *  Access control rules
+	// *  Allow method rules
+	// *  Request header rules
+	// *  Response header rules
+	ListListenerRules(ctx context.Context, request ListListenerRulesRequest) (response ListListenerRulesResponse, err error)
+
+	// ListLoadBalancerHealths Lists the summary health statuses for all load balancers in the specified compartment.
+	ListLoadBalancerHealths(ctx context.Context, request ListLoadBalancerHealthsRequest) (response ListLoadBalancerHealthsResponse, err error)
+
+	// ListLoadBalancers Lists all load balancers in the specified compartment.
+	ListLoadBalancers(ctx context.Context, request ListLoadBalancersRequest) (response ListLoadBalancersResponse, err error)
+
+	// ListPathRouteSets Lists all path route sets associated with the specified load balancer.
+	ListPathRouteSets(ctx context.Context, request ListPathRouteSetsRequest) (response ListPathRouteSetsResponse, err error)
+
+	// ListPolicies Lists the available load balancer policies.
+	ListPolicies(ctx context.Context, request ListPoliciesRequest) (response ListPoliciesResponse, err error)
+
+	// ListProtocols Lists all supported traffic protocols.
+	ListProtocols(ctx context.Context, request ListProtocolsRequest) (response ListProtocolsResponse, err error)
+
+	// ListRuleSets Lists all rule sets associated with the specified load balancer.
+	ListRuleSets(ctx context.Context, request ListRuleSetsRequest) (response ListRuleSetsResponse, err error)
+
+	// ListSSLCipherSuites Lists all SSL cipher suites associated with the specified load balancer.
+	ListSSLCipherSuites(ctx context.Context, request ListSSLCipherSuitesRequest) (response ListSSLCipherSuitesResponse, err error)
+
+	// ListShapes Lists the valid load balancer shapes.
+	ListShapes(ctx context.Context, request ListShapesRequest) (response ListShapesResponse, err error)
+
+	// ListWorkRequests Lists the work requests for a given load balancer.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateBackend Updates the configuration of a backend server within the specified backend set.
+	UpdateBackend(ctx context.Context, request UpdateBackendRequest) (response UpdateBackendResponse, err error)
+
+	// UpdateBackendSet Updates a backend set.
+	UpdateBackendSet(ctx context.Context, request UpdateBackendSetRequest) (response UpdateBackendSetResponse, err error)
+
+	// UpdateHealthChecker Updates the health check policy for a given load balancer and backend set.
+	UpdateHealthChecker(ctx context.Context, request UpdateHealthCheckerRequest) (response UpdateHealthCheckerResponse, err error)
+
+	// UpdateHostname Overwrites an existing hostname resource on the specified load balancer. Use this operation to change a
+	// virtual hostname.
+	UpdateHostname(ctx context.Context, request UpdateHostnameRequest) (response UpdateHostnameResponse, err error)
+
+	// UpdateListener Updates a listener for a given load balancer.
+	UpdateListener(ctx context.Context, request UpdateListenerRequest) (response UpdateListenerResponse, err error)
+
+	// UpdateLoadBalancer Updates a load balancer's configuration.
+	UpdateLoadBalancer(ctx context.Context, request UpdateLoadBalancerRequest) (response UpdateLoadBalancerResponse, err error)
+
+	// UpdateLoadBalancerShape Update the shape of a load balancer. The new shape can be larger or smaller compared to existing shape of the
+	// LB. The service will try to perform this operation in the least disruptive way to existing connections, but
+	// there is a possibility that they might be lost during the LB resizing process. The new shape becomes effective
+	// as soon as the related work request completes successfully, i.e. when reshaping to a larger shape, the LB will
+	// start accepting larger bandwidth and when reshaping to a smaller one, the LB will be accepting smaller
+	// bandwidth.
+	UpdateLoadBalancerShape(ctx context.Context, request UpdateLoadBalancerShapeRequest) (response UpdateLoadBalancerShapeResponse, err error)
+
+	// UpdateNetworkSecurityGroups Updates the network security groups associated with the specified load balancer.
+	UpdateNetworkSecurityGroups(ctx context.Context, request UpdateNetworkSecurityGroupsRequest) (response UpdateNetworkSecurityGroupsResponse, err error)
+
+	// UpdatePathRouteSet Overwrites an existing path route set on the specified load balancer. Use this operation to add, delete, or alter
+	// path route rules in a path route set.
+	// To add a new path route rule to a path route set, the `pathRoutes` in the
+	// UpdatePathRouteSetDetails object must include
+	// both the new path route rule to add and the existing path route rules to retain.
+	UpdatePathRouteSet(ctx context.Context, request UpdatePathRouteSetRequest) (response UpdatePathRouteSetResponse, err error)
+
+	// UpdateRuleSet Overwrites an existing set of rules on the specified load balancer. Use this operation to add or alter
+	// the rules in a rule set.
+	// To add a new rule to a set, the body must include both the new rule to add and the existing rules to retain.
+	UpdateRuleSet(ctx context.Context, request UpdateRuleSetRequest) (response UpdateRuleSetResponse, err error)
+
+	// UpdateSSLCipherSuite Updates an existing SSL cipher suite for the specified load balancer.
+	UpdateSSLCipherSuite(ctx context.Context, request UpdateSSLCipherSuiteRequest) (response UpdateSSLCipherSuiteResponse, err error)
+}
+
+// LoadBalancerClient implements LoadBalancerClientAPI
+var _ LoadBalancerClientAPI = LoadBalancerClient{}
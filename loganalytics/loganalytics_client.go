@@ -102,10 +102,12 @@ func (client LogAnalyticsClient) AddEntityAssociation(ctx context.Context, reque
 				response = AddEntityAssociationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AddEntityAssociationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AddEntityAssociationResponse")
 	}
@@ -257,10 +259,12 @@ func (client LogAnalyticsClient) ChangeLogAnalyticsEntityCompartment(ctx context
 				response = ChangeLogAnalyticsEntityCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeLogAnalyticsEntityCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeLogAnalyticsEntityCompartmentResponse")
 	}
@@ -312,10 +316,12 @@ func (client LogAnalyticsClient) ChangeLogAnalyticsLogGroupCompartment(ctx conte
 				response = ChangeLogAnalyticsLogGroupCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeLogAnalyticsLogGroupCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeLogAnalyticsLogGroupCompartmentResponse")
 	}
@@ -417,10 +423,12 @@ func (client LogAnalyticsClient) ChangeScheduledTaskCompartment(ctx context.Cont
 				response = ChangeScheduledTaskCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeScheduledTaskCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeScheduledTaskCompartmentResponse")
 	}
@@ -473,10 +481,12 @@ func (client LogAnalyticsClient) Clean(ctx context.Context, request CleanRequest
 				response = CleanResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CleanResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CleanResponse")
 	}
@@ -528,10 +538,12 @@ func (client LogAnalyticsClient) CreateLogAnalyticsEntity(ctx context.Context, r
 				response = CreateLogAnalyticsEntityResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogAnalyticsEntityResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogAnalyticsEntityResponse")
 	}
@@ -583,10 +595,12 @@ func (client LogAnalyticsClient) CreateLogAnalyticsEntityType(ctx context.Contex
 				response = CreateLogAnalyticsEntityTypeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogAnalyticsEntityTypeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogAnalyticsEntityTypeResponse")
 	}
@@ -638,10 +652,12 @@ func (client LogAnalyticsClient) CreateLogAnalyticsLogGroup(ctx context.Context,
 				response = CreateLogAnalyticsLogGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogAnalyticsLogGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogAnalyticsLogGroupResponse")
 	}
@@ -743,10 +759,12 @@ func (client LogAnalyticsClient) CreateScheduledTask(ctx context.Context, reques
 				response = CreateScheduledTaskResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateScheduledTaskResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateScheduledTaskResponse")
 	}
@@ -798,10 +816,12 @@ func (client LogAnalyticsClient) DeleteAssociations(ctx context.Context, request
 				response = DeleteAssociationsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteAssociationsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteAssociationsResponse")
 	}
@@ -853,10 +873,12 @@ func (client LogAnalyticsClient) DeleteField(ctx context.Context, request Delete
 				response = DeleteFieldResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteFieldResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteFieldResponse")
 	}
@@ -908,10 +930,12 @@ func (client LogAnalyticsClient) DeleteLabel(ctx context.Context, request Delete
 				response = DeleteLabelResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteLabelResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteLabelResponse")
 	}
@@ -1164,10 +1188,12 @@ func (client LogAnalyticsClient) DeleteParser(ctx context.Context, request Delet
 				response = DeleteParserResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteParserResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteParserResponse")
 	}
@@ -1269,10 +1295,12 @@ func (client LogAnalyticsClient) DeleteSource(ctx context.Context, request Delet
 				response = DeleteSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteSourceResponse")
 	}
@@ -1576,10 +1604,12 @@ func (client LogAnalyticsClient) EstimatePurgeDataSize(ctx context.Context, requ
 				response = EstimatePurgeDataSizeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(EstimatePurgeDataSizeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into EstimatePurgeDataSizeResponse")
 	}
@@ -1631,10 +1661,12 @@ func (client LogAnalyticsClient) ExportCustomContent(ctx context.Context, reques
 				response = ExportCustomContentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExportCustomContentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExportCustomContentResponse")
 	}
@@ -1734,10 +1766,12 @@ func (client LogAnalyticsClient) ExtractStructuredLogFieldPaths(ctx context.Cont
 				response = ExtractStructuredLogFieldPathsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExtractStructuredLogFieldPathsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExtractStructuredLogFieldPathsResponse")
 	}
@@ -1789,10 +1823,12 @@ func (client LogAnalyticsClient) ExtractStructuredLogHeaderPaths(ctx context.Con
 				response = ExtractStructuredLogHeaderPathsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExtractStructuredLogHeaderPathsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExtractStructuredLogHeaderPathsResponse")
 	}
@@ -1944,10 +1980,12 @@ func (client LogAnalyticsClient) GetColumnNames(ctx context.Context, request Get
 				response = GetColumnNamesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GetColumnNamesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GetColumnNamesResponse")
 	}
@@ -3201,10 +3239,12 @@ func (client LogAnalyticsClient) ImportCustomContent(ctx context.Context, reques
 				response = ImportCustomContentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportCustomContentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportCustomContentResponse")
 	}
@@ -5057,10 +5097,12 @@ func (client LogAnalyticsClient) OnboardNamespace(ctx context.Context, request O
 				response = OnboardNamespaceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(OnboardNamespaceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into OnboardNamespaceResponse")
 	}
@@ -5162,10 +5204,12 @@ func (client LogAnalyticsClient) PurgeStorageData(ctx context.Context, request P
 				response = PurgeStorageDataResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(PurgeStorageDataResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into PurgeStorageDataResponse")
 	}
@@ -5317,10 +5361,12 @@ func (client LogAnalyticsClient) RecallArchivedData(ctx context.Context, request
 				response = RecallArchivedDataResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RecallArchivedDataResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RecallArchivedDataResponse")
 	}
@@ -5372,10 +5418,12 @@ func (client LogAnalyticsClient) RegisterLookup(ctx context.Context, request Reg
 				response = RegisterLookupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RegisterLookupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RegisterLookupResponse")
 	}
@@ -5427,10 +5475,12 @@ func (client LogAnalyticsClient) ReleaseRecalledData(ctx context.Context, reques
 				response = ReleaseRecalledDataResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ReleaseRecalledDataResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ReleaseRecalledDataResponse")
 	}
@@ -5482,10 +5532,12 @@ func (client LogAnalyticsClient) RemoveEntityAssociations(ctx context.Context, r
 				response = RemoveEntityAssociationsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RemoveEntityAssociationsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RemoveEntityAssociationsResponse")
 	}
@@ -5539,10 +5591,12 @@ func (client LogAnalyticsClient) Run(ctx context.Context, request RunRequest) (r
 				response = RunResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RunResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RunResponse")
 	}
@@ -5644,10 +5698,12 @@ func (client LogAnalyticsClient) TestParser(ctx context.Context, request TestPar
 				response = TestParserResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(TestParserResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into TestParserResponse")
 	}
@@ -5999,10 +6055,12 @@ func (client LogAnalyticsClient) UploadLogFile(ctx context.Context, request Uplo
 				response = UploadLogFileResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UploadLogFileResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UploadLogFileResponse")
 	}
@@ -6064,10 +6122,12 @@ func (client LogAnalyticsClient) UpsertAssociations(ctx context.Context, request
 				response = UpsertAssociationsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpsertAssociationsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpsertAssociationsResponse")
 	}
@@ -6119,10 +6179,12 @@ func (client LogAnalyticsClient) UpsertField(ctx context.Context, request Upsert
 				response = UpsertFieldResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpsertFieldResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpsertFieldResponse")
 	}
@@ -6174,10 +6236,12 @@ func (client LogAnalyticsClient) UpsertLabel(ctx context.Context, request Upsert
 				response = UpsertLabelResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpsertLabelResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpsertLabelResponse")
 	}
@@ -6229,10 +6293,12 @@ func (client LogAnalyticsClient) UpsertParser(ctx context.Context, request Upser
 				response = UpsertParserResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpsertParserResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpsertParserResponse")
 	}
@@ -6284,10 +6350,12 @@ func (client LogAnalyticsClient) UpsertSource(ctx context.Context, request Upser
 				response = UpsertSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpsertSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpsertSourceResponse")
 	}
@@ -6339,10 +6407,12 @@ func (client LogAnalyticsClient) ValidateAssociationParameters(ctx context.Conte
 				response = ValidateAssociationParametersResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidateAssociationParametersResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidateAssociationParametersResponse")
 	}
@@ -6444,10 +6514,12 @@ func (client LogAnalyticsClient) ValidateSource(ctx context.Context, request Val
 				response = ValidateSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidateSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidateSourceResponse")
 	}
@@ -6499,10 +6571,12 @@ func (client LogAnalyticsClient) ValidateSourceExtendedFieldDetails(ctx context.
 				response = ValidateSourceExtendedFieldDetailsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ValidateSourceExtendedFieldDetailsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ValidateSourceExtendedFieldDetailsResponse")
 	}
@@ -0,0 +1,402 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loganalytics
+
+import (
+	"context"
+)
+
+// LogAnalyticsClientAPI is the interface of the operations of LogAnalyticsClient, to inject the client and mock it in tests
+type LogAnalyticsClientAPI interface {
+	// AddEntityAssociation Adds association between input source log analytics entity and destination entities.
+	AddEntityAssociation(ctx context.Context, request AddEntityAssociationRequest) (response AddEntityAssociationResponse, err error)
+
+	// BatchGetBasicInfo get basic information about a specified set of labels
+	BatchGetBasicInfo(ctx context.Context, request BatchGetBasicInfoRequest) (response BatchGetBasicInfoResponse, err error)
+
+	// CancelQueryWorkRequest Cancel/Remove query job work request.
+	CancelQueryWorkRequest(ctx context.Context, request CancelQueryWorkRequestRequest) (response CancelQueryWorkRequestResponse, err error)
+
+	// ChangeLogAnalyticsEntityCompartment Update the compartment of the log analytics entity with the given id.
+	ChangeLogAnalyticsEntityCompartment(ctx context.Context, request ChangeLogAnalyticsEntityCompartmentRequest) (response ChangeLogAnalyticsEntityCompartmentResponse, err error)
+
+	// ChangeLogAnalyticsLogGroupCompartment Updates the compartment of the Log-Analytics group with the given id.
+	ChangeLogAnalyticsLogGroupCompartment(ctx context.Context, request ChangeLogAnalyticsLogGroupCompartmentRequest) (response ChangeLogAnalyticsLogGroupCompartmentResponse, err error)
+
+	// ChangeLogAnalyticsObjectCollectionRuleCompartment Move the rule from it's current compartment to given compartment.
+	ChangeLogAnalyticsObjectCollectionRuleCompartment(ctx context.Context, request ChangeLogAnalyticsObjectCollectionRuleCompartmentRequest) (response ChangeLogAnalyticsObjectCollectionRuleCompartmentResponse, err error)
+
+	// ChangeScheduledTaskCompartment Move the scheduled task into a different compartment within the same tenancy.
+	ChangeScheduledTaskCompartment(ctx context.Context, request ChangeScheduledTaskCompartmentRequest) (response ChangeScheduledTaskCompartmentResponse, err error)
+
+	// Clean Clean accumulated acceleration data stored for the accelerated saved search.
+	// The ScheduledTask taskType must be ACCELERATION.
+	Clean(ctx context.Context, request CleanRequest) (response CleanResponse, err error)
+
+	// CreateLogAnalyticsEntity Create a new log analytics entity.
+	CreateLogAnalyticsEntity(ctx context.Context, request CreateLogAnalyticsEntityRequest) (response CreateLogAnalyticsEntityResponse, err error)
+
+	// CreateLogAnalyticsEntityType Add custom log analytics entity type.
+	CreateLogAnalyticsEntityType(ctx context.Context, request CreateLogAnalyticsEntityTypeRequest) (response CreateLogAnalyticsEntityTypeResponse, err error)
+
+	// CreateLogAnalyticsLogGroup Creates a new Log-Analytics group.
+	CreateLogAnalyticsLogGroup(ctx context.Context, request CreateLogAnalyticsLogGroupRequest) (response CreateLogAnalyticsLogGroupResponse, err error)
+
+	// CreateLogAnalyticsObjectCollectionRule Create a configuration to collect logs from object storage bucket.
+	CreateLogAnalyticsObjectCollectionRule(ctx context.Context, request CreateLogAnalyticsObjectCollectionRuleRequest) (response CreateLogAnalyticsObjectCollectionRuleResponse, err error)
+
+	// CreateScheduledTask Schedule a task as specified and return task info.
+	CreateScheduledTask(ctx context.Context, request CreateScheduledTaskRequest) (response CreateScheduledTaskResponse, err error)
+
+	// DeleteAssociations delete associations
+	DeleteAssociations(ctx context.Context, request DeleteAssociationsRequest) (response DeleteAssociationsResponse, err error)
+
+	// DeleteField delete field with specified name
+	DeleteField(ctx context.Context, request DeleteFieldRequest) (response DeleteFieldResponse, err error)
+
+	// DeleteLabel delete a label
+	DeleteLabel(ctx context.Context, request DeleteLabelRequest) (response DeleteLabelResponse, err error)
+
+	// DeleteLogAnalyticsEntity Delete log analytics entity with the given id.
+	DeleteLogAnalyticsEntity(ctx context.Context, request DeleteLogAnalyticsEntityRequest) (response DeleteLogAnalyticsEntityResponse, err error)
+
+	// DeleteLogAnalyticsEntityType Delete the log analytics entity type with the given name.
+	DeleteLogAnalyticsEntityType(ctx context.Context, request DeleteLogAnalyticsEntityTypeRequest) (response DeleteLogAnalyticsEntityTypeResponse, err error)
+
+	// DeleteLogAnalyticsLogGroup Deletes the Log-Analytics group with the given id.
+	DeleteLogAnalyticsLogGroup(ctx context.Context, request DeleteLogAnalyticsLogGroupRequest) (response DeleteLogAnalyticsLogGroupResponse, err error)
+
+	// DeleteLogAnalyticsObjectCollectionRule Deletes a configured object storage bucket based collection rule to stop the log collection of the configured bucket .
+	// It will not delete the already collected log data from the configured bucket.
+	DeleteLogAnalyticsObjectCollectionRule(ctx context.Context, request DeleteLogAnalyticsObjectCollectionRuleRequest) (response DeleteLogAnalyticsObjectCollectionRuleResponse, err error)
+
+	// DeleteParser delete parser with specified name
+	DeleteParser(ctx context.Context, request DeleteParserRequest) (response DeleteParserResponse, err error)
+
+	// DeleteScheduledTask Delete the scheduled task.
+	DeleteScheduledTask(ctx context.Context, request DeleteScheduledTaskRequest) (response DeleteScheduledTaskResponse, err error)
+
+	// DeleteSource delete source with specified ID
+	DeleteSource(ctx context.Context, request DeleteSourceRequest) (response DeleteSourceResponse, err error)
+
+	// DeleteUpload Deletes an Upload by its reference.
+	// It deletes all the logs in storage asscoiated with the upload and the corresponding upload metadata.
+	DeleteUpload(ctx context.Context, request DeleteUploadRequest) (response DeleteUploadResponse, err error)
+
+	// DeleteUploadFile Deletes a specific log file inside an upload by providing upload file reference.
+	// It deletes all the logs in storage asscoiated with the upload file and the corresponding upload metadata.
+	DeleteUploadFile(ctx context.Context, request DeleteUploadFileRequest) (response DeleteUploadFileResponse, err error)
+
+	// DeleteUploadWarning Suppresses a specific warning inside an upload.
+	DeleteUploadWarning(ctx context.Context, request DeleteUploadWarningRequest) (response DeleteUploadWarningResponse, err error)
+
+	// DisableArchiving disable archiving
+	DisableArchiving(ctx context.Context, request DisableArchivingRequest) (response DisableArchivingResponse, err error)
+
+	// EnableArchiving enable archiving.
+	EnableArchiving(ctx context.Context, request EnableArchivingRequest) (response EnableArchivingResponse, err error)
+
+	// EstimatePurgeDataSize estimate the size of data to be purged based on query parameters.
+	EstimatePurgeDataSize(ctx context.Context, request EstimatePurgeDataSizeRequest) (response EstimatePurgeDataSizeResponse, err error)
+
+	// ExportCustomContent export
+	ExportCustomContent(ctx context.Context, request ExportCustomContentRequest) (response ExportCustomContentResponse, err error)
+
+	// ExportQueryResult Export data based on query. Endpoint returns a stream of data. Endpoint is synchronous. Queries must deliver first result within 60 seconds or calls are subject to timeout.
+	ExportQueryResult(ctx context.Context, request ExportQueryResultRequest) (response ExportQueryResultResponse, err error)
+
+	// ExtractStructuredLogFieldPaths structured log fieldpaths
+	ExtractStructuredLogFieldPaths(ctx context.Context, request ExtractStructuredLogFieldPathsRequest) (response ExtractStructuredLogFieldPathsResponse, err error)
+
+	// ExtractStructuredLogHeaderPaths structured log header paths
+	ExtractStructuredLogHeaderPaths(ctx context.Context, request ExtractStructuredLogHeaderPathsRequest) (response ExtractStructuredLogHeaderPathsResponse, err error)
+
+	// Filter Each filter specifies an operator, a field and one or more values.
+	Filter(ctx context.Context, request FilterRequest) (response FilterResponse, err error)
+
+	// GetAssociationSummary association summary
+	GetAssociationSummary(ctx context.Context, request GetAssociationSummaryRequest) (response GetAssociationSummaryResponse, err error)
+
+	// GetColumnNames extract column names from SQL query
+	GetColumnNames(ctx context.Context, request GetColumnNamesRequest) (response GetColumnNamesResponse, err error)
+
+	// GetConfigWorkRequest association summary by source
+	GetConfigWorkRequest(ctx context.Context, request GetConfigWorkRequestRequest) (response GetConfigWorkRequestResponse, err error)
+
+	// GetField get common field with specified name
+	GetField(ctx context.Context, request GetFieldRequest) (response GetFieldResponse, err error)
+
+	// GetFieldsSummary get field summary
+	GetFieldsSummary(ctx context.Context, request GetFieldsSummaryRequest) (response GetFieldsSummaryResponse, err error)
+
+	// GetLabel get label with specified name
+	GetLabel(ctx context.Context, request GetLabelRequest) (response GetLabelResponse, err error)
+
+	// GetLabelSummary get total count
+	GetLabelSummary(ctx context.Context, request GetLabelSummaryRequest) (response GetLabelSummaryResponse, err error)
+
+	// GetLogAnalyticsEntitiesSummary Returns log analytics entities count summary.
+	GetLogAnalyticsEntitiesSummary(ctx context.Context, request GetLogAnalyticsEntitiesSummaryRequest) (response GetLogAnalyticsEntitiesSummaryResponse, err error)
+
+	// GetLogAnalyticsEntity Retrieve the log analytics entity with the given id.
+	GetLogAnalyticsEntity(ctx context.Context, request GetLogAnalyticsEntityRequest) (response GetLogAnalyticsEntityResponse, err error)
+
+	// GetLogAnalyticsEntityType Retrieve the log analytics entity type with the given name.
+	GetLogAnalyticsEntityType(ctx context.Context, request GetLogAnalyticsEntityTypeRequest) (response GetLogAnalyticsEntityTypeResponse, err error)
+
+	// GetLogAnalyticsLogGroup Retrieves the Log-Analytics group with the given id.
+	GetLogAnalyticsLogGroup(ctx context.Context, request GetLogAnalyticsLogGroupRequest) (response GetLogAnalyticsLogGroupResponse, err error)
+
+	// GetLogAnalyticsLogGroupsSummary Returns a count of Log-Analytics groups.
+	GetLogAnalyticsLogGroupsSummary(ctx context.Context, request GetLogAnalyticsLogGroupsSummaryRequest) (response GetLogAnalyticsLogGroupsSummaryResponse, err error)
+
+	// GetLogAnalyticsObjectCollectionRule Gets a configured object storage based collection rule by given id
+	GetLogAnalyticsObjectCollectionRule(ctx context.Context, request GetLogAnalyticsObjectCollectionRuleRequest) (response GetLogAnalyticsObjectCollectionRuleResponse, err error)
+
+	// GetNamespace Get Namespace of a tenancy already onboarded in Log Analytics Application
+	GetNamespace(ctx context.Context, request GetNamespaceRequest) (response GetNamespaceResponse, err error)
+
+	// GetParser get parser with fields by Name
+	GetParser(ctx context.Context, request GetParserRequest) (response GetParserResponse, err error)
+
+	// GetParserSummary parser summary
+	GetParserSummary(ctx context.Context, request GetParserSummaryRequest) (response GetParserSummaryResponse, err error)
+
+	// GetQueryResult Returns the intermediate results for a query that was specified to run asynchronously if the query has not completed,
+	// otherwise the final query results identified by a queryWorkRequestId returned when submitting the query execute asynchronously.
+	GetQueryResult(ctx context.Context, request GetQueryResultRequest) (response GetQueryResultResponse, err error)
+
+	// GetQueryWorkRequest Retrieve work request details by workRequestId. This endpoint can be polled for status tracking of work request. Clients should poll using the interval returned in the retry-after header.
+	GetQueryWorkRequest(ctx context.Context, request GetQueryWorkRequestRequest) (response GetQueryWorkRequestResponse, err error)
+
+	// GetScheduledTask Get the scheduled task for the specified task identifier.
+	GetScheduledTask(ctx context.Context, request GetScheduledTaskRequest) (response GetScheduledTaskResponse, err error)
+
+	// GetSource get source with specified name
+	GetSource(ctx context.Context, request GetSourceRequest) (response GetSourceResponse, err error)
+
+	// GetSourceSummary source summary
+	GetSourceSummary(ctx context.Context, request GetSourceSummaryRequest) (response GetSourceSummaryResponse, err error)
+
+	// GetStorage Storage configuration and status.
+	GetStorage(ctx context.Context, request GetStorageRequest) (response GetStorageResponse, err error)
+
+	// GetStorageUsage Storage usage info includes active, archived or recalled data.  The unit of return value is in bytes.
+	GetStorageUsage(ctx context.Context, request GetStorageUsageRequest) (response GetStorageUsageResponse, err error)
+
+	// GetStorageWorkRequest Retrieve work request details by key. This endpoint can be polled for status tracking of work request.
+	// Clients should poll using the interval returned in retry-after header.
+	GetStorageWorkRequest(ctx context.Context, request GetStorageWorkRequestRequest) (response GetStorageWorkRequestResponse, err error)
+
+	// GetUpload Gets an On-Demand Upload info by reference
+	GetUpload(ctx context.Context, request GetUploadRequest) (response GetUploadResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ImportCustomContent register custom content
+	ImportCustomContent(ctx context.Context, request ImportCustomContentRequest) (response ImportCustomContentResponse, err error)
+
+	// ListAssociatedEntities list of entities that have been associated to at least one source
+	ListAssociatedEntities(ctx context.Context, request ListAssociatedEntitiesRequest) (response ListAssociatedEntitiesResponse, err error)
+
+	// ListConfigWorkRequests association summary by source
+	ListConfigWorkRequests(ctx context.Context, request ListConfigWorkRequestsRequest) (response ListConfigWorkRequestsResponse, err error)
+
+	// ListEntityAssociations Return a list of log analytics entities associated with input source log analytics entity.
+	ListEntityAssociations(ctx context.Context, request ListEntityAssociationsRequest) (response ListEntityAssociationsResponse, err error)
+
+	// ListEntitySourceAssociations entity associations summary
+	ListEntitySourceAssociations(ctx context.Context, request ListEntitySourceAssociationsRequest) (response ListEntitySourceAssociationsResponse, err error)
+
+	// ListFields get all common field with specified display name and description
+	ListFields(ctx context.Context, request ListFieldsRequest) (response ListFieldsResponse, err error)
+
+	// ListLabelPriorities get list of priorities
+	ListLabelPriorities(ctx context.Context, request ListLabelPrioritiesRequest) (response ListLabelPrioritiesResponse, err error)
+
+	// ListLabelSourceDetails get details of sources using the label
+	ListLabelSourceDetails(ctx context.Context, request ListLabelSourceDetailsRequest) (response ListLabelSourceDetailsResponse, err error)
+
+	// ListLabels get labels passing specified filter
+	ListLabels(ctx context.Context, request ListLabelsRequest) (response ListLabelsResponse, err error)
+
+	// ListLogAnalyticsEntities Return a list of log analytics entities.
+	ListLogAnalyticsEntities(ctx context.Context, request ListLogAnalyticsEntitiesRequest) (response ListLogAnalyticsEntitiesResponse, err error)
+
+	// ListLogAnalyticsEntityTypes Return a list of log analytics entity types.
+	ListLogAnalyticsEntityTypes(ctx context.Context, request ListLogAnalyticsEntityTypesRequest) (response ListLogAnalyticsEntityTypesResponse, err error)
+
+	// ListLogAnalyticsLogGroups Returns a list of Log-Analytics groups.
+	ListLogAnalyticsLogGroups(ctx context.Context, request ListLogAnalyticsLogGroupsRequest) (response ListLogAnalyticsLogGroupsResponse, err error)
+
+	// ListLogAnalyticsObjectCollectionRules Gets list of configuration details of Object Storage based collection rules.
+	ListLogAnalyticsObjectCollectionRules(ctx context.Context, request ListLogAnalyticsObjectCollectionRulesRequest) (response ListLogAnalyticsObjectCollectionRulesResponse, err error)
+
+	// ListMetaSourceTypes get all meta source types
+	ListMetaSourceTypes(ctx context.Context, request ListMetaSourceTypesRequest) (response ListMetaSourceTypesResponse, err error)
+
+	// ListNamespaces List Namespaces.
+	ListNamespaces(ctx context.Context, request ListNamespacesRequest) (response ListNamespacesResponse, err error)
+
+	// ListParserFunctions get pre-process plugin instance
+	ListParserFunctions(ctx context.Context, request ListParserFunctionsRequest) (response ListParserFunctionsResponse, err error)
+
+	// ListParserMetaPlugins get pre-process Meta plugins
+	ListParserMetaPlugins(ctx context.Context, request ListParserMetaPluginsRequest) (response ListParserMetaPluginsResponse, err error)
+
+	// ListParsers List parsers passing specified filter
+	ListParsers(ctx context.Context, request ListParsersRequest) (response ListParsersResponse, err error)
+
+	// ListQueryWorkRequests List active asynchronous queries.
+	ListQueryWorkRequests(ctx context.Context, request ListQueryWorkRequestsRequest) (response ListQueryWorkRequestsResponse, err error)
+
+	// ListScheduledTasks Lists scheduled tasks.
+	ListScheduledTasks(ctx context.Context, request ListScheduledTasksRequest) (response ListScheduledTasksResponse, err error)
+
+	// ListSourceAssociations association summary by source
+	ListSourceAssociations(ctx context.Context, request ListSourceAssociationsRequest) (response ListSourceAssociationsResponse, err error)
+
+	// ListSourceExtendedFieldDefinitions get source extended fields for source with specified Id
+	ListSourceExtendedFieldDefinitions(ctx context.Context, request ListSourceExtendedFieldDefinitionsRequest) (response ListSourceExtendedFieldDefinitionsResponse, err error)
+
+	// ListSourceLabelOperators list source label operators
+	ListSourceLabelOperators(ctx context.Context, request ListSourceLabelOperatorsRequest) (response ListSourceLabelOperatorsResponse, err error)
+
+	// ListSourceMetaFunctions get source meta functions
+	ListSourceMetaFunctions(ctx context.Context, request ListSourceMetaFunctionsRequest) (response ListSourceMetaFunctionsResponse, err error)
+
+	// ListSourcePatterns get source patterns for source with specified Id
+	ListSourcePatterns(ctx context.Context, request ListSourcePatternsRequest) (response ListSourcePatternsResponse, err error)
+
+	// ListSources source list
+	ListSources(ctx context.Context, request ListSourcesRequest) (response ListSourcesResponse, err error)
+
+	// ListStorageWorkRequestErrors Retrieve work request errors if any
+	ListStorageWorkRequestErrors(ctx context.Context, request ListStorageWorkRequestErrorsRequest) (response ListStorageWorkRequestErrorsResponse, err error)
+
+	// ListStorageWorkRequests List non-expired storage manager work requests.
+	ListStorageWorkRequests(ctx context.Context, request ListStorageWorkRequestsRequest) (response ListStorageWorkRequestsResponse, err error)
+
+	// ListSupportedCharEncodings Gets the list of character encodings supported for log files.
+	ListSupportedCharEncodings(ctx context.Context, request ListSupportedCharEncodingsRequest) (response ListSupportedCharEncodingsResponse, err error)
+
+	// ListSupportedTimezones Gets timezones that are supported when performing uploads.
+	ListSupportedTimezones(ctx context.Context, request ListSupportedTimezonesRequest) (response ListSupportedTimezonesResponse, err error)
+
+	// ListUploadFiles Gets list of files in an upload.
+	ListUploadFiles(ctx context.Context, request ListUploadFilesRequest) (response ListUploadFilesResponse, err error)
+
+	// ListUploadWarnings Gets list of warnings in an upload explaining the failures due to incorrect configuration.
+	ListUploadWarnings(ctx context.Context, request ListUploadWarningsRequest) (response ListUploadWarningsResponse, err error)
+
+	// ListUploads Gets a list of all On-demand uploads.
+	// To use this and other API operations, you must be authorized in an IAM policy.
+	ListUploads(ctx context.Context, request ListUploadsRequest) (response ListUploadsResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// OffboardNamespace Off-boards a tenant from Logging Analytics
+	OffboardNamespace(ctx context.Context, request OffboardNamespaceRequest) (response OffboardNamespaceResponse, err error)
+
+	// OnboardNamespace On-boards a tenant to Logging Analytics.
+	OnboardNamespace(ctx context.Context, request OnboardNamespaceRequest) (response OnboardNamespaceResponse, err error)
+
+	// ParseQuery Describe query
+	ParseQuery(ctx context.Context, request ParseQueryRequest) (response ParseQueryResponse, err error)
+
+	// PurgeStorageData submit work requests to purge old data based on the type.
+	PurgeStorageData(ctx context.Context, request PurgeStorageDataRequest) (response PurgeStorageDataResponse, err error)
+
+	// PutQueryWorkRequestBackground Put the work request specified by {workRequestId} into the background.
+	PutQueryWorkRequestBackground(ctx context.Context, request PutQueryWorkRequestBackgroundRequest) (response PutQueryWorkRequestBackgroundResponse, err error)
+
+	// Query Performs a log analytics search, if shouldRunAsync is false returns the query results once they become available subject to 60 second timeout. If a query is subject to exceed that time then it should be run asynchronously. Asynchronous query submissions return the queryWorkRequestId to use for execution tracking, query submission lifecycle actions and to poll for query results.
+	Query(ctx context.Context, request QueryRequest) (response QueryResponse, err error)
+
+	// RecallArchivedData submit work requests to recall archived data.
+	RecallArchivedData(ctx context.Context, request RecallArchivedDataRequest) (response RecallArchivedDataResponse, err error)
+
+	// RegisterLookup register lookup
+	RegisterLookup(ctx context.Context, request RegisterLookupRequest) (response RegisterLookupResponse, err error)
+
+	// ReleaseRecalledData submit work requests to release recalled data.
+	ReleaseRecalledData(ctx context.Context, request ReleaseRecalledDataRequest) (response ReleaseRecalledDataResponse, err error)
+
+	// RemoveEntityAssociations Delete association between input source log analytics entity and destination entities.
+	RemoveEntityAssociations(ctx context.Context, request RemoveEntityAssociationsRequest) (response RemoveEntityAssociationsResponse, err error)
+
+	// Run Execute the saved search acceleration task in the foreground.
+	// The ScheduledTask taskType must be ACCELERATION.
+	// Optionally specify time range (timeStart and timeEnd). The default is all time.
+	Run(ctx context.Context, request RunRequest) (response RunResponse, err error)
+
+	// Suggest Returns a context specific list of either commands, fields, or values to add to the end of the query string.
+	Suggest(ctx context.Context, request SuggestRequest) (response SuggestResponse, err error)
+
+	// TestParser test parser
+	TestParser(ctx context.Context, request TestParserRequest) (response TestParserResponse, err error)
+
+	// UpdateLogAnalyticsEntity Update the log analytics entity with the given id.
+	UpdateLogAnalyticsEntity(ctx context.Context, request UpdateLogAnalyticsEntityRequest) (response UpdateLogAnalyticsEntityResponse, err error)
+
+	// UpdateLogAnalyticsEntityType Update custom log analytics entity type. Out of box entity types cannot be udpated.
+	UpdateLogAnalyticsEntityType(ctx context.Context, request UpdateLogAnalyticsEntityTypeRequest) (response UpdateLogAnalyticsEntityTypeResponse, err error)
+
+	// UpdateLogAnalyticsLogGroup Updates the Log-Analytics group with the given id.
+	UpdateLogAnalyticsLogGroup(ctx context.Context, request UpdateLogAnalyticsLogGroupRequest) (response UpdateLogAnalyticsLogGroupResponse, err error)
+
+	// UpdateLogAnalyticsObjectCollectionRule Update the rule with the given id.
+	UpdateLogAnalyticsObjectCollectionRule(ctx context.Context, request UpdateLogAnalyticsObjectCollectionRuleRequest) (response UpdateLogAnalyticsObjectCollectionRuleResponse, err error)
+
+	// UpdateScheduledTask Update the scheduled task. Schedules may be updated only for taskType SAVED_SEARCH and PURGE.
+	UpdateScheduledTask(ctx context.Context, request UpdateScheduledTaskRequest) (response UpdateScheduledTaskResponse, err error)
+
+	// UpdateStorage update the archiving configuration
+	UpdateStorage(ctx context.Context, request UpdateStorageRequest) (response UpdateStorageResponse, err error)
+
+	// UploadLogFile Accepts log data for processing by Log Analytics.
+	UploadLogFile(ctx context.Context, request UploadLogFileRequest) (response UploadLogFileResponse, err error)
+
+	// UpsertAssociations create or update associations for a source
+	UpsertAssociations(ctx context.Context, request UpsertAssociationsRequest) (response UpsertAssociationsResponse, err error)
+
+	// UpsertField Defines or update a field.
+	UpsertField(ctx context.Context, request UpsertFieldRequest) (response UpsertFieldResponse, err error)
+
+	// UpsertLabel Define or update a label.
+	UpsertLabel(ctx context.Context, request UpsertLabelRequest) (response UpsertLabelResponse, err error)
+
+	// UpsertParser Define or update parser
+	UpsertParser(ctx context.Context, request UpsertParserRequest) (response UpsertParserResponse, err error)
+
+	// UpsertSource Define or update a source
+	UpsertSource(ctx context.Context, request UpsertSourceRequest) (response UpsertSourceResponse, err error)
+
+	// ValidateAssociationParameters association parameter validation
+	ValidateAssociationParameters(ctx context.Context, request ValidateAssociationParametersRequest) (response ValidateAssociationParametersResponse, err error)
+
+	// ValidateFile Validates a log file to check whether it is eligible to upload or not.
+	ValidateFile(ctx context.Context, request ValidateFileRequest) (response ValidateFileResponse, err error)
+
+	// ValidateSource Pre-define or update a source
+	ValidateSource(ctx context.Context, request ValidateSourceRequest) (response ValidateSourceResponse, err error)
+
+	// ValidateSourceExtendedFieldDetails test extended fields
+	ValidateSourceExtendedFieldDetails(ctx context.Context, request ValidateSourceExtendedFieldDetailsRequest) (response ValidateSourceExtendedFieldDetailsResponse, err error)
+
+	// ValidateSourceMapping Validates the source mapping for given file and provides match status and parsed representation of log data.
+	ValidateSourceMapping(ctx context.Context, request ValidateSourceMappingRequest) (response ValidateSourceMappingResponse, err error)
+}
+
+// LogAnalyticsClient implements LogAnalyticsClientAPI
+var _ LogAnalyticsClientAPI = LogAnalyticsClient{}
@@ -0,0 +1,139 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loganalytics
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*AbstractColumn)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractcolumn{} })
+	common.RegisterPolymorphicModel((*AbstractCommandDescriptor)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractcommanddescriptor{} })
+	common.RegisterPolymorphicModel((*AbstractField)(nil), func() common.PolymorphicJSONUnmarshaler { return &abstractfield{} })
+	common.RegisterPolymorphicModel((*Action)(nil), func() common.PolymorphicJSONUnmarshaler { return &action{} })
+	common.RegisterPolymorphicModel((*Argument)(nil), func() common.PolymorphicJSONUnmarshaler { return &argument{} })
+	common.RegisterPolymorphicModel((*CreateScheduledTaskDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createscheduledtaskdetails{} })
+	common.RegisterPolymorphicModel((*Schedule)(nil), func() common.PolymorphicJSONUnmarshaler { return &schedule{} })
+}
+
+// MarshalJSON marshals a AbstractColumn whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractcolumn) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractcolumn abstractcolumn
+	s := struct {
+		MarshalTypeabstractcolumn
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractcolumn: (MarshalTypeabstractcolumn)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AbstractCommandDescriptor whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractcommanddescriptor) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractcommanddescriptor abstractcommanddescriptor
+	s := struct {
+		MarshalTypeabstractcommanddescriptor
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractcommanddescriptor: (MarshalTypeabstractcommanddescriptor)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a AbstractField whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m abstractfield) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeabstractfield abstractfield
+	s := struct {
+		MarshalTypeabstractfield
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeabstractfield: (MarshalTypeabstractfield)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Action whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m action) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeaction action
+	s := struct {
+		MarshalTypeaction
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeaction: (MarshalTypeaction)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Argument whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m argument) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeargument argument
+	s := struct {
+		MarshalTypeargument
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeargument: (MarshalTypeargument)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateScheduledTaskDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createscheduledtaskdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatescheduledtaskdetails createscheduledtaskdetails
+	s := struct {
+		MarshalTypecreatescheduledtaskdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatescheduledtaskdetails: (MarshalTypecreatescheduledtaskdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a Schedule whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m schedule) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeschedule schedule
+	s := struct {
+		MarshalTypeschedule
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeschedule: (MarshalTypeschedule)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -0,0 +1,1001 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loganalytics
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetAbstractColumnTypeEnumValues(),
+		GetAbstractCommandDescriptorNameEnumValues(),
+		GetAbstractFieldNameEnumValues(),
+		GetActionTypeEnumValues(),
+		GetActionTypesEnumValues(),
+		GetArgumentTypeEnumValues(),
+		GetAttributeMaximumLenEnumValues(),
+		GetAttributePopulatedByEnumValues(),
+		GetAttributeRequiredInJSONEnumValues(),
+		GetAttributeUsageSenarioEnumValues(),
+		GetAttributeValueDataTypeEnumValues(),
+		GetAttributeValuePopulationPriorityEnumValues(),
+		GetBatchGetBasicInfoBasicLabelSortByEnumValues(),
+		GetBatchGetBasicInfoSortOrderEnumValues(),
+		GetCreateScheduledTaskDetailsKindEnumValues(),
+		GetEntityCloudTypeEnumValues(),
+		GetEntityLifecycleStatesEnumValues(),
+		GetExportDetailsOutputFormatEnumValues(),
+		GetExtractStructuredLogFieldPathsParserTypeEnumValues(),
+		GetExtractStructuredLogHeaderPathsParserTypeEnumValues(),
+		GetFieldsAddRemoveFieldOperationEnumValues(),
+		GetFilterOperatorEnumValues(),
+		GetGetQueryResultOutputModeEnumValues(),
+		GetJobModeEnumValues(),
+		GetJobModeFilterEnumValues(),
+		GetLabelPriorityPriorityEnumValues(),
+		GetLifecycleStatesEnumValues(),
+		GetListAssociatedEntitiesSortByEnumValues(),
+		GetListAssociatedEntitiesSortOrderEnumValues(),
+		GetListConfigWorkRequestsSortByEnumValues(),
+		GetListConfigWorkRequestsSortOrderEnumValues(),
+		GetListEntityAssociationsDirectOrAllAssociationsEnumValues(),
+		GetListEntityAssociationsSortByEnumValues(),
+		GetListEntityAssociationsSortOrderEnumValues(),
+		GetListEntitySourceAssociationsLifeCycleStateEnumValues(),
+		GetListEntitySourceAssociationsSortByEnumValues(),
+		GetListEntitySourceAssociationsSortOrderEnumValues(),
+		GetListFieldsParserTypeEnumValues(),
+		GetListFieldsSortByEnumValues(),
+		GetListFieldsSortOrderEnumValues(),
+		GetListLabelSourceDetailsLabelSourceSortByEnumValues(),
+		GetListLabelSourceDetailsSortOrderEnumValues(),
+		GetListLabelsIsSystemEnumValues(),
+		GetListLabelsLabelPriorityEnumValues(),
+		GetListLabelsLabelSortByEnumValues(),
+		GetListLabelsSortOrderEnumValues(),
+		GetListLogAnalyticsEntitiesIsManagementAgentIdNullEnumValues(),
+		GetListLogAnalyticsEntitiesLifecycleStateEnumValues(),
+		GetListLogAnalyticsEntitiesSortByEnumValues(),
+		GetListLogAnalyticsEntitiesSortOrderEnumValues(),
+		GetListLogAnalyticsEntityTypesCloudTypeEnumValues(),
+		GetListLogAnalyticsEntityTypesLifecycleStateEnumValues(),
+		GetListLogAnalyticsEntityTypesSortByEnumValues(),
+		GetListLogAnalyticsEntityTypesSortOrderEnumValues(),
+		GetListLogAnalyticsLogGroupsSortByEnumValues(),
+		GetListLogAnalyticsLogGroupsSortOrderEnumValues(),
+		GetListLogAnalyticsObjectCollectionRulesLifecycleStateEnumValues(),
+		GetListLogAnalyticsObjectCollectionRulesSortByEnumValues(),
+		GetListLogAnalyticsObjectCollectionRulesSortOrderEnumValues(),
+		GetListMetaSourceTypesSortOrderEnumValues(),
+		GetListParserFunctionsSortOrderEnumValues(),
+		GetListParserMetaPluginsSortOrderEnumValues(),
+		GetListParsersIsSystemEnumValues(),
+		GetListParsersParserTypeEnumValues(),
+		GetListParsersSortByEnumValues(),
+		GetListParsersSortOrderEnumValues(),
+		GetListParsersSourceTypeEnumValues(),
+		GetListQueryWorkRequestsModeEnumValues(),
+		GetListQueryWorkRequestsSortByEnumValues(),
+		GetListQueryWorkRequestsSortOrderEnumValues(),
+		GetListScheduledTasksSortByEnumValues(),
+		GetListScheduledTasksSortOrderEnumValues(),
+		GetListScheduledTasksTaskTypeEnumValues(),
+		GetListSourceAssociationsLifeCycleStateEnumValues(),
+		GetListSourceAssociationsSortByEnumValues(),
+		GetListSourceAssociationsSortOrderEnumValues(),
+		GetListSourceExtendedFieldDefinitionsSortByEnumValues(),
+		GetListSourceExtendedFieldDefinitionsSortOrderEnumValues(),
+		GetListSourceLabelOperatorsSortOrderEnumValues(),
+		GetListSourceMetaFunctionsSortOrderEnumValues(),
+		GetListSourcePatternsSortByEnumValues(),
+		GetListSourcePatternsSortOrderEnumValues(),
+		GetListSourcesIsSystemEnumValues(),
+		GetListSourcesSortByEnumValues(),
+		GetListSourcesSortOrderEnumValues(),
+		GetListStorageWorkRequestErrorsSortByEnumValues(),
+		GetListStorageWorkRequestErrorsSortOrderEnumValues(),
+		GetListStorageWorkRequestsOperationTypeEnumValues(),
+		GetListStorageWorkRequestsSortByEnumValues(),
+		GetListStorageWorkRequestsSortOrderEnumValues(),
+		GetListStorageWorkRequestsStatusEnumValues(),
+		GetListUploadFilesSortByEnumValues(),
+		GetListUploadFilesSortOrderEnumValues(),
+		GetListUploadFilesStatusEnumValues(),
+		GetListUploadsSortByEnumValues(),
+		GetListUploadsSortOrderEnumValues(),
+		GetLogAnalyticsAssociationLifeCycleStateEnumValues(),
+		GetLogAnalyticsAssociationParameterStatusEnumValues(),
+		GetLogAnalyticsConfigWorkRequestLifecycleStateEnumValues(),
+		GetLogAnalyticsConfigWorkRequestOperationTypeEnumValues(),
+		GetLogAnalyticsConfigWorkRequestSummaryLifecycleStateEnumValues(),
+		GetLogAnalyticsConfigWorkRequestSummaryOperationTypeEnumValues(),
+		GetLogAnalyticsLabelAliasPriorityEnumValues(),
+		GetLogAnalyticsLabelPriorityEnumValues(),
+		GetLogAnalyticsLabelSummaryPriorityEnumValues(),
+		GetLogAnalyticsLabelSummaryTypeEnumValues(),
+		GetLogAnalyticsLabelTypeEnumValues(),
+		GetLogAnalyticsLabelViewPriorityEnumValues(),
+		GetLogAnalyticsMetricMetricTypeEnumValues(),
+		GetLogAnalyticsMetricOperatorEnumValues(),
+		GetLogAnalyticsObjectCollectionRuleLifecycleStateEnumValues(),
+		GetLogAnalyticsOperationTypesEnumValues(),
+		GetLogAnalyticsParserSummaryTypeEnumValues(),
+		GetLogAnalyticsParserTypeEnumValues(),
+		GetLogAnalyticsSourceDataFilterFilterTypeEnumValues(),
+		GetObjectCollectionRuleCollectionTypesEnumValues(),
+		GetOperationStatusEnumValues(),
+		GetOutOfBoxEntityTypeDetailsCloudTypeEnumValues(),
+		GetQueryOperationTypeEnumValues(),
+		GetRegisterLookupTypeEnumValues(),
+		GetScheduleMisfirePolicyEnumValues(),
+		GetScheduleTypeEnumValues(),
+		GetScheduledTaskLifecycleStateEnumValues(),
+		GetScheduledTaskSummaryTaskStatusEnumValues(),
+		GetScheduledTaskTaskStatusEnumValues(),
+		GetSortFieldDirectionEnumValues(),
+		GetSortOrdersEnumValues(),
+		GetStorageDataTypeEnumValues(),
+		GetStorageOperationTypeEnumValues(),
+		GetSubSystemNameEnumValues(),
+		GetTaskTypeEnumValues(),
+		GetTestParserPayloadDetailsTypeEnumValues(),
+		GetTestParserScopeEnumValues(),
+		GetUploadFileSummaryStatusEnumValues(),
+		GetUpsertLogAnalyticsLabelDetailsPriorityEnumValues(),
+		GetUpsertLogAnalyticsLabelDetailsTypeEnumValues(),
+		GetUpsertLogAnalyticsParserDetailsTypeEnumValues(),
+		GetValidateAssociationParametersSortByEnumValues(),
+		GetValidateAssociationParametersSortOrderEnumValues(),
+		GetValueTypeEnumValues(),
+		GetViolationRuleTypeEnumValues(),
+		GetWorkRequestStatusEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AddEntityAssociationRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request BatchGetBasicInfoRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CancelQueryWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeLogAnalyticsEntityCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeLogAnalyticsLogGroupCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeLogAnalyticsObjectCollectionRuleCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeScheduledTaskCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CleanRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateLogAnalyticsEntityRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateLogAnalyticsEntityTypeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateLogAnalyticsLogGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateLogAnalyticsObjectCollectionRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateScheduledTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteFieldRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteLabelRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteLogAnalyticsEntityRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteLogAnalyticsEntityTypeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteLogAnalyticsLogGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteLogAnalyticsObjectCollectionRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteParserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteScheduledTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteUploadFileRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteUploadRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteUploadWarningRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DisableArchivingRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request EnableArchivingRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request EstimatePurgeDataSizeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ExportCustomContentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ExportQueryResultRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ExtractStructuredLogFieldPathsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ExtractStructuredLogHeaderPathsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request FilterRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetAssociationSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetColumnNamesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetConfigWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetFieldRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetFieldsSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLabelRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLabelSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsEntitiesSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsEntityRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsEntityTypeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsLogGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsLogGroupsSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetLogAnalyticsObjectCollectionRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetNamespaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetParserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetParserSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetQueryResultRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetQueryWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetScheduledTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSourceSummaryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetStorageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetStorageUsageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetStorageWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetUploadRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ImportCustomContentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAssociatedEntitiesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListConfigWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListEntityAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListEntitySourceAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListFieldsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLabelPrioritiesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLabelSourceDetailsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLabelsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLogAnalyticsEntitiesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLogAnalyticsEntityTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLogAnalyticsLogGroupsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListLogAnalyticsObjectCollectionRulesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListMetaSourceTypesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListNamespacesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListParserFunctionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListParserMetaPluginsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListParsersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListQueryWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListScheduledTasksRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourceAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourceExtendedFieldDefinitionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourceLabelOperatorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourceMetaFunctionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourcePatternsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSourcesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListStorageWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListStorageWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSupportedCharEncodingsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSupportedTimezonesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListUploadFilesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListUploadWarningsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListUploadsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request OffboardNamespaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request OnboardNamespaceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ParseQueryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request PurgeStorageDataRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request PutQueryWorkRequestBackgroundRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request QueryRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RecallArchivedDataRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RegisterLookupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ReleaseRecalledDataRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RemoveEntityAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RunRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SuggestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request TestParserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateLogAnalyticsEntityRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateLogAnalyticsEntityTypeRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateLogAnalyticsLogGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateLogAnalyticsObjectCollectionRuleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateScheduledTaskRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateStorageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UploadLogFileRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpsertAssociationsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpsertFieldRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpsertLabelRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpsertParserRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpsertSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateAssociationParametersRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateFileRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateSourceExtendedFieldDetailsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateSourceMappingRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ValidateSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AddEntityAssociationDetails, see common.ValidateStruct
+func (m AddEntityAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeLogAnalyticsEntityCompartmentDetails, see common.ValidateStruct
+func (m ChangeLogAnalyticsEntityCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeLogAnalyticsLogGroupCompartmentDetails, see common.ValidateStruct
+func (m ChangeLogAnalyticsLogGroupCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeLogAnalyticsObjectCollectionRuleCompartmentDetails, see common.ValidateStruct
+func (m ChangeLogAnalyticsObjectCollectionRuleCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeScheduledTaskCompartmentDetails, see common.ValidateStruct
+func (m ChangeScheduledTaskCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateAccelerationTaskDetails, see common.ValidateStruct
+func (m CreateAccelerationTaskDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateLogAnalyticsEntityDetails, see common.ValidateStruct
+func (m CreateLogAnalyticsEntityDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateLogAnalyticsEntityTypeDetails, see common.ValidateStruct
+func (m CreateLogAnalyticsEntityTypeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateLogAnalyticsLogGroupDetails, see common.ValidateStruct
+func (m CreateLogAnalyticsLogGroupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateLogAnalyticsObjectCollectionRuleDetails, see common.ValidateStruct
+func (m CreateLogAnalyticsObjectCollectionRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateNamespaceDetails, see common.ValidateStruct
+func (m CreateNamespaceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateStandardTaskDetails, see common.ValidateStruct
+func (m CreateStandardTaskDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DeleteLogAnalyticsAssociationDetails, see common.ValidateStruct
+func (m DeleteLogAnalyticsAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ErrorDetails, see common.ValidateStruct
+func (m ErrorDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of EstimatePurgeDataSizeDetails, see common.ValidateStruct
+func (m EstimatePurgeDataSizeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ExportDetails, see common.ValidateStruct
+func (m ExportDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ExtractLogHeaderDetails, see common.ValidateStruct
+func (m ExtractLogHeaderDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of FilterDetails, see common.ValidateStruct
+func (m FilterDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of GenerateAgentObjectNameDetails, see common.ValidateStruct
+func (m GenerateAgentObjectNameDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of OutOfBoxEntityTypeDetails, see common.ValidateStruct
+func (m OutOfBoxEntityTypeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ParseQueryDetails, see common.ValidateStruct
+func (m ParseQueryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of PurgeStorageDataDetails, see common.ValidateStruct
+func (m PurgeStorageDataDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of QueryDetails, see common.ValidateStruct
+func (m QueryDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RecallArchivedDataDetails, see common.ValidateStruct
+func (m RecallArchivedDataDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RegisterEntityTypesDetails, see common.ValidateStruct
+func (m RegisterEntityTypesDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ReleaseRecalledDataDetails, see common.ValidateStruct
+func (m ReleaseRecalledDataDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RemoveEntityAssociationsDetails, see common.ValidateStruct
+func (m RemoveEntityAssociationsDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SourceValidateDetails, see common.ValidateStruct
+func (m SourceValidateDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of StringListDetails, see common.ValidateStruct
+func (m StringListDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of SuggestDetails, see common.ValidateStruct
+func (m SuggestDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of TestParserPayloadDetails, see common.ValidateStruct
+func (m TestParserPayloadDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateLogAnalyticsEntityDetails, see common.ValidateStruct
+func (m UpdateLogAnalyticsEntityDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateLogAnalyticsEntityTypeDetails, see common.ValidateStruct
+func (m UpdateLogAnalyticsEntityTypeDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateLogAnalyticsLogGroupDetails, see common.ValidateStruct
+func (m UpdateLogAnalyticsLogGroupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateLogAnalyticsObjectCollectionRuleDetails, see common.ValidateStruct
+func (m UpdateLogAnalyticsObjectCollectionRuleDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateScheduledTaskDetails, see common.ValidateStruct
+func (m UpdateScheduledTaskDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateStorageDetails, see common.ValidateStruct
+func (m UpdateStorageDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpsertLogAnalyticsAssociationDetails, see common.ValidateStruct
+func (m UpsertLogAnalyticsAssociationDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpsertLogAnalyticsFieldDetails, see common.ValidateStruct
+func (m UpsertLogAnalyticsFieldDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpsertLogAnalyticsLabelDetails, see common.ValidateStruct
+func (m UpsertLogAnalyticsLabelDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpsertLogAnalyticsParserDetails, see common.ValidateStruct
+func (m UpsertLogAnalyticsParserDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpsertLogAnalyticsSourceDetails, see common.ValidateStruct
+func (m UpsertLogAnalyticsSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
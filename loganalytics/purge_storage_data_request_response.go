@@ -69,6 +69,9 @@ type PurgeStorageDataResponse struct {
 
 	// URI to entity or work request created.
 	Location *string `presentIn:"header" name:"location"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response PurgeStorageDataResponse) String() string {
@@ -106,6 +106,9 @@ type UploadLogFileResponse struct {
 
 	// Unique Oracle-assigned identifier for log data.
 	OpcObjectId *string `presentIn:"header" name:"opc-object-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response UploadLogFileResponse) String() string {
@@ -204,10 +204,12 @@ func (client LoggingManagementClient) ChangeLogSavedSearchCompartment(ctx contex
 				response = ChangeLogSavedSearchCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeLogSavedSearchCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeLogSavedSearchCompartmentResponse")
 	}
@@ -260,10 +262,12 @@ func (client LoggingManagementClient) ChangeUnifiedAgentConfigurationCompartment
 				response = ChangeUnifiedAgentConfigurationCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeUnifiedAgentConfigurationCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeUnifiedAgentConfigurationCompartmentResponse")
 	}
@@ -316,10 +320,12 @@ func (client LoggingManagementClient) CreateLog(ctx context.Context, request Cre
 				response = CreateLogResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogResponse")
 	}
@@ -372,10 +378,12 @@ func (client LoggingManagementClient) CreateLogGroup(ctx context.Context, reques
 				response = CreateLogGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogGroupResponse")
 	}
@@ -427,10 +435,12 @@ func (client LoggingManagementClient) CreateLogSavedSearch(ctx context.Context,
 				response = CreateLogSavedSearchResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateLogSavedSearchResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateLogSavedSearchResponse")
 	}
@@ -482,10 +492,12 @@ func (client LoggingManagementClient) CreateUnifiedAgentConfiguration(ctx contex
 				response = CreateUnifiedAgentConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateUnifiedAgentConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateUnifiedAgentConfigurationResponse")
 	}
@@ -0,0 +1,119 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package logging
+
+import (
+	"context"
+)
+
+// LoggingManagementClientAPI is the interface of the operations of LoggingManagementClient, to inject the client and mock it in tests
+type LoggingManagementClientAPI interface {
+	// ChangeLogGroupCompartment Moves a log group into a different compartment within the same tenancy.  When provided, the If-Match is checked against the resource ETag values.
+	// For information about moving resources between compartments, see Moving Resources Between Compartments (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeLogGroupCompartment(ctx context.Context, request ChangeLogGroupCompartmentRequest) (response ChangeLogGroupCompartmentResponse, err error)
+
+	// ChangeLogLogGroup Moves a log into a different log group within the same tenancy.  When provided, the If-Match is checked against the ETag values of the resource.
+	ChangeLogLogGroup(ctx context.Context, request ChangeLogLogGroupRequest) (response ChangeLogLogGroupResponse, err error)
+
+	// ChangeLogSavedSearchCompartment Moves a saved search into a different compartment within the same tenancy. For information about moving
+	// resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeLogSavedSearchCompartment(ctx context.Context, request ChangeLogSavedSearchCompartmentRequest) (response ChangeLogSavedSearchCompartmentResponse, err error)
+
+	// ChangeUnifiedAgentConfigurationCompartment Moves the unified agent configuration into a different compartment within the same tenancy.  When provided, the If-Match is checked against the ETag values of the resource.
+	// For information about moving resources between compartments, see Moving Resources Between Compartments (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeUnifiedAgentConfigurationCompartment(ctx context.Context, request ChangeUnifiedAgentConfigurationCompartmentRequest) (response ChangeUnifiedAgentConfigurationCompartmentResponse, err error)
+
+	// CreateLog Creates a log within the specified log group. This call fails if a log group has already been created
+	// with the same displayName or (service, resource, category) triplet.
+	CreateLog(ctx context.Context, request CreateLogRequest) (response CreateLogResponse, err error)
+
+	// CreateLogGroup Create a new log group with a unique display name. This call fails
+	// if the log group is already created with the same displayName in the compartment.
+	CreateLogGroup(ctx context.Context, request CreateLogGroupRequest) (response CreateLogGroupResponse, err error)
+
+	// CreateLogSavedSearch Creates a new LogSavedSearch.
+	CreateLogSavedSearch(ctx context.Context, request CreateLogSavedSearchRequest) (response CreateLogSavedSearchResponse, err error)
+
+	// CreateUnifiedAgentConfiguration Create unified agent configuration registration.
+	CreateUnifiedAgentConfiguration(ctx context.Context, request CreateUnifiedAgentConfigurationRequest) (response CreateUnifiedAgentConfigurationResponse, err error)
+
+	// DeleteLog Deletes the log object in a log group.
+	DeleteLog(ctx context.Context, request DeleteLogRequest) (response DeleteLogResponse, err error)
+
+	// DeleteLogGroup Deletes the specified log group.
+	DeleteLogGroup(ctx context.Context, request DeleteLogGroupRequest) (response DeleteLogGroupResponse, err error)
+
+	// DeleteLogSavedSearch Deletes the specified log saved search.
+	DeleteLogSavedSearch(ctx context.Context, request DeleteLogSavedSearchRequest) (response DeleteLogSavedSearchResponse, err error)
+
+	// DeleteUnifiedAgentConfiguration Delete unified agent configuration.
+	DeleteUnifiedAgentConfiguration(ctx context.Context, request DeleteUnifiedAgentConfigurationRequest) (response DeleteUnifiedAgentConfigurationResponse, err error)
+
+	// DeleteWorkRequest Cancel a work request that has not started yet.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// GetLog Gets the log object configuration for the log object OCID.
+	GetLog(ctx context.Context, request GetLogRequest) (response GetLogResponse, err error)
+
+	// GetLogGroup Get the specified log group's information.
+	GetLogGroup(ctx context.Context, request GetLogGroupRequest) (response GetLogGroupResponse, err error)
+
+	// GetLogIncludedSearch Retrieves a LogIncludedSearch.
+	GetLogIncludedSearch(ctx context.Context, request GetLogIncludedSearchRequest) (response GetLogIncludedSearchResponse, err error)
+
+	// GetLogSavedSearch Retrieves a log saved search.
+	GetLogSavedSearch(ctx context.Context, request GetLogSavedSearchRequest) (response GetLogSavedSearchResponse, err error)
+
+	// GetUnifiedAgentConfiguration Get the unified agent configuration for an ID.
+	GetUnifiedAgentConfiguration(ctx context.Context, request GetUnifiedAgentConfigurationRequest) (response GetUnifiedAgentConfigurationResponse, err error)
+
+	// GetWorkRequest Gets the details of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListLogGroups Lists all log groups for the specified compartment or tenancy.
+	ListLogGroups(ctx context.Context, request ListLogGroupsRequest) (response ListLogGroupsResponse, err error)
+
+	// ListLogIncludedSearches Lists Logging Included Searches for this compartment.
+	ListLogIncludedSearches(ctx context.Context, request ListLogIncludedSearchesRequest) (response ListLogIncludedSearchesResponse, err error)
+
+	// ListLogSavedSearches Lists Logging Saved Searches for this compartment.
+	ListLogSavedSearches(ctx context.Context, request ListLogSavedSearchesRequest) (response ListLogSavedSearchesResponse, err error)
+
+	// ListLogs Lists the specified log group's log objects.
+	ListLogs(ctx context.Context, request ListLogsRequest) (response ListLogsResponse, err error)
+
+	// ListServices Lists all services that support logging.
+	ListServices(ctx context.Context, request ListServicesRequest) (response ListServicesResponse, err error)
+
+	// ListUnifiedAgentConfigurations Lists all unified agent configurations in the specified compartment.
+	ListUnifiedAgentConfigurations(ctx context.Context, request ListUnifiedAgentConfigurationsRequest) (response ListUnifiedAgentConfigurationsResponse, err error)
+
+	// ListWorkRequestErrors Return a list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateLog Updates the existing log object with the associated configuration. This call
+	//       fails if the log object does not exist.
+	UpdateLog(ctx context.Context, request UpdateLogRequest) (response UpdateLogResponse, err error)
+
+	// UpdateLogGroup Updates the existing log group with the associated configuration. This call
+	//       fails if the log group does not exist.
+	UpdateLogGroup(ctx context.Context, request UpdateLogGroupRequest) (response UpdateLogGroupResponse, err error)
+
+	// UpdateLogSavedSearch Updates an  existing log saved search.
+	UpdateLogSavedSearch(ctx context.Context, request UpdateLogSavedSearchRequest) (response UpdateLogSavedSearchResponse, err error)
+
+	// UpdateUnifiedAgentConfiguration Update an existing unified agent configuration. This call
+	//       fails if the log group does not exist.
+	UpdateUnifiedAgentConfiguration(ctx context.Context, request UpdateUnifiedAgentConfigurationRequest) (response UpdateUnifiedAgentConfigurationResponse, err error)
+}
+
+// LoggingManagementClient implements LoggingManagementClientAPI
+var _ LoggingManagementClientAPI = LoggingManagementClient{}
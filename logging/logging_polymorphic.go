@@ -0,0 +1,85 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package logging
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*Source)(nil), func() common.PolymorphicJSONUnmarshaler { return &source{} })
+	common.RegisterPolymorphicModel((*UnifiedAgentLoggingSource)(nil), func() common.PolymorphicJSONUnmarshaler { return &unifiedagentloggingsource{} })
+	common.RegisterPolymorphicModel((*UnifiedAgentParser)(nil), func() common.PolymorphicJSONUnmarshaler { return &unifiedagentparser{} })
+	common.RegisterPolymorphicModel((*UnifiedAgentServiceConfigurationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &unifiedagentserviceconfigurationdetails{} })
+}
+
+// MarshalJSON marshals a Source whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m source) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesource source
+	s := struct {
+		MarshalTypesource
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesource: (MarshalTypesource)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UnifiedAgentLoggingSource whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m unifiedagentloggingsource) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeunifiedagentloggingsource unifiedagentloggingsource
+	s := struct {
+		MarshalTypeunifiedagentloggingsource
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeunifiedagentloggingsource: (MarshalTypeunifiedagentloggingsource)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UnifiedAgentParser whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m unifiedagentparser) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeunifiedagentparser unifiedagentparser
+	s := struct {
+		MarshalTypeunifiedagentparser
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeunifiedagentparser: (MarshalTypeunifiedagentparser)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UnifiedAgentServiceConfigurationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m unifiedagentserviceconfigurationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeunifiedagentserviceconfigurationdetails unifiedagentserviceconfigurationdetails
+	s := struct {
+		MarshalTypeunifiedagentserviceconfigurationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeunifiedagentserviceconfigurationdetails: (MarshalTypeunifiedagentserviceconfigurationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loggingingestion
+
+import (
+	"context"
+)
+
+// LoggingClientAPI is the interface of the operations of LoggingClient, to inject the client and mock it in tests
+type LoggingClientAPI interface {
+	// PutLogs This API allows ingesting logs associated with a logId. A success
+	// response implies the data has been accepted.
+	PutLogs(ctx context.Context, request PutLogsRequest) (response PutLogsResponse, err error)
+}
+
+// LoggingClient implements LoggingClientAPI
+var _ LoggingClientAPI = LoggingClient{}
@@ -0,0 +1,388 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package shipper ships application logs to a custom log of the Logging service. The Shipper buffers the entries
+// given to Log or written to it as an io.Writer, groups them in PutLogs requests by size, count or interval, retries
+// the requests failing with transient errors, and spills the batches to disk while the service is unreachable.
+package shipper
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+	"github.com/oracle/oci-go-sdk/v27/loggingingestion"
+)
+
+var (
+	errorShipperClosed = errors.New("the shipper is closed")
+	errorEntryTooLarge = errors.New("the entry is larger than maxBatchBytes")
+)
+
+const (
+	// specVersion is the version of the format of the PutLogs requests
+	specVersion = "1.0"
+
+	// entryOverhead accounts for the id, time and JSON syntax around the data of an entry in a request
+	entryOverhead = 128
+
+	spillFileSuffix = ".json"
+)
+
+// item is an entry buffered, or a request to flush the entries buffered before it
+type item struct {
+	entry   loggingingestion.LogEntry
+	size    int
+	flushed chan struct{}
+}
+
+// Shipper sends log entries to a custom log in batches, in the order they are given. A single request is in flight
+// at a time. Shipper is safe for concurrent use and must be closed to flush the entries buffered.
+type Shipper struct {
+	client loggingingestion.LoggingClient
+	config ShipperConfiguration
+
+	items      *worker.Queue
+	done       chan struct{}
+	hasSpilled bool
+}
+
+// NewShipper returns a shipper to the log described by config, and starts sending the entries in the background,
+// beginning with the batches spilled by a previous shipper.
+func NewShipper(client loggingingestion.LoggingClient, config ShipperConfiguration) (*Shipper, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+
+	if config.SpillDirectory != nil {
+		if err := os.MkdirAll(*config.SpillDirectory, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	shipper := &Shipper{
+		client:     client,
+		config:     config,
+		items:      worker.NewQueue(*config.MaxBufferedEntries, errorShipperClosed),
+		done:       make(chan struct{}),
+		hasSpilled: config.SpillDirectory != nil,
+	}
+	go shipper.run()
+	return shipper, nil
+}
+
+// Log buffers an entry to send, it blocks while the buffer is full until ctx is done. The zero time is replaced by
+// the current time.
+func (shipper *Shipper) Log(ctx context.Context, t time.Time, data string) error {
+	size := len(data) + entryOverhead
+	if size > *shipper.config.MaxBatchBytes {
+		return errorEntryTooLarge
+	}
+
+	id, err := newEntryID()
+	if err != nil {
+		return err
+	}
+
+	if t.IsZero() {
+		t = time.Now()
+	}
+	entry := loggingingestion.LogEntry{
+		Id:   common.String(id),
+		Data: common.String(data),
+		Time: &common.SDKTime{Time: t},
+	}
+	return shipper.items.Put(ctx, &item{entry: entry, size: size})
+}
+
+// Write buffers every non-empty line of p as an entry stamped with the current time, so that the shipper can be
+// the output of a logger. It blocks while the buffer is full.
+func (shipper *Shipper) Write(p []byte) (int, error) {
+	now := time.Now()
+	for _, line := range strings.Split(string(p), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if len(line) == 0 {
+			continue
+		}
+
+		if err := shipper.Log(context.Background(), now, line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends the entries buffered before the call, and waits until they are sent, spilled or dropped, or ctx is done
+func (shipper *Shipper) Flush(ctx context.Context) error {
+	flush := &item{flushed: make(chan struct{})}
+	if err := shipper.items.Put(ctx, flush); err != nil {
+		return err
+	}
+
+	select {
+	case <-flush.flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting entries and waits until the buffered entries are sent, spilled or dropped, or ctx is done.
+// Entries still buffered when ctx is done keep being sent in the background.
+func (shipper *Shipper) Close(ctx context.Context) error {
+	shipper.items.Close()
+
+	select {
+	case <-shipper.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run batches the buffered entries and sends them until the shipper is closed and the buffer is empty
+func (shipper *Shipper) run() {
+	defer close(shipper.done)
+	shipper.sendSpilled()
+
+	var queue []*item
+	interval := time.NewTimer(*shipper.config.FlushInterval)
+	interval.Stop()
+	intervalExpired, closing := false, false
+
+	for len(queue) > 0 || !closing {
+		if len(queue) > 0 && (closing || intervalExpired || shipper.isBatchFull(queue)) {
+			queue = shipper.sendBatch(queue)
+			intervalExpired = false
+			if len(queue) > 0 {
+				interval.Reset(*shipper.config.FlushInterval)
+			}
+			continue
+		}
+
+		select {
+		case received, ok := <-shipper.items.Items():
+			if !ok {
+				closing = true
+				continue
+			}
+
+			item := received.(*item)
+			if item.flushed != nil {
+				for len(queue) > 0 {
+					queue = shipper.sendBatch(queue)
+				}
+				close(item.flushed)
+				continue
+			}
+
+			queue = append(queue, item)
+			if len(queue) == 1 {
+				// drain an expiry left over from the previous batch before restarting the timer
+				if !interval.Stop() {
+					select {
+					case <-interval.C:
+					default:
+					}
+				}
+				interval.Reset(*shipper.config.FlushInterval)
+			}
+		case <-interval.C:
+			intervalExpired = true
+		}
+	}
+	interval.Stop()
+}
+
+// isBatchFull returns true if the queue holds at least a full batch
+func (shipper *Shipper) isBatchFull(queue []*item) bool {
+	if len(queue) >= *shipper.config.MaxBatchEntries {
+		return true
+	}
+
+	size := 0
+	for _, item := range queue {
+		size += item.size
+		if size >= *shipper.config.MaxBatchBytes {
+			return true
+		}
+	}
+	return false
+}
+
+// sendBatch sends the first entries of the queue in a single request and returns the rest of the queue
+func (shipper *Shipper) sendBatch(queue []*item) []*item {
+	count, size := 0, 0
+	for count < len(queue) && count < *shipper.config.MaxBatchEntries && size+queue[count].size <= *shipper.config.MaxBatchBytes {
+		size += queue[count].size
+		count++
+	}
+
+	batch := loggingingestion.LogEntryBatch{
+		Entries:             make([]loggingingestion.LogEntry, count),
+		Source:              shipper.config.Source,
+		Type:                shipper.config.Type,
+		Subject:             shipper.config.Subject,
+		Defaultlogentrytime: &common.SDKTime{Time: time.Now()},
+	}
+	for i, item := range queue[:count] {
+		batch.Entries[i] = item.entry
+	}
+
+	if err := shipper.putLogs(batch); err != nil {
+		shipper.spill(batch, err)
+	} else {
+		shipper.sendSpilled()
+	}
+	return queue[count:]
+}
+
+// putLogs sends a batch, retrying on transient errors up to the maximum number of attempts
+func (shipper *Shipper) putLogs(batch loggingingestion.LogEntryBatch) error {
+	for attempt := 1; ; attempt++ {
+		_, err := shipper.client.PutLogs(context.Background(), loggingingestion.PutLogsRequest{
+			LogId: shipper.config.LogId,
+			PutLogsDetails: loggingingestion.PutLogsDetails{
+				Specversion:     common.String(specVersion),
+				LogEntryBatches: []loggingingestion.LogEntryBatch{batch},
+			},
+			RequestMetadata: shipper.config.RequestMetadata,
+		})
+		if err == nil || !worker.IsTransientError(err) || attempt >= *shipper.config.MaxAttempts {
+			return err
+		}
+
+		common.Debugf("sending %d entries to log %s failed with error: %v, retrying\n", len(batch.Entries), *shipper.config.LogId, err)
+		time.Sleep(shipper.retryBackoff(attempt))
+	}
+}
+
+// retryBackoff returns the wait after the given failed attempt to send a batch, RetryBackoff doubled after each retry
+// until it reaches maxRetryBackoff
+func (shipper *Shipper) retryBackoff(attempt int) time.Duration {
+	backoff := *shipper.config.RetryBackoff
+	for i := 1; i < attempt && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff && backoff > *shipper.config.RetryBackoff {
+		return maxRetryBackoff
+	}
+	return backoff
+}
+
+// spill writes a batch that failed with a transient error to the spill directory, or drops it
+func (shipper *Shipper) spill(batch loggingingestion.LogEntryBatch, err error) {
+	if shipper.config.SpillDirectory == nil || !worker.IsTransientError(err) {
+		shipper.drop(batch.Entries, err)
+		return
+	}
+
+	content, marshalErr := json.Marshal(batch)
+	if marshalErr == nil {
+		name := fmt.Sprintf("%020d%s", time.Now().UnixNano(), spillFileSuffix)
+		marshalErr = writeFileAtomically(filepath.Join(*shipper.config.SpillDirectory, name), content)
+	}
+
+	if marshalErr != nil {
+		common.Debugf("spilling %d entries of log %s failed with error: %v\n", len(batch.Entries), *shipper.config.LogId, marshalErr)
+		shipper.drop(batch.Entries, err)
+		return
+	}
+	shipper.hasSpilled = true
+}
+
+// sendSpilled sends the spilled batches, oldest first, until one fails with a transient error
+func (shipper *Shipper) sendSpilled() {
+	if !shipper.hasSpilled {
+		return
+	}
+
+	files, err := ioutil.ReadDir(*shipper.config.SpillDirectory)
+	if err != nil {
+		common.Debugf("reading the spilled entries of log %s failed with error: %v\n", *shipper.config.LogId, err)
+		return
+	}
+
+	var names []string
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), spillFileSuffix) {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := filepath.Join(*shipper.config.SpillDirectory, name)
+		var batch loggingingestion.LogEntryBatch
+		content, err := ioutil.ReadFile(path)
+		if err == nil {
+			err = json.Unmarshal(content, &batch)
+		}
+		if err != nil {
+			common.Debugf("reading the spilled entries %s failed with error: %v\n", path, err)
+			continue
+		}
+
+		if err = shipper.putLogs(batch); err != nil && worker.IsTransientError(err) {
+			return
+		}
+
+		if err != nil {
+			shipper.drop(batch.Entries, err)
+		}
+		os.Remove(path)
+	}
+	shipper.hasSpilled = false
+}
+
+// drop reports the entries which could neither be sent nor spilled
+func (shipper *Shipper) drop(entries []loggingingestion.LogEntry, err error) {
+	common.Debugf("dropping %d entries of log %s after error: %v\n", len(entries), *shipper.config.LogId, err)
+	if shipper.config.OnDrop != nil {
+		shipper.config.OnDrop(entries, err)
+	}
+}
+
+// writeFileAtomically writes a temporary file renamed once complete, so that a crash never leaves a partial file
+func writeFileAtomically(path string, content []byte) error {
+	temp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err = temp.Write(content); err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+
+	if err = temp.Close(); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// newEntryID returns a random UUID identifying an entry
+func newEntryID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	// version 4, variant RFC 4122
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package shipper
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/loggingingestion"
+)
+
+// ShipperConfiguration defines the input parameters of NewShipper
+type ShipperConfiguration struct {
+	// The OCID of the custom log to ship the entries to.
+	LogId *string `mandatory:"true"`
+
+	// [Optional] The source of the entries, for example the name of the host. Defaults to the host name.
+	Source *string `mandatory:"false"`
+
+	// [Optional] The type of the entries, for example ServerA.requestLogs. Defaults to "application".
+	Type *string `mandatory:"false"`
+
+	// [Optional] The sub-resource or input file of the entries, for example /var/log/application.log.
+	Subject *string `mandatory:"false"`
+
+	// [Optional] The maximum number of entries sent in a single PutLogs request. Defaults to 1,000.
+	MaxBatchEntries *int `mandatory:"false"`
+
+	// [Optional] The maximum size in bytes of the entries of a PutLogs request. Defaults to 1 MiB, the max is 10 MiB.
+	MaxBatchBytes *int `mandatory:"false"`
+
+	// [Optional] How long an entry waits for more entries to fill its batch before the batch is sent.
+	// Defaults to 5 seconds.
+	FlushInterval *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum number of times a batch is sent before it is spilled or dropped. Defaults to 5.
+	MaxAttempts *int `mandatory:"false"`
+
+	// [Optional] The wait before the first retry of a batch, doubled after each retry up to 30 seconds.
+	// Defaults to 500 milliseconds.
+	RetryBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum number of entries buffered before Log and Write block. Defaults to 10,000.
+	MaxBufferedEntries *int `mandatory:"false"`
+
+	// [Optional] The directory the batches are written to when the service is unreachable after all the attempts.
+	// They are sent again once a request succeeds, and when a shipper is created. The batches are dropped if not set.
+	SpillDirectory *string `mandatory:"false"`
+
+	// [Optional] Called with the entries of a batch which could neither be sent nor spilled, and the error.
+	OnDrop func(entries []loggingingestion.LogEntry, err error) `mandatory:"false"`
+
+	// [Optional] The retry policy of each PutLogs request. A batch failing once the policy gave up is sent again by
+	// the shipper, up to MaxAttempts.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidLogID         = errors.New("logId is required")
+	errorInvalidBatchEntries  = errors.New("maxBatchEntries must be greater than 0")
+	errorInvalidBatchBytes    = errors.New("maxBatchBytes must be between 1 and 10 MiB")
+	errorInvalidMaxAttempts   = errors.New("maxAttempts must be greater than 0")
+	errorInvalidFlushInterval = errors.New("flushInterval must be greater than 0")
+)
+
+const (
+	// maxRequestBytes is the limit of the service for a request
+	maxRequestBytes = 10 * 1024 * 1024
+
+	defaultType               = "application"
+	defaultMaxBatchEntries    = 1000
+	defaultMaxBatchBytes      = 1024 * 1024
+	defaultFlushInterval      = 5 * time.Second
+	defaultMaxAttempts        = 5
+	defaultRetryBackoff       = 500 * time.Millisecond
+	maxRetryBackoff           = 30 * time.Second
+	defaultMaxBufferedEntries = 10000
+)
+
+func (config ShipperConfiguration) validate() error {
+	if config.LogId == nil {
+		return errorInvalidLogID
+	}
+
+	if config.MaxBatchEntries != nil && *config.MaxBatchEntries <= 0 {
+		return errorInvalidBatchEntries
+	}
+
+	if config.MaxBatchBytes != nil && (*config.MaxBatchBytes <= 0 || *config.MaxBatchBytes > maxRequestBytes) {
+		return errorInvalidBatchBytes
+	}
+
+	if config.MaxAttempts != nil && *config.MaxAttempts <= 0 {
+		return errorInvalidMaxAttempts
+	}
+
+	if config.FlushInterval != nil && *config.FlushInterval <= 0 {
+		return errorInvalidFlushInterval
+	}
+
+	return nil
+}
+
+func (config *ShipperConfiguration) initDefaultValues() {
+	if config.Source == nil {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "unknown"
+		}
+		config.Source = common.String(hostname)
+	}
+
+	if config.Type == nil {
+		config.Type = common.String(defaultType)
+	}
+
+	if config.MaxBatchEntries == nil {
+		config.MaxBatchEntries = common.Int(defaultMaxBatchEntries)
+	}
+
+	if config.MaxBatchBytes == nil {
+		config.MaxBatchBytes = common.Int(defaultMaxBatchBytes)
+	}
+
+	if config.FlushInterval == nil {
+		flushInterval := defaultFlushInterval
+		config.FlushInterval = &flushInterval
+	}
+
+	if config.MaxAttempts == nil {
+		config.MaxAttempts = common.Int(defaultMaxAttempts)
+	}
+
+	if config.RetryBackoff == nil || *config.RetryBackoff < 0 {
+		retryBackoff := defaultRetryBackoff
+		config.RetryBackoff = &retryBackoff
+	}
+
+	if config.MaxBufferedEntries == nil || *config.MaxBufferedEntries < 0 {
+		config.MaxBufferedEntries = common.Int(defaultMaxBufferedEntries)
+	}
+}
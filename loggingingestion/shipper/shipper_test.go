@@ -0,0 +1,189 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package shipper
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/loggingingestion"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// fakeLoggingServer records the requests, and fails them with 503 while unavailable
+type fakeLoggingServer struct {
+	mutex       sync.Mutex
+	unavailable bool
+	requests    []loggingingestion.PutLogsDetails
+}
+
+func (server *fakeLoggingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"code":"ServiceUnavailable","message":"unavailable"}`))
+		return
+	}
+
+	var details loggingingestion.PutLogsDetails
+	json.NewDecoder(r.Body).Decode(&details)
+	server.requests = append(server.requests, details)
+}
+
+// data returns the data of the entries of each request
+func (server *fakeLoggingServer) data() [][]string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var data [][]string
+	for _, request := range server.requests {
+		var entries []string
+		for _, entry := range request.LogEntryBatches[0].Entries {
+			entries = append(entries, *entry.Data)
+		}
+		data = append(data, entries)
+	}
+	return data
+}
+
+func TestShipper_BatchesEntries(t *testing.T) {
+	fake := &fakeLoggingServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client := loggingingestion.LoggingClient{BaseClient: server.BaseClient()}
+	retryBackoff := time.Millisecond
+	shipper, err := NewShipper(client, ShipperConfiguration{
+		LogId:           common.String("log1"),
+		RetryBackoff:    &retryBackoff,
+		Source:          common.String("host1"),
+		MaxBatchEntries: common.Int(2),
+	})
+	assert.NoError(t, err)
+
+	logger := log.New(shipper, "", 0)
+	logger.Print("first")
+	logger.Print("second")
+	logger.Print("third")
+	assert.NoError(t, shipper.Close(context.Background()))
+
+	assert.Equal(t, [][]string{{"first", "second"}, {"third"}}, fake.data())
+	request := fake.requests[0]
+	assert.Equal(t, "1.0", *request.Specversion)
+	assert.Equal(t, "host1", *request.LogEntryBatches[0].Source)
+	assert.Equal(t, "application", *request.LogEntryBatches[0].Type)
+	assert.Len(t, *request.LogEntryBatches[0].Entries[0].Id, 36)
+	assert.NotEqual(t, *request.LogEntryBatches[0].Entries[0].Id, *request.LogEntryBatches[0].Entries[1].Id)
+
+	assert.Equal(t, errorShipperClosed, shipper.Log(context.Background(), time.Now(), "closed"))
+}
+
+func TestShipper_FlushesOnInterval(t *testing.T) {
+	fake := &fakeLoggingServer{}
+	flushInterval := 10 * time.Millisecond
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client := loggingingestion.LoggingClient{BaseClient: server.BaseClient()}
+	retryBackoff := time.Millisecond
+	shipper, err := NewShipper(client, ShipperConfiguration{
+		LogId:         common.String("log1"),
+		RetryBackoff:  &retryBackoff,
+		FlushInterval: &flushInterval,
+	})
+	assert.NoError(t, err)
+	defer shipper.Close(context.Background())
+
+	timestamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.NoError(t, shipper.Log(context.Background(), timestamp, "entry"))
+	assert.Eventually(t, func() bool { return len(fake.data()) == 1 }, time.Second, time.Millisecond)
+	assert.True(t, timestamp.Equal(fake.requests[0].LogEntryBatches[0].Entries[0].Time.Time))
+}
+
+func TestShipper_SpillsWhileUnavailable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spill")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fake := &fakeLoggingServer{unavailable: true}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client := loggingingestion.LoggingClient{BaseClient: server.BaseClient()}
+	retryBackoff := time.Millisecond
+	shipper, err := NewShipper(client, ShipperConfiguration{
+		LogId:          common.String("log1"),
+		RetryBackoff:   &retryBackoff,
+		SpillDirectory: common.String(dir),
+		MaxAttempts:    common.Int(2),
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, shipper.Log(context.Background(), time.Now(), "first"))
+	assert.NoError(t, shipper.Flush(context.Background()))
+	assert.NoError(t, shipper.Log(context.Background(), time.Now(), "second"))
+	assert.NoError(t, shipper.Close(context.Background()))
+
+	files, _ := ioutil.ReadDir(dir)
+	assert.Len(t, files, 2)
+	assert.Empty(t, fake.data())
+
+	// a new shipper sends the spilled batches in order before its own entries
+	fake.unavailable = false
+	shipper, err = NewShipper(client, ShipperConfiguration{
+		LogId:          common.String("log1"),
+		RetryBackoff:   &retryBackoff,
+		SpillDirectory: common.String(dir),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, shipper.Log(context.Background(), time.Now(), "third"))
+	assert.NoError(t, shipper.Close(context.Background()))
+
+	assert.Equal(t, [][]string{{"first"}, {"second"}, {"third"}}, fake.data())
+	files, _ = ioutil.ReadDir(dir)
+	assert.Empty(t, files)
+}
+
+func TestShipper_DropsWithoutSpillDirectory(t *testing.T) {
+	fake := &fakeLoggingServer{unavailable: true}
+	var dropped []string
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client := loggingingestion.LoggingClient{BaseClient: server.BaseClient()}
+	retryBackoff := time.Millisecond
+	shipper, err := NewShipper(client, ShipperConfiguration{
+		LogId:        common.String("log1"),
+		RetryBackoff: &retryBackoff,
+		MaxAttempts:  common.Int(1),
+		OnDrop: func(entries []loggingingestion.LogEntry, err error) {
+			for _, entry := range entries {
+				dropped = append(dropped, *entry.Data)
+			}
+		},
+	})
+	assert.NoError(t, err)
+
+	shipper.Write([]byte("first\nsecond\n"))
+	assert.NoError(t, shipper.Close(context.Background()))
+	assert.Equal(t, []string{"first", "second"}, dropped)
+}
+
+func TestShipper_RetryBackoff(t *testing.T) {
+	config := ShipperConfiguration{LogId: common.String("log1"), MaxAttempts: common.Int(100)}
+	config.initDefaultValues()
+	shipper := &Shipper{config: config}
+	assert.Equal(t, defaultRetryBackoff, shipper.retryBackoff(1))
+	assert.Equal(t, 4*defaultRetryBackoff, shipper.retryBackoff(3))
+	assert.Equal(t, maxRetryBackoff, shipper.retryBackoff(64))
+	assert.Equal(t, maxRetryBackoff, shipper.retryBackoff(100))
+}
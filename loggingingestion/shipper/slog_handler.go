@@ -0,0 +1,82 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+//go:build go1.21
+// +build go1.21
+
+package shipper
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// Handler is a slog.Handler shipping every record as an entry holding the record formatted as JSON, as done by
+// slog.JSONHandler. The entries are stamped with the time of the records.
+type Handler struct {
+	shipper *Shipper
+	opts    slog.HandlerOptions
+
+	// the attributes and groups added to the handler, applied in order to the JSON handler of each record
+	with []func(slog.Handler) slog.Handler
+}
+
+// NewHandler returns a handler logging to the shipper, opts may be nil for the default options
+func NewHandler(shipper *Shipper, opts *slog.HandlerOptions) *Handler {
+	handler := &Handler{shipper: shipper}
+	if opts != nil {
+		handler.opts = *opts
+	}
+	return handler
+}
+
+// Enabled reports whether the level is at least the minimum level of the options
+func (handler *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	minLevel := slog.LevelInfo
+	if handler.opts.Level != nil {
+		minLevel = handler.opts.Level.Level()
+	}
+	return level >= minLevel
+}
+
+// Handle formats the record and buffers it in the shipper
+func (handler *Handler) Handle(ctx context.Context, record slog.Record) error {
+	var buffer bytes.Buffer
+	var jsonHandler slog.Handler = slog.NewJSONHandler(&buffer, &handler.opts)
+	for _, with := range handler.with {
+		jsonHandler = with(jsonHandler)
+	}
+
+	if err := jsonHandler.Handle(ctx, record); err != nil {
+		return err
+	}
+	return handler.shipper.Log(ctx, record.Time, strings.TrimSuffix(buffer.String(), "\n"))
+}
+
+// WithAttrs returns a handler adding the attributes to every record
+func (handler *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return handler
+	}
+	return handler.withHandler(func(jsonHandler slog.Handler) slog.Handler {
+		return jsonHandler.WithAttrs(attrs)
+	})
+}
+
+// WithGroup returns a handler qualifying the following attributes with the group name
+func (handler *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return handler
+	}
+	return handler.withHandler(func(jsonHandler slog.Handler) slog.Handler {
+		return jsonHandler.WithGroup(name)
+	})
+}
+
+func (handler *Handler) withHandler(with func(slog.Handler) slog.Handler) *Handler {
+	withs := make([]func(slog.Handler) slog.Handler, len(handler.with), len(handler.with)+1)
+	copy(withs, handler.with)
+	return &Handler{shipper: handler.shipper, opts: handler.opts, with: append(withs, with)}
+}
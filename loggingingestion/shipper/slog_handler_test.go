@@ -0,0 +1,47 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+//go:build go1.21
+// +build go1.21
+
+package shipper
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/loggingingestion"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+func TestHandler(t *testing.T) {
+	fake := &fakeLoggingServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	shipper, err := NewShipper(loggingingestion.LoggingClient{BaseClient: server.BaseClient()}, ShipperConfiguration{LogId: common.String("log1")})
+	assert.NoError(t, err)
+
+	logger := slog.New(NewHandler(shipper, nil)).With("service", "orders").WithGroup("request")
+	logger.Debug("ignored")
+	logger.Info("handled", "status", 200)
+	assert.NoError(t, shipper.Close(context.Background()))
+
+	data := fake.data()
+	assert.Len(t, data, 1)
+	assert.Len(t, data[0], 1)
+
+	var record map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(data[0][0]), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "handled", record["msg"])
+	assert.Equal(t, "orders", record["service"])
+	assert.Equal(t, map[string]interface{}{"status": float64(200)}, record["request"])
+
+	entry := fake.requests[0].LogEntryBatches[0].Entries[0]
+	assert.Equal(t, record["time"], entry.Time.Time.Format("2006-01-02T15:04:05.999999999Z07:00"))
+}
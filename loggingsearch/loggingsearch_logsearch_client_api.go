@@ -0,0 +1,18 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package loggingsearch
+
+import (
+	"context"
+)
+
+// LogSearchClientAPI is the interface of the operations of LogSearchClient, to inject the client and mock it in tests
+type LogSearchClientAPI interface {
+	// SearchLogs Submit a query to search logs.
+	SearchLogs(ctx context.Context, request SearchLogsRequest) (response SearchLogsResponse, err error)
+}
+
+// LogSearchClient implements LogSearchClientAPI
+var _ LogSearchClientAPI = LogSearchClient{}
@@ -0,0 +1,128 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"github.com/oracle/oci-go-sdk/v27/loggingsearch"
+)
+
+var errorNoResult = errors.New("there is no current result, Next must return true first")
+
+// Iterator reads the results of a search, requesting the next pages as needed. It is used like a bufio.Scanner:
+//
+//	iterator := search.NewIterator(client, request)
+//	for iterator.Next(ctx) {
+//		var entry LogEntry
+//		if err := iterator.Decode(&entry); err != nil {
+//			...
+//		}
+//	}
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	client  loggingsearch.LogSearchClient
+	request loggingsearch.SearchLogsRequest
+
+	results []loggingsearch.SearchResult
+	current *loggingsearch.SearchResult
+	fields  []loggingsearch.FieldInfo
+	started bool
+	err     error
+}
+
+// NewIterator returns an iterator over the results of the request, starting at its page
+func NewIterator(client loggingsearch.LogSearchClient, request loggingsearch.SearchLogsRequest) *Iterator {
+	return &Iterator{client: client, request: request}
+}
+
+// Next advances to the next result, it returns false once all the results are read or a request fails
+func (iterator *Iterator) Next(ctx context.Context) bool {
+	iterator.current = nil
+	for len(iterator.results) == 0 {
+		if iterator.err != nil || (iterator.started && iterator.request.Page == nil) {
+			return false
+		}
+
+		resp, err := iterator.client.SearchLogs(ctx, iterator.request)
+		if err != nil {
+			iterator.err = err
+			return false
+		}
+
+		iterator.started = true
+		iterator.results = resp.Results
+		iterator.request.Page = resp.OpcNextPage
+		if len(resp.Fields) > 0 {
+			iterator.fields = resp.Fields
+		}
+	}
+
+	iterator.current = &iterator.results[0]
+	iterator.results = iterator.results[1:]
+	return true
+}
+
+// Result returns the current result
+func (iterator *Iterator) Result() loggingsearch.SearchResult {
+	if iterator.current == nil {
+		return loggingsearch.SearchResult{}
+	}
+	return *iterator.current
+}
+
+// Decode decodes the data of the current result into v, as encoding/json does
+func (iterator *Iterator) Decode(v interface{}) error {
+	if iterator.current == nil {
+		return errorNoResult
+	}
+	return decode(*iterator.current, v)
+}
+
+// Fields returns the field schema information of the log, if the request sets IsReturnFieldInfo
+func (iterator *Iterator) Fields() []loggingsearch.FieldInfo {
+	return iterator.fields
+}
+
+// Err returns the error which stopped the iteration, if any
+func (iterator *Iterator) Err() error {
+	return iterator.err
+}
+
+// DecodeAll reads all the remaining results and appends them to the slice v points to, decoding them into its
+// element type
+func (iterator *Iterator) DecodeAll(ctx context.Context, v interface{}) error {
+	slice := reflect.ValueOf(v)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return errors.New("DecodeAll requires a pointer to a slice")
+	}
+	slice = slice.Elem()
+
+	for iterator.Next(ctx) {
+		element := reflect.New(slice.Type().Elem())
+		if err := iterator.Decode(element.Interface()); err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, element.Elem()))
+	}
+	return iterator.Err()
+}
+
+// decode re-encodes the JSON blob of the result to decode it into v
+func decode(result loggingsearch.SearchResult, v interface{}) error {
+	if result.Data == nil {
+		return json.Unmarshal([]byte("null"), v)
+	}
+
+	content, err := json.Marshal(*result.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package search builds queries of the Logging query language and iterates over the results of SearchLogs. A query
+// like
+//
+//	search "<compartment OCID>/<log group OCID>/<log OCID>" | where data.statusCode >= 500 | sort by datetime desc
+//
+// is built with
+//
+//	search.Logs(search.Source(compartmentID, logGroupID, logID)).
+//		Where(search.Field("data.statusCode").GreaterOrEqual(500)).
+//		SortBy("datetime", search.Descending)
+//
+// and its results are read page after page by an Iterator, which decodes them into structs.
+package search
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/loggingsearch"
+)
+
+var identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Source returns the source of the logs of a compartment, of a log group of the compartment, or of a log of the log
+// group, depending on the number of OCIDs given
+func Source(compartmentID string, logGroupAndLogIDs ...string) string {
+	return strings.Join(append([]string{compartmentID}, logGroupAndLogIDs...), "/")
+}
+
+// SortOrder is the order of a sort by clause
+type SortOrder string
+
+// The sort orders
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// Query is a query of the Logging query language, made of a search of the sources followed by a pipeline of
+// commands. The methods append the commands in the order they are called.
+type Query struct {
+	sources  []string
+	commands []string
+}
+
+// Logs returns a query searching the logs of the sources, see Source
+func Logs(sources ...string) *Query {
+	return &Query{sources: sources}
+}
+
+// Where keeps the entries matching the condition
+func (query *Query) Where(condition Condition) *Query {
+	return query.Pipe("where " + condition.String())
+}
+
+// SortBy sorts the entries by the field
+func (query *Query) SortBy(field string, order SortOrder) *Query {
+	return query.Pipe("sort by " + formatField(field) + " " + string(order))
+}
+
+// Summarize aggregates the entries, grouped by the values of the fields if any
+func (query *Query) Summarize(aggregations []Aggregation, byFields ...string) *Query {
+	parts := make([]string, len(aggregations))
+	for i, aggregation := range aggregations {
+		parts[i] = aggregation.String()
+	}
+
+	command := "summarize " + strings.Join(parts, ", ")
+	if len(byFields) > 0 {
+		fields := make([]string, len(byFields))
+		for i, field := range byFields {
+			fields[i] = formatField(field)
+		}
+		command += " by " + strings.Join(fields, ", ")
+	}
+	return query.Pipe(command)
+}
+
+// Pipe appends a command written in the query language, for the commands not supported by the builder
+func (query *Query) Pipe(command string) *Query {
+	query.commands = append(query.commands, command)
+	return query
+}
+
+func (query *Query) String() string {
+	sources := make([]string, len(query.sources))
+	for i, source := range query.sources {
+		sources[i] = strconv.Quote(source)
+	}
+
+	parts := append([]string{"search " + strings.Join(sources, ", ")}, query.commands...)
+	return strings.Join(parts, " | ")
+}
+
+// Details returns the details of a SearchLogs request of the query over the time window
+func (query *Query) Details(timeStart, timeEnd time.Time) loggingsearch.SearchLogsDetails {
+	return loggingsearch.SearchLogsDetails{
+		TimeStart:   &common.SDKTime{Time: timeStart},
+		TimeEnd:     &common.SDKTime{Time: timeEnd},
+		SearchQuery: common.String(query.String()),
+	}
+}
+
+// Aggregation is a function of a summarize command, such as count() or avg(data.latency) as latency
+type Aggregation struct {
+	Function string
+	Field    string
+	Alias    string
+}
+
+func (aggregation Aggregation) String() string {
+	text := aggregation.Function + "("
+	if len(aggregation.Field) > 0 {
+		text += formatField(aggregation.Field)
+	}
+	text += ")"
+
+	if len(aggregation.Alias) > 0 {
+		text += " as " + formatField(aggregation.Alias)
+	}
+	return text
+}
+
+// As returns the aggregation named alias in the results
+func (aggregation Aggregation) As(alias string) Aggregation {
+	aggregation.Alias = alias
+	return aggregation
+}
+
+// Count returns the count() aggregation
+func Count() Aggregation {
+	return Aggregation{Function: "count"}
+}
+
+// Sum returns the sum aggregation of the field
+func Sum(field string) Aggregation {
+	return Aggregation{Function: "sum", Field: field}
+}
+
+// Avg returns the avg aggregation of the field
+func Avg(field string) Aggregation {
+	return Aggregation{Function: "avg", Field: field}
+}
+
+// Min returns the min aggregation of the field
+func Min(field string) Aggregation {
+	return Aggregation{Function: "min", Field: field}
+}
+
+// Max returns the max aggregation of the field
+func Max(field string) Aggregation {
+	return Aggregation{Function: "max", Field: field}
+}
+
+// Condition is a boolean expression of a where command
+type Condition interface {
+	String() string
+}
+
+type comparison struct {
+	field    string
+	operator string
+	value    interface{}
+}
+
+func (c comparison) String() string {
+	return formatField(c.field) + " " + c.operator + " " + formatValue(c.value)
+}
+
+type logical struct {
+	operator   string
+	conditions []Condition
+}
+
+func (l logical) String() string {
+	parts := make([]string, len(l.conditions))
+	for i, condition := range l.conditions {
+		parts[i] = condition.String()
+		if _, ok := condition.(logical); ok {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " "+l.operator+" ")
+}
+
+type negation struct {
+	condition Condition
+}
+
+func (n negation) String() string {
+	return "not (" + n.condition.String() + ")"
+}
+
+// And returns the condition matching the entries matching all the conditions
+func And(conditions ...Condition) Condition {
+	return logical{operator: "and", conditions: conditions}
+}
+
+// Or returns the condition matching the entries matching any of the conditions
+func Or(conditions ...Condition) Condition {
+	return logical{operator: "or", conditions: conditions}
+}
+
+// Not returns the condition matching the entries not matching the condition
+func Not(condition Condition) Condition {
+	return negation{condition: condition}
+}
+
+// FieldRef is a field of the log entries, such as type, source or data.statusCode
+type FieldRef string
+
+// Field returns the field of the name, whose dot separated parts are quoted if needed
+func Field(name string) FieldRef {
+	return FieldRef(name)
+}
+
+// Equal returns the condition field = value, the values are strings, numbers or booleans
+func (field FieldRef) Equal(value interface{}) Condition {
+	return comparison{field: string(field), operator: "=", value: value}
+}
+
+// NotEqual returns the condition field != value
+func (field FieldRef) NotEqual(value interface{}) Condition {
+	return comparison{field: string(field), operator: "!=", value: value}
+}
+
+// GreaterThan returns the condition field > value
+func (field FieldRef) GreaterThan(value interface{}) Condition {
+	return comparison{field: string(field), operator: ">", value: value}
+}
+
+// GreaterOrEqual returns the condition field >= value
+func (field FieldRef) GreaterOrEqual(value interface{}) Condition {
+	return comparison{field: string(field), operator: ">=", value: value}
+}
+
+// LessThan returns the condition field < value
+func (field FieldRef) LessThan(value interface{}) Condition {
+	return comparison{field: string(field), operator: "<", value: value}
+}
+
+// LessOrEqual returns the condition field <= value
+func (field FieldRef) LessOrEqual(value interface{}) Condition {
+	return comparison{field: string(field), operator: "<=", value: value}
+}
+
+// formatField quotes the parts of a field name which are not identifiers
+func formatField(field string) string {
+	parts := strings.Split(field, ".")
+	for i, part := range parts {
+		if !identifierPattern.MatchString(part) {
+			parts[i] = strconv.Quote(part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// formatValue returns a literal of the query language, the strings are single quoted
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+	case bool:
+		return strconv.FormatBool(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v)
+	case time.Time:
+		return "'" + v.UTC().Format(time.RFC3339Nano) + "'"
+	default:
+		return formatValue(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/loggingsearch"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+func TestQuery(t *testing.T) {
+	query := Logs(Source("compartment1", "group1", "log1"), Source("compartment2")).
+		Where(And(
+			Field("data.statusCode").GreaterOrEqual(500),
+			Or(Field("type").Equal("com.oraclecloud.loadbalancer.access"), Not(Field("data.request-id").Equal(`it's`))),
+		)).
+		SortBy("datetime", Descending).
+		Summarize([]Aggregation{Count().As("errors"), Avg("data.latency")}, "source")
+
+	assert.Equal(t, `search "compartment1/group1/log1", "compartment2"`+
+		` | where data.statusCode >= 500 and (type = 'com.oraclecloud.loadbalancer.access' or not (data."request-id" = 'it\'s'))`+
+		` | sort by datetime desc`+
+		` | summarize count() as errors, avg(data.latency) by source`, query.String())
+
+	timeStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	details := query.Details(timeStart, timeStart.Add(time.Hour))
+	assert.Equal(t, query.String(), *details.SearchQuery)
+	assert.Equal(t, timeStart.Add(time.Hour), details.TimeEnd.Time)
+
+	assert.Equal(t, "'2020-01-01T00:00:00Z'", formatValue(timeStart))
+	assert.Equal(t, "1.5", formatValue(1.5))
+	assert.Equal(t, "true", formatValue(true))
+}
+
+type accessLog struct {
+	Datetime int64 `json:"datetime"`
+	Data     struct {
+		StatusCode int `json:"statusCode"`
+	} `json:"logContent"`
+}
+
+// serveSearch returns 5 results in pages of 2, the page is the offset of its first result
+func serveSearch(w http.ResponseWriter, r *http.Request) {
+	var details loggingsearch.SearchLogsDetails
+	json.NewDecoder(r.Body).Decode(&details)
+	if *details.SearchQuery != `search "compartment1"` {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"InvalidParameter","message":"invalid query"}`))
+		return
+	}
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	results := []map[string]interface{}{}
+	for i := offset; i < 5 && i < offset+2; i++ {
+		results = append(results, map[string]interface{}{"data": map[string]interface{}{
+			"datetime":   i,
+			"logContent": map[string]interface{}{"statusCode": 200 + i},
+		}})
+	}
+
+	if offset+2 < 5 {
+		w.Header().Set("opc-next-page", strconv.Itoa(offset+2))
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"summary": map[string]interface{}{"resultCount": len(results)}, "results": results})
+}
+
+func TestIterator_FollowsPages(t *testing.T) {
+	server := ocitest.NewServer(http.HandlerFunc(serveSearch))
+	defer server.Close()
+	client := loggingsearch.LogSearchClient{BaseClient: server.BaseClient()}
+	timeStart, timeEnd := time.Now().Add(-time.Hour), time.Now()
+	iterator := NewIterator(client, loggingsearch.SearchLogsRequest{SearchLogsDetails: Logs("compartment1").Details(timeStart, timeEnd)})
+
+	assert.Equal(t, errorNoResult, iterator.Decode(&accessLog{}))
+
+	var statusCodes []int
+	for iterator.Next(context.Background()) {
+		var entry accessLog
+		assert.NoError(t, iterator.Decode(&entry))
+		statusCodes = append(statusCodes, entry.Data.StatusCode)
+	}
+	assert.NoError(t, iterator.Err())
+	assert.Equal(t, []int{200, 201, 202, 203, 204}, statusCodes)
+	assert.False(t, iterator.Next(context.Background()))
+}
+
+func TestIterator_DecodeAll(t *testing.T) {
+	server := ocitest.NewServer(http.HandlerFunc(serveSearch))
+	defer server.Close()
+	client := loggingsearch.LogSearchClient{BaseClient: server.BaseClient()}
+	timeStart, timeEnd := time.Now().Add(-time.Hour), time.Now()
+	iterator := NewIterator(client, loggingsearch.SearchLogsRequest{SearchLogsDetails: Logs("compartment1").Details(timeStart, timeEnd)})
+
+	var entries []accessLog
+	assert.NoError(t, iterator.DecodeAll(context.Background(), &entries))
+	assert.Len(t, entries, 5)
+	assert.Equal(t, int64(4), entries[4].Datetime)
+
+	iterator = NewIterator(client, loggingsearch.SearchLogsRequest{SearchLogsDetails: Logs("compartment2").Details(timeStart, timeEnd)})
+	err := iterator.DecodeAll(context.Background(), &entries)
+	serviceError, ok := common.IsServiceError(err)
+	if assert.True(t, ok, fmt.Sprint(err)) {
+		assert.Equal(t, http.StatusBadRequest, serviceError.GetHTTPStatusCode())
+	}
+}
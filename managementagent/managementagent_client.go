@@ -102,10 +102,12 @@ func (client ManagementAgentClient) CreateManagementAgentInstallKey(ctx context.
 				response = CreateManagementAgentInstallKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateManagementAgentInstallKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateManagementAgentInstallKeyResponse")
 	}
@@ -307,10 +309,12 @@ func (client ManagementAgentClient) DeployPlugins(ctx context.Context, request D
 				response = DeployPluginsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeployPluginsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeployPluginsResponse")
 	}
@@ -561,10 +565,12 @@ func (client ManagementAgentClient) ListManagementAgentImages(ctx context.Contex
 				response = ListManagementAgentImagesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ListManagementAgentImagesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ListManagementAgentImagesResponse")
 	}
@@ -916,10 +922,12 @@ func (client ManagementAgentClient) UpdateManagementAgent(ctx context.Context, r
 				response = UpdateManagementAgentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateManagementAgentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateManagementAgentResponse")
 	}
@@ -971,10 +979,12 @@ func (client ManagementAgentClient) UpdateManagementAgentInstallKey(ctx context.
 				response = UpdateManagementAgentInstallKeyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateManagementAgentInstallKeyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateManagementAgentInstallKeyResponse")
 	}
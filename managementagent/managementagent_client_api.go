@@ -0,0 +1,69 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package managementagent
+
+import (
+	"context"
+)
+
+// ManagementAgentClientAPI is the interface of the operations of ManagementAgentClient, to inject the client and mock it in tests
+type ManagementAgentClientAPI interface {
+	// CreateManagementAgentInstallKey User creates a new install key as part of this API.
+	CreateManagementAgentInstallKey(ctx context.Context, request CreateManagementAgentInstallKeyRequest) (response CreateManagementAgentInstallKeyResponse, err error)
+
+	// DeleteManagementAgent Deletes a Management Agent resource by identifier
+	DeleteManagementAgent(ctx context.Context, request DeleteManagementAgentRequest) (response DeleteManagementAgentResponse, err error)
+
+	// DeleteManagementAgentInstallKey Deletes a Management Agent install Key resource by identifier
+	DeleteManagementAgentInstallKey(ctx context.Context, request DeleteManagementAgentInstallKeyRequest) (response DeleteManagementAgentInstallKeyResponse, err error)
+
+	// DeleteWorkRequest Cancel the work request with the given ID.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// DeployPlugins Deploys Plugins to a given list of agentIds.
+	DeployPlugins(ctx context.Context, request DeployPluginsRequest) (response DeployPluginsResponse, err error)
+
+	// GetManagementAgent Gets complete details of the inventory of a given agent id
+	GetManagementAgent(ctx context.Context, request GetManagementAgentRequest) (response GetManagementAgentResponse, err error)
+
+	// GetManagementAgentInstallKey Gets complete details of the Agent install Key for a given key id
+	GetManagementAgentInstallKey(ctx context.Context, request GetManagementAgentInstallKeyRequest) (response GetManagementAgentInstallKeyResponse, err error)
+
+	// GetManagementAgentInstallKeyContent Returns a file with Management Agent install Key in it
+	GetManagementAgentInstallKeyContent(ctx context.Context, request GetManagementAgentInstallKeyContentRequest) (response GetManagementAgentInstallKeyContentResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListManagementAgentImages Get supported agent image information
+	ListManagementAgentImages(ctx context.Context, request ListManagementAgentImagesRequest) (response ListManagementAgentImagesResponse, err error)
+
+	// ListManagementAgentInstallKeys Returns a list of Management Agent installed Keys.
+	ListManagementAgentInstallKeys(ctx context.Context, request ListManagementAgentInstallKeysRequest) (response ListManagementAgentInstallKeysResponse, err error)
+
+	// ListManagementAgentPlugins Returns a list of managementAgentPlugins.
+	ListManagementAgentPlugins(ctx context.Context, request ListManagementAgentPluginsRequest) (response ListManagementAgentPluginsResponse, err error)
+
+	// ListManagementAgents Returns a list of Management Agent.
+	ListManagementAgents(ctx context.Context, request ListManagementAgentsRequest) (response ListManagementAgentsResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateManagementAgent API to update the console managed properties of the Management Agent.
+	UpdateManagementAgent(ctx context.Context, request UpdateManagementAgentRequest) (response UpdateManagementAgentResponse, err error)
+
+	// UpdateManagementAgentInstallKey API to update the modifiable properties of the Management Agent install key.
+	UpdateManagementAgentInstallKey(ctx context.Context, request UpdateManagementAgentInstallKeyRequest) (response UpdateManagementAgentInstallKeyResponse, err error)
+}
+
+// ManagementAgentClient implements ManagementAgentClientAPI
+var _ ManagementAgentClientAPI = ManagementAgentClient{}
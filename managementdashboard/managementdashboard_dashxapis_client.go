@@ -104,10 +104,12 @@ func (client DashxApisClient) ChangeManagementDashboardsCompartment(ctx context.
 				response = ChangeManagementDashboardsCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeManagementDashboardsCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeManagementDashboardsCompartmentResponse")
 	}
@@ -159,10 +161,12 @@ func (client DashxApisClient) ChangeManagementSavedSearchesCompartment(ctx conte
 				response = ChangeManagementSavedSearchesCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeManagementSavedSearchesCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeManagementSavedSearchesCompartmentResponse")
 	}
@@ -214,10 +218,12 @@ func (client DashxApisClient) CreateManagementDashboard(ctx context.Context, req
 				response = CreateManagementDashboardResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateManagementDashboardResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateManagementDashboardResponse")
 	}
@@ -269,10 +275,12 @@ func (client DashxApisClient) CreateManagementSavedSearch(ctx context.Context, r
 				response = CreateManagementSavedSearchResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateManagementSavedSearchResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateManagementSavedSearchResponse")
 	}
@@ -424,10 +432,12 @@ func (client DashxApisClient) ExportDashboard(ctx context.Context, request Expor
 				response = ExportDashboardResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ExportDashboardResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ExportDashboardResponse")
 	}
@@ -479,10 +489,12 @@ func (client DashxApisClient) GetManagementDashboard(ctx context.Context, reques
 				response = GetManagementDashboardResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GetManagementDashboardResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GetManagementDashboardResponse")
 	}
@@ -534,10 +546,12 @@ func (client DashxApisClient) GetManagementSavedSearch(ctx context.Context, requ
 				response = GetManagementSavedSearchResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(GetManagementSavedSearchResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into GetManagementSavedSearchResponse")
 	}
@@ -589,10 +603,12 @@ func (client DashxApisClient) ImportDashboard(ctx context.Context, request Impor
 				response = ImportDashboardResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ImportDashboardResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ImportDashboardResponse")
 	}
@@ -744,10 +760,12 @@ func (client DashxApisClient) UpdateManagementDashboard(ctx context.Context, req
 				response = UpdateManagementDashboardResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateManagementDashboardResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateManagementDashboardResponse")
 	}
@@ -799,10 +817,12 @@ func (client DashxApisClient) UpdateManagementSavedSearch(ctx context.Context, r
 				response = UpdateManagementSavedSearchResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateManagementSavedSearchResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateManagementSavedSearchResponse")
 	}
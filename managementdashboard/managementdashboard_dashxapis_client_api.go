@@ -0,0 +1,57 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package managementdashboard
+
+import (
+	"context"
+)
+
+// DashxApisClientAPI is the interface of the operations of DashxApisClient, to inject the client and mock it in tests
+type DashxApisClientAPI interface {
+	// ChangeManagementDashboardsCompartment Move the dashboard from existing compartment to a new compartment.
+	ChangeManagementDashboardsCompartment(ctx context.Context, request ChangeManagementDashboardsCompartmentRequest) (response ChangeManagementDashboardsCompartmentResponse, err error)
+
+	// ChangeManagementSavedSearchesCompartment Move the saved search from existing compartment to a new compartment.
+	ChangeManagementSavedSearchesCompartment(ctx context.Context, request ChangeManagementSavedSearchesCompartmentRequest) (response ChangeManagementSavedSearchesCompartmentResponse, err error)
+
+	// CreateManagementDashboard Creates a new dashboard.  Limit for number of saved searches in a dashboard is 20.
+	CreateManagementDashboard(ctx context.Context, request CreateManagementDashboardRequest) (response CreateManagementDashboardResponse, err error)
+
+	// CreateManagementSavedSearch Creates a new saved search.
+	CreateManagementSavedSearch(ctx context.Context, request CreateManagementSavedSearchRequest) (response CreateManagementSavedSearchResponse, err error)
+
+	// DeleteManagementDashboard Deletes a Dashboard by id.
+	DeleteManagementDashboard(ctx context.Context, request DeleteManagementDashboardRequest) (response DeleteManagementDashboardResponse, err error)
+
+	// DeleteManagementSavedSearch Deletes a saved search by Id
+	DeleteManagementSavedSearch(ctx context.Context, request DeleteManagementSavedSearchRequest) (response DeleteManagementSavedSearchResponse, err error)
+
+	// ExportDashboard Exports an array of dashboards and their saved searches.
+	ExportDashboard(ctx context.Context, request ExportDashboardRequest) (response ExportDashboardResponse, err error)
+
+	// GetManagementDashboard Get a Dashboard and its saved searches by id.  Deleted or unauthorized saved searches are marked by tile's state property.
+	GetManagementDashboard(ctx context.Context, request GetManagementDashboardRequest) (response GetManagementDashboardResponse, err error)
+
+	// GetManagementSavedSearch Get a saved search by Id.
+	GetManagementSavedSearch(ctx context.Context, request GetManagementSavedSearchRequest) (response GetManagementSavedSearchResponse, err error)
+
+	// ImportDashboard Import an array of dashboards and their saved searches.
+	ImportDashboard(ctx context.Context, request ImportDashboardRequest) (response ImportDashboardResponse, err error)
+
+	// ListManagementDashboards Gets list of dashboards and their saved searches for compartment with pagination.  Returned properties are a summary.
+	ListManagementDashboards(ctx context.Context, request ListManagementDashboardsRequest) (response ListManagementDashboardsResponse, err error)
+
+	// ListManagementSavedSearches Gets list of saved searches with pagination.  Returned properties are a summary.
+	ListManagementSavedSearches(ctx context.Context, request ListManagementSavedSearchesRequest) (response ListManagementSavedSearchesResponse, err error)
+
+	// UpdateManagementDashboard Updates an existing dashboard identified by id path parameter.  Limit for number of saved searches in a dashboard is 20.
+	UpdateManagementDashboard(ctx context.Context, request UpdateManagementDashboardRequest) (response UpdateManagementDashboardResponse, err error)
+
+	// UpdateManagementSavedSearch Update an existing saved search.  Id cannot be updated.
+	UpdateManagementSavedSearch(ctx context.Context, request UpdateManagementSavedSearchRequest) (response UpdateManagementSavedSearchResponse, err error)
+}
+
+// DashxApisClient implements DashxApisClientAPI
+var _ DashxApisClientAPI = DashxApisClient{}
@@ -103,10 +103,12 @@ func (client MarketplaceClient) CreateAcceptedAgreement(ctx context.Context, req
 				response = CreateAcceptedAgreementResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAcceptedAgreementResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAcceptedAgreementResponse")
 	}
@@ -905,10 +907,12 @@ func (client MarketplaceClient) UpdateAcceptedAgreement(ctx context.Context, req
 				response = UpdateAcceptedAgreementResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateAcceptedAgreementResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateAcceptedAgreementResponse")
 	}
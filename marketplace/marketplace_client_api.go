@@ -0,0 +1,111 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package marketplace
+
+import (
+	"context"
+)
+
+// MarketplaceClientAPI is the interface of the operations of MarketplaceClient, to inject the client and mock it in tests
+type MarketplaceClientAPI interface {
+	// CreateAcceptedAgreement Accepts a terms of use agreement for a specific package version of a listing. You must accept all
+	// terms of use for a package before you can deploy the package.
+	CreateAcceptedAgreement(ctx context.Context, request CreateAcceptedAgreementRequest) (response CreateAcceptedAgreementResponse, err error)
+
+	// DeleteAcceptedAgreement Removes a previously accepted terms of use agreement from the list of agreements that Marketplace checks
+	// before initiating a deployment. Listings in the Marketplace that require acceptance of the specified terms
+	// of use can no longer be deployed, but existing deployments aren't affected.
+	DeleteAcceptedAgreement(ctx context.Context, request DeleteAcceptedAgreementRequest) (response DeleteAcceptedAgreementResponse, err error)
+
+	// GetAcceptedAgreement Gets the details of a specific, previously accepted terms of use agreement.
+	GetAcceptedAgreement(ctx context.Context, request GetAcceptedAgreementRequest) (response GetAcceptedAgreementResponse, err error)
+
+	// GetAgreement Returns a terms of use agreement for a package with a time-based signature that can be used to
+	// accept the agreement.
+	GetAgreement(ctx context.Context, request GetAgreementRequest) (response GetAgreementResponse, err error)
+
+	// GetListing Gets detailed information about a listing, including the listing's name, version, description, and
+	// resources.
+	// If you plan to launch an instance from an image listing, you must first subscribe to the listing. When
+	// you launch the instance, you also need to provide the image ID of the listing resource version that you want.
+	// Subscribing to the listing requires you to first get a signature from the terms of use agreement for the
+	// listing resource version. To get the signature, issue a GetAppCatalogListingAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements/GetAppCatalogListingAgreements) API call.
+	// The AppCatalogListingResourceVersionAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements) object, including
+	// its signature, is returned in the response. With the signature for the terms of use agreement for the desired
+	// listing resource version, create a subscription by issuing a
+	// CreateAppCatalogSubscription (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogSubscription/CreateAppCatalogSubscription) API call.
+	// To get the image ID to launch an instance, issue a GetAppCatalogListingResourceVersion (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersion/GetAppCatalogListingResourceVersion) API call.
+	// Lastly, to launch the instance, use the image ID of the listing resource version to issue a LaunchInstance (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/Instance/LaunchInstance) API call.
+	GetListing(ctx context.Context, request GetListingRequest) (response GetListingResponse, err error)
+
+	// GetPackage Get the details of the specified version of a package, including information needed to launch the package.
+	// If you plan to launch an instance from an image listing, you must first subscribe to the listing. When
+	// you launch the instance, you also need to provide the image ID of the listing resource version that you want.
+	// Subscribing to the listing requires you to first get a signature from the terms of use agreement for the
+	// listing resource version. To get the signature, issue a GetAppCatalogListingAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements/GetAppCatalogListingAgreements) API call.
+	// The AppCatalogListingResourceVersionAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements) object, including
+	// its signature, is returned in the response. With the signature for the terms of use agreement for the desired
+	// listing resource version, create a subscription by issuing a
+	// CreateAppCatalogSubscription (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogSubscription/CreateAppCatalogSubscription) API call.
+	// To get the image ID to launch an instance, issue a GetAppCatalogListingResourceVersion (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersion/GetAppCatalogListingResourceVersion) API call.
+	// Lastly, to launch the instance, use the image ID of the listing resource version to issue a LaunchInstance (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/Instance/LaunchInstance) API call.
+	GetPackage(ctx context.Context, request GetPackageRequest) (response GetPackageResponse, err error)
+
+	// ListAcceptedAgreements Lists the terms of use agreements that have been accepted in the specified compartment.
+	// You can filter results by specifying query parameters.
+	ListAcceptedAgreements(ctx context.Context, request ListAcceptedAgreementsRequest) (response ListAcceptedAgreementsResponse, err error)
+
+	// ListAgreements Returns the terms of use agreements that must be accepted before you can deploy the specified version of a package.
+	ListAgreements(ctx context.Context, request ListAgreementsRequest) (response ListAgreementsResponse, err error)
+
+	// ListCategories Gets the list of all the categories for listings published to Oracle Cloud Infrastructure Marketplace. Categories apply
+	// to the software product provided by the listing.
+	ListCategories(ctx context.Context, request ListCategoriesRequest) (response ListCategoriesResponse, err error)
+
+	// ListListings Gets a list of listings from Oracle Cloud Infrastructure Marketplace by searching keywords and
+	// filtering according to listing attributes.
+	// If you plan to launch an instance from an image listing, you must first subscribe to the listing. When
+	// you launch the instance, you also need to provide the image ID of the listing resource version that you want.
+	// Subscribing to the listing requires you to first get a signature from the terms of use agreement for the
+	// listing resource version. To get the signature, issue a GetAppCatalogListingAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements/GetAppCatalogListingAgreements) API call.
+	// The AppCatalogListingResourceVersionAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements) object, including
+	// its signature, is returned in the response. With the signature for the terms of use agreement for the desired
+	// listing resource version, create a subscription by issuing a
+	// CreateAppCatalogSubscription (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogSubscription/CreateAppCatalogSubscription) API call.
+	// To get the image ID to launch an instance, issue a GetAppCatalogListingResourceVersion (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersion/GetAppCatalogListingResourceVersion) API call.
+	// Lastly, to launch the instance, use the image ID of the listing resource version to issue a LaunchInstance (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/Instance/LaunchInstance) API call.
+	ListListings(ctx context.Context, request ListListingsRequest) (response ListListingsResponse, err error)
+
+	// ListPackages Gets the list of packages for a listing.
+	// If you plan to launch an instance from an image listing, you must first subscribe to the listing. When
+	// you launch the instance, you also need to provide the image ID of the listing resource version that you want.
+	// Subscribing to the listing requires you to first get a signature from the terms of use agreement for the
+	// listing resource version. To get the signature, issue a GetAppCatalogListingAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements/GetAppCatalogListingAgreements) API call.
+	// The AppCatalogListingResourceVersionAgreements (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersionAgreements) object, including
+	// its signature, is returned in the response. With the signature for the terms of use agreement for the desired
+	// listing resource version, create a subscription by issuing a
+	// CreateAppCatalogSubscription (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogSubscription/CreateAppCatalogSubscription) API call.
+	// To get the image ID to launch an instance, issue a GetAppCatalogListingResourceVersion (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/AppCatalogListingResourceVersion/GetAppCatalogListingResourceVersion) API call.
+	// Lastly, to launch the instance, use the image ID of the listing resource version to issue a LaunchInstance (https://docs.cloud.oracle.com/en-us/iaas/api/#/en/iaas/latest/Instance/LaunchInstance) API call.
+	ListPackages(ctx context.Context, request ListPackagesRequest) (response ListPackagesResponse, err error)
+
+	// ListPublishers Gets the list of all the publishers of listings available in Oracle Cloud Infrastructure Marketplace.
+	ListPublishers(ctx context.Context, request ListPublishersRequest) (response ListPublishersResponse, err error)
+
+	// ListReportTypes Lists available types of reports for the compartment.
+	ListReportTypes(ctx context.Context, request ListReportTypesRequest) (response ListReportTypesResponse, err error)
+
+	// ListReports Lists reports in the compartment that match the specified report type and date.
+	ListReports(ctx context.Context, request ListReportsRequest) (response ListReportsResponse, err error)
+
+	// ListTaxes Returns list of all tax implications that current tenant may be liable to once they launch the listing.
+	ListTaxes(ctx context.Context, request ListTaxesRequest) (response ListTaxesResponse, err error)
+
+	// UpdateAcceptedAgreement Updates the display name or tags associated with a listing's previously accepted terms of use agreement.
+	UpdateAcceptedAgreement(ctx context.Context, request UpdateAcceptedAgreementRequest) (response UpdateAcceptedAgreementResponse, err error)
+}
+
+// MarketplaceClient implements MarketplaceClientAPI
+var _ MarketplaceClientAPI = MarketplaceClient{}
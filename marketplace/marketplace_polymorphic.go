@@ -0,0 +1,31 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package marketplace
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*ListingPackage)(nil), func() common.PolymorphicJSONUnmarshaler { return &listingpackage{} })
+}
+
+// MarshalJSON marshals a ListingPackage whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m listingpackage) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypelistingpackage listingpackage
+	s := struct {
+		MarshalTypelistingpackage
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypelistingpackage: (MarshalTypelistingpackage)(m),
+	}
+	return json.Marshal(&s)
+}
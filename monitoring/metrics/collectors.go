@@ -0,0 +1,93 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package metrics
+
+import (
+	"expvar"
+	"strings"
+)
+
+// ExpvarCollector exports the numeric variables published with the expvar package. The integers and floats are
+// exported under their name, the numeric entries of the maps under the name of the map with a "key" dimension
+// holding the key of the entry. The other variables are ignored.
+type ExpvarCollector struct {
+	prefix string
+}
+
+// NewExpvarCollector returns a collector of the expvar variables, their names are prefixed with prefix
+func NewExpvarCollector(prefix string) *ExpvarCollector {
+	return &ExpvarCollector{prefix: prefix}
+}
+
+// Collect reports the current value of the numeric variables
+func (collector *ExpvarCollector) Collect(report func(name string, dimensions Dimensions, value float64)) {
+	expvar.Do(func(variable expvar.KeyValue) {
+		name := collector.prefix + sanitizeName(variable.Key)
+		if value, ok := expvarValue(variable.Value); ok {
+			report(name, nil, value)
+			return
+		}
+
+		if entries, ok := variable.Value.(*expvar.Map); ok {
+			entries.Do(func(entry expvar.KeyValue) {
+				if value, ok := expvarValue(entry.Value); ok {
+					report(name, Dimensions{"key": entry.Key}, value)
+				}
+			})
+		}
+	})
+}
+
+// expvarValue returns the value of a numeric variable
+func expvarValue(variable expvar.Var) (float64, bool) {
+	switch v := variable.(type) {
+	case *expvar.Int:
+		return float64(v.Value()), true
+	case *expvar.Float:
+		return v.Value(), true
+	case expvar.Func:
+		return numberValue(v.Value())
+	}
+	return 0, false
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// sanitizeName replaces the characters not allowed in a metric name by underscores
+func sanitizeName(name string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', strings.ContainsRune("_.-$", r):
+			return r
+		case r == '/':
+			return '.'
+		}
+		return '_'
+	}, strings.TrimLeft(name, "/"))
+
+	if len(sanitized) == 0 || !(sanitized[0] >= 'a' && sanitized[0] <= 'z' || sanitized[0] >= 'A' && sanitized[0] <= 'Z') {
+		sanitized = "m" + sanitized
+	}
+	return sanitized
+}
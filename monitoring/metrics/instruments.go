@@ -0,0 +1,243 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package metrics
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/monitoring"
+)
+
+// The limits of the service on the names and dimensions of the metrics
+const (
+	maxDimensions        = 20
+	maxDimensionLength   = 256
+	reservedPrefix       = "oci_"
+	dimensionKeyExcluded = ". "
+)
+
+// maxHistogramDatapoints is the maximum number of datapoints of a histogram per flush, the values of a histogram
+// with more distinct values are merged into as many buckets
+const maxHistogramDatapoints = 50
+
+var (
+	namespacePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+	namePattern      = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.\-$]*$`)
+)
+
+// Dimensions are the key-value pairs qualifying a metric stream, for example "resourceId": "ocid1.instance..."
+type Dimensions map[string]string
+
+func (dimensions Dimensions) validate() error {
+	if len(dimensions) > maxDimensions {
+		return fmt.Errorf("a metric can not have more than %d dimensions", maxDimensions)
+	}
+
+	for key, value := range dimensions {
+		if len(key) == 0 || len(key) > maxDimensionLength || strings.ContainsAny(key, dimensionKeyExcluded) {
+			return fmt.Errorf("invalid dimension key %q", key)
+		}
+
+		for _, r := range key {
+			if r < '!' || r > '~' {
+				return fmt.Errorf("invalid dimension key %q", key)
+			}
+		}
+
+		if len(value) == 0 || utf8.RuneCountInString(value) > maxDimensionLength {
+			return fmt.Errorf("invalid value of dimension %q", key)
+		}
+	}
+	return nil
+}
+
+// merge returns the dimensions added to the defaults
+func (dimensions Dimensions) merge(defaults Dimensions) Dimensions {
+	merged := make(Dimensions, len(defaults)+len(dimensions))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range dimensions {
+		merged[key] = value
+	}
+	return merged
+}
+
+// key identifies the metric stream of a name and dimensions
+func (dimensions Dimensions) key(name string) string {
+	pairs := make([]string, 0, len(dimensions))
+	for key, value := range dimensions {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return name + "\x00" + strings.Join(pairs, "\x00")
+}
+
+func validateName(name string) error {
+	if !namePattern.MatchString(name) || hasReservedPrefix(name) {
+		return fmt.Errorf("invalid metric name %q", name)
+	}
+	return nil
+}
+
+func hasReservedPrefix(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), reservedPrefix)
+}
+
+type metricKind int
+
+const (
+	counterKind metricKind = iota
+	gaugeKind
+	histogramKind
+)
+
+func (kind metricKind) String() string {
+	return [...]string{"counter", "gauge", "histogram"}[kind]
+}
+
+// series is the aggregation of a metric stream since the last flush
+type series struct {
+	name       string
+	dimensions Dimensions
+	kind       metricKind
+
+	mutex  sync.Mutex
+	sum    float64
+	adds   int
+	gauge  *float64
+	values map[float64]int
+}
+
+// collect returns the datapoints aggregated since the last flush and resets the aggregation
+func (series *series) collect(timestamp *common.SDKTime) []monitoring.Datapoint {
+	series.mutex.Lock()
+	defer series.mutex.Unlock()
+
+	switch series.kind {
+	case counterKind:
+		if series.adds == 0 {
+			return nil
+		}
+		sum := series.sum
+		series.sum, series.adds = 0, 0
+		return []monitoring.Datapoint{{Timestamp: timestamp, Value: &sum}}
+	case gaugeKind:
+		if series.gauge == nil {
+			return nil
+		}
+		value := *series.gauge
+		return []monitoring.Datapoint{{Timestamp: timestamp, Value: &value}}
+	default:
+		values := make([]float64, 0, len(series.values))
+		for value := range series.values {
+			values = append(values, value)
+		}
+		sort.Float64s(values)
+
+		counts := make([]int, len(values))
+		for i, value := range values {
+			counts[i] = series.values[value]
+		}
+		series.values = make(map[float64]int)
+
+		if len(values) > maxHistogramDatapoints {
+			values, counts = bucket(values, counts, maxHistogramDatapoints)
+		}
+		datapoints := make([]monitoring.Datapoint, len(values))
+		for i := range values {
+			datapoints[i] = monitoring.Datapoint{Timestamp: timestamp, Value: &values[i], Count: common.Int(counts[i])}
+		}
+		return datapoints
+	}
+}
+
+// bucket merges sorted values and their counts into at most n buckets of equal width between the lowest and the
+// highest value. A bucket has the mean of its values as value and the sum of their counts as count, which keeps
+// the count, sum and mean of the distribution.
+func bucket(values []float64, counts []int, n int) ([]float64, []int) {
+	low, width := values[0], (values[len(values)-1]-values[0])/float64(n)
+	var bucketValues []float64
+	var bucketCounts []int
+	current := -1
+	for i, value := range values {
+		index := int((value - low) / width)
+		if index >= n {
+			index = n - 1
+		}
+
+		if index != current {
+			bucketValues = append(bucketValues, 0)
+			bucketCounts = append(bucketCounts, 0)
+			current = index
+		}
+		// the bucket value holds the sum of its values until the mean is computed
+		last := len(bucketCounts) - 1
+		bucketValues[last] += value * float64(counts[i])
+		bucketCounts[last] += counts[i]
+	}
+
+	for i := range bucketValues {
+		bucketValues[i] /= float64(bucketCounts[i])
+	}
+	return bucketValues, bucketCounts
+}
+
+// Counter is a metric summing the values added during each flush interval, published as a single datapoint per
+// interval. A counter without any value added during an interval is not published.
+type Counter struct {
+	series *series
+}
+
+// Add adds a value to the counter
+func (counter *Counter) Add(value float64) {
+	counter.series.mutex.Lock()
+	counter.series.sum += value
+	counter.series.adds++
+	counter.series.mutex.Unlock()
+}
+
+// Inc adds 1 to the counter
+func (counter *Counter) Inc() {
+	counter.Add(1)
+}
+
+// Gauge is a metric publishing its last value at every flush, once it is set
+type Gauge struct {
+	series *series
+}
+
+// Set sets the value of the gauge
+func (gauge *Gauge) Set(value float64) {
+	gauge.series.mutex.Lock()
+	gauge.series.gauge = &value
+	gauge.series.mutex.Unlock()
+}
+
+// Histogram is a metric publishing the distribution of the values recorded during each flush interval, as one
+// datapoint per distinct value with its number of occurrences, so that the percentiles are computed by the service.
+// The values should be rounded to the precision needed to keep the number of distinct values low: beyond 50
+// distinct values in an interval, the values are merged into 50 buckets, which blurs the percentiles.
+type Histogram struct {
+	series *series
+}
+
+// Record records a value
+func (histogram *Histogram) Record(value float64) {
+	histogram.series.mutex.Lock()
+	histogram.series.values[value]++
+	histogram.series.mutex.Unlock()
+}
+
+// RecordDuration records a duration in milliseconds, rounded to the millisecond
+func (histogram *Histogram) RecordDuration(duration time.Duration) {
+	histogram.Record(float64(duration.Round(time.Millisecond) / time.Millisecond))
+}
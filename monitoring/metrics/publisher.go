@@ -0,0 +1,291 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package metrics publishes custom metrics to the Monitoring service. The Publisher aggregates the values of its
+// counters, gauges and histograms in memory, and posts them with PostMetricData at every flush interval, in requests
+// respecting the limits of the service. Collectors export the metrics of other registries, such as expvar.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+	"github.com/oracle/oci-go-sdk/v27/monitoring"
+)
+
+var errorPublisherClosed = errors.New("the publisher is closed")
+
+// SetIngestionRegion sets the endpoint of the client to the telemetry-ingestion endpoint of the region, which is
+// the endpoint PostMetricData must be sent to.
+func SetIngestionRegion(client *monitoring.MonitoringClient, region string) {
+	client.Host = common.StringToRegion(region).EndpointForTemplate("telemetry-ingestion", "https://telemetry-ingestion.{region}.{secondLevelDomain}")
+}
+
+// Collector exports the metrics of another registry. Collect is called at every flush and reports the current
+// value of every metric, which is published as a gauge.
+type Collector interface {
+	Collect(report func(name string, dimensions Dimensions, value float64))
+}
+
+// Publisher aggregates metrics and publishes them periodically. Publisher is safe for concurrent use and must be
+// closed to publish the last values.
+type Publisher struct {
+	client monitoring.MonitoringClient
+	config PublisherConfiguration
+
+	mutex      sync.Mutex
+	series     map[string]*series
+	collectors []Collector
+	closed     bool
+
+	// flushMutex serializes the flushes and guards pending, the metric streams to post again
+	flushMutex sync.Mutex
+	pending    []monitoring.MetricDataDetails
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewPublisher returns a publisher of the metrics described by config, and starts publishing them in the
+// background. client must be created for the telemetry-ingestion endpoint, see SetIngestionRegion.
+func NewPublisher(client monitoring.MonitoringClient, config PublisherConfiguration) (*Publisher, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+
+	publisher := &Publisher{
+		client: client,
+		config: config,
+		series: make(map[string]*series),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go publisher.run()
+	return publisher, nil
+}
+
+// Counter returns the counter of the name and dimensions, created on first use
+func (publisher *Publisher) Counter(name string, dimensions Dimensions) (*Counter, error) {
+	series, err := publisher.getSeries(name, dimensions, counterKind)
+	if err != nil {
+		return nil, err
+	}
+	return &Counter{series: series}, nil
+}
+
+// Gauge returns the gauge of the name and dimensions, created on first use
+func (publisher *Publisher) Gauge(name string, dimensions Dimensions) (*Gauge, error) {
+	series, err := publisher.getSeries(name, dimensions, gaugeKind)
+	if err != nil {
+		return nil, err
+	}
+	return &Gauge{series: series}, nil
+}
+
+// Histogram returns the histogram of the name and dimensions, created on first use
+func (publisher *Publisher) Histogram(name string, dimensions Dimensions) (*Histogram, error) {
+	series, err := publisher.getSeries(name, dimensions, histogramKind)
+	if err != nil {
+		return nil, err
+	}
+	return &Histogram{series: series}, nil
+}
+
+// Register adds a collector whose metrics are published at every flush
+func (publisher *Publisher) Register(collector Collector) {
+	publisher.mutex.Lock()
+	publisher.collectors = append(publisher.collectors, collector)
+	publisher.mutex.Unlock()
+}
+
+// getSeries returns the series of the metric stream, it fails if the stream exists with another kind
+func (publisher *Publisher) getSeries(name string, dimensions Dimensions, kind metricKind) (*series, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	dimensions = dimensions.merge(publisher.config.Dimensions)
+	if err := dimensions.validate(); err != nil {
+		return nil, err
+	}
+
+	key := dimensions.key(name)
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	if publisher.closed {
+		return nil, errorPublisherClosed
+	}
+
+	if existing, ok := publisher.series[key]; ok {
+		if existing.kind != kind {
+			return nil, fmt.Errorf("metric %s is a %s, not a %s", name, existing.kind, kind)
+		}
+		return existing, nil
+	}
+
+	series := &series{name: name, dimensions: dimensions, kind: kind, values: make(map[float64]int)}
+	publisher.series[key] = series
+	return series, nil
+}
+
+// Flush publishes the metrics aggregated since the last flush, and the metrics left to post again by a previous
+// flush. It returns the first error of the requests, the metric streams of the requests failing with a transient
+// error are posted again at the next flush.
+func (publisher *Publisher) Flush(ctx context.Context) error {
+	publisher.flushMutex.Lock()
+	defer publisher.flushMutex.Unlock()
+
+	metrics := append(publisher.pending, publisher.collect()...)
+	publisher.pending = nil
+
+	var firstErr error
+	for start := 0; start < len(metrics); {
+		end := publisher.batchEnd(metrics, start)
+		if err := publisher.post(ctx, metrics[start:end]); err != nil {
+			if worker.IsTransientError(err) {
+				publisher.retain(metrics[start:end])
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		start = end
+	}
+	return firstErr
+}
+
+// batchEnd returns the end of the request starting at start, which holds at least one metric stream and stays
+// within the maximum number of metric streams and datapoints
+func (publisher *Publisher) batchEnd(metrics []monitoring.MetricDataDetails, start int) int {
+	end, datapoints := start+1, len(metrics[start].Datapoints)
+	for end < len(metrics) && end-start < *publisher.config.MaxMetricsPerRequest &&
+		datapoints+len(metrics[end].Datapoints) <= *publisher.config.MaxDatapointsPerRequest {
+		datapoints += len(metrics[end].Datapoints)
+		end++
+	}
+	return end
+}
+
+// Close stops the periodic flushes and publishes the last values. The publisher can not be used afterwards.
+func (publisher *Publisher) Close(ctx context.Context) error {
+	publisher.closeOnce.Do(func() {
+		close(publisher.stop)
+		publisher.mutex.Lock()
+		publisher.closed = true
+		publisher.mutex.Unlock()
+	})
+
+	select {
+	case <-publisher.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return publisher.Flush(ctx)
+}
+
+// run flushes the metrics at every interval until the publisher is closed
+func (publisher *Publisher) run() {
+	defer close(publisher.done)
+
+	ticker := time.NewTicker(*publisher.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := publisher.Flush(context.Background()); err != nil {
+				common.Debugf("publishing the metrics of namespace %s failed with error: %v\n", *publisher.config.Namespace, err)
+				if publisher.config.OnError != nil {
+					publisher.config.OnError(err)
+				}
+			}
+		case <-publisher.stop:
+			return
+		}
+	}
+}
+
+// collect returns the metric streams of the series and the collectors with datapoints since the last flush
+func (publisher *Publisher) collect() []monitoring.MetricDataDetails {
+	publisher.mutex.Lock()
+	allSeries := make([]*series, 0, len(publisher.series))
+	for _, series := range publisher.series {
+		allSeries = append(allSeries, series)
+	}
+	collectors := publisher.collectors
+	publisher.mutex.Unlock()
+
+	timestamp := &common.SDKTime{Time: time.Now()}
+	var metrics []monitoring.MetricDataDetails
+	for _, series := range allSeries {
+		if datapoints := series.collect(timestamp); len(datapoints) > 0 {
+			metrics = append(metrics, publisher.metricData(series.name, series.dimensions, datapoints))
+		}
+	}
+
+	for _, collector := range collectors {
+		collector.Collect(func(name string, dimensions Dimensions, value float64) {
+			dimensions = dimensions.merge(publisher.config.Dimensions)
+			if err := validateName(name); err != nil {
+				common.Debugf("skipping collected metric: %v\n", err)
+				return
+			}
+			if err := dimensions.validate(); err != nil {
+				common.Debugf("skipping collected metric %s: %v\n", name, err)
+				return
+			}
+
+			datapoints := []monitoring.Datapoint{{Timestamp: timestamp, Value: common.Float64(value)}}
+			metrics = append(metrics, publisher.metricData(name, dimensions, datapoints))
+		})
+	}
+	return metrics
+}
+
+func (publisher *Publisher) metricData(name string, dimensions Dimensions, datapoints []monitoring.Datapoint) monitoring.MetricDataDetails {
+	return monitoring.MetricDataDetails{
+		Namespace:     publisher.config.Namespace,
+		CompartmentId: publisher.config.CompartmentId,
+		ResourceGroup: publisher.config.ResourceGroup,
+		Name:          common.String(name),
+		Dimensions:    dimensions,
+		Datapoints:    datapoints,
+	}
+}
+
+// post sends a request and reports the metric streams rejected by the service
+func (publisher *Publisher) post(ctx context.Context, metrics []monitoring.MetricDataDetails) error {
+	resp, err := publisher.client.PostMetricData(ctx, monitoring.PostMetricDataRequest{
+		PostMetricDataDetails: monitoring.PostMetricDataDetails{
+			MetricData:     metrics,
+			BatchAtomicity: publisher.config.BatchAtomicity,
+		},
+		RequestMetadata: publisher.config.RequestMetadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.FailedMetrics) > 0 {
+		common.Debugf("%d metrics of namespace %s failed validation\n", len(resp.FailedMetrics), *publisher.config.Namespace)
+		if publisher.config.OnFailedMetrics != nil {
+			publisher.config.OnFailedMetrics(resp.FailedMetrics)
+		}
+	}
+	return nil
+}
+
+// retain keeps metric streams to post again, dropping the oldest ones beyond the maximum
+func (publisher *Publisher) retain(metrics []monitoring.MetricDataDetails) {
+	publisher.pending = append(publisher.pending, metrics...)
+	if dropped := len(publisher.pending) - *publisher.config.MaxPendingMetrics; dropped > 0 {
+		common.Debugf("dropping %d metrics of namespace %s\n", dropped, *publisher.config.Namespace)
+		publisher.pending = publisher.pending[dropped:]
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/monitoring"
+)
+
+// PublisherConfiguration defines the input parameters of NewPublisher
+type PublisherConfiguration struct {
+	// The namespace of the metrics, for example my_namespace. The oci_ prefix is reserved.
+	Namespace *string `mandatory:"true"`
+
+	// The OCID of the compartment of the metrics.
+	CompartmentId *string `mandatory:"true"`
+
+	// [Optional] The resource group assigned to the metrics.
+	ResourceGroup *string `mandatory:"false"`
+
+	// [Optional] The dimensions added to every metric, for example the host name. The dimensions of a metric
+	// override them.
+	Dimensions Dimensions `mandatory:"false"`
+
+	// [Optional] How often the aggregated metrics are published. Defaults to 1 minute, the resolution of the
+	// Monitoring service.
+	FlushInterval *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum number of metric streams posted in a single PostMetricData request. Defaults to 50, which
+	// is the limit of the service.
+	MaxMetricsPerRequest *int `mandatory:"false"`
+
+	// [Optional] The maximum number of datapoints posted in a single PostMetricData request, summed over its metric
+	// streams. Defaults to 1,000.
+	MaxDatapointsPerRequest *int `mandatory:"false"`
+
+	// [Optional] The maximum number of metric streams kept to be posted again after a transient error. The oldest
+	// ones are dropped first. Defaults to 1,000.
+	MaxPendingMetrics *int `mandatory:"false"`
+
+	// [Optional] Whether a request fails if any of its metric streams fails validation. Defaults to NON_ATOMIC.
+	BatchAtomicity monitoring.PostMetricDataDetailsBatchAtomicityEnum `mandatory:"false"`
+
+	// [Optional] Called with the metric streams the service rejected in a request.
+	OnFailedMetrics func(failedMetrics []monitoring.FailedMetricRecord) `mandatory:"false"`
+
+	// [Optional] Called with the errors of the requests sent in the background.
+	OnError func(err error) `mandatory:"false"`
+
+	// [Optional] The retry policy of the PostMetricData requests. The metric streams of a request failing with a
+	// transient error once the policy gave up are posted again with the next flush.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidNamespace         = errors.New("namespace must start with a letter, contain only letters, digits and underscores, and not start with oci_")
+	errorInvalidCompartmentID     = errors.New("compartmentId is required")
+	errorInvalidFlushInterval     = errors.New("flushInterval must be greater than 0")
+	errorInvalidMetricsPerRequest = errors.New("maxMetricsPerRequest must be between 1 and 50")
+	errorInvalidDatapoints        = errors.New("maxDatapointsPerRequest must be greater than 0")
+)
+
+const (
+	// maxMetricsPerRequest is the limit of the service for the unique metric streams of a request
+	maxMetricsPerRequest = 50
+
+	defaultFlushInterval           = time.Minute
+	defaultMaxDatapointsPerRequest = 1000
+	defaultMaxPendingMetrics       = 1000
+)
+
+func (config PublisherConfiguration) validate() error {
+	if config.Namespace == nil || !namespacePattern.MatchString(*config.Namespace) || hasReservedPrefix(*config.Namespace) {
+		return errorInvalidNamespace
+	}
+
+	if config.CompartmentId == nil {
+		return errorInvalidCompartmentID
+	}
+
+	if config.FlushInterval != nil && *config.FlushInterval <= 0 {
+		return errorInvalidFlushInterval
+	}
+
+	if config.MaxMetricsPerRequest != nil && (*config.MaxMetricsPerRequest <= 0 || *config.MaxMetricsPerRequest > maxMetricsPerRequest) {
+		return errorInvalidMetricsPerRequest
+	}
+
+	if config.MaxDatapointsPerRequest != nil && *config.MaxDatapointsPerRequest <= 0 {
+		return errorInvalidDatapoints
+	}
+
+	return config.Dimensions.validate()
+}
+
+func (config *PublisherConfiguration) initDefaultValues() {
+	if config.FlushInterval == nil {
+		flushInterval := defaultFlushInterval
+		config.FlushInterval = &flushInterval
+	}
+
+	if config.MaxMetricsPerRequest == nil {
+		config.MaxMetricsPerRequest = common.Int(maxMetricsPerRequest)
+	}
+
+	if config.MaxDatapointsPerRequest == nil {
+		config.MaxDatapointsPerRequest = common.Int(defaultMaxDatapointsPerRequest)
+	}
+
+	if config.MaxPendingMetrics == nil || *config.MaxPendingMetrics < 0 {
+		config.MaxPendingMetrics = common.Int(defaultMaxPendingMetrics)
+	}
+
+	if len(config.BatchAtomicity) == 0 {
+		config.BatchAtomicity = monitoring.PostMetricDataDetailsBatchAtomicityNonAtomic
+	}
+}
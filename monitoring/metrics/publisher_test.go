@@ -0,0 +1,244 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/monitoring"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// fakeMonitoringServer records the requests, rejects the metrics named "rejected" and fails the requests with 503
+// while unavailable
+type fakeMonitoringServer struct {
+	mutex       sync.Mutex
+	unavailable bool
+	requests    []monitoring.PostMetricDataDetails
+}
+
+func (server *fakeMonitoringServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"code":"ServiceUnavailable","message":"unavailable"}`))
+		return
+	}
+
+	var details monitoring.PostMetricDataDetails
+	json.NewDecoder(r.Body).Decode(&details)
+	server.requests = append(server.requests, details)
+
+	var failed []map[string]interface{}
+	for _, metric := range details.MetricData {
+		if *metric.Name == "rejected" {
+			failed = append(failed, map[string]interface{}{"message": "invalid", "metricData": metric})
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"failedMetricsCount": len(failed), "failedMetrics": failed})
+}
+
+// metrics returns the metrics posted by name
+func (server *fakeMonitoringServer) metrics() map[string][]monitoring.MetricDataDetails {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	metrics := make(map[string][]monitoring.MetricDataDetails)
+	for _, request := range server.requests {
+		for _, metric := range request.MetricData {
+			metrics[*metric.Name] = append(metrics[*metric.Name], metric)
+		}
+	}
+	return metrics
+}
+
+func TestPublisher_AggregatesMetrics(t *testing.T) {
+	fake := &fakeMonitoringServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	publisher, err := NewPublisher(monitoring.MonitoringClient{BaseClient: server.BaseClient()}, PublisherConfiguration{
+		Namespace:     common.String("my_namespace"),
+		CompartmentId: common.String("compartment1"),
+		Dimensions:    Dimensions{"host": "host1"},
+	})
+	assert.NoError(t, err)
+
+	requests, _ := publisher.Counter("requests", Dimensions{"method": "GET"})
+	requests.Inc()
+	requests.Add(2)
+	sameRequests, _ := publisher.Counter("requests", Dimensions{"method": "GET"})
+	sameRequests.Inc()
+
+	connections, _ := publisher.Gauge("connections", nil)
+	connections.Set(5)
+	connections.Set(3)
+
+	latency, _ := publisher.Histogram("latency", nil)
+	latency.Record(10)
+	latency.Record(20)
+	latency.RecordDuration(10 * time.Millisecond)
+
+	_, err = publisher.Gauge("requests", Dimensions{"method": "GET"})
+	assert.EqualError(t, err, "metric requests is a counter, not a gauge")
+
+	assert.NoError(t, publisher.Flush(context.Background()))
+	metrics := fake.metrics()
+	assert.Equal(t, Dimensions{"host": "host1", "method": "GET"}, Dimensions(metrics["requests"][0].Dimensions))
+	assert.Equal(t, 4.0, *metrics["requests"][0].Datapoints[0].Value)
+	assert.Equal(t, 3.0, *metrics["connections"][0].Datapoints[0].Value)
+	assert.Equal(t, "my_namespace", *metrics["latency"][0].Namespace)
+
+	datapoints := metrics["latency"][0].Datapoints
+	assert.Len(t, datapoints, 2)
+	assert.Equal(t, 10.0, *datapoints[0].Value)
+	assert.Equal(t, 2, *datapoints[0].Count)
+	assert.Equal(t, 20.0, *datapoints[1].Value)
+	assert.Equal(t, 1, *datapoints[1].Count)
+
+	// the counters and histograms without new values are not published again, the gauges are
+	assert.NoError(t, publisher.Close(context.Background()))
+	metrics = fake.metrics()
+	assert.Len(t, metrics["requests"], 1)
+	assert.Len(t, metrics["latency"], 1)
+	assert.Len(t, metrics["connections"], 2)
+
+	_, err = publisher.Counter("requests", nil)
+	assert.Equal(t, errorPublisherClosed, err)
+}
+
+func TestPublisher_BatchesAndRetries(t *testing.T) {
+	fake := &fakeMonitoringServer{unavailable: true}
+	var failedMetrics []monitoring.FailedMetricRecord
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	publisher, err := NewPublisher(monitoring.MonitoringClient{BaseClient: server.BaseClient()}, PublisherConfiguration{
+		Namespace:            common.String("my_namespace"),
+		CompartmentId:        common.String("compartment1"),
+		MaxMetricsPerRequest: common.Int(2),
+		OnFailedMetrics: func(failed []monitoring.FailedMetricRecord) {
+			failedMetrics = append(failedMetrics, failed...)
+		},
+	})
+	assert.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		counter, _ := publisher.Counter(fmt.Sprintf("counter%d", i), nil)
+		counter.Inc()
+	}
+	rejected, _ := publisher.Counter("rejected", nil)
+	rejected.Inc()
+
+	// the metrics of the failed requests are posted again at the next flush
+	assert.Error(t, publisher.Flush(context.Background()))
+	fake.mutex.Lock()
+	fake.unavailable = false
+	fake.mutex.Unlock()
+	assert.NoError(t, publisher.Flush(context.Background()))
+
+	assert.Len(t, fake.requests, 3)
+	for _, request := range fake.requests {
+		assert.True(t, len(request.MetricData) <= 2)
+	}
+	assert.Len(t, fake.metrics(), 5)
+	assert.Len(t, failedMetrics, 1)
+	assert.Equal(t, "rejected", *failedMetrics[0].MetricData.Name)
+	assert.NoError(t, publisher.Close(context.Background()))
+}
+
+func TestPublisher_CapsHistogramDatapoints(t *testing.T) {
+	fake := &fakeMonitoringServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	publisher, err := NewPublisher(monitoring.MonitoringClient{BaseClient: server.BaseClient()}, PublisherConfiguration{
+		Namespace:               common.String("my_namespace"),
+		CompartmentId:           common.String("compartment1"),
+		MaxDatapointsPerRequest: common.Int(60),
+	})
+	assert.NoError(t, err)
+
+	// 1000 distinct values are merged into 50 buckets of 20 values
+	latency, _ := publisher.Histogram("latency", nil)
+	for i := 0; i < 1000; i++ {
+		latency.Record(float64(i))
+	}
+	other, _ := publisher.Histogram("other", nil)
+	for i := 0; i < 20; i++ {
+		other.Record(float64(i))
+	}
+	assert.NoError(t, publisher.Flush(context.Background()))
+
+	datapoints := fake.metrics()["latency"][0].Datapoints
+	assert.Len(t, datapoints, maxHistogramDatapoints)
+	count, sum := 0, 0.0
+	for _, datapoint := range datapoints {
+		count += *datapoint.Count
+		sum += *datapoint.Value * float64(*datapoint.Count)
+	}
+	assert.Equal(t, 1000, count)
+	assert.InDelta(t, 499500.0, sum, 0.001)
+	assert.Equal(t, 9.5, *datapoints[0].Value)
+	assert.Equal(t, 20, *datapoints[0].Count)
+
+	// the two histograms do not fit in a single request
+	assert.Len(t, fake.requests, 2)
+	assert.NoError(t, publisher.Close(context.Background()))
+}
+
+func TestPublisher_ValidatesMetrics(t *testing.T) {
+	fake := &fakeMonitoringServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	publisher, err := NewPublisher(monitoring.MonitoringClient{BaseClient: server.BaseClient()}, PublisherConfiguration{
+		Namespace:     common.String("my_namespace"),
+		CompartmentId: common.String("compartment1"),
+	})
+	assert.NoError(t, err)
+	defer publisher.Close(context.Background())
+
+	_, err = publisher.Counter("oci_requests", nil)
+	assert.Error(t, err)
+	_, err = publisher.Counter("requests count", nil)
+	assert.Error(t, err)
+	_, err = publisher.Counter("requests", Dimensions{"resource.id": "1"})
+	assert.Error(t, err)
+	_, err = publisher.Counter("requests", Dimensions{"resourceId": ""})
+	assert.Error(t, err)
+
+	tooMany := Dimensions{}
+	for i := 0; i <= maxDimensions; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+	_, err = publisher.Counter("requests", tooMany)
+	assert.Error(t, err)
+
+	_, err = NewPublisher(monitoring.MonitoringClient{}, PublisherConfiguration{Namespace: common.String("oci_namespace"), CompartmentId: common.String("compartment1")})
+	assert.Equal(t, errorInvalidNamespace, err)
+}
+
+func TestExpvarCollector(t *testing.T) {
+	expvar.NewInt("test_int").Set(7)
+	expvar.NewMap("test_map").Add("first", 2)
+
+	collected := make(map[string]float64)
+	NewExpvarCollector("app.").Collect(func(name string, dimensions Dimensions, value float64) {
+		collected[name+fmt.Sprint(map[string]string(dimensions))] = value
+	})
+	assert.Equal(t, 7.0, collected["app.test_int"+fmt.Sprint(map[string]string(nil))])
+	assert.Equal(t, 2.0, collected["app.test_map"+fmt.Sprint(map[string]string{"key": "first"})])
+
+	assert.Equal(t, "gc.cycles.total_gc-cycles", sanitizeName("/gc/cycles/total:gc-cycles"))
+	assert.Equal(t, "m1st", sanitizeName("1st"))
+}
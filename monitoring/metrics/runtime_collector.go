@@ -0,0 +1,53 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+//go:build go1.17
+// +build go1.17
+
+package metrics
+
+import (
+	"runtime/metrics"
+)
+
+// RuntimeCollector exports the scalar metrics of the runtime/metrics package, such as /gc/cycles/total:gc-cycles
+// exported as gc.cycles.total_gc-cycles. The histograms are ignored.
+type RuntimeCollector struct {
+	prefix  string
+	samples []metrics.Sample
+}
+
+// NewRuntimeCollector returns a collector of the runtime metrics of the given names, or of all the scalar runtime
+// metrics if none is given. Their names are prefixed with prefix.
+func NewRuntimeCollector(prefix string, names ...string) *RuntimeCollector {
+	if len(names) == 0 {
+		for _, description := range metrics.All() {
+			if description.Kind == metrics.KindUint64 || description.Kind == metrics.KindFloat64 {
+				names = append(names, description.Name)
+			}
+		}
+	}
+
+	samples := make([]metrics.Sample, len(names))
+	for i, name := range names {
+		samples[i].Name = name
+	}
+	return &RuntimeCollector{prefix: prefix, samples: samples}
+}
+
+// Collect reports the current value of the runtime metrics
+func (collector *RuntimeCollector) Collect(report func(name string, dimensions Dimensions, value float64)) {
+	samples := make([]metrics.Sample, len(collector.samples))
+	copy(samples, collector.samples)
+	metrics.Read(samples)
+
+	for _, sample := range samples {
+		name := collector.prefix + sanitizeName(sample.Name)
+		switch sample.Value.Kind() {
+		case metrics.KindUint64:
+			report(name, nil, float64(sample.Value.Uint64()))
+		case metrics.KindFloat64:
+			report(name, nil, sample.Value.Float64())
+		}
+	}
+}
@@ -105,10 +105,12 @@ func (client MonitoringClient) ChangeAlarmCompartment(ctx context.Context, reque
 				response = ChangeAlarmCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAlarmCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAlarmCompartmentResponse")
 	}
@@ -164,10 +166,12 @@ func (client MonitoringClient) CreateAlarm(ctx context.Context, request CreateAl
 				response = CreateAlarmResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAlarmResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAlarmResponse")
 	}
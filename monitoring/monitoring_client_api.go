@@ -0,0 +1,102 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package monitoring
+
+import (
+	"context"
+)
+
+// MonitoringClientAPI is the interface of the operations of MonitoringClient, to inject the client and mock it in tests
+type MonitoringClientAPI interface {
+	// ChangeAlarmCompartment Moves an alarm into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see Moving Resources Between Compartments (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeAlarmCompartment(ctx context.Context, request ChangeAlarmCompartmentRequest) (response ChangeAlarmCompartmentResponse, err error)
+
+	// CreateAlarm Creates a new alarm in the specified compartment.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	CreateAlarm(ctx context.Context, request CreateAlarmRequest) (response CreateAlarmResponse, err error)
+
+	// DeleteAlarm Deletes the specified alarm.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	DeleteAlarm(ctx context.Context, request DeleteAlarmRequest) (response DeleteAlarmResponse, err error)
+
+	// GetAlarm Gets the specified alarm.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	GetAlarm(ctx context.Context, request GetAlarmRequest) (response GetAlarmResponse, err error)
+
+	// GetAlarmHistory Get the history of the specified alarm.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	GetAlarmHistory(ctx context.Context, request GetAlarmHistoryRequest) (response GetAlarmHistoryResponse, err error)
+
+	// ListAlarms Lists the alarms for the specified compartment.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	ListAlarms(ctx context.Context, request ListAlarmsRequest) (response ListAlarmsResponse, err error)
+
+	// ListAlarmsStatus List the status of each alarm in the specified compartment.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	ListAlarmsStatus(ctx context.Context, request ListAlarmsStatusRequest) (response ListAlarmsStatusResponse, err error)
+
+	// ListMetrics Returns metric definitions that match the criteria specified in the request. Compartment OCID required.
+	// For information about metrics, see Metrics Overview (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#MetricsOverview).
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// Transactions Per Second (TPS) per-tenancy limit for this operation: 10.
+	ListMetrics(ctx context.Context, request ListMetricsRequest) (response ListMetricsResponse, err error)
+
+	// PostMetricData Publishes raw metric data points to the Monitoring service.
+	// For more information about publishing metrics, see Publishing Custom Metrics (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Tasks/publishingcustommetrics.htm).
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// Per-call limits information follows.
+	// * Dimensions per metric group*. Maximum: 20. Minimum: 1.
+	// * Unique metric streams*. Maximum: 50.
+	// * Transactions Per Second (TPS) per-tenancy limit for this operation: 50.
+	// *A metric group is the combination of a given metric, metric namespace, and tenancy for the purpose of determining limits.
+	// A dimension is a qualifier provided in a metric definition.
+	// A metric stream is an individual set of aggregated data for a metric, typically specific to a resource.
+	// For more information about metric-related concepts, see Monitoring Concepts (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#concepts).
+	// The endpoints for this operation differ from other Monitoring operations. Replace the string `telemetry` with `telemetry-ingestion` in the endpoint, as in the following example:
+	// https://telemetry-ingestion.eu-frankfurt-1.oraclecloud.com
+	PostMetricData(ctx context.Context, request PostMetricDataRequest) (response PostMetricDataResponse, err error)
+
+	// RemoveAlarmSuppression Removes any existing suppression for the specified alarm.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	RemoveAlarmSuppression(ctx context.Context, request RemoveAlarmSuppressionRequest) (response RemoveAlarmSuppressionResponse, err error)
+
+	// SummarizeMetricsData Returns aggregated data that match the criteria specified in the request. Compartment OCID required.
+	// For information on metric queries, see Building Metric Queries (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Tasks/buildingqueries.htm).
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// Transactions Per Second (TPS) per-tenancy limit for this operation: 10.
+	SummarizeMetricsData(ctx context.Context, request SummarizeMetricsDataRequest) (response SummarizeMetricsDataResponse, err error)
+
+	// UpdateAlarm Updates the specified alarm.
+	// For important limits information, see Limits on Monitoring (https://docs.cloud.oracle.com/iaas/Content/Monitoring/Concepts/monitoringoverview.htm#Limits).
+	// This call is subject to a Monitoring limit that applies to the total number of requests across all alarm operations.
+	// Monitoring might throttle this call to reject an otherwise valid request when the total rate of alarm operations exceeds 10 requests,
+	// or transactions, per second (TPS) for a given tenancy.
+	UpdateAlarm(ctx context.Context, request UpdateAlarmRequest) (response UpdateAlarmResponse, err error)
+}
+
+// MonitoringClient implements MonitoringClientAPI
+var _ MonitoringClientAPI = MonitoringClient{}
@@ -0,0 +1,373 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package mql builds and validates Monitoring Query Language (MQL) expressions, such as the Query of
+// SummarizeMetricsDataDetails and CreateAlarmDetails. The builder renders expressions like
+//
+//	CpuUtilization[1m]{resourceId="ocid1.instance..."}.groupBy(availabilityDomain).mean() > 80
+//
+// and Parse checks the syntax of a query locally, so that the typos surface in unit tests rather than as 400 errors.
+package mql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expression is a node of an MQL expression: a metric query, a number, an arithmetic operation, a condition or a
+// logical operation. String returns its MQL text.
+type Expression interface {
+	String() string
+}
+
+// The statistics of a metric query
+const (
+	StatisticAbsent     = "absent"
+	StatisticCount      = "count"
+	StatisticFirst      = "first"
+	StatisticLast       = "last"
+	StatisticMax        = "max"
+	StatisticMean       = "mean"
+	StatisticMin        = "min"
+	StatisticPercentile = "percentile"
+	StatisticRate       = "rate"
+	StatisticSum        = "sum"
+)
+
+// The operators of the dimension filters
+const (
+	FilterEqual    = "="
+	FilterNotEqual = "!="
+	FilterMatch    = "=~"
+)
+
+// Filter restricts a metric query to the streams of a dimension value. The value may list alternatives separated by
+// | and, with the =~ operator, contain * wildcards.
+type Filter struct {
+	Dimension string
+	Operator  string
+	Value     string
+}
+
+func (filter Filter) String() string {
+	return filter.Dimension + filter.Operator + strconv.Quote(filter.Value)
+}
+
+// MetricQuery is the query of a metric, aggregated over an interval with a statistic
+type MetricQuery struct {
+	Name     string
+	Window   string
+	Filters  []Filter
+	Grouping bool
+
+	// the dimensions of a groupBy grouping function
+	GroupByDimensions []string
+
+	Statistic string
+
+	// the percentile of the percentile statistic, between 0 and 1
+	Percentile float64
+}
+
+// Metric returns a query of a metric, aggregated over 1 minute intervals by default
+func Metric(name string) *MetricQuery {
+	return &MetricQuery{Name: name, Window: "1m"}
+}
+
+// Interval sets the aggregation interval. It is rendered in days, hours or minutes, the service supports intervals
+// between 1 and 60 minutes, 1 and 24 hours, or 1 day.
+func (query *MetricQuery) Interval(interval time.Duration) *MetricQuery {
+	switch {
+	case interval > 0 && interval%(24*time.Hour) == 0:
+		query.Window = fmt.Sprintf("%dd", interval/(24*time.Hour))
+	case interval > 0 && interval%time.Hour == 0:
+		query.Window = fmt.Sprintf("%dh", interval/time.Hour)
+	case interval > 0 && interval%time.Minute == 0:
+		query.Window = fmt.Sprintf("%dm", interval/time.Minute)
+	default:
+		// not supported by the service, reported by Build
+		query.Window = fmt.Sprintf("%ds", interval/time.Second)
+	}
+	return query
+}
+
+// Where keeps the streams whose dimension is one of the values
+func (query *MetricQuery) Where(dimension string, values ...string) *MetricQuery {
+	return query.filter(dimension, FilterEqual, strings.Join(values, "|"))
+}
+
+// WhereNot drops the streams whose dimension is the value
+func (query *MetricQuery) WhereNot(dimension, value string) *MetricQuery {
+	return query.filter(dimension, FilterNotEqual, value)
+}
+
+// Like keeps the streams whose dimension matches the pattern, in which * matches any characters
+func (query *MetricQuery) Like(dimension, pattern string) *MetricQuery {
+	return query.filter(dimension, FilterMatch, pattern)
+}
+
+func (query *MetricQuery) filter(dimension, operator, value string) *MetricQuery {
+	query.Filters = append(query.Filters, Filter{Dimension: dimension, Operator: operator, Value: value})
+	return query
+}
+
+// GroupAll aggregates all the streams together with the grouping() function
+func (query *MetricQuery) GroupAll() *MetricQuery {
+	query.Grouping, query.GroupByDimensions = true, nil
+	return query
+}
+
+// GroupBy aggregates the streams by the values of the dimensions with the groupBy() function
+func (query *MetricQuery) GroupBy(dimensions ...string) *MetricQuery {
+	query.Grouping, query.GroupByDimensions = true, dimensions
+	return query
+}
+
+// Absent sets the absent() statistic, which detects the missing metrics in alarms
+func (query *MetricQuery) Absent() *MetricQuery {
+	return query.statistic(StatisticAbsent)
+}
+
+// Count sets the count() statistic
+func (query *MetricQuery) Count() *MetricQuery {
+	return query.statistic(StatisticCount)
+}
+
+// First sets the first() statistic
+func (query *MetricQuery) First() *MetricQuery {
+	return query.statistic(StatisticFirst)
+}
+
+// Last sets the last() statistic
+func (query *MetricQuery) Last() *MetricQuery {
+	return query.statistic(StatisticLast)
+}
+
+// Max sets the max() statistic
+func (query *MetricQuery) Max() *MetricQuery {
+	return query.statistic(StatisticMax)
+}
+
+// Mean sets the mean() statistic
+func (query *MetricQuery) Mean() *MetricQuery {
+	return query.statistic(StatisticMean)
+}
+
+// Min sets the min() statistic
+func (query *MetricQuery) Min() *MetricQuery {
+	return query.statistic(StatisticMin)
+}
+
+// Rate sets the rate() statistic
+func (query *MetricQuery) Rate() *MetricQuery {
+	return query.statistic(StatisticRate)
+}
+
+// Sum sets the sum() statistic
+func (query *MetricQuery) Sum() *MetricQuery {
+	return query.statistic(StatisticSum)
+}
+
+// PercentileOf sets the percentile() statistic, percentile is between 0 and 1, for example 0.9
+func (query *MetricQuery) PercentileOf(percentile float64) *MetricQuery {
+	query.Percentile = percentile
+	return query.statistic(StatisticPercentile)
+}
+
+func (query *MetricQuery) statistic(statistic string) *MetricQuery {
+	query.Statistic = statistic
+	return query
+}
+
+func (query *MetricQuery) String() string {
+	var builder strings.Builder
+	builder.WriteString(query.Name)
+	builder.WriteString("[" + query.Window + "]")
+
+	if len(query.Filters) > 0 {
+		filters := make([]string, len(query.Filters))
+		for i, filter := range query.Filters {
+			filters[i] = filter.String()
+		}
+		builder.WriteString("{" + strings.Join(filters, ", ") + "}")
+	}
+
+	switch {
+	case len(query.GroupByDimensions) > 0:
+		builder.WriteString(".groupBy(" + strings.Join(query.GroupByDimensions, ", ") + ")")
+	case query.Grouping:
+		builder.WriteString(".grouping()")
+	}
+
+	// a query without statistic is rendered without it, for Build to report it
+	switch query.Statistic {
+	case "":
+	case StatisticPercentile:
+		builder.WriteString(".percentile(" + formatNumber(query.Percentile) + ")")
+	default:
+		builder.WriteString("." + query.Statistic + "()")
+	}
+	return builder.String()
+}
+
+// Number is a constant of an arithmetic expression
+type Number float64
+
+func (number Number) String() string {
+	return formatNumber(float64(number))
+}
+
+// Arithmetic is an arithmetic operation between two expressions
+type Arithmetic struct {
+	Left     Expression
+	Operator string
+	Right    Expression
+}
+
+func (arithmetic *Arithmetic) String() string {
+	return "(" + arithmetic.Left.String() + " " + arithmetic.Operator + " " + arithmetic.Right.String() + ")"
+}
+
+// Add returns left + right
+func Add(left, right Expression) *Arithmetic {
+	return &Arithmetic{Left: left, Operator: "+", Right: right}
+}
+
+// Sub returns left - right
+func Sub(left, right Expression) *Arithmetic {
+	return &Arithmetic{Left: left, Operator: "-", Right: right}
+}
+
+// Mul returns left * right
+func Mul(left, right Expression) *Arithmetic {
+	return &Arithmetic{Left: left, Operator: "*", Right: right}
+}
+
+// Div returns left / right
+func Div(left, right Expression) *Arithmetic {
+	return &Arithmetic{Left: left, Operator: "/", Right: right}
+}
+
+// Condition is the predicate of an alarm, comparing an expression to a threshold, or to a range with the in and not
+// in operators
+type Condition struct {
+	Expression Expression
+	Operator   string
+	Threshold  float64
+
+	// the upper bound of the in and not in operators, whose lower bound is Threshold
+	UpperThreshold float64
+}
+
+// The operators of the conditions
+const (
+	OperatorGreaterThan    = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorEqual          = "=="
+	OperatorNotEqual       = "!="
+	OperatorLessThan       = "<"
+	OperatorLessOrEqual    = "<="
+	OperatorIn             = "in"
+	OperatorNotIn          = "not in"
+)
+
+func (condition *Condition) String() string {
+	if condition.Operator == OperatorIn || condition.Operator == OperatorNotIn {
+		return fmt.Sprintf("%s %s (%s, %s)", condition.Expression, condition.Operator, formatNumber(condition.Threshold), formatNumber(condition.UpperThreshold))
+	}
+	return fmt.Sprintf("%s %s %s", condition.Expression, condition.Operator, formatNumber(condition.Threshold))
+}
+
+// GreaterThan returns the condition expression > threshold
+func GreaterThan(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorGreaterThan, Threshold: threshold}
+}
+
+// GreaterOrEqual returns the condition expression >= threshold
+func GreaterOrEqual(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorGreaterOrEqual, Threshold: threshold}
+}
+
+// Equal returns the condition expression == threshold
+func Equal(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorEqual, Threshold: threshold}
+}
+
+// NotEqual returns the condition expression != threshold
+func NotEqual(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorNotEqual, Threshold: threshold}
+}
+
+// LessThan returns the condition expression < threshold
+func LessThan(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorLessThan, Threshold: threshold}
+}
+
+// LessOrEqual returns the condition expression <= threshold
+func LessOrEqual(expression Expression, threshold float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorLessOrEqual, Threshold: threshold}
+}
+
+// InRange returns the condition expression in (low, high), true when the value is between the bounds inclusive
+func InRange(expression Expression, low, high float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorIn, Threshold: low, UpperThreshold: high}
+}
+
+// NotInRange returns the condition expression not in (low, high)
+func NotInRange(expression Expression, low, high float64) *Condition {
+	return &Condition{Expression: expression, Operator: OperatorNotIn, Threshold: low, UpperThreshold: high}
+}
+
+// Logical joins two conditions with && or ||
+type Logical struct {
+	Left     Expression
+	Operator string
+	Right    Expression
+}
+
+func (logical *Logical) String() string {
+	return logicalOperand(logical.Left, logical.Operator) + " " + logical.Operator + " " + logicalOperand(logical.Right, logical.Operator)
+}
+
+// logicalOperand parenthesizes the logical operands of another operator
+func logicalOperand(operand Expression, operator string) string {
+	if logical, ok := operand.(*Logical); ok && logical.Operator != operator {
+		return "(" + operand.String() + ")"
+	}
+	return operand.String()
+}
+
+// And returns left && right
+func And(left, right Expression) *Logical {
+	return &Logical{Left: left, Operator: "&&", Right: right}
+}
+
+// Or returns left || right
+func Or(left, right Expression) *Logical {
+	return &Logical{Left: left, Operator: "||", Right: right}
+}
+
+// Build returns the MQL text of the expression, and an error if the text is not a valid query
+func Build(expression Expression) (string, error) {
+	query := expression.String()
+	if _, err := Parse(query); err != nil {
+		return "", err
+	}
+	return query, nil
+}
+
+// BuildAlarm returns the MQL text of the expression, and an error if the text is not a valid alarm query
+func BuildAlarm(expression Expression) (string, error) {
+	query := expression.String()
+	if err := ValidateAlarm(query); err != nil {
+		return "", err
+	}
+	return query, nil
+}
+
+// formatNumber renders a number in decimal notation, MQL has no exponent notation
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
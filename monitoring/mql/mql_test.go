@@ -0,0 +1,127 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package mql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild(t *testing.T) {
+	testCases := []struct {
+		expression Expression
+		expected   string
+	}{
+		{
+			expression: Metric("CpuUtilization").Mean(),
+			expected:   "CpuUtilization[1m].mean()",
+		},
+		{
+			expression: Metric("CpuUtilization").Interval(5*time.Minute).Where("availabilityDomain", "AD-1", "AD-2").Like("resourceDisplayName", "web*").GroupBy("availabilityDomain").PercentileOf(0.9),
+			expected:   `CpuUtilization[5m]{availabilityDomain="AD-1|AD-2", resourceDisplayName=~"web*"}.groupBy(availabilityDomain).percentile(0.9)`,
+		},
+		{
+			expression: Metric("my_app.success_rate").Interval(time.Hour).WhereNot("env", `say "hi"`).GroupAll().Sum(),
+			expected:   `my_app.success_rate[1h]{env!="say \"hi\""}.grouping().sum()`,
+		},
+		{
+			expression: Div(Add(Metric("BytesIn").Rate(), Metric("BytesOut").Rate()), Number(1024)),
+			expected:   "((BytesIn[1m].rate() + BytesOut[1m].rate()) / 1024)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		query, err := Build(testCase.expression)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, query)
+
+		// the parsed query renders the same text
+		expression, err := Parse(query)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, expression.String())
+	}
+
+	_, err := Build(Metric("CpuUtilization").Interval(30 * time.Second).Mean())
+	assert.EqualError(t, err, `invalid MQL query at offset 15: invalid interval "30s", expected minutes, hours or days such as 5m`)
+
+	_, err = Build(Metric("CpuUtilization").Interval(2 * time.Hour * 24).Mean())
+	assert.EqualError(t, err, `invalid MQL query at offset 15: invalid interval "2d", the max is 1d`)
+
+	_, err = Build(Metric("CpuUtilization"))
+	assert.EqualError(t, err, "invalid MQL query at offset 18: expected a statistic such as .mean() after the metric CpuUtilization")
+
+	// the numbers are rendered without exponent
+	query, err := Build(GreaterThan(Metric("BytesIn").Sum(), 1e21))
+	assert.NoError(t, err)
+	assert.Equal(t, "BytesIn[1m].sum() > 1000000000000000000000", query)
+	assert.Equal(t, "0.0000001", Number(1e-7).String())
+}
+
+func TestBuildAlarm(t *testing.T) {
+	cpu := Metric("CpuUtilization").Max()
+	memory := Metric("MemoryUtilization").Max()
+
+	query, err := BuildAlarm(Or(And(GreaterThan(cpu, 80), GreaterOrEqual(memory, 90.5)), InRange(cpu, 0, 1)))
+	assert.NoError(t, err)
+	assert.Equal(t, "(CpuUtilization[1m].max() > 80 && MemoryUtilization[1m].max() >= 90.5) || CpuUtilization[1m].max() in (0, 1)", query)
+
+	query, err = BuildAlarm(Metric("HeartBeat").Where("resourceId", "ocid1.instance.oc1..a").Absent())
+	assert.NoError(t, err)
+	assert.Equal(t, `HeartBeat[1m]{resourceId="ocid1.instance.oc1..a"}.absent()`, query)
+
+	_, err = BuildAlarm(cpu)
+	assert.Error(t, err)
+
+	_, err = BuildAlarm(And(GreaterThan(cpu, 80), memory))
+	assert.Error(t, err)
+}
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"CpuUtilization[1m].mean()",
+		"CpuUtilization[60m]{resourceId = \"ocid1\" , availabilityDomain=~\"*AD-1\"}.grouping().max() > 80",
+		"CpuUtilization[24h].groupBy(resourceId, availabilityDomain).count() not in (1, 2)",
+		"(A[1m].sum() + B[1m].sum()) * 2 - C[1m].sum() / -1500.5 <= 0",
+		"A[1m].mean() > 1 && B[1m].mean() < 2 || C[1d].absent()",
+		"A[1m].mean() != 0 && (B[1m].mean() == 1 || C[1m].mean() in (1.5, 2))",
+	}
+	for _, query := range valid {
+		expression, err := Parse(query)
+		assert.NoError(t, err, query)
+
+		// the rendered expression is equivalent to the query
+		rendered, err := Parse(expression.String())
+		assert.NoError(t, err, query)
+		assert.Equal(t, expression.String(), rendered.String())
+	}
+
+	invalid := map[string]string{
+		"CpuUtilization.mean()":                           `expected "[" and the interval after the metric name`,
+		"CpuUtilization[1s].mean()":                       `invalid interval "1s", expected minutes, hours or days such as 5m`,
+		"CpuUtilization[61m].mean()":                      `invalid interval "61m", the max is 60m`,
+		"CpuUtilization[1m]":                              "expected a statistic such as .mean() after the metric CpuUtilization",
+		"CpuUtilization[1m].avg()":                        `unknown function "avg"`,
+		"CpuUtilization[1m].mean().max()":                 `unexpected function "max" after the statistic`,
+		"CpuUtilization[1m].grouping().grouping().mean()": "more than one grouping function",
+		"CpuUtilization[1m].percentile(90)":               "the percentile must be between 0 and 1 exclusive",
+		"CpuUtilization[1m]{resourceId=ocid1}.mean()":     "expected a double quoted value",
+		"CpuUtilization[1m]{resourceId=\"\"}.mean()":      "empty dimension value",
+		"CpuUtilization[1m]{resourceId:\"a\"}.mean()":     "expected =, != or =~ after the dimension resourceId:",
+		"CpuUtilization[1m].mean() >":                     "expected a number",
+		"CpuUtilization[1m].mean() in (2, 1)":             "the lower bound of the range is greater than its upper bound",
+		"CpuUtilization[1m].mean() > 80 80":               `unexpected "80"`,
+		"CpuUtilization[1m].mean() > 1e3":                 `unexpected "e3"`,
+		"(CpuUtilization[1m].mean() > 80":                 `expected ")"`,
+		"oci-metric[1m].mean() + ":                        "unexpected end of query",
+		"1metric[1m].mean()":                              `unexpected "metric[1m].mean()"`,
+	}
+	for query, message := range invalid {
+		err := Validate(query)
+		if assert.Error(t, err, query) {
+			assert.Equal(t, message, err.(*SyntaxError).Message, query)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package mql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError is the error of an invalid query, at the offset in bytes of the query where parsing failed
+type SyntaxError struct {
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid MQL query at offset %d: %s", e.Offset, e.Message)
+}
+
+var (
+	metricNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.\-$]*$`)
+	intervalPattern   = regexp.MustCompile(`^([1-9][0-9]*)([mhd])$`)
+	numberPattern     = regexp.MustCompile(`^-?([0-9]+(\.[0-9]*)?|\.[0-9]+)`)
+
+	// the maximum of each unit of the intervals
+	maxIntervals = map[string]int{"m": 60, "h": 24, "d": 1}
+
+	statistics = map[string]bool{
+		StatisticAbsent: true, StatisticCount: true, StatisticFirst: true, StatisticLast: true, StatisticMax: true,
+		StatisticMean: true, StatisticMin: true, StatisticPercentile: true, StatisticRate: true, StatisticSum: true,
+	}
+
+	// the comparison operators, the longest first
+	comparisonOperators = []string{OperatorGreaterOrEqual, OperatorLessOrEqual, OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorLessThan}
+)
+
+// Parse parses an MQL query and returns its expression
+func Parse(query string) (Expression, error) {
+	parser := &parser{input: query}
+	expression, err := parser.parseLogical()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.skipSpaces()
+	if !parser.done() {
+		return nil, parser.errorf("unexpected %q", parser.input[parser.pos:])
+	}
+	return expression, nil
+}
+
+// Validate returns an error if the query is not valid MQL
+func Validate(query string) error {
+	_, err := Parse(query)
+	return err
+}
+
+// ValidateAlarm returns an error if the query is not a valid alarm query, which must be a condition, conditions
+// joined by && or ||, or a metric query with the absent() statistic
+func ValidateAlarm(query string) error {
+	expression, err := Parse(query)
+	if err != nil {
+		return err
+	}
+
+	if !isAlarmCondition(expression) {
+		return &SyntaxError{Offset: len(query), Message: "an alarm query must end with a condition, such as > 80, or use absent()"}
+	}
+	return nil
+}
+
+func isAlarmCondition(expression Expression) bool {
+	switch e := expression.(type) {
+	case *Condition:
+		return true
+	case *Logical:
+		return isAlarmCondition(e.Left) && isAlarmCondition(e.Right)
+	case *MetricQuery:
+		return e.Statistic == StatisticAbsent
+	}
+	return false
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (parser *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: parser.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (parser *parser) done() bool {
+	return parser.pos >= len(parser.input)
+}
+
+func (parser *parser) skipSpaces() {
+	for !parser.done() && strings.IndexByte(" \t\r\n", parser.input[parser.pos]) >= 0 {
+		parser.pos++
+	}
+}
+
+// consume skips the spaces and the token if the input continues with it
+func (parser *parser) consume(token string) bool {
+	parser.skipSpaces()
+	if strings.HasPrefix(parser.input[parser.pos:], token) {
+		parser.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// consumeWord consumes a keyword not followed by a letter
+func (parser *parser) consumeWord(word string) bool {
+	parser.skipSpaces()
+	rest := parser.input[parser.pos:]
+	if strings.HasPrefix(rest, word) && (len(rest) == len(word) || !isLetter(rest[len(word)])) {
+		parser.pos += len(word)
+		return true
+	}
+	return false
+}
+
+func (parser *parser) expect(token string) error {
+	if !parser.consume(token) {
+		return parser.errorf("expected %q", token)
+	}
+	return nil
+}
+
+// consumeWhile returns the characters accepted by the function from the current position
+func (parser *parser) consumeWhile(accept func(byte) bool) string {
+	start := parser.pos
+	for !parser.done() && accept(parser.input[parser.pos]) {
+		parser.pos++
+	}
+	return parser.input[start:parser.pos]
+}
+
+// parseLogical parses conditions joined by ||, whose precedence is lower than &&
+func (parser *parser) parseLogical() (Expression, error) {
+	left, err := parser.parseAnd()
+	for err == nil && parser.consume("||") {
+		var right Expression
+		if right, err = parser.parseAnd(); err == nil {
+			left = Or(left, right)
+		}
+	}
+	return left, err
+}
+
+func (parser *parser) parseAnd() (Expression, error) {
+	left, err := parser.parseCondition()
+	for err == nil && parser.consume("&&") {
+		var right Expression
+		if right, err = parser.parseCondition(); err == nil {
+			left = And(left, right)
+		}
+	}
+	return left, err
+}
+
+func (parser *parser) parseCondition() (Expression, error) {
+	expression, err := parser.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operator := range comparisonOperators {
+		if parser.consume(operator) {
+			threshold, err := parser.parseNumber()
+			if err != nil {
+				return nil, err
+			}
+			return &Condition{Expression: expression, Operator: operator, Threshold: threshold}, nil
+		}
+	}
+
+	operator := OperatorIn
+	if parser.consumeWord("not") {
+		operator = OperatorNotIn
+		if !parser.consumeWord("in") {
+			return nil, parser.errorf("expected \"in\" after \"not\"")
+		}
+	} else if !parser.consumeWord("in") {
+		return expression, nil
+	}
+
+	condition := &Condition{Expression: expression, Operator: operator}
+	if err = parser.expect("("); err != nil {
+		return nil, err
+	}
+	if condition.Threshold, err = parser.parseNumber(); err != nil {
+		return nil, err
+	}
+	if err = parser.expect(","); err != nil {
+		return nil, err
+	}
+	if condition.UpperThreshold, err = parser.parseNumber(); err != nil {
+		return nil, err
+	}
+	if err = parser.expect(")"); err != nil {
+		return nil, err
+	}
+
+	if condition.Threshold > condition.UpperThreshold {
+		return nil, parser.errorf("the lower bound of the range is greater than its upper bound")
+	}
+	return condition, nil
+}
+
+func (parser *parser) parseAdditive() (Expression, error) {
+	left, err := parser.parseTerm()
+	for err == nil {
+		var operator string
+		switch {
+		case parser.consume("+"):
+			operator = "+"
+		case parser.consume("-"):
+			operator = "-"
+		default:
+			return left, nil
+		}
+
+		var right Expression
+		if right, err = parser.parseTerm(); err == nil {
+			left = &Arithmetic{Left: left, Operator: operator, Right: right}
+		}
+	}
+	return nil, err
+}
+
+func (parser *parser) parseTerm() (Expression, error) {
+	left, err := parser.parseFactor()
+	for err == nil {
+		var operator string
+		switch {
+		case parser.consume("*"):
+			operator = "*"
+		case parser.consume("/"):
+			operator = "/"
+		default:
+			return left, nil
+		}
+
+		var right Expression
+		if right, err = parser.parseFactor(); err == nil {
+			left = &Arithmetic{Left: left, Operator: operator, Right: right}
+		}
+	}
+	return nil, err
+}
+
+func (parser *parser) parseFactor() (Expression, error) {
+	parser.skipSpaces()
+	switch {
+	case parser.done():
+		return nil, parser.errorf("unexpected end of query")
+	case parser.consume("("):
+		expression, err := parser.parseLogical()
+		if err != nil {
+			return nil, err
+		}
+		if err = parser.expect(")"); err != nil {
+			return nil, err
+		}
+		return expression, nil
+	case isLetter(parser.input[parser.pos]):
+		return parser.parseMetricQuery()
+	default:
+		number, err := parser.parseNumber()
+		if err != nil {
+			return nil, parser.errorf("expected a metric query, a number or \"(\"")
+		}
+		return Number(number), nil
+	}
+}
+
+func (parser *parser) parseNumber() (float64, error) {
+	parser.skipSpaces()
+	text := numberPattern.FindString(parser.input[parser.pos:])
+	if len(text) == 0 {
+		return 0, parser.errorf("expected a number")
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, parser.errorf("invalid number %q", text)
+	}
+	parser.pos += len(text)
+	return number, nil
+}
+
+// parseMetricQuery parses metric[interval]{filters}.grouping.statistic, the filters and grouping being optional
+func (parser *parser) parseMetricQuery() (*MetricQuery, error) {
+	query := &MetricQuery{}
+	start := parser.pos
+	query.Name = parser.consumeWhile(func(c byte) bool { return c != '[' && !isSpace(c) && strings.IndexByte("(){}", c) < 0 })
+	if !metricNamePattern.MatchString(query.Name) {
+		parser.pos = start
+		return nil, parser.errorf("invalid metric name %q", query.Name)
+	}
+
+	if parser.done() || parser.input[parser.pos] != '[' {
+		return nil, parser.errorf("expected \"[\" and the interval after the metric name")
+	}
+	parser.pos++
+
+	start = parser.pos
+	query.Window = parser.consumeWhile(func(c byte) bool { return c != ']' })
+	match := intervalPattern.FindStringSubmatch(query.Window)
+	if match == nil {
+		parser.pos = start
+		return nil, parser.errorf("invalid interval %q, expected minutes, hours or days such as 5m", query.Window)
+	}
+	if value, _ := strconv.Atoi(match[1]); value > maxIntervals[match[2]] {
+		parser.pos = start
+		return nil, parser.errorf("invalid interval %q, the max is %d%s", query.Window, maxIntervals[match[2]], match[2])
+	}
+	if err := parser.expect("]"); err != nil {
+		return nil, err
+	}
+
+	if parser.consume("{") {
+		if err := parser.parseFilters(query); err != nil {
+			return nil, err
+		}
+	}
+
+	for parser.consume(".") {
+		start = parser.pos
+		function := parser.consumeWhile(isLetter)
+		if err := parser.expect("("); err != nil {
+			return nil, err
+		}
+
+		if len(query.Statistic) > 0 {
+			parser.pos = start
+			return nil, parser.errorf("unexpected function %q after the statistic", function)
+		}
+
+		switch {
+		case function == "grouping" || function == "groupBy":
+			if query.Grouping {
+				parser.pos = start
+				return nil, parser.errorf("more than one grouping function")
+			}
+			if err := parser.parseGrouping(query, function); err != nil {
+				return nil, err
+			}
+		case statistics[function]:
+			query.Statistic = function
+			if function == StatisticPercentile {
+				percentile, err := parser.parseNumber()
+				if err != nil {
+					return nil, err
+				}
+				if percentile <= 0 || percentile >= 1 {
+					return nil, parser.errorf("the percentile must be between 0 and 1 exclusive")
+				}
+				query.Percentile = percentile
+			}
+		default:
+			parser.pos = start
+			return nil, parser.errorf("unknown function %q", function)
+		}
+
+		if err := parser.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(query.Statistic) == 0 {
+		return nil, parser.errorf("expected a statistic such as .mean() after the metric %s", query.Name)
+	}
+	return query, nil
+}
+
+func (parser *parser) parseFilters(query *MetricQuery) error {
+	for {
+		parser.skipSpaces()
+		filter := Filter{Dimension: parser.consumeWhile(func(c byte) bool {
+			return !isSpace(c) && strings.IndexByte("=!~,{}\"", c) < 0
+		})}
+		if len(filter.Dimension) == 0 {
+			return parser.errorf("expected a dimension name")
+		}
+
+		switch {
+		case parser.consume(FilterMatch):
+			filter.Operator = FilterMatch
+		case parser.consume(FilterNotEqual):
+			filter.Operator = FilterNotEqual
+		case parser.consume(FilterEqual):
+			filter.Operator = FilterEqual
+		default:
+			return parser.errorf("expected =, != or =~ after the dimension %s", filter.Dimension)
+		}
+
+		value, err := parser.parseString()
+		if err != nil {
+			return err
+		}
+		filter.Value = value
+		query.Filters = append(query.Filters, filter)
+
+		if parser.consume("}") {
+			return nil
+		}
+		if err = parser.expect(","); err != nil {
+			return err
+		}
+	}
+}
+
+// parseString parses a double quoted string, whose quotes and backslashes are escaped with backslashes
+func (parser *parser) parseString() (string, error) {
+	parser.skipSpaces()
+	if parser.done() || parser.input[parser.pos] != '"' {
+		return "", parser.errorf("expected a double quoted value")
+	}
+
+	for end := parser.pos + 1; end < len(parser.input); end++ {
+		switch parser.input[end] {
+		case '\\':
+			end++
+		case '"':
+			value, err := strconv.Unquote(parser.input[parser.pos : end+1])
+			if err != nil {
+				return "", parser.errorf("invalid value %s", parser.input[parser.pos:end+1])
+			}
+			if len(value) == 0 {
+				return "", parser.errorf("empty dimension value")
+			}
+			parser.pos = end + 1
+			return value, nil
+		}
+	}
+	return "", parser.errorf("unterminated value")
+}
+
+func (parser *parser) parseGrouping(query *MetricQuery, function string) error {
+	query.Grouping = true
+	if function == "grouping" {
+		return nil
+	}
+
+	for {
+		parser.skipSpaces()
+		dimension := parser.consumeWhile(func(c byte) bool { return !isSpace(c) && strings.IndexByte(",()", c) < 0 })
+		if len(dimension) == 0 {
+			return parser.errorf("expected a dimension name")
+		}
+		query.GroupByDimensions = append(query.GroupByDimensions, dimension)
+
+		if !parser.consume(",") {
+			return nil
+		}
+	}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isSpace(c byte) bool {
+	return strings.IndexByte(" \t\r\n", c) >= 0
+}
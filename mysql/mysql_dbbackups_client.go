@@ -102,10 +102,12 @@ func (client DbBackupsClient) CreateBackup(ctx context.Context, request CreateBa
 				response = CreateBackupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateBackupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateBackupResponse")
 	}
@@ -0,0 +1,30 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package mysql
+
+import (
+	"context"
+)
+
+// DbBackupsClientAPI is the interface of the operations of DbBackupsClient, to inject the client and mock it in tests
+type DbBackupsClientAPI interface {
+	// CreateBackup Create a backup of a DB System.
+	CreateBackup(ctx context.Context, request CreateBackupRequest) (response CreateBackupResponse, err error)
+
+	// DeleteBackup Delete a Backup.
+	DeleteBackup(ctx context.Context, request DeleteBackupRequest) (response DeleteBackupResponse, err error)
+
+	// GetBackup Get information about the specified Backup
+	GetBackup(ctx context.Context, request GetBackupRequest) (response GetBackupResponse, err error)
+
+	// ListBackups Get a list of DB System backups.
+	ListBackups(ctx context.Context, request ListBackupsRequest) (response ListBackupsResponse, err error)
+
+	// UpdateBackup Update the metadata of a Backup. Metadata such as the displayName or description
+	UpdateBackup(ctx context.Context, request UpdateBackupRequest) (response UpdateBackupResponse, err error)
+}
+
+// DbBackupsClient implements DbBackupsClientAPI
+var _ DbBackupsClientAPI = DbBackupsClient{}
@@ -102,10 +102,12 @@ func (client DbSystemClient) CreateDbSystem(ctx context.Context, request CreateD
 				response = CreateDbSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateDbSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateDbSystemResponse")
 	}
@@ -309,10 +311,12 @@ func (client DbSystemClient) RestartDbSystem(ctx context.Context, request Restar
 				response = RestartDbSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RestartDbSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RestartDbSystemResponse")
 	}
@@ -364,10 +368,12 @@ func (client DbSystemClient) StartDbSystem(ctx context.Context, request StartDbS
 				response = StartDbSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartDbSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartDbSystemResponse")
 	}
@@ -420,10 +426,12 @@ func (client DbSystemClient) StopDbSystem(ctx context.Context, request StopDbSys
 				response = StopDbSystemResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopDbSystemResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopDbSystemResponse")
 	}
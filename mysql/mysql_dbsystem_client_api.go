@@ -0,0 +1,48 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package mysql
+
+import (
+	"context"
+)
+
+// DbSystemClientAPI is the interface of the operations of DbSystemClient, to inject the client and mock it in tests
+type DbSystemClientAPI interface {
+	// CreateDbSystem Creates and launches a DB System.
+	CreateDbSystem(ctx context.Context, request CreateDbSystemRequest) (response CreateDbSystemResponse, err error)
+
+	// DeleteDbSystem Delete a DB System, including terminating, detaching,
+	// removing, finalizing and otherwise deleting all related resources.
+	DeleteDbSystem(ctx context.Context, request DeleteDbSystemRequest) (response DeleteDbSystemResponse, err error)
+
+	// GetDbSystem Get information about the specified DB System.
+	GetDbSystem(ctx context.Context, request GetDbSystemRequest) (response GetDbSystemResponse, err error)
+
+	// ListDbSystems Get a list of DB Systems in the specified compartment.
+	// The default sort order is by timeUpdated, descending.
+	ListDbSystems(ctx context.Context, request ListDbSystemsRequest) (response ListDbSystemsResponse, err error)
+
+	// RestartDbSystem Restarts the specified DB System.
+	RestartDbSystem(ctx context.Context, request RestartDbSystemRequest) (response RestartDbSystemResponse, err error)
+
+	// StartDbSystem Start the specified DB System.
+	StartDbSystem(ctx context.Context, request StartDbSystemRequest) (response StartDbSystemResponse, err error)
+
+	// StopDbSystem Stops the specified DB System.
+	// A stopped DB System is not billed.
+	StopDbSystem(ctx context.Context, request StopDbSystemRequest) (response StopDbSystemResponse, err error)
+
+	// UpdateDbSystem Update the configuration of a DB System.
+	// Updating different fields in the DB System will have different results
+	// on the uptime of the DB System. For example, changing the displayName of
+	// a DB System will take effect immediately, but changing the shape of a
+	// DB System is an asynchronous operation that involves provisioning new
+	// Compute resources, pausing the DB System and migrating storage
+	// before making the DB System available again.
+	UpdateDbSystem(ctx context.Context, request UpdateDbSystemRequest) (response UpdateDbSystemResponse, err error)
+}
+
+// DbSystemClient implements DbSystemClientAPI
+var _ DbSystemClientAPI = DbSystemClient{}
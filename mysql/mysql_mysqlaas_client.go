@@ -102,10 +102,12 @@ func (client MysqlaasClient) CreateConfiguration(ctx context.Context, request Cr
 				response = CreateConfigurationResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConfigurationResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConfigurationResponse")
 	}
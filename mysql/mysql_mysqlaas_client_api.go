@@ -0,0 +1,46 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package mysql
+
+import (
+	"context"
+)
+
+// MysqlaasClientAPI is the interface of the operations of MysqlaasClient, to inject the client and mock it in tests
+type MysqlaasClientAPI interface {
+	// CreateConfiguration Creates a new Configuration.
+	CreateConfiguration(ctx context.Context, request CreateConfigurationRequest) (response CreateConfigurationResponse, err error)
+
+	// DeleteConfiguration Deletes a Configuration.
+	// The Configuration must not be in use by any DB Systems.
+	DeleteConfiguration(ctx context.Context, request DeleteConfigurationRequest) (response DeleteConfigurationResponse, err error)
+
+	// GetConfiguration Get the full details of the specified Configuration, including the list of MySQL Variables and their values.
+	GetConfiguration(ctx context.Context, request GetConfigurationRequest) (response GetConfigurationResponse, err error)
+
+	// ListConfigurations Lists the Configurations available when creating a DB System.
+	// This may include DEFAULT configurations per Shape and CUSTOM configurations.
+	// The default sort order is a multi-part sort by:
+	//   - shapeName, ascending
+	//   - DEFAULT-before-CUSTOM
+	//   - displayName ascending
+	ListConfigurations(ctx context.Context, request ListConfigurationsRequest) (response ListConfigurationsResponse, err error)
+
+	// ListShapes Gets a list of the shapes you can use to create a new MySQL DB System.
+	// The shape determines the resources allocated to the DB System:
+	// CPU cores and memory for VM shapes; CPU cores, memory and
+	// storage for non-VM (or bare metal) shapes.
+	ListShapes(ctx context.Context, request ListShapesRequest) (response ListShapesResponse, err error)
+
+	// ListVersions Get a list of supported and available MySQL database major versions.
+	// The list is sorted by version family.
+	ListVersions(ctx context.Context, request ListVersionsRequest) (response ListVersionsResponse, err error)
+
+	// UpdateConfiguration Updates the Configuration details.
+	UpdateConfiguration(ctx context.Context, request UpdateConfigurationRequest) (response UpdateConfigurationResponse, err error)
+}
+
+// MysqlaasClient implements MysqlaasClientAPI
+var _ MysqlaasClientAPI = MysqlaasClient{}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package mysql
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*CreateDbSystemSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createdbsystemsourcedetails{} })
+	common.RegisterPolymorphicModel((*DbSystemSource)(nil), func() common.PolymorphicJSONUnmarshaler { return &dbsystemsource{} })
+}
+
+// MarshalJSON marshals a CreateDbSystemSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createdbsystemsourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatedbsystemsourcedetails createdbsystemsourcedetails
+	s := struct {
+		MarshalTypecreatedbsystemsourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatedbsystemsourcedetails: (MarshalTypecreatedbsystemsourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a DbSystemSource whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m dbsystemsource) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypedbsystemsource dbsystemsource
+	s := struct {
+		MarshalTypedbsystemsource
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypedbsystemsource: (MarshalTypedbsystemsource)(m),
+	}
+	return json.Marshal(&s)
+}
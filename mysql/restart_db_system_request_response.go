@@ -69,6 +69,9 @@ type RestartDbSystemResponse struct {
 
 	// OCID of the WorkRequest associated with this operation.
 	OpcWorkRequestId *string `presentIn:"header" name:"opc-work-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response RestartDbSystemResponse) String() string {
@@ -105,10 +105,12 @@ func (client NosqlClient) ChangeTableCompartment(ctx context.Context, request Ch
 				response = ChangeTableCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeTableCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeTableCompartmentResponse")
 	}
@@ -160,10 +162,12 @@ func (client NosqlClient) CreateIndex(ctx context.Context, request CreateIndexRe
 				response = CreateIndexResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateIndexResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateIndexResponse")
 	}
@@ -215,10 +219,12 @@ func (client NosqlClient) CreateTable(ctx context.Context, request CreateTableRe
 				response = CreateTableResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTableResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTableResponse")
 	}
@@ -0,0 +1,82 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package nosql
+
+import (
+	"context"
+)
+
+// NosqlClientAPI is the interface of the operations of NosqlClient, to inject the client and mock it in tests
+type NosqlClientAPI interface {
+	// ChangeTableCompartment Change a table's compartment.
+	ChangeTableCompartment(ctx context.Context, request ChangeTableCompartmentRequest) (response ChangeTableCompartmentResponse, err error)
+
+	// CreateIndex Create a new index on the table identified by tableNameOrId.
+	CreateIndex(ctx context.Context, request CreateIndexRequest) (response CreateIndexResponse, err error)
+
+	// CreateTable Create a new table.
+	CreateTable(ctx context.Context, request CreateTableRequest) (response CreateTableResponse, err error)
+
+	// DeleteIndex Delete an index from the table identified by tableNameOrId.
+	DeleteIndex(ctx context.Context, request DeleteIndexRequest) (response DeleteIndexResponse, err error)
+
+	// DeleteRow Delete a single row from the table, by primary key.
+	DeleteRow(ctx context.Context, request DeleteRowRequest) (response DeleteRowResponse, err error)
+
+	// DeleteTable Delete a table by tableNameOrId.
+	DeleteTable(ctx context.Context, request DeleteTableRequest) (response DeleteTableResponse, err error)
+
+	// DeleteWorkRequest Cancel a work request operation with the given ID.
+	DeleteWorkRequest(ctx context.Context, request DeleteWorkRequestRequest) (response DeleteWorkRequestResponse, err error)
+
+	// GetIndex Get information about a single index.
+	GetIndex(ctx context.Context, request GetIndexRequest) (response GetIndexResponse, err error)
+
+	// GetRow Get a single row from the table by primary key.
+	GetRow(ctx context.Context, request GetRowRequest) (response GetRowResponse, err error)
+
+	// GetTable Get table info by identifier.
+	GetTable(ctx context.Context, request GetTableRequest) (response GetTableResponse, err error)
+
+	// GetWorkRequest Get the status of the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListIndexes Get a list of indexes on a table.
+	ListIndexes(ctx context.Context, request ListIndexesRequest) (response ListIndexesResponse, err error)
+
+	// ListTableUsage Get table usage info.
+	ListTableUsage(ctx context.Context, request ListTableUsageRequest) (response ListTableUsageResponse, err error)
+
+	// ListTables Get a list of tables in a compartment.
+	ListTables(ctx context.Context, request ListTablesRequest) (response ListTablesResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests List the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// PrepareStatement Prepare a SQL statement for use in a query with variable substitution.
+	PrepareStatement(ctx context.Context, request PrepareStatementRequest) (response PrepareStatementResponse, err error)
+
+	// Query Execute a SQL query.
+	Query(ctx context.Context, request QueryRequest) (response QueryResponse, err error)
+
+	// SummarizeStatement Check the syntax and return a brief summary of a SQL statement.
+	SummarizeStatement(ctx context.Context, request SummarizeStatementRequest) (response SummarizeStatementResponse, err error)
+
+	// UpdateRow Write a single row into the table.
+	UpdateRow(ctx context.Context, request UpdateRowRequest) (response UpdateRowResponse, err error)
+
+	// UpdateTable Alter the table identified by tableNameOrId,
+	// changing schema, limits, or tags
+	UpdateTable(ctx context.Context, request UpdateTableRequest) (response UpdateTableResponse, err error)
+}
+
+// NosqlClient implements NosqlClientAPI
+var _ NosqlClientAPI = NosqlClient{}
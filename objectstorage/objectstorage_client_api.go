@@ -0,0 +1,225 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package objectstorage
+
+import (
+	"context"
+)
+
+// ObjectStorageClientAPI is the interface of the operations of ObjectStorageClient, to inject the client and mock it in tests
+type ObjectStorageClientAPI interface {
+	// AbortMultipartUpload Aborts an in-progress multipart upload and deletes all parts that have been uploaded.
+	AbortMultipartUpload(ctx context.Context, request AbortMultipartUploadRequest) (response AbortMultipartUploadResponse, err error)
+
+	// CancelWorkRequest Cancels a work request.
+	CancelWorkRequest(ctx context.Context, request CancelWorkRequestRequest) (response CancelWorkRequestResponse, err error)
+
+	// CommitMultipartUpload Commits a multipart upload, which involves checking part numbers and entity tags (ETags) of the parts, to create an aggregate object.
+	CommitMultipartUpload(ctx context.Context, request CommitMultipartUploadRequest) (response CommitMultipartUploadResponse, err error)
+
+	// CopyObject Creates a request to copy an object within a region or to another region.
+	CopyObject(ctx context.Context, request CopyObjectRequest) (response CopyObjectResponse, err error)
+
+	// CreateBucket Creates a bucket in the given namespace with a bucket name and optional user-defined metadata. Avoid entering
+	// confidential information in bucket names.
+	CreateBucket(ctx context.Context, request CreateBucketRequest) (response CreateBucketResponse, err error)
+
+	// CreateMultipartUpload Starts a new multipart upload to a specific object in the given bucket in the given namespace.
+	CreateMultipartUpload(ctx context.Context, request CreateMultipartUploadRequest) (response CreateMultipartUploadResponse, err error)
+
+	// CreatePreauthenticatedRequest Creates a pre-authenticated request specific to the bucket.
+	CreatePreauthenticatedRequest(ctx context.Context, request CreatePreauthenticatedRequestRequest) (response CreatePreauthenticatedRequestResponse, err error)
+
+	// CreateReplicationPolicy Creates a replication policy for the specified bucket.
+	CreateReplicationPolicy(ctx context.Context, request CreateReplicationPolicyRequest) (response CreateReplicationPolicyResponse, err error)
+
+	// CreateRetentionRule Creates a new retention rule in the specified bucket. The new rule will take effect typically within 30 seconds.
+	// Note that a maximum of 100 rules are supported on a bucket.
+	CreateRetentionRule(ctx context.Context, request CreateRetentionRuleRequest) (response CreateRetentionRuleResponse, err error)
+
+	// DeleteBucket Deletes a bucket if the bucket is already empty. If the bucket is not empty, use
+	// DeleteObject first. In addition,
+	// you cannot delete a bucket that has a multipart upload in progress or a pre-authenticated
+	// request associated with that bucket.
+	DeleteBucket(ctx context.Context, request DeleteBucketRequest) (response DeleteBucketResponse, err error)
+
+	// DeleteObject Deletes an object.
+	DeleteObject(ctx context.Context, request DeleteObjectRequest) (response DeleteObjectResponse, err error)
+
+	// DeleteObjectLifecyclePolicy Deletes the object lifecycle policy for the bucket.
+	DeleteObjectLifecyclePolicy(ctx context.Context, request DeleteObjectLifecyclePolicyRequest) (response DeleteObjectLifecyclePolicyResponse, err error)
+
+	// DeletePreauthenticatedRequest Deletes the pre-authenticated request for the bucket.
+	DeletePreauthenticatedRequest(ctx context.Context, request DeletePreauthenticatedRequestRequest) (response DeletePreauthenticatedRequestResponse, err error)
+
+	// DeleteReplicationPolicy Deletes the replication policy associated with the source bucket.
+	DeleteReplicationPolicy(ctx context.Context, request DeleteReplicationPolicyRequest) (response DeleteReplicationPolicyResponse, err error)
+
+	// DeleteRetentionRule Deletes the specified rule. The deletion takes effect typically within 30 seconds.
+	DeleteRetentionRule(ctx context.Context, request DeleteRetentionRuleRequest) (response DeleteRetentionRuleResponse, err error)
+
+	// GetBucket Gets the current representation of the given bucket in the given Object Storage namespace.
+	GetBucket(ctx context.Context, request GetBucketRequest) (response GetBucketResponse, err error)
+
+	// GetNamespace Each Oracle Cloud Infrastructure tenant is assigned one unique and uneditable Object Storage namespace. The namespace
+	// is a system-generated string assigned during account creation. For some older tenancies, the namespace string may be
+	// the tenancy name in all lower-case letters. You cannot edit a namespace.
+	// GetNamespace returns the name of the Object Storage namespace for the user making the request.
+	// If an optional compartmentId query parameter is provided, GetNamespace returns the namespace name of the corresponding
+	// tenancy, provided the user has access to it.
+	GetNamespace(ctx context.Context, request GetNamespaceRequest) (response GetNamespaceResponse, err error)
+
+	// GetNamespaceMetadata Gets the metadata for the Object Storage namespace, which contains defaultS3CompartmentId and
+	// defaultSwiftCompartmentId.
+	// Any user with the OBJECTSTORAGE_NAMESPACE_READ permission will be able to see the current metadata. If you are
+	// not authorized, talk to an administrator. If you are an administrator who needs to write policies
+	// to give users access, see
+	// Getting Started with Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/policygetstarted.htm).
+	GetNamespaceMetadata(ctx context.Context, request GetNamespaceMetadataRequest) (response GetNamespaceMetadataResponse, err error)
+
+	// GetObject Gets the metadata and body of an object.
+	GetObject(ctx context.Context, request GetObjectRequest) (response GetObjectResponse, err error)
+
+	// GetObjectLifecyclePolicy Gets the object lifecycle policy for the bucket.
+	GetObjectLifecyclePolicy(ctx context.Context, request GetObjectLifecyclePolicyRequest) (response GetObjectLifecyclePolicyResponse, err error)
+
+	// GetPreauthenticatedRequest Gets the pre-authenticated request for the bucket.
+	GetPreauthenticatedRequest(ctx context.Context, request GetPreauthenticatedRequestRequest) (response GetPreauthenticatedRequestResponse, err error)
+
+	// GetReplicationPolicy Get the replication policy.
+	GetReplicationPolicy(ctx context.Context, request GetReplicationPolicyRequest) (response GetReplicationPolicyResponse, err error)
+
+	// GetRetentionRule Get the specified retention rule.
+	GetRetentionRule(ctx context.Context, request GetRetentionRuleRequest) (response GetRetentionRuleResponse, err error)
+
+	// GetWorkRequest Gets the status of the work request for the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// HeadBucket Efficiently checks to see if a bucket exists and gets the current entity tag (ETag) for the bucket.
+	HeadBucket(ctx context.Context, request HeadBucketRequest) (response HeadBucketResponse, err error)
+
+	// HeadObject Gets the user-defined metadata and entity tag (ETag) for an object.
+	HeadObject(ctx context.Context, request HeadObjectRequest) (response HeadObjectResponse, err error)
+
+	// ListBuckets Gets a list of all BucketSummary items in a compartment. A BucketSummary contains only summary fields for the bucket
+	// and does not contain fields like the user-defined metadata.
+	// To use this and other API operations, you must be authorized in an IAM policy. If you are not authorized,
+	// talk to an administrator. If you are an administrator who needs to write policies to give users access, see
+	// Getting Started with Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/policygetstarted.htm).
+	ListBuckets(ctx context.Context, request ListBucketsRequest) (response ListBucketsResponse, err error)
+
+	// ListMultipartUploadParts Lists the parts of an in-progress multipart upload.
+	ListMultipartUploadParts(ctx context.Context, request ListMultipartUploadPartsRequest) (response ListMultipartUploadPartsResponse, err error)
+
+	// ListMultipartUploads Lists all of the in-progress multipart uploads for the given bucket in the given Object Storage namespace.
+	ListMultipartUploads(ctx context.Context, request ListMultipartUploadsRequest) (response ListMultipartUploadsResponse, err error)
+
+	// ListObjectVersions Lists the object versions in a bucket.
+	// To use this and other API operations, you must be authorized in an IAM policy. If you are not authorized,
+	// talk to an administrator. If you are an administrator who needs to write policies to give users access, see
+	// Getting Started with Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/policygetstarted.htm).
+	ListObjectVersions(ctx context.Context, request ListObjectVersionsRequest) (response ListObjectVersionsResponse, err error)
+
+	// ListObjects Lists the objects in a bucket.
+	// To use this and other API operations, you must be authorized in an IAM policy. If you are not authorized,
+	// talk to an administrator. If you are an administrator who needs to write policies to give users access, see
+	// Getting Started with Policies (https://docs.cloud.oracle.com/Content/Identity/Concepts/policygetstarted.htm).
+	ListObjects(ctx context.Context, request ListObjectsRequest) (response ListObjectsResponse, err error)
+
+	// ListPreauthenticatedRequests Lists pre-authenticated requests for the bucket.
+	ListPreauthenticatedRequests(ctx context.Context, request ListPreauthenticatedRequestsRequest) (response ListPreauthenticatedRequestsResponse, err error)
+
+	// ListReplicationPolicies List the replication policies associated with a bucket.
+	ListReplicationPolicies(ctx context.Context, request ListReplicationPoliciesRequest) (response ListReplicationPoliciesResponse, err error)
+
+	// ListReplicationSources List the replication sources of a destination bucket.
+	ListReplicationSources(ctx context.Context, request ListReplicationSourcesRequest) (response ListReplicationSourcesResponse, err error)
+
+	// ListRetentionRules List the retention rules for a bucket. The retention rules are sorted based on creation time,
+	// with the most recently created retention rule returned first.
+	ListRetentionRules(ctx context.Context, request ListRetentionRulesRequest) (response ListRetentionRulesResponse, err error)
+
+	// ListWorkRequestErrors Lists the errors of the work request with the given ID.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Lists the logs of the work request with the given ID.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// MakeBucketWritable Stops replication to the destination bucket and removes the replication policy. When the replication
+	// policy was created, this destination bucket became read-only except for new and changed objects replicated
+	// automatically from the source bucket. MakeBucketWritable removes the replication policy. This bucket is no
+	// longer the target for replication and is now writable, allowing users to make changes to bucket contents.
+	MakeBucketWritable(ctx context.Context, request MakeBucketWritableRequest) (response MakeBucketWritableResponse, err error)
+
+	// PutObject Creates a new object or overwrites an existing object with the same name. The maximum object size allowed by
+	// PutObject is 50 GiB.
+	// See Object Names (https://docs.cloud.oracle.com/Content/Object/Tasks/managingobjects.htm#namerequirements)
+	// for object naming requirements.
+	// See Special Instructions for Object Storage PUT (https://docs.cloud.oracle.com/Content/API/Concepts/signingrequests.htm#ObjectStoragePut)
+	// for request signature requirements.
+	PutObject(ctx context.Context, request PutObjectRequest) (response PutObjectResponse, err error)
+
+	// PutObjectLifecyclePolicy Creates or replaces the object lifecycle policy for the bucket.
+	PutObjectLifecyclePolicy(ctx context.Context, request PutObjectLifecyclePolicyRequest) (response PutObjectLifecyclePolicyResponse, err error)
+
+	// ReencryptBucket Re-encrypts the unique data encryption key that encrypts each object written to the bucket by using the most recent
+	// version of the master encryption key assigned to the bucket. (All data encryption keys are encrypted by a master
+	// encryption key. Master encryption keys are assigned to buckets and managed by Oracle by default, but you can assign
+	// a key that you created and control through the Oracle Cloud Infrastructure Key Management service.) The kmsKeyId property
+	// of the bucket determines which master encryption key is assigned to the bucket. If you assigned a different Key Management
+	// master encryption key to the bucket, you can call this API to re-encrypt all data encryption keys with the newly
+	// assigned key. Similarly, you might want to re-encrypt all data encryption keys if the assigned key has been rotated to
+	// a new key version since objects were last added to the bucket. If you call this API and there is no kmsKeyId associated
+	// with the bucket, the call will fail.
+	// Calling this API starts a work request task to re-encrypt the data encryption key of all objects in the bucket. Only
+	// objects created before the time of the API call will be re-encrypted. The call can take a long time, depending on how many
+	// objects are in the bucket and how big they are. This API returns a work request ID that you can use to retrieve the status
+	// of the work request task.
+	// All the versions of objects will be re-encrypted whether versioning is enabled or suspended at the bucket.
+	ReencryptBucket(ctx context.Context, request ReencryptBucketRequest) (response ReencryptBucketResponse, err error)
+
+	// ReencryptObject Re-encrypts the data encryption keys that encrypt the object and its chunks. By default, when you create a bucket, the Object Storage
+	// service manages the master encryption key used to encrypt each object's data encryption keys. The encryption mechanism that you specify for
+	// the bucket applies to the objects it contains.
+	// You can alternatively employ one of these encryption strategies for an object:
+	// - You can assign a key that you created and control through the Oracle Cloud Infrastructure Vault service.
+	// - You can encrypt an object using your own encryption key. The key you supply is known as a customer-provided encryption key (SSE-C).
+	ReencryptObject(ctx context.Context, request ReencryptObjectRequest) (response ReencryptObjectResponse, err error)
+
+	// RenameObject Rename an object in the given Object Storage namespace.
+	// See Object Names (https://docs.cloud.oracle.com/Content/Object/Tasks/managingobjects.htm#namerequirements)
+	// for object naming requirements.
+	RenameObject(ctx context.Context, request RenameObjectRequest) (response RenameObjectResponse, err error)
+
+	// RestoreObjects Restores one or more objects specified by the objectName parameter.
+	// By default objects will be restored for 24 hours. Duration can be configured using the hours parameter.
+	RestoreObjects(ctx context.Context, request RestoreObjectsRequest) (response RestoreObjectsResponse, err error)
+
+	// UpdateBucket Performs a partial or full update of a bucket's user-defined metadata.
+	// Use UpdateBucket to move a bucket from one compartment to another within the same tenancy. Supply the compartmentID
+	// of the compartment that you want to move the bucket to. For more information about moving resources between compartments,
+	// see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	UpdateBucket(ctx context.Context, request UpdateBucketRequest) (response UpdateBucketResponse, err error)
+
+	// UpdateNamespaceMetadata By default, buckets created using the Amazon S3 Compatibility API or the Swift API are created in the root
+	// compartment of the Oracle Cloud Infrastructure tenancy.
+	// You can change the default Swift/Amazon S3 compartmentId designation to a different compartmentId. All
+	// subsequent bucket creations will use the new default compartment, but no previously created
+	// buckets will be modified. A user must have OBJECTSTORAGE_NAMESPACE_UPDATE permission to make changes to the default
+	// compartments for Amazon S3 and Swift.
+	UpdateNamespaceMetadata(ctx context.Context, request UpdateNamespaceMetadataRequest) (response UpdateNamespaceMetadataResponse, err error)
+
+	// UpdateRetentionRule Updates the specified retention rule. Rule changes take effect typically within 30 seconds.
+	UpdateRetentionRule(ctx context.Context, request UpdateRetentionRuleRequest) (response UpdateRetentionRuleResponse, err error)
+
+	// UploadPart Uploads a single part of a multipart upload.
+	UploadPart(ctx context.Context, request UploadPartRequest) (response UploadPartResponse, err error)
+}
+
+// ObjectStorageClient implements ObjectStorageClientAPI
+var _ ObjectStorageClientAPI = ObjectStorageClient{}
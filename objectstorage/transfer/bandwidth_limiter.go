@@ -0,0 +1,132 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// maxThrottledChunkSize is the largest number of bytes a throttled reader hands out per Read call,
+// it keeps the waits short so that concurrent parts share the bandwidth fairly
+const maxThrottledChunkSize = 64 * 1024
+
+// BandwidthLimiter caps the number of bytes per second transferred by every reader attached to it.
+// A single limiter is safe for concurrent use, share it between several requests to cap the
+// combined throughput of all the transfers in a process.
+type BandwidthLimiter struct {
+	mutex          sync.Mutex
+	bytesPerSecond int64
+	available      float64 // number of bytes that can be sent without waiting, negative if already reserved
+	lastUpdated    time.Time
+}
+
+// NewBandwidthLimiter returns a pointer to a BandwidthLimiter allowing up to bytesPerSecond bytes per second
+func NewBandwidthLimiter(bytesPerSecond int64) *BandwidthLimiter {
+	return &BandwidthLimiter{
+		bytesPerSecond: bytesPerSecond,
+		available:      float64(bytesPerSecond),
+		lastUpdated:    time.Now(),
+	}
+}
+
+// BytesPerSecond returns the current limit of the bandwidth limiter
+func (limiter *BandwidthLimiter) BytesPerSecond() int64 {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	return limiter.bytesPerSecond
+}
+
+// SetBytesPerSecond changes the limit of the bandwidth limiter, transfers in progress pick up the new limit
+// for the bytes they have not reserved yet
+func (limiter *BandwidthLimiter) SetBytesPerSecond(bytesPerSecond int64) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	limiter.refill(time.Now())
+	limiter.bytesPerSecond = bytesPerSecond
+	if limiter.available > float64(bytesPerSecond) {
+		limiter.available = float64(bytesPerSecond)
+	}
+}
+
+// WaitN blocks until n bytes can be transferred without exceeding the limit, or until ctx is done
+func (limiter *BandwidthLimiter) WaitN(ctx context.Context, n int) error {
+	delay := limiter.reserve(n)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes n bytes from the bucket and returns how long the caller has to wait before sending them
+func (limiter *BandwidthLimiter) reserve(n int) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if limiter.bytesPerSecond <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	limiter.refill(now)
+	limiter.available -= float64(n)
+	if limiter.available >= 0 {
+		return 0
+	}
+
+	return time.Duration(-limiter.available / float64(limiter.bytesPerSecond) * float64(time.Second))
+}
+
+// refill adds the bytes accumulated since the last update, the bucket holds at most one second of bandwidth
+func (limiter *BandwidthLimiter) refill(now time.Time) {
+	elapsed := now.Sub(limiter.lastUpdated)
+	limiter.lastUpdated = now
+	if elapsed <= 0 {
+		return
+	}
+
+	limiter.available += elapsed.Seconds() * float64(limiter.bytesPerSecond)
+	if limiter.available > float64(limiter.bytesPerSecond) {
+		limiter.available = float64(limiter.bytesPerSecond)
+	}
+}
+
+// throttledReader is an io.Reader which waits on a BandwidthLimiter before handing out the bytes it reads
+type throttledReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *BandwidthLimiter
+}
+
+// newThrottledReader wraps the reader with the limiter, the reader is returned as is if limiter is nil
+func newThrottledReader(ctx context.Context, reader io.Reader, limiter *BandwidthLimiter) io.Reader {
+	if limiter == nil {
+		return reader
+	}
+	return &throttledReader{ctx: ctx, reader: reader, limiter: limiter}
+}
+
+func (reader *throttledReader) Read(p []byte) (n int, err error) {
+	if len(p) > maxThrottledChunkSize {
+		p = p[:maxThrottledChunkSize]
+	}
+
+	n, err = reader.reader.Read(p)
+	if n > 0 {
+		if waitErr := reader.limiter.WaitN(reader.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return
+}
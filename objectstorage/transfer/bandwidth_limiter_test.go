@@ -0,0 +1,56 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBandwidthLimiter_Reserve(t *testing.T) {
+	limiter := NewBandwidthLimiter(1000)
+
+	// the bucket starts full
+	assert.Equal(t, time.Duration(0), limiter.reserve(1000))
+
+	// the next bytes have to wait for the bucket to refill
+	delay := limiter.reserve(500)
+	assert.True(t, delay > 400*time.Millisecond && delay <= 500*time.Millisecond, "unexpected delay %v", delay)
+
+	// reservations queue up behind each other
+	delay = limiter.reserve(500)
+	assert.True(t, delay > 900*time.Millisecond && delay <= time.Second, "unexpected delay %v", delay)
+}
+
+func TestBandwidthLimiter_WaitNCancelled(t *testing.T) {
+	limiter := NewBandwidthLimiter(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, limiter.WaitN(ctx, 10))
+	assert.Equal(t, context.Canceled, limiter.WaitN(ctx, 10))
+}
+
+func TestThrottledReader(t *testing.T) {
+	content := make([]byte, 3*maxThrottledChunkSize)
+	limiter := NewBandwidthLimiter(20 * maxThrottledChunkSize)
+
+	start := time.Now()
+	reader := newThrottledReader(context.Background(), bytes.NewReader(content), limiter)
+	read, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+
+	// the content fits in the initial bucket, no wait is expected
+	assert.True(t, time.Since(start) < time.Second)
+
+	// a nil limiter does not wrap the reader
+	plain := bytes.NewReader(content)
+	assert.Equal(t, plain, newThrottledReader(context.Background(), plain, nil))
+}
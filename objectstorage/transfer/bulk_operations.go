@@ -0,0 +1,294 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+// bulkOperation processes a single object, it returns the id of the last request made for it
+type bulkOperation func(ctx context.Context, object BulkObject) (opcRequestID *string, err error)
+
+// objectLister calls visit for every object to process until visit returns false
+type objectLister func(visit func(object BulkObject) bool) error
+
+// BulkDelete deletes the objects given by the request, or all the objects of the bucket matching its prefix. Objects
+// which no longer exist are reported as deleted. The returned error is only set if the objects could not be listed or
+// ctx is done, the failures of single objects are reported by the outcomes of the response.
+func BulkDelete(ctx context.Context, request BulkDeleteRequest) (response BulkResponse, err error) {
+	if err = request.validate(); err != nil {
+		return
+	}
+
+	if err = request.initDefaultValues(); err != nil {
+		return
+	}
+
+	response.Outcomes, err = runBulkOperation(ctx, *request.MaxConcurrency, request.listObjects(ctx), func(ctx context.Context, object BulkObject) (*string, error) {
+		resp, err := request.ObjectStorageClient.DeleteObject(ctx, objectstorage.DeleteObjectRequest{
+			NamespaceName:      request.NamespaceName,
+			BucketName:         request.BucketName,
+			ObjectName:         common.String(object.Name),
+			VersionId:          object.VersionId,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+		return resp.OpcRequestId, ignoreNotFound(err)
+	})
+	if err != nil || request.AbortMultipartUploads == nil || !*request.AbortMultipartUploads {
+		return
+	}
+
+	aborted, err := runBulkOperation(ctx, *request.MaxConcurrency, request.listMultipartUploads(ctx), func(ctx context.Context, upload BulkObject) (*string, error) {
+		resp, err := request.ObjectStorageClient.AbortMultipartUpload(ctx, objectstorage.AbortMultipartUploadRequest{
+			NamespaceName:      request.NamespaceName,
+			BucketName:         request.BucketName,
+			ObjectName:         common.String(upload.Name),
+			UploadId:           upload.UploadId,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+		return resp.OpcRequestId, ignoreNotFound(err)
+	})
+	response.Outcomes = append(response.Outcomes, aborted...)
+	return
+}
+
+// BulkRestore restores the archived objects given by the request, or all the objects of the bucket matching its
+// prefix. The returned error is only set if the objects could not be listed or ctx is done, the failures of single
+// objects are reported by the outcomes of the response.
+func BulkRestore(ctx context.Context, request BulkRestoreRequest) (response BulkResponse, err error) {
+	if err = request.validate(); err != nil {
+		return
+	}
+
+	if err = request.initDefaultValues(); err != nil {
+		return
+	}
+
+	response.Outcomes, err = runBulkOperation(ctx, *request.MaxConcurrency, request.listObjects(ctx), func(ctx context.Context, object BulkObject) (*string, error) {
+		resp, err := request.ObjectStorageClient.RestoreObjects(ctx, objectstorage.RestoreObjectsRequest{
+			NamespaceName: request.NamespaceName,
+			BucketName:    request.BucketName,
+			RestoreObjectsDetails: objectstorage.RestoreObjectsDetails{
+				ObjectName: common.String(object.Name),
+				VersionId:  object.VersionId,
+				Hours:      request.Hours,
+			},
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+		return resp.OpcRequestId, err
+	})
+	return
+}
+
+// runBulkOperation runs the operation on the listed objects, at most concurrency at a time. The outcomes are in the
+// order of the listing, the objects not processed because ctx is done are left out.
+func runBulkOperation(ctx context.Context, concurrency int, list objectLister, operation bulkOperation) ([]BulkObjectOutcome, error) {
+	var (
+		outcomes  []BulkObjectOutcome
+		mutex     sync.Mutex
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, concurrency)
+	)
+
+	err := list(func(object BulkObject) bool {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return false
+		}
+
+		mutex.Lock()
+		outcomes = append(outcomes, BulkObjectOutcome{BulkObject: object})
+		index := len(outcomes) - 1
+		mutex.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			opcRequestID, err := operation(ctx, object)
+			if err != nil {
+				common.Debugf("bulk operation failed for object %s: %v\n", object.Name, err)
+			}
+
+			mutex.Lock()
+			outcomes[index].OpcRequestId = opcRequestID
+			outcomes[index].Err = err
+			mutex.Unlock()
+		}()
+		return true
+	})
+	wg.Wait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	return outcomes, err
+}
+
+// listObjects returns the lister of the objects of the request: the given objects, or the objects or versions
+// matching the prefix
+func (request BulkRequest) listObjects(ctx context.Context) objectLister {
+	if len(request.Objects) > 0 {
+		return func(visit func(object BulkObject) bool) error {
+			for _, object := range request.Objects {
+				if !visit(object) {
+					return nil
+				}
+			}
+			return nil
+		}
+	}
+
+	if *request.IncludeAllVersions {
+		return request.listObjectVersions(ctx)
+	}
+
+	return func(visit func(object BulkObject) bool) error {
+		listRequest := objectstorage.ListObjectsRequest{
+			NamespaceName:      request.NamespaceName,
+			BucketName:         request.BucketName,
+			Prefix:             request.Prefix,
+			Fields:             common.String("name,timeModified"),
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		}
+
+		for {
+			resp, err := request.ObjectStorageClient.ListObjects(ctx, listRequest)
+			if err != nil {
+				return err
+			}
+
+			for _, summary := range resp.Objects {
+				object := BulkObject{Name: *summary.Name, TimeModified: summary.TimeModified}
+				if request.Filter != nil && !request.Filter(object) {
+					continue
+				}
+
+				if !visit(object) {
+					return nil
+				}
+			}
+
+			if resp.NextStartWith == nil {
+				return nil
+			}
+			listRequest.Start = resp.NextStartWith
+		}
+	}
+}
+
+// listObjectVersions returns the lister of all the versions of the objects matching the prefix
+func (request BulkRequest) listObjectVersions(ctx context.Context) objectLister {
+	return func(visit func(object BulkObject) bool) error {
+		listRequest := objectstorage.ListObjectVersionsRequest{
+			NamespaceName:      request.NamespaceName,
+			BucketName:         request.BucketName,
+			Prefix:             request.Prefix,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		}
+
+		for {
+			resp, err := request.ObjectStorageClient.ListObjectVersions(ctx, listRequest)
+			if err != nil {
+				return err
+			}
+
+			for _, summary := range resp.Items {
+				object := BulkObject{
+					Name:           *summary.Name,
+					VersionId:      summary.VersionId,
+					TimeModified:   summary.TimeModified,
+					IsDeleteMarker: summary.IsDeleteMarker != nil && *summary.IsDeleteMarker,
+				}
+				if request.Filter != nil && !request.Filter(object) {
+					continue
+				}
+
+				if !visit(object) {
+					return nil
+				}
+			}
+
+			if resp.OpcNextPage == nil {
+				return nil
+			}
+			listRequest.Page = resp.OpcNextPage
+		}
+	}
+}
+
+// listMultipartUploads returns the lister of the incomplete multipart uploads of the objects of the request. The
+// uploads can not be listed by prefix, all the uploads of the bucket are listed and filtered.
+func (request BulkRequest) listMultipartUploads(ctx context.Context) objectLister {
+	names := make(map[string]bool, len(request.Objects))
+	for _, object := range request.Objects {
+		names[object.Name] = true
+	}
+
+	isSelected := func(name string) bool {
+		if len(names) > 0 {
+			return names[name]
+		}
+		return request.Prefix == nil || strings.HasPrefix(name, *request.Prefix)
+	}
+
+	return func(visit func(object BulkObject) bool) error {
+		listRequest := objectstorage.ListMultipartUploadsRequest{
+			NamespaceName:      request.NamespaceName,
+			BucketName:         request.BucketName,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		}
+
+		for {
+			resp, err := request.ObjectStorageClient.ListMultipartUploads(ctx, listRequest)
+			if err != nil {
+				return err
+			}
+
+			for _, upload := range resp.Items {
+				if !isSelected(*upload.Object) {
+					continue
+				}
+
+				object := BulkObject{Name: *upload.Object, UploadId: upload.UploadId, TimeModified: upload.TimeCreated}
+				if request.Filter != nil && !request.Filter(object) {
+					continue
+				}
+
+				if !visit(object) {
+					return nil
+				}
+			}
+
+			if resp.OpcNextPage == nil {
+				return nil
+			}
+			listRequest.Page = resp.OpcNextPage
+		}
+	}
+}
+
+// ignoreNotFound treats the objects and uploads already gone as deleted
+func ignoreNotFound(err error) error {
+	if serviceError, ok := common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+// BulkObject identifies an object, or a version of an object, processed by a bulk operation
+type BulkObject struct {
+	// The name of the object.
+	Name string
+
+	// [Optional] The version of the object, the latest version is processed if not set.
+	VersionId *string
+
+	// The identifier of the incomplete multipart upload, only set for the uploads aborted by BulkDelete.
+	UploadId *string
+
+	// The last modification time of the object or version, only set for listed objects.
+	TimeModified *common.SDKTime
+
+	// Whether the version is a delete marker, only set for listed versions.
+	IsDeleteMarker bool
+}
+
+// BulkRequest defines the objects processed by a bulk operation and how they are processed
+type BulkRequest struct {
+	// The top-level namespace used for the request.
+	NamespaceName *string `mandatory:"true"`
+
+	// The name of the bucket. Avoid entering confidential information. Example: my-new-bucket1
+	BucketName *string `mandatory:"true"`
+
+	// [Optional] The prefix of the names of the objects to process, all the objects of the bucket are processed
+	// if neither Prefix nor Objects is set.
+	Prefix *string `mandatory:"false"`
+
+	// [Optional] The objects to process. When set, the bucket is not listed and Prefix and IncludeAllVersions are ignored.
+	Objects []BulkObject `mandatory:"false"`
+
+	// [Optional] Whether or not all the versions of the objects are processed, including the delete markers.
+	// Only the latest versions are processed otherwise. Defaults to False.
+	IncludeAllVersions *bool `mandatory:"false"`
+
+	// [Optional] Selects the listed objects or versions to process, for example the versions older than a given date.
+	// All the listed objects are processed if not set.
+	Filter func(object BulkObject) bool `mandatory:"false"`
+
+	// [Optional] The maximum number of objects processed at the same time. Defaults to 10.
+	MaxConcurrency *int `mandatory:"false"`
+
+	// A configured object storage client to use for interacting with the Object Storage service.
+	ObjectStorageClient *objectstorage.ObjectStorageClient `mandatory:"false"`
+
+	// [Optional] The client request ID for tracing.
+	OpcClientRequestID *string `mandatory:"false"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior. Each object is retried on its
+	// own, throttling, server side errors and broken connections are retried 3 times by default.
+	RequestMetadata common.RequestMetadata
+}
+
+// BulkDeleteRequest defines the input parameters for BulkDelete
+type BulkDeleteRequest struct {
+	BulkRequest
+
+	// [Optional] Whether or not the incomplete multipart uploads of the objects matching Prefix, or Objects, are
+	// aborted as well. Defaults to False.
+	AbortMultipartUploads *bool `mandatory:"false"`
+}
+
+// BulkRestoreRequest defines the input parameters for BulkRestore
+type BulkRestoreRequest struct {
+	BulkRequest
+
+	// [Optional] The number of hours for which the restored objects are available for reading. Defaults to 24 hours.
+	Hours *int `mandatory:"false"`
+}
+
+// BulkObjectOutcome is the result of the operation on a single object
+type BulkObjectOutcome struct {
+	BulkObject
+
+	// Unique Oracle-assigned identifier for the last request made for the object, if any.
+	OpcRequestId *string
+
+	// The error of the operation, nil if it succeeded.
+	Err error
+}
+
+// BulkResponse reports the outcome of a bulk operation for every object, in the order the objects were given or listed
+type BulkResponse struct {
+	Outcomes []BulkObjectOutcome
+}
+
+// Failed returns the outcomes of the objects the operation failed for
+func (response BulkResponse) Failed() []BulkObjectOutcome {
+	var failed []BulkObjectOutcome
+	for _, outcome := range response.Outcomes {
+		if outcome.Err != nil {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+var errorInvalidMaxConcurrency = errors.New("maxConcurrency must be greater than 0")
+
+const defaultBulkMaxConcurrency = 10
+
+func (request BulkRequest) validate() error {
+	if request.NamespaceName == nil {
+		return errorInvalidNamespace
+	}
+
+	if request.BucketName == nil {
+		return errorInvalidBucketName
+	}
+
+	for _, object := range request.Objects {
+		if len(object.Name) == 0 {
+			return errorInvalidObjectName
+		}
+	}
+
+	if request.MaxConcurrency != nil && *request.MaxConcurrency <= 0 {
+		return errorInvalidMaxConcurrency
+	}
+
+	return nil
+}
+
+func (request *BulkRequest) initDefaultValues() error {
+	if request.ObjectStorageClient == nil {
+		client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(common.DefaultConfigProvider())
+		if err != nil {
+			return err
+		}
+		request.ObjectStorageClient = &client
+	}
+
+	if request.MaxConcurrency == nil {
+		request.MaxConcurrency = common.Int(defaultBulkMaxConcurrency)
+	}
+
+	if request.IncludeAllVersions == nil {
+		request.IncludeAllVersions = common.Bool(false)
+	}
+
+	if request.RequestMetadata.RetryPolicy == nil {
+		request.RequestMetadata.RetryPolicy = getBulkRetryPolicy()
+	}
+
+	return nil
+}
+
+func (request *BulkRestoreRequest) initDefaultValues() error {
+	if request.Hours == nil {
+		request.Hours = common.Int(24)
+	}
+	return request.BulkRequest.initDefaultValues()
+}
+
+// getBulkRetryPolicy retries the transient failures only, unlike the upload policy a missing object or a conflict
+// is final
+func getBulkRetryPolicy() *common.RetryPolicy {
+	attempts := uint(3)
+	retryOnTransientErrors := func(r common.OCIOperationResponse) bool {
+		if r.Error == nil {
+			return false
+		}
+
+		if serviceError, ok := common.IsServiceError(r.Error); ok {
+			statusCode := serviceError.GetHTTPStatusCode()
+			return isThrottlingStatusCode(statusCode) || statusCode >= http.StatusInternalServerError
+		}
+		return true
+	}
+
+	exponentialBackoff := func(r common.OCIOperationResponse) time.Duration {
+		return time.Duration(math.Pow(float64(2), float64(r.AttemptNumber-1))) * time.Second
+	}
+	policy := common.NewRetryPolicy(attempts, retryOnTransientErrors, exponentialBackoff)
+
+	return &policy
+}
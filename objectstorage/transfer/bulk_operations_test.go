@@ -0,0 +1,178 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// failingDeletes serves the requests with the handler of a fake control plane, except the deletions of the objects
+// named "fail" which fail. The objects are listed two at a time.
+func failingDeletes(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/fail") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":"InvalidParameter","message":"failed"}`)
+			return
+		}
+
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/o") {
+			query := r.URL.Query()
+			query.Set("limit", "2")
+			r.URL.RawQuery = query.Encode()
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// objectNames returns the names of the objects of the bucket of newTestBucket
+func objectNames(t *testing.T, server *ocitest.ControlPlaneServer, client objectstorage.ObjectStorageClient) []string {
+	resp, err := client.ListObjects(context.Background(), objectstorage.ListObjectsRequest{
+		NamespaceName: common.String(server.Namespace),
+		BucketName:    common.String("bucket1"),
+	})
+	assert.NoError(t, err)
+
+	var names []string
+	for _, object := range resp.Objects {
+		names = append(names, *object.Name)
+	}
+	return names
+}
+
+func TestBulkDelete(t *testing.T) {
+	controlPlane := ocitest.NewControlPlaneServer()
+	defer controlPlane.Close()
+	content := []byte("content")
+	controlPlaneClient := newTestBucket(t, controlPlane, map[string][]byte{"a/1": content, "a/2": content, "a/3": content, "a/fail": content, "b/1": content})
+
+	uploadIDs := make(map[string]string)
+	for _, name := range []string{"a/4", "b/2"} {
+		resp, err := controlPlaneClient.CreateMultipartUpload(context.Background(), objectstorage.CreateMultipartUploadRequest{
+			NamespaceName:                common.String(controlPlane.Namespace),
+			BucketName:                   common.String("bucket1"),
+			CreateMultipartUploadDetails: objectstorage.CreateMultipartUploadDetails{Object: common.String(name)},
+		})
+		assert.NoError(t, err)
+		uploadIDs[name] = *resp.UploadId
+	}
+
+	server := ocitest.NewServer(failingDeletes(controlPlane.Config.Handler))
+	defer server.Close()
+	client := objectstorage.ObjectStorageClient{BaseClient: server.BaseClient()}
+	response, err := BulkDelete(context.Background(), BulkDeleteRequest{
+		BulkRequest: BulkRequest{
+			NamespaceName:       common.String(controlPlane.Namespace),
+			BucketName:          common.String("bucket1"),
+			Prefix:              common.String("a/"),
+			ObjectStorageClient: &client,
+			MaxConcurrency:      common.Int(2),
+		},
+		AbortMultipartUploads: common.Bool(true),
+	})
+	assert.NoError(t, err)
+
+	var names []string
+	for _, outcome := range response.Outcomes {
+		names = append(names, outcome.Name)
+	}
+	assert.Equal(t, []string{"a/1", "a/2", "a/3", "a/fail", "a/4"}, names)
+	assert.Equal(t, uploadIDs["a/4"], *response.Outcomes[4].UploadId)
+
+	failed := response.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "a/fail", failed[0].Name)
+	serviceError, ok := common.IsServiceError(failed[0].Err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, serviceError.GetHTTPStatusCode())
+
+	assert.Equal(t, []string{"a/fail", "b/1"}, objectNames(t, controlPlane, controlPlaneClient))
+	uploads, err := controlPlaneClient.ListMultipartUploads(context.Background(), objectstorage.ListMultipartUploadsRequest{
+		NamespaceName: common.String(controlPlane.Namespace),
+		BucketName:    common.String("bucket1"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, uploads.Items, 1)
+	assert.Equal(t, uploadIDs["b/2"], *uploads.Items[0].UploadId)
+}
+
+func TestBulkDelete_Filter(t *testing.T) {
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	content := []byte("content")
+	client := newTestBucket(t, server, map[string][]byte{"a/1": content, "a/2": content, "a/3": content})
+
+	response, err := BulkDelete(context.Background(), BulkDeleteRequest{BulkRequest: BulkRequest{
+		NamespaceName:       common.String(server.Namespace),
+		BucketName:          common.String("bucket1"),
+		ObjectStorageClient: &client,
+		Filter: func(object BulkObject) bool {
+			return object.Name != "a/2"
+		},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, response.Outcomes, 2)
+	assert.Empty(t, response.Failed())
+	assert.Equal(t, []string{"a/2"}, objectNames(t, server, client))
+}
+
+func TestBulkRestore(t *testing.T) {
+	// the fake control plane does not archive objects, the restore requests are only recorded
+	var mutex sync.Mutex
+	var requests []string
+	server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mutex.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		mutex.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	client := objectstorage.ObjectStorageClient{BaseClient: server.BaseClient()}
+
+	response, err := BulkRestore(context.Background(), BulkRestoreRequest{
+		BulkRequest: BulkRequest{
+			NamespaceName:       common.String("ns"),
+			BucketName:          common.String("bucket"),
+			Objects:             []BulkObject{{Name: "a/1"}, {Name: "a/2", VersionId: common.String("v1")}},
+			ObjectStorageClient: &client,
+			MaxConcurrency:      common.Int(2),
+		},
+		Hours: common.Int(48),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, response.Outcomes, 2)
+	assert.Empty(t, response.Failed())
+
+	sort.Strings(requests)
+	assert.Equal(t, []string{
+		`POST /n/ns/b/bucket/actions/restoreObjects {"hours":48,"objectName":"a/1"}`,
+		`POST /n/ns/b/bucket/actions/restoreObjects {"hours":48,"objectName":"a/2","versionId":"v1"}`,
+	}, requests)
+}
+
+func TestBulkRequest_Validate(t *testing.T) {
+	request := BulkRequest{NamespaceName: common.String("ns"), BucketName: common.String("bucket")}
+	assert.NoError(t, request.validate())
+
+	request.Objects = []BulkObject{{}}
+	assert.Equal(t, errorInvalidObjectName, request.validate())
+
+	request.Objects = nil
+	request.MaxConcurrency = common.Int(0)
+	assert.Equal(t, errorInvalidMaxConcurrency, request.validate())
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/oracle/oci-go-sdk/v27/keymanagement/envelope"
+)
+
+var (
+	errorInvalidEncryptor = errors.New("encryptor is required, use envelope.NewEncryptor to create it")
+	errorInvalidDecryptor = errors.New("decryptor is required, use envelope.NewDecryptor to create it")
+)
+
+// EncryptionManager encrypts objects on the client side before uploading them, and decrypts them when reading them.
+// The content of an object is a stream envelope of the keymanagement/envelope package: the data encryption key
+// wrapped by a master key of a vault and the parameters of the encryption are stored in the header of the content,
+// followed by the data encrypted with AES-256-GCM in chunks, which allows multipart uploads of streams and ranged
+// reads. The Encryptor and the Decryptor may be shared with other users of envelope encryption, they cache the data
+// encryption keys.
+type EncryptionManager struct {
+	UploadManager *UploadManager
+
+	// The encryptor of the uploaded objects, required to upload objects.
+	Encryptor *envelope.Encryptor
+
+	// The decryptor of the objects read, required to read objects.
+	Decryptor *envelope.Decryptor
+}
+
+// NewEncryptionManager returns a pointer to an EncryptionManager using the default UploadManager. Either the
+// encryptor or the decryptor may be nil if the manager only reads or only uploads objects.
+func NewEncryptionManager(encryptor *envelope.Encryptor, decryptor *envelope.Decryptor) *EncryptionManager {
+	return &EncryptionManager{
+		UploadManager: NewUploadManager(),
+		Encryptor:     encryptor,
+		Decryptor:     decryptor,
+	}
+}
+
+// UploadFile encrypts and uploads a file to Object Storage. Encrypted files are uploaded as streams, the
+// upload is not resumable.
+func (manager *EncryptionManager) UploadFile(ctx context.Context, request UploadFileRequest) (response UploadResponse, err error) {
+	if err = request.validate(); err != nil {
+		return
+	}
+
+	file, err := os.Open(request.FilePath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	return manager.UploadStream(ctx, UploadStreamRequest{UploadRequest: request.UploadRequest, StreamReader: file})
+}
+
+// UploadStream encrypts and uploads streaming data to Object Storage. The ContentMD5 of the request is ignored,
+// since the uploaded content is the encrypted data.
+func (manager *EncryptionManager) UploadStream(ctx context.Context, request UploadStreamRequest) (response UploadResponse, err error) {
+	if err = request.validate(); err != nil {
+		return
+	}
+
+	if manager.Encryptor == nil {
+		err = errorInvalidEncryptor
+		return
+	}
+
+	if manager.UploadManager == nil {
+		err = errorInvalidStreamUploader
+		return
+	}
+
+	encrypted, err := manager.Encryptor.EncryptStream(ctx, request.StreamReader, nil)
+	if err != nil {
+		return
+	}
+
+	request.ContentMD5 = nil
+	request.StreamReader = encrypted
+	return manager.UploadManager.UploadStream(ctx, request)
+}
+
+// NewObjectReader returns a reader of the plaintext of an object encrypted by UploadFile or UploadStream.
+func (manager *EncryptionManager) NewObjectReader(ctx context.Context, request ObjectReaderRequest) (reader *DecryptedObjectReader, err error) {
+	if manager.Decryptor == nil {
+		err = errorInvalidDecryptor
+		return
+	}
+
+	encrypted, err := NewObjectReader(ctx, request)
+	if err != nil {
+		return
+	}
+
+	stream, err := manager.Decryptor.NewStreamReader(ctx, encrypted)
+	if err != nil {
+		encrypted.Close()
+		return
+	}
+	return &DecryptedObjectReader{StreamReader: stream, object: encrypted}, nil
+}
+
+// DecryptedObjectReader reads the plaintext of an object encrypted on the client side, see envelope.StreamReader.
+// It implements io.ReadSeeker, io.ReaderAt and io.Closer, ranges of the plaintext are read by fetching only the
+// chunks they span. DecryptedObjectReader is not safe for concurrent use, except for ReadAt.
+type DecryptedObjectReader struct {
+	*envelope.StreamReader
+	object *ObjectReader
+}
+
+// Close closes the reader of the encrypted object
+func (reader *DecryptedObjectReader) Close() error {
+	return reader.object.Close()
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement/envelope"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+func TestEncryptionManager_UploadAndRead(t *testing.T) {
+	vault := ocitest.NewVaultServer()
+	defer vault.Close()
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	management := keymanagement.KmsManagementClient{BaseClient: vault.BaseClient()}
+	crypto := keymanagement.KmsCryptoClient{BaseClient: vault.BaseClient()}
+	key, err := management.CreateKey(ctx, keymanagement.CreateKeyRequest{CreateKeyDetails: keymanagement.CreateKeyDetails{
+		CompartmentId: common.String("compartment1"),
+		DisplayName:   common.String("key1"),
+		KeyShape:      &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(32)},
+	}})
+	assert.NoError(t, err)
+
+	encryptor, err := envelope.NewEncryptor(crypto, envelope.EncryptorConfiguration{KeyId: key.Id})
+	assert.NoError(t, err)
+	decryptor, err := envelope.NewDecryptor(func(string) (keymanagement.KmsCryptoClient, error) {
+		return crypto, nil
+	}, envelope.DecryptorConfiguration{AllowedCryptoEndpoints: []string{crypto.Host}})
+	assert.NoError(t, err)
+	manager := NewEncryptionManager(encryptor, decryptor)
+
+	client := objectstorage.ObjectStorageClient{BaseClient: server.BaseClient()}
+	_, err = client.CreateBucket(ctx, objectstorage.CreateBucketRequest{
+		NamespaceName:       common.String(server.Namespace),
+		CreateBucketDetails: objectstorage.CreateBucketDetails{Name: common.String("bucket1"), CompartmentId: common.String("compartment1")},
+	})
+	assert.NoError(t, err)
+
+	plaintext := make([]byte, 300000)
+	rand.Read(plaintext)
+	_, err = manager.UploadStream(ctx, UploadStreamRequest{
+		UploadRequest: UploadRequest{
+			NamespaceName:       common.String(server.Namespace),
+			BucketName:          common.String("bucket1"),
+			ObjectName:          common.String("obj"),
+			PartSize:            common.Int64(100000),
+			Metadata:            map[string]string{"owner": "me"},
+			ObjectStorageClient: &client,
+		},
+		StreamReader: bytes.NewReader(plaintext),
+	})
+	assert.NoError(t, err)
+
+	// the object is a stream envelope
+	object, err := client.GetObject(ctx, objectstorage.GetObjectRequest{
+		NamespaceName: common.String(server.Namespace),
+		BucketName:    common.String("bucket1"),
+		ObjectName:    common.String("obj"),
+	})
+	assert.NoError(t, err)
+	content, _ := ioutil.ReadAll(object.Content)
+	assert.Equal(t, "me", object.OpcMeta["owner"])
+	assert.False(t, bytes.Contains(content, plaintext[:64]))
+	parsed, err := envelope.ParseEnvelope(content)
+	assert.NoError(t, err)
+	assert.Equal(t, *key.Id, parsed.KeyId)
+	assert.Equal(t, envelope.AlgorithmAES256GCMChunked, parsed.Algorithm)
+
+	reader, err := manager.NewObjectReader(ctx, ObjectReaderRequest{
+		NamespaceName:       common.String(server.Namespace),
+		BucketName:          common.String("bucket1"),
+		ObjectName:          common.String("obj"),
+		ObjectStorageClient: &client,
+	})
+	assert.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, int64(len(plaintext)), reader.Size())
+
+	buffer := make([]byte, 100000)
+	n, err := reader.ReadAt(buffer, 150000)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext[150000:250000], buffer[:n])
+
+	_, err = reader.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	decrypted, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(plaintext, decrypted))
+}
+
+func TestEncryptionManager_RequiresEncryptorAndDecryptor(t *testing.T) {
+	manager := NewEncryptionManager(nil, nil)
+	_, err := manager.UploadStream(context.Background(), UploadStreamRequest{
+		UploadRequest: UploadRequest{
+			NamespaceName:       common.String("ns"),
+			BucketName:          common.String("bucket1"),
+			ObjectName:          common.String("obj"),
+			ObjectStorageClient: &objectstorage.ObjectStorageClient{},
+		},
+		StreamReader: bytes.NewReader(nil),
+	})
+	assert.Equal(t, errorInvalidEncryptor, err)
+
+	_, err = manager.NewObjectReader(context.Background(), ObjectReaderRequest{
+		NamespaceName: common.String("ns"),
+		BucketName:    common.String("bucket1"),
+		ObjectName:    common.String("obj"),
+	})
+	assert.Equal(t, errorInvalidDecryptor, err)
+}
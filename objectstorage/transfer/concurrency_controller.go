@@ -0,0 +1,211 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// throttleCooldown is the minimum time between two reductions of the concurrency limit, it prevents a burst
+// of throttled responses from in-flight parts to collapse the limit to one in a single go
+const throttleCooldown = time.Second
+
+// concurrencyController adapts the number of parts uploaded in parallel to the responses of the service.
+// The limit is halved when the service throttles the requests (429 or 503), and grows by one part
+// once a whole window of parts, as large as the current limit, succeeded without being throttled.
+// The callers of acquire wait on changed, which is closed and replaced when a slot is released or the limit grows.
+type concurrencyController struct {
+	mutex         sync.Mutex
+	changed       chan struct{}
+	limit         int
+	maxLimit      int
+	inFlight      int
+	successes     int
+	lastThrottled time.Time
+}
+
+// newConcurrencyController returns a controller allowing up to maxLimit parts in flight, it starts at the max limit
+func newConcurrencyController(maxLimit int) *concurrencyController {
+	if maxLimit < 1 {
+		maxLimit = 1
+	}
+	return &concurrencyController{changed: make(chan struct{}), limit: maxLimit, maxLimit: maxLimit}
+}
+
+// currentLimit returns the number of parts that can currently be in flight
+func (controller *concurrencyController) currentLimit() int {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	return controller.limit
+}
+
+// acquire blocks until a part can be uploaded within the current limit, it returns false if done is closed first
+func (controller *concurrencyController) acquire(done <-chan struct{}) bool {
+	for {
+		controller.mutex.Lock()
+		if controller.inFlight < controller.limit {
+			controller.inFlight++
+			controller.mutex.Unlock()
+			return true
+		}
+		changed := controller.changed
+		controller.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-done:
+			return false
+		}
+	}
+}
+
+// release frees the slot taken by acquire
+func (controller *concurrencyController) release() {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	controller.inFlight--
+	controller.notifyChanged()
+}
+
+// notifyChanged wakes up the callers of acquire, the mutex must be held
+func (controller *concurrencyController) notifyChanged() {
+	close(controller.changed)
+	controller.changed = make(chan struct{})
+}
+
+// onThrottled halves the limit, at most once per cooldown period
+func (controller *concurrencyController) onThrottled() {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	controller.successes = 0
+	now := time.Now()
+	if now.Sub(controller.lastThrottled) < throttleCooldown {
+		return
+	}
+	controller.lastThrottled = now
+	controller.limit = controller.limit / 2
+	if controller.limit < 1 {
+		controller.limit = 1
+	}
+	common.Debugf("upload throttled, reducing the number of parallel parts to %v\n", controller.limit)
+}
+
+// onSuccess grows the limit by one after a window of successful requests
+func (controller *concurrencyController) onSuccess() {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+	controller.successes++
+	if controller.successes < controller.limit || controller.limit >= controller.maxLimit {
+		return
+	}
+	controller.successes = 0
+	controller.limit++
+	controller.notifyChanged()
+	common.Debugf("upload not throttled, increasing the number of parallel parts to %v\n", controller.limit)
+}
+
+// observe reports the outcome of a single request attempt to the controller
+func (controller *concurrencyController) observe(response common.OCIOperationResponse) {
+	if isThrottled(response) {
+		controller.onThrottled()
+		return
+	}
+	if response.Error == nil {
+		controller.onSuccess()
+	}
+}
+
+// wrapRetryPolicy returns a copy of the policy that reports every attempt to the controller before deciding to
+// retry, so the limit reacts to throttling even when the retry eventually succeeds
+func (controller *concurrencyController) wrapRetryPolicy(policy *common.RetryPolicy) *common.RetryPolicy {
+	wrapped := common.NoRetryPolicy()
+	if policy != nil {
+		wrapped = *policy
+	}
+
+	shouldRetryOperation := wrapped.ShouldRetryOperation
+	wrapped.ShouldRetryOperation = func(response common.OCIOperationResponse) bool {
+		controller.observe(response)
+		return shouldRetryOperation(response)
+	}
+	return &wrapped
+}
+
+// gate forwards the parts one by one, each of them only once a slot is available
+func (controller *concurrencyController) gate(done <-chan struct{}, parts <-chan uploadPart) <-chan uploadPart {
+	gatedParts := make(chan uploadPart)
+	go func() {
+		defer close(gatedParts)
+		for part := range parts {
+			if !controller.acquire(done) {
+				return
+			}
+			select {
+			case gatedParts <- part:
+			case <-done:
+				controller.release()
+				return
+			}
+		}
+	}()
+	return gatedParts
+}
+
+// relay forwards the uploaded parts to result, releasing the slot of each of them, and closes result
+// once uploaded is closed. Once done is closed, the parts are no longer forwarded, but their slots are
+// still released until uploaded is closed, so that neither gate nor the upload go routines block.
+func (controller *concurrencyController) relay(done <-chan struct{}, uploaded <-chan uploadPart, result chan<- uploadPart) {
+	go func() {
+		defer close(result)
+		for part := range uploaded {
+			controller.release()
+			select {
+			case result <- part:
+			case <-done:
+				for range uploaded {
+					controller.release()
+				}
+				return
+			}
+		}
+	}()
+}
+
+// adaptConcurrency puts a concurrencyController between the parts and the upload go routines if adaptive concurrency
+// is enabled. It returns the parts to upload and the channel the go routines send the uploaded parts to, the caller
+// closes that channel once all the go routines returned.
+func adaptConcurrency(done <-chan struct{}, parts <-chan uploadPart, result chan uploadPart, request *UploadRequest) (<-chan uploadPart, chan uploadPart) {
+	if !isAdaptiveConcurrencyEnabled(*request) {
+		return parts, result
+	}
+
+	controller := newConcurrencyController(*request.NumberOfGoroutines)
+	request.RequestMetadata.RetryPolicy = controller.wrapRetryPolicy(request.RetryPolicy())
+	uploaded := make(chan uploadPart)
+	controller.relay(done, uploaded, result)
+	return controller.gate(done, parts), uploaded
+}
+
+func isThrottled(response common.OCIOperationResponse) bool {
+	if serviceError, ok := common.IsServiceError(response.Error); ok {
+		return isThrottlingStatusCode(serviceError.GetHTTPStatusCode())
+	}
+
+	if response.Response != nil && response.Response.HTTPResponse() != nil {
+		return isThrottlingStatusCode(response.Response.HTTPResponse().StatusCode)
+	}
+	return false
+}
+
+func isThrottlingStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+func isAdaptiveConcurrencyEnabled(request UploadRequest) bool {
+	return request.EnableAdaptiveConcurrency != nil && *request.EnableAdaptiveConcurrency
+}
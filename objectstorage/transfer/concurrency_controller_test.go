@@ -0,0 +1,150 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+func operationResponse(statusCode int) common.OCIOperationResponse {
+	httpResponse := &http.Response{StatusCode: statusCode, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+	return common.NewOCIOperationResponse(objectstorage.UploadPartResponse{RawResponse: httpResponse}, nil, 1)
+}
+
+func TestConcurrencyController_AdaptsLimit(t *testing.T) {
+	controller := newConcurrencyController(8)
+	assert.Equal(t, 8, controller.currentLimit())
+
+	controller.observe(operationResponse(http.StatusTooManyRequests))
+	assert.Equal(t, 4, controller.currentLimit())
+
+	// throttling within the cooldown period does not reduce the limit again
+	controller.observe(operationResponse(http.StatusServiceUnavailable))
+	assert.Equal(t, 4, controller.currentLimit())
+
+	controller.lastThrottled = time.Now().Add(-throttleCooldown)
+	controller.observe(operationResponse(http.StatusServiceUnavailable))
+	assert.Equal(t, 2, controller.currentLimit())
+
+	// a full window of successes grows the limit by one
+	controller.observe(operationResponse(http.StatusOK))
+	assert.Equal(t, 2, controller.currentLimit())
+	controller.observe(operationResponse(http.StatusOK))
+	assert.Equal(t, 3, controller.currentLimit())
+
+	for i := 0; i < 100; i++ {
+		controller.observe(operationResponse(http.StatusOK))
+	}
+	assert.Equal(t, 8, controller.currentLimit())
+}
+
+func TestConcurrencyController_WrapRetryPolicy(t *testing.T) {
+	controller := newConcurrencyController(4)
+	policy := controller.wrapRetryPolicy(getUploadManagerDefaultRetryPolicy())
+
+	assert.True(t, policy.ShouldRetryOperation(operationResponse(http.StatusTooManyRequests)))
+	assert.Equal(t, 2, controller.currentLimit())
+	assert.False(t, policy.ShouldRetryOperation(operationResponse(http.StatusOK)))
+}
+
+func TestAdaptConcurrency(t *testing.T) {
+	parts := make(chan uploadPart)
+	go func() {
+		defer close(parts)
+		for i := 1; i <= 20; i++ {
+			parts <- uploadPart{partNum: i}
+		}
+	}()
+
+	request := UploadRequest{NumberOfGoroutines: common.Int(3), EnableAdaptiveConcurrency: common.Bool(true)}
+	done := make(chan struct{})
+	defer close(done)
+	result := make(chan uploadPart)
+	gatedParts, uploaded := adaptConcurrency(done, parts, result, &request)
+	assert.NotNil(t, request.RetryPolicy())
+
+	go func() {
+		defer close(uploaded)
+		for part := range gatedParts {
+			uploaded <- part
+		}
+	}()
+
+	received := 0
+	for range result {
+		received++
+	}
+	assert.Equal(t, 20, received)
+}
+
+func TestAdaptConcurrency_Abort(t *testing.T) {
+	parts := make(chan uploadPart)
+	go func() {
+		defer close(parts)
+		for i := 1; i <= 20; i++ {
+			parts <- uploadPart{partNum: i}
+		}
+	}()
+
+	request := UploadRequest{NumberOfGoroutines: common.Int(1), EnableAdaptiveConcurrency: common.Bool(true)}
+	done := make(chan struct{})
+	result := make(chan uploadPart)
+	gatedParts, uploaded := adaptConcurrency(done, parts, result, &request)
+
+	// the first part is uploaded but not read from result, the second one waits for its slot
+	uploadedAll := make(chan struct{})
+	go func() {
+		defer close(uploadedAll)
+		defer close(uploaded)
+		uploaded <- <-gatedParts
+		uploaded <- uploadPart{partNum: 21}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(done)
+	for range gatedParts {
+	}
+	select {
+	case <-uploadedAll:
+	case <-time.After(time.Second):
+		t.Fatal("the upload go routine is blocked")
+	}
+	for range result {
+	}
+}
+
+func TestAdaptConcurrency_AbortWhileWaitingForASlot(t *testing.T) {
+	parts := make(chan uploadPart, 2)
+	parts <- uploadPart{partNum: 1}
+	parts <- uploadPart{partNum: 2}
+	close(parts)
+
+	request := UploadRequest{NumberOfGoroutines: common.Int(1), EnableAdaptiveConcurrency: common.Bool(true)}
+	done := make(chan struct{})
+	result := make(chan uploadPart)
+	gatedParts, uploaded := adaptConcurrency(done, parts, result, &request)
+
+	// the first part holds the only slot, the second one waits for it
+	<-gatedParts
+	time.Sleep(10 * time.Millisecond)
+	close(done)
+	select {
+	case _, ok := <-gatedParts:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("the gate is blocked")
+	}
+	close(uploaded)
+	for range result {
+	}
+}
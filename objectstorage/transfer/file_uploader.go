@@ -7,12 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"sync"
 
 	"github.com/oracle/oci-go-sdk/v27/common"
-	"github.com/oracle/oci-go-sdk/v27/objectstorage"
 )
 
 // FileUploader is an interface to upload a file
@@ -89,24 +89,7 @@ func (fileUpload *fileUpload) UploadFilePutObject(ctx context.Context, request U
 
 	fileSize := int64(fi.Size())
 
-	req := objectstorage.PutObjectRequest{
-		NamespaceName:      request.NamespaceName,
-		BucketName:         request.BucketName,
-		ObjectName:         request.ObjectName,
-		ContentLength:      common.Int64(fileSize),
-		PutObjectBody:      file,
-		OpcMeta:            request.Metadata,
-		IfMatch:            request.IfMatch,
-		IfNoneMatch:        request.IfNoneMatch,
-		ContentType:        request.ContentType,
-		ContentLanguage:    request.ContentLanguage,
-		ContentEncoding:    request.ContentEncoding,
-		ContentMD5:         request.ContentMD5,
-		OpcClientRequestId: request.OpcClientRequestID,
-		RequestMetadata:    request.RequestMetadata,
-	}
-
-	resp, err := request.ObjectStorageClient.PutObject(ctx, req)
+	resp, err := request.putObject(ctx, ioutil.NopCloser(newThrottledReader(ctx, file, request.BandwidthLimiter)), fileSize)
 
 	if err != nil {
 		return response, err
@@ -163,20 +146,21 @@ func (fileUpload *fileUpload) ResumeUploadFile(ctx context.Context, uploadID str
 func (fileUpload *fileUpload) startConcurrentUpload(ctx context.Context, done <-chan struct{}, parts <-chan uploadPart, request UploadFileRequest) (response UploadResponse, err error) {
 	result := make(chan uploadPart)
 	numUploads := *request.NumberOfGoroutines
+	parts, uploaded := adaptConcurrency(done, parts, result, &request.UploadRequest)
 	var wg sync.WaitGroup
 	wg.Add(numUploads)
 
 	// start fixed number of goroutines to upload parts
 	for i := 0; i < numUploads; i++ {
 		go func() {
-			fileUpload.multipartUploader.uploadParts(ctx, done, parts, result, request.UploadRequest, fileUpload.uploadID)
+			fileUpload.multipartUploader.uploadParts(ctx, done, parts, uploaded, request.UploadRequest, fileUpload.uploadID)
 			wg.Done()
 		}()
 	}
 
 	go func() {
 		wg.Wait()
-		close(result)
+		close(uploaded)
 	}()
 
 	fileUpload.manifest.updateManifest(result, fileUpload.uploadID)
@@ -167,12 +167,18 @@ func (manifest *multipartManifest) splitStreamToParts(done <-chan struct{}, part
 		partNum := 1
 		for {
 			buffer := make([]byte, partSize)
-			numberOfBytesRead, err := reader.Read(buffer)
+			// readers such as network streams return less than a part per Read, fill the part
+			// so that only the last one is smaller than partSize
+			numberOfBytesRead, err := io.ReadFull(reader, buffer)
 
 			if err == io.EOF {
 				break
 			}
 
+			if err == io.ErrUnexpectedEOF {
+				err = nil
+			}
+
 			// If the number of bytes read is less than the initial buffer size, reduce the buffer size to match the actual content size.
 			if int64(numberOfBytesRead) < partSize {
 				buffer = buffer[:numberOfBytesRead]
@@ -4,10 +4,13 @@
 package transfer
 
 import (
+	"bytes"
 	"os"
 	"path"
 	"testing"
+	"testing/iotest"
 
+	"github.com/oracle/oci-go-sdk/v27/common"
 	"github.com/oracle/oci-go-sdk/v27/example/helpers"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,3 +77,21 @@ func TestSplitFileParts(t *testing.T) {
 		close(done)
 	}
 }
+
+func TestSplitStreamToPartsFillsTheParts(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 5)
+	manifest := multipartManifest{}
+	done := make(chan struct{})
+	defer close(done)
+
+	// the reader returns a single byte per Read, the parts are nonetheless filled up to the part size
+	var sizes []int64
+	var uploaded []byte
+	for part := range manifest.splitStreamToParts(done, 20, common.Bool(false), iotest.OneByteReader(bytes.NewReader(content))) {
+		assert.NoError(t, part.err)
+		sizes = append(sizes, part.size)
+		uploaded = append(uploaded, part.partBody...)
+	}
+	assert.Equal(t, []int64{20, 20, 10}, sizes)
+	assert.Equal(t, content, uploaded)
+}
@@ -25,6 +25,10 @@ type multipartUpload struct{}
 
 // createMultipartUpload creates a new multipart upload in Object Storage and return the uploadId
 func (uploader *multipartUpload) createMultipartUpload(ctx context.Context, request UploadRequest) (string, error) {
+	if request.PreauthenticatedRequestClient != nil {
+		return uploader.createPreauthenticatedMultipartUpload(ctx, request)
+	}
+
 	multipartUploadRequest := objectstorage.CreateMultipartUploadRequest{
 		NamespaceName:      request.NamespaceName,
 		BucketName:         request.BucketName,
@@ -82,15 +86,49 @@ func (uploader *multipartUpload) uploadParts(ctx context.Context, done <-chan st
 	}
 }
 
+// createPreauthenticatedMultipartUpload creates a new multipart upload through the pre-authenticated request of the request
+func (uploader *multipartUpload) createPreauthenticatedMultipartUpload(ctx context.Context, request UploadRequest) (string, error) {
+	multipartUploadRequest := PreauthenticatedCreateMultipartUploadRequest{
+		ObjectName:         request.ObjectName,
+		IfMatch:            request.IfMatch,
+		IfNoneMatch:        request.IfNoneMatch,
+		OpcClientRequestId: request.OpcClientRequestID,
+		ContentType:        request.ContentType,
+		ContentLanguage:    request.ContentLanguage,
+		ContentEncoding:    request.ContentEncoding,
+		OpcMeta:            request.Metadata,
+		RequestMetadata:    request.RequestMetadata,
+	}
+
+	resp, err := request.PreauthenticatedRequestClient.CreateMultipartUpload(ctx, multipartUploadRequest)
+	if err != nil {
+		return "", err
+	}
+	return *resp.UploadId, nil
+}
+
 // send request to upload part to object storage
 func (uploader *multipartUpload) uploadPart(ctx context.Context, request UploadRequest, part uploadPart, uploadID string) (objectstorage.UploadPartResponse, error) {
+	if request.PreauthenticatedRequestClient != nil {
+		return request.PreauthenticatedRequestClient.UploadPart(ctx, PreauthenticatedUploadPartRequest{
+			ObjectName:         request.ObjectName,
+			UploadId:           common.String(uploadID),
+			UploadPartNum:      common.Int(part.partNum),
+			UploadPartBody:     ioutil.NopCloser(newThrottledReader(ctx, bytes.NewReader(part.partBody), request.BandwidthLimiter)),
+			ContentLength:      common.Int64(part.size),
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+			ContentMD5:         part.opcMD5,
+		})
+	}
+
 	req := objectstorage.UploadPartRequest{
 		NamespaceName:      request.NamespaceName,
 		BucketName:         request.BucketName,
 		ObjectName:         request.ObjectName,
 		UploadId:           common.String(uploadID),
 		UploadPartNum:      common.Int(part.partNum),
-		UploadPartBody:     ioutil.NopCloser(bytes.NewReader(part.partBody)),
+		UploadPartBody:     ioutil.NopCloser(newThrottledReader(ctx, bytes.NewReader(part.partBody), request.BandwidthLimiter)),
 		ContentLength:      common.Int64(part.size),
 		IfMatch:            request.IfMatch,
 		IfNoneMatch:        request.IfNoneMatch,
@@ -136,6 +174,16 @@ func (uploader *multipartUpload) commit(ctx context.Context, request UploadReque
 		}
 	}
 
+	if request.PreauthenticatedRequestClient != nil {
+		// uploads through pre-authenticated requests commit all the uploaded parts
+		return request.PreauthenticatedRequestClient.CommitMultipartUpload(ctx, PreauthenticatedMultipartUploadRequest{
+			ObjectName:         request.ObjectName,
+			UploadId:           common.String(uploadID),
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+	}
+
 	req.PartsToCommit = partsToCommit
 	resp, err = request.ObjectStorageClient.CommitMultipartUpload(ctx, req)
 	return
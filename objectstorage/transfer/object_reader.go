@@ -0,0 +1,313 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+)
+
+var (
+	errorObjectReaderClosed = errors.New("object reader is closed")
+	errorInvalidSeekOffset  = errors.New("seek to a negative offset")
+	errorRangeNotSupported  = errors.New("the service ignored the requested byte range")
+)
+
+// ObjectReader reads an object with ranged GetObject requests. It implements io.ReadSeeker, io.ReaderAt and io.Closer,
+// so it can be given to io.Copy or archive/zip.NewReader. If the connection drops in the middle of a read, the reader
+// transparently reconnects at the current offset. All the requests are pinned to the entity tag of the object at the
+// time the reader was created, reads fail with a 412 service error if the object is overwritten in the meantime.
+// ObjectReader is not safe for concurrent use, except for ReadAt.
+type ObjectReader struct {
+	ctx     context.Context
+	request ObjectReaderRequest
+	size    int64
+	etag    *string
+	opcMeta map[string]string
+
+	// offset of the next Read
+	offset int64
+
+	// stream of the object starting at offset, nil until the next Read
+	body   io.ReadCloser
+	buffer *bufio.Reader
+
+	// 1 once the reader is closed, accessed atomically as ReadAt may run concurrently with Close
+	closed int32
+}
+
+// NewObjectReader returns a reader of the object described by request. The context is used for all the requests
+// made by the reader.
+func NewObjectReader(ctx context.Context, request ObjectReaderRequest) (reader *ObjectReader, err error) {
+	if err = request.validate(); err != nil {
+		return
+	}
+
+	if err = request.initDefaultValues(); err != nil {
+		return
+	}
+
+	resp, err := request.headObject(ctx)
+	if err != nil {
+		return
+	}
+
+	if resp.ContentLength == nil || resp.ETag == nil {
+		err = errors.New("the size and entity tag of the object are required to read it")
+		return
+	}
+
+	reader = &ObjectReader{
+		ctx:     ctx,
+		request: request,
+		size:    *resp.ContentLength,
+		etag:    resp.ETag,
+		opcMeta: resp.OpcMeta,
+	}
+	return
+}
+
+// Size returns the size of the object in bytes
+func (reader *ObjectReader) Size() int64 {
+	return reader.size
+}
+
+// ETag returns the entity tag the reads are pinned to
+func (reader *ObjectReader) ETag() string {
+	return *reader.etag
+}
+
+// Metadata returns the user-defined metadata of the object, without the opc-meta- prefix
+func (reader *ObjectReader) Metadata() map[string]string {
+	return reader.opcMeta
+}
+
+// Read reads up to len(p) bytes of the object at the current offset, reconnecting after transient errors
+func (reader *ObjectReader) Read(p []byte) (n int, err error) {
+	if reader.isClosed() {
+		return 0, errorObjectReaderClosed
+	}
+
+	if reader.offset >= reader.size {
+		return 0, io.EOF
+	}
+
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for attempt := 0; ; attempt++ {
+		if reader.body == nil {
+			err = reader.open()
+		}
+
+		if err == nil {
+			n, err = reader.buffer.Read(p)
+			reader.offset += int64(n)
+			if err == io.EOF && reader.offset < reader.size {
+				err = io.ErrUnexpectedEOF
+			}
+
+			if err != nil {
+				// the stream is broken, reconnect on the next attempt
+				reader.closeBody()
+			}
+
+			if n > 0 || err == nil {
+				return n, nil
+			}
+		}
+
+		if attempt >= *reader.request.MaxReconnectAttempts || !reader.isTransientError(err) {
+			return 0, err
+		}
+
+		common.Debugf("reading object failed at offset %v with error: %v, reconnecting\n", reader.offset, err)
+		if err = reader.wait(attempt); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Seek sets the offset for the next Read, interpreted according to whence, see io.Seeker
+func (reader *ObjectReader) Seek(offset int64, whence int) (int64, error) {
+	if reader.isClosed() {
+		return 0, errorObjectReaderClosed
+	}
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += reader.offset
+	case io.SeekEnd:
+		offset += reader.size
+	default:
+		return 0, fmt.Errorf("invalid whence %v", whence)
+	}
+
+	if offset < 0 {
+		return 0, errorInvalidSeekOffset
+	}
+
+	if offset == reader.offset {
+		return offset, nil
+	}
+
+	// short seeks forward skip the buffered bytes, other seeks reconnect on the next Read
+	if reader.body != nil && offset > reader.offset && offset-reader.offset <= int64(reader.buffer.Buffered()) {
+		reader.buffer.Discard(int(offset - reader.offset))
+	} else {
+		reader.closeBody()
+	}
+
+	reader.offset = offset
+	return offset, nil
+}
+
+// ReadAt reads len(p) bytes of the object starting at off, see io.ReaderAt. It does not change the offset of
+// Read and is safe for concurrent use.
+func (reader *ObjectReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if reader.isClosed() {
+		return 0, errorObjectReaderClosed
+	}
+
+	if off < 0 {
+		return 0, errorInvalidSeekOffset
+	}
+
+	if off >= reader.size {
+		return 0, io.EOF
+	}
+
+	toRead := int64(len(p))
+	if off+toRead > reader.size {
+		toRead = reader.size - off
+	}
+
+	maxReconnects := maxReconnectsWithProgressFactor * *reader.request.MaxReconnectAttempts
+	for attempt, reconnects := 0, 0; int64(n) < toRead; attempt, reconnects = attempt+1, reconnects+1 {
+		var read int
+		read, err = reader.readRange(p[n:toRead], off+int64(n))
+		n += read
+		if err == nil {
+			break
+		}
+
+		if read > 0 {
+			// progress was made, the failure gets a full set of attempts, up to a bound for trickling connections
+			attempt = 0
+		}
+
+		if attempt >= *reader.request.MaxReconnectAttempts || reconnects >= maxReconnects || !reader.isTransientError(err) {
+			return n, err
+		}
+
+		if err = reader.wait(attempt); err != nil {
+			return n, err
+		}
+	}
+
+	if toRead < int64(len(p)) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Close closes the connection to the object, if any
+func (reader *ObjectReader) Close() error {
+	atomic.StoreInt32(&reader.closed, 1)
+	reader.closeBody()
+	return nil
+}
+
+func (reader *ObjectReader) isClosed() bool {
+	return atomic.LoadInt32(&reader.closed) == 1
+}
+
+// open starts streaming the object from the current offset
+func (reader *ObjectReader) open() error {
+	body, err := reader.get(reader.offset, reader.size-1)
+	if err != nil {
+		return err
+	}
+
+	reader.body = body
+	reader.buffer = bufio.NewReaderSize(body, *reader.request.ReadAheadSize)
+	return nil
+}
+
+// readRange reads the bytes of p starting at offset with a single request
+func (reader *ObjectReader) readRange(p []byte, offset int64) (int, error) {
+	body, err := reader.get(offset, offset+int64(len(p))-1)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.ReadFull(body, p)
+}
+
+// get returns the body of the object from start to end, both included
+func (reader *ObjectReader) get(start, end int64) (io.ReadCloser, error) {
+	resp, err := reader.request.getObject(reader.ctx, fmt.Sprintf("bytes=%d-%d", start, end), reader.etag)
+	if err != nil {
+		return nil, err
+	}
+
+	if start > 0 && resp.RawResponse != nil && resp.RawResponse.StatusCode != http.StatusPartialContent {
+		resp.Content.Close()
+		return nil, errorRangeNotSupported
+	}
+
+	if reader.request.BandwidthLimiter == nil {
+		return resp.Content, nil
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{newThrottledReader(reader.ctx, resp.Content, reader.request.BandwidthLimiter), resp.Content}, nil
+}
+
+func (reader *ObjectReader) closeBody() {
+	if reader.body != nil {
+		reader.body.Close()
+		reader.body = nil
+		reader.buffer = nil
+	}
+}
+
+// wait blocks before the next reconnect attempt, the first reconnect is immediate
+func (reader *ObjectReader) wait(attempt int) error {
+	duration := time.Duration(1<<uint(attempt)>>1) * time.Second
+	if duration == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-reader.ctx.Done():
+		return reader.ctx.Err()
+	}
+}
+
+// isTransientError returns true for errors worth reconnecting for: broken connections, throttling and
+// server side errors
+func (reader *ObjectReader) isTransientError(err error) bool {
+	if reader.ctx.Err() != nil || err == errorRangeNotSupported {
+		return false
+	}
+	return worker.IsTransientError(err)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+// ObjectReaderRequest defines the input parameters for NewObjectReader
+type ObjectReaderRequest struct {
+	// The top-level namespace used for the request.
+	NamespaceName *string `mandatory:"true"`
+
+	// The name of the bucket. Avoid entering confidential information. Example: my-new-bucket1
+	BucketName *string `mandatory:"true"`
+
+	// The name of the object. Avoid entering confidential information. Example: test/object1.log
+	ObjectName *string `mandatory:"true"`
+
+	// [Optional] The version of the object to read, the latest version is read if not set.
+	VersionId *string `mandatory:"false"`
+
+	// A configured object storage client to use for interacting with the Object Storage service.
+	ObjectStorageClient *objectstorage.ObjectStorageClient `mandatory:"false"`
+
+	// [Optional] A client for a pre-authenticated request of the bucket or of the object to read. When set, the object
+	// is read through the pre-authenticated request, ObjectStorageClient, NamespaceName and BucketName are not used.
+	// ObjectName is only required for bucket level pre-authenticated requests.
+	PreauthenticatedRequestClient *PreauthenticatedRequestClient `mandatory:"false"`
+
+	// [Optional] The number of bytes buffered ahead of the reads. Defaults to 1 MiB.
+	ReadAheadSize *int `mandatory:"false"`
+
+	// [Optional] The number of times the reader reconnects after a transient error, before giving up on a read.
+	// A ReadAt making progress between the errors reconnects at most 4 times as often. Defaults to 3.
+	MaxReconnectAttempts *int `mandatory:"false"`
+
+	// [Optional] The maximum number of bytes per second downloaded by this reader, shared across Read and ReadAt.
+	// This setting is ignored if BandwidthLimiter is set. Defaults to no limit.
+	MaxBytesPerSecond *int64 `mandatory:"false"`
+
+	// [Optional] The bandwidth limiter used to throttle this reader. Use the same limiter for several readers and
+	// uploads to cap the combined throughput of the transfers in the process.
+	BandwidthLimiter *BandwidthLimiter `mandatory:"false"`
+
+	// [Optional] The client request ID for tracing.
+	OpcClientRequestID *string `mandatory:"false"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+var errorInvalidReadAheadSize = errors.New("readAheadSize must be greater than 0")
+
+const (
+	defaultReadAheadSize        = 1024 * 1024 // 1MB
+	defaultMaxReconnectAttempts = 3
+
+	// maxReconnectsWithProgressFactor bounds the reconnects of a ReadAt which makes progress between the errors, as
+	// a multiple of MaxReconnectAttempts
+	maxReconnectsWithProgressFactor = 4
+)
+
+func (request ObjectReaderRequest) validate() error {
+	if request.PreauthenticatedRequestClient != nil {
+		if request.PreauthenticatedRequestClient.IsBucketLevel() && request.ObjectName == nil {
+			return errorInvalidObjectName
+		}
+	} else {
+		if request.NamespaceName == nil {
+			return errorInvalidNamespace
+		}
+
+		if request.BucketName == nil {
+			return errorInvalidBucketName
+		}
+
+		if request.ObjectName == nil {
+			return errorInvalidObjectName
+		}
+	}
+
+	if request.ReadAheadSize != nil && *request.ReadAheadSize <= 0 {
+		return errorInvalidReadAheadSize
+	}
+
+	if request.MaxBytesPerSecond != nil && *request.MaxBytesPerSecond <= 0 {
+		return errorInvalidBandwidth
+	}
+
+	return nil
+}
+
+func (request *ObjectReaderRequest) initDefaultValues() error {
+	if request.ObjectStorageClient == nil && request.PreauthenticatedRequestClient == nil {
+		client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(common.DefaultConfigProvider())
+		if err != nil {
+			return err
+		}
+
+		// default timeout is 60s which includes the time for reading the body
+		// default timeout doesn't work for big objects, here will use the default
+		// 0s which means no timeout
+		client.HTTPClient = &http.Client{}
+		request.ObjectStorageClient = &client
+	}
+
+	if request.ReadAheadSize == nil {
+		request.ReadAheadSize = common.Int(defaultReadAheadSize)
+	}
+
+	if request.MaxReconnectAttempts == nil || *request.MaxReconnectAttempts < 0 {
+		request.MaxReconnectAttempts = common.Int(defaultMaxReconnectAttempts)
+	}
+
+	if request.BandwidthLimiter == nil && request.MaxBytesPerSecond != nil {
+		request.BandwidthLimiter = NewBandwidthLimiter(*request.MaxBytesPerSecond)
+	}
+
+	return nil
+}
+
+// headObject gets the size and entity tag of the object, through the pre-authenticated request client if one is set
+func (request ObjectReaderRequest) headObject(ctx context.Context) (objectstorage.HeadObjectResponse, error) {
+	if request.PreauthenticatedRequestClient != nil {
+		return request.PreauthenticatedRequestClient.HeadObject(ctx, PreauthenticatedHeadObjectRequest{
+			ObjectName:         request.ObjectName,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+	}
+
+	return request.ObjectStorageClient.HeadObject(ctx, objectstorage.HeadObjectRequest{
+		NamespaceName:      request.NamespaceName,
+		BucketName:         request.BucketName,
+		ObjectName:         request.ObjectName,
+		VersionId:          request.VersionId,
+		OpcClientRequestId: request.OpcClientRequestID,
+		RequestMetadata:    request.RequestMetadata,
+	})
+}
+
+// getObject gets the given range of the object if it still matches the etag, through the pre-authenticated
+// request client if one is set
+func (request ObjectReaderRequest) getObject(ctx context.Context, byteRange string, etag *string) (objectstorage.GetObjectResponse, error) {
+	if request.PreauthenticatedRequestClient != nil {
+		return request.PreauthenticatedRequestClient.GetObject(ctx, PreauthenticatedGetObjectRequest{
+			ObjectName:         request.ObjectName,
+			IfMatch:            etag,
+			Range:              common.String(byteRange),
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+	}
+
+	return request.ObjectStorageClient.GetObject(ctx, objectstorage.GetObjectRequest{
+		NamespaceName:      request.NamespaceName,
+		BucketName:         request.BucketName,
+		ObjectName:         request.ObjectName,
+		VersionId:          request.VersionId,
+		IfMatch:            etag,
+		Range:              common.String(byteRange),
+		OpcClientRequestId: request.OpcClientRequestID,
+		RequestMetadata:    request.RequestMetadata,
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+)
+
+// fakeObjectServer serves an object with ranges, failing some of the GET requests half way
+type fakeObjectServer struct {
+	content       []byte
+	dropped       int32
+	dropFirstRead bool
+
+	// every GET request sends a single byte before dropping the connection
+	trickle bool
+	gets    int32
+}
+
+func (server *fakeObjectServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("etag", `"etag1"`)
+	if r.Method == http.MethodGet {
+		atomic.AddInt32(&server.gets, 1)
+	}
+	if r.Method == http.MethodGet && server.trickle {
+		w.Header().Set("Content-Length", strconv.Itoa(len(server.content)))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(server.content[:1])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	if r.Method == http.MethodGet && server.dropFirstRead && atomic.CompareAndSwapInt32(&server.dropped, 0, 1) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(server.content)))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(server.content[:len(server.content)/2])
+		panic(http.ErrAbortHandler)
+	}
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(server.content))
+}
+
+// newTestBucket creates the bucket "bucket1" on a fake control plane with the given objects, and returns a client of
+// the control plane
+func newTestBucket(t *testing.T, server *ocitest.ControlPlaneServer, objects map[string][]byte) objectstorage.ObjectStorageClient {
+	client := objectstorage.ObjectStorageClient{BaseClient: server.BaseClient()}
+	_, err := client.CreateBucket(context.Background(), objectstorage.CreateBucketRequest{
+		NamespaceName:       common.String(server.Namespace),
+		CreateBucketDetails: objectstorage.CreateBucketDetails{Name: common.String("bucket1"), CompartmentId: common.String(server.TenancyId)},
+	})
+	assert.NoError(t, err)
+
+	for name, content := range objects {
+		_, err = client.PutObject(context.Background(), objectstorage.PutObjectRequest{
+			NamespaceName: common.String(server.Namespace),
+			BucketName:    common.String("bucket1"),
+			ObjectName:    common.String(name),
+			ContentLength: common.Int64(int64(len(content))),
+			PutObjectBody: ioutil.NopCloser(bytes.NewReader(content)),
+		})
+		assert.NoError(t, err)
+	}
+	return client
+}
+
+// objectReaderRequest returns the request of a reader of an object of the bucket of newTestBucket
+func objectReaderRequest(server *ocitest.ControlPlaneServer, client *objectstorage.ObjectStorageClient, name string) ObjectReaderRequest {
+	return ObjectReaderRequest{
+		NamespaceName:       common.String(server.Namespace),
+		BucketName:          common.String("bucket1"),
+		ObjectName:          common.String(name),
+		ObjectStorageClient: client,
+		ReadAheadSize:       common.Int(16),
+	}
+}
+
+func TestObjectReader_ReadReconnects(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 100)
+	fake := &fakeObjectServer{content: content, dropFirstRead: true}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client, err := NewPreauthenticatedRequestClient(server.URL + "/p/token/n/ns/b/bucket/o/obj")
+	assert.NoError(t, err)
+	reader, err := NewObjectReader(context.Background(), ObjectReaderRequest{PreauthenticatedRequestClient: &client, ReadAheadSize: common.Int(16)})
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	assert.Equal(t, int64(len(content)), reader.Size())
+	assert.Equal(t, `"etag1"`, reader.ETag())
+
+	read, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+	assert.Equal(t, int32(1), fake.dropped)
+}
+
+func TestObjectReader_ReadDoesNotReconnectOnPermanentErrors(t *testing.T) {
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"obj": bytes.Repeat([]byte("0123456789"), 100)})
+	reader, err := NewObjectReader(context.Background(), objectReaderRequest(server, &client, "obj"))
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	// a TLS misconfiguration is not fixed by reconnecting, which would wait between the attempts
+	client.Host = strings.Replace(client.Host, "http://", "https://", 1)
+	start := time.Now()
+	_, err = reader.Read(make([]byte, 10))
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < time.Second, "reconnected for %v", time.Since(start))
+}
+
+func TestObjectReader_SeekAndReadAt(t *testing.T) {
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"obj": bytes.Repeat([]byte("0123456789"), 10)})
+	reader, err := NewObjectReader(context.Background(), objectReaderRequest(server, &client, "obj"))
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	buffer := make([]byte, 4)
+	_, err = io.ReadFull(reader, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123", string(buffer))
+
+	// within the read-ahead buffer
+	offset, err := reader.Seek(2, io.SeekCurrent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), offset)
+	_, err = io.ReadFull(reader, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, "6789", string(buffer))
+
+	offset, err = reader.Seek(-3, io.SeekEnd)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(97), offset)
+	rest, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "789", string(rest))
+
+	n, err := reader.ReadAt(buffer, 41)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "1234", string(buffer))
+
+	n, err = reader.ReadAt(buffer, 98)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "89", string(buffer[:n]))
+
+	_, err = reader.Seek(-1, io.SeekStart)
+	assert.Equal(t, errorInvalidSeekOffset, err)
+}
+
+func TestObjectReader_Zip(t *testing.T) {
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	file, _ := writer.Create("file.txt")
+	file.Write([]byte("zipped content"))
+	writer.Close()
+
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"archive.zip": archive.Bytes()})
+	reader, err := NewObjectReader(context.Background(), objectReaderRequest(server, &client, "archive.zip"))
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	zipReader, err := zip.NewReader(reader, reader.Size())
+	assert.NoError(t, err)
+	assert.Len(t, zipReader.File, 1)
+	zipped, _ := zipReader.File[0].Open()
+	content, _ := ioutil.ReadAll(zipped)
+	assert.Equal(t, "zipped content", string(content))
+}
+
+func TestObjectReader_PinsETag(t *testing.T) {
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"obj": []byte("content")})
+	reader, err := NewObjectReader(context.Background(), objectReaderRequest(server, &client, "obj"))
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	// the object is overwritten after the reader was created
+	_, err = client.PutObject(context.Background(), objectstorage.PutObjectRequest{
+		NamespaceName: common.String(server.Namespace),
+		BucketName:    common.String("bucket1"),
+		ObjectName:    common.String("obj"),
+		ContentLength: common.Int64(9),
+		PutObjectBody: ioutil.NopCloser(strings.NewReader("content 2")),
+	})
+	assert.NoError(t, err)
+	_, err = ioutil.ReadAll(reader)
+	serviceError, ok := common.IsServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusPreconditionFailed, serviceError.GetHTTPStatusCode())
+}
+
+func TestObjectReader_MaxBytesPerSecond(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 150)
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"obj": content})
+
+	request := objectReaderRequest(server, &client, "obj")
+	request.MaxBytesPerSecond = common.Int64(0)
+	_, err := NewObjectReader(context.Background(), request)
+	assert.Equal(t, errorInvalidBandwidth, err)
+
+	request.MaxBytesPerSecond = common.Int64(1000)
+	request.ReadAheadSize = nil
+	reader, err := NewObjectReader(context.Background(), request)
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	// the first 1000 bytes fit in the initial bucket, the next 500 wait for half a second
+	start := time.Now()
+	read, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+	assert.True(t, time.Since(start) >= 400*time.Millisecond, "read too fast: %v", time.Since(start))
+}
+
+func TestObjectReader_ReadAtBoundsReconnectsWithProgress(t *testing.T) {
+	fake := &fakeObjectServer{content: bytes.Repeat([]byte("0123456789"), 10), trickle: true}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	client, err := NewPreauthenticatedRequestClient(server.URL + "/p/token/n/ns/b/bucket/o/obj")
+	assert.NoError(t, err)
+	reader, err := NewObjectReader(context.Background(), ObjectReaderRequest{PreauthenticatedRequestClient: &client, ReadAheadSize: common.Int(16)})
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	// each request makes progress, the reconnects are nonetheless bounded
+	p := make([]byte, 100)
+	n, err := reader.ReadAt(p, 0)
+	assert.Error(t, err)
+	assert.Equal(t, maxReconnectsWithProgressFactor*defaultMaxReconnectAttempts+1, n)
+	assert.Equal(t, int32(n), atomic.LoadInt32(&fake.gets))
+}
+
+func TestObjectReader_ReadAtConcurrentWithClose(t *testing.T) {
+	server := ocitest.NewControlPlaneServer()
+	defer server.Close()
+	client := newTestBucket(t, server, map[string][]byte{"obj": []byte("0123456789")})
+	reader, err := NewObjectReader(context.Background(), objectReaderRequest(server, &client, "obj"))
+	assert.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p := make([]byte, 5)
+		for i := 0; i < 10; i++ {
+			reader.ReadAt(p, 0)
+		}
+	}()
+	reader.Close()
+	<-done
+
+	_, err = reader.ReadAt(make([]byte, 5), 0)
+	assert.Equal(t, errorObjectReaderClosed, err)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+// requestHeaderOpcMultipart The key for passing a header to start a multipart upload through a pre-authenticated request
+const requestHeaderOpcMultipart = "opc-multipart"
+
+var errorInvalidPreauthenticatedRequestURL = errors.New("parURL must be the absolute URL of an object or bucket level pre-authenticated request")
+
+// PreauthenticatedRequestClient uploads and downloads objects through a pre-authenticated request (PAR).
+// The requests are not signed, the client does not need any credentials, which allows to hand out limited
+// rights on a bucket or an object to untrusted workers.
+type PreauthenticatedRequestClient struct {
+	common.BaseClient
+
+	// escaped path of the bucket of the pre-authenticated request, /p/<token>/n/<namespace>/b/<bucket>
+	bucketPath string
+
+	// escaped name of the object of an object level request, empty for bucket level requests
+	objectName string
+}
+
+// noSigner leaves the requests untouched, the access to pre-authenticated requests is granted by their URL
+type noSigner struct{}
+
+func (noSigner) Sign(*http.Request) error {
+	return nil
+}
+
+// NewPreauthenticatedRequestClient creates a client for the pre-authenticated request at parURL. parURL is the Object
+// Storage endpoint followed by the AccessUri returned by CreatePreauthenticatedRequest, for example
+// https://objectstorage.us-phoenix-1.oraclecloud.com/p/<token>/n/<namespace>/b/<bucket>/o/ for a bucket level request.
+func NewPreauthenticatedRequestClient(parURL string) (client PreauthenticatedRequestClient, err error) {
+	parsedURL, err := url.Parse(parURL)
+	if err != nil {
+		return
+	}
+
+	if !parsedURL.IsAbs() || len(parsedURL.Host) == 0 {
+		err = errorInvalidPreauthenticatedRequestURL
+		return
+	}
+
+	bucketPath, objectName, err := parsePreauthenticatedRequestPath(parsedURL.EscapedPath())
+	if err != nil {
+		return
+	}
+
+	client = PreauthenticatedRequestClient{
+		BaseClient: common.DefaultBaseClientWithSigner(noSigner{}),
+		bucketPath: bucketPath,
+		objectName: objectName,
+	}
+	client.Host = fmt.Sprintf("%s://%s", parsedURL.Scheme, parsedURL.Host)
+
+	// the default timeout includes the time for reading the body and does not work
+	// for big objects, use no timeout as the upload manager does
+	client.HTTPClient = &http.Client{}
+	return
+}
+
+// parsePreauthenticatedRequestPath splits the escaped path of a pre-authenticated request by its fixed segments,
+// /p/<token>/n/<namespace>/b/<bucket>/o/ followed by the object name for object level requests. The object name is
+// everything after /o/, it may contain any character, including slashes and "/o/".
+func parsePreauthenticatedRequestPath(escapedPath string) (bucketPath, objectName string, err error) {
+	// "", "p", token, "n", namespace, "b", bucket, "o", object name
+	segments := strings.SplitN(escapedPath, "/", 9)
+	if len(segments) != 9 || segments[0] != "" || segments[1] != "p" || segments[3] != "n" || segments[5] != "b" || segments[7] != "o" ||
+		len(segments[2]) == 0 || len(segments[4]) == 0 || len(segments[6]) == 0 {
+		err = errorInvalidPreauthenticatedRequestURL
+		return
+	}
+	return strings.Join(segments[:7], "/"), segments[8], nil
+}
+
+// IsBucketLevel returns true if the pre-authenticated request grants access to the objects of a bucket, false if it
+// only grants access to a single object
+func (client PreauthenticatedRequestClient) IsBucketLevel() bool {
+	return len(client.objectName) == 0
+}
+
+// escapedObjectName returns the escaped name of the object, the name is ignored for object level requests
+func (client PreauthenticatedRequestClient) escapedObjectName(objectName *string) (string, error) {
+	if !client.IsBucketLevel() {
+		return client.objectName, nil
+	}
+
+	if objectName == nil || len(*objectName) == 0 {
+		return "", errorInvalidObjectName
+	}
+	return url.PathEscape(*objectName), nil
+}
+
+// objectPath returns the escaped path of the object
+func (client PreauthenticatedRequestClient) objectPath(objectName *string) (string, error) {
+	name, err := client.escapedObjectName(objectName)
+	if err != nil {
+		return "", err
+	}
+	return client.bucketPath + "/o/" + name, nil
+}
+
+// multipartUploadPath returns the path under which the parts of a multipart upload are uploaded and committed
+func (client PreauthenticatedRequestClient) multipartUploadPath(objectName, uploadID *string) (string, error) {
+	if uploadID == nil || len(*uploadID) == 0 {
+		return "", errors.New("uploadId is required")
+	}
+
+	name, err := client.escapedObjectName(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	// the multipart operations live under /u/ instead of /o/
+	return fmt.Sprintf("%s/u/%s/id/%s/", client.bucketPath, name, url.PathEscape(*uploadID)), nil
+}
+
+// retry executes the operation with the retry policy of the request, or the one of the client if none is set
+func (client PreauthenticatedRequestClient) retry(ctx context.Context, request common.OCIRetryableRequest, operation common.OCIOperation) (common.OCIResponse, error) {
+	policy := common.NoRetryPolicy()
+	if client.RetryPolicy() != nil {
+		policy = *client.RetryPolicy()
+	}
+	if request.RetryPolicy() != nil {
+		policy = *request.RetryPolicy()
+	}
+	return common.Retry(ctx, request, operation, policy)
+}
+
+// call sends the request to the escaped path and unmarshals the response, the body of a successful response is kept open if keepBody is true
+func (client PreauthenticatedRequestClient) call(ctx context.Context, request common.OCIRequest, method, path string, response common.OCIResponse, keepBody bool) (*http.Response, error) {
+	httpRequest, err := request.HTTPRequest(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// send the path as escaped, the object names may contain any character
+	httpRequest.URL.RawPath = path
+	if httpRequest.URL.Path, err = url.PathUnescape(path); err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := client.Call(ctx, &httpRequest)
+	if err != nil || !keepBody {
+		defer common.CloseBodyIfValid(httpResponse)
+	}
+	if err != nil {
+		return httpResponse, err
+	}
+
+	err = common.UnmarshalResponse(httpResponse, response)
+	return httpResponse, err
+}
+
+// GetObject gets the metadata and body of an object.
+func (client PreauthenticatedRequestClient) GetObject(ctx context.Context, request PreauthenticatedGetObjectRequest) (response objectstorage.GetObjectResponse, err error) {
+	path, err := client.objectPath(request.ObjectName)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.GetObjectResponse
+		httpResponse, err := client.call(ctx, request, http.MethodGet, path, &response, true)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.GetObjectResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// HeadObject gets the user-defined metadata and entity tag (ETag) for an object.
+func (client PreauthenticatedRequestClient) HeadObject(ctx context.Context, request PreauthenticatedHeadObjectRequest) (response objectstorage.HeadObjectResponse, err error) {
+	path, err := client.objectPath(request.ObjectName)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.HeadObjectResponse
+		httpResponse, err := client.call(ctx, request, http.MethodHead, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.HeadObjectResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// PutObject creates a new object or overwrites an existing object with the same name.
+func (client PreauthenticatedRequestClient) PutObject(ctx context.Context, request PreauthenticatedPutObjectRequest) (response objectstorage.PutObjectResponse, err error) {
+	path, err := client.objectPath(request.ObjectName)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.PutObjectResponse
+		httpResponse, err := client.call(ctx, request, http.MethodPut, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.PutObjectResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// CreateMultipartUpload starts a new multipart upload, the upload must be committed or aborted.
+func (client PreauthenticatedRequestClient) CreateMultipartUpload(ctx context.Context, request PreauthenticatedCreateMultipartUploadRequest) (response PreauthenticatedCreateMultipartUploadResponse, err error) {
+	path, err := client.objectPath(request.ObjectName)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response PreauthenticatedCreateMultipartUploadResponse
+		httpResponse, err := client.call(ctx, request, http.MethodPut, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(PreauthenticatedCreateMultipartUploadResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// UploadPart uploads a single part of a multipart upload.
+func (client PreauthenticatedRequestClient) UploadPart(ctx context.Context, request PreauthenticatedUploadPartRequest) (response objectstorage.UploadPartResponse, err error) {
+	path, err := client.multipartUploadPath(request.ObjectName, request.UploadId)
+	if err != nil {
+		return
+	}
+
+	if request.UploadPartNum == nil {
+		err = errors.New("uploadPartNum is required")
+		return
+	}
+	path += strconv.Itoa(*request.UploadPartNum)
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.UploadPartResponse
+		httpResponse, err := client.call(ctx, request, http.MethodPut, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.UploadPartResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// CommitMultipartUpload commits a multipart upload, all the parts uploaded so far make the object.
+func (client PreauthenticatedRequestClient) CommitMultipartUpload(ctx context.Context, request PreauthenticatedMultipartUploadRequest) (response objectstorage.CommitMultipartUploadResponse, err error) {
+	path, err := client.multipartUploadPath(request.ObjectName, request.UploadId)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.CommitMultipartUploadResponse
+		httpResponse, err := client.call(ctx, request, http.MethodPost, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.CommitMultipartUploadResponse); ok {
+		response = converted
+	}
+	return
+}
+
+// AbortMultipartUpload aborts a multipart upload and deletes all parts that have been uploaded.
+func (client PreauthenticatedRequestClient) AbortMultipartUpload(ctx context.Context, request PreauthenticatedMultipartUploadRequest) (response objectstorage.AbortMultipartUploadResponse, err error) {
+	path, err := client.multipartUploadPath(request.ObjectName, request.UploadId)
+	if err != nil {
+		return
+	}
+
+	ociResponse, err := client.retry(ctx, request, func(ctx context.Context, request common.OCIRequest) (common.OCIResponse, error) {
+		var response objectstorage.AbortMultipartUploadResponse
+		httpResponse, err := client.call(ctx, request, http.MethodDelete, path, &response, false)
+		response.RawResponse = httpResponse
+		return response, err
+	})
+	if converted, ok := ociResponse.(objectstorage.AbortMultipartUploadResponse); ok {
+		response = converted
+	}
+	return
+}
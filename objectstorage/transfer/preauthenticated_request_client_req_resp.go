@@ -0,0 +1,316 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// PreauthenticatedGetObjectRequest defines the input parameters for the GetObject method of PreauthenticatedRequestClient
+type PreauthenticatedGetObjectRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The entity tag (ETag) to match.
+	IfMatch *string `mandatory:"false" contributesTo:"header" name:"if-match"`
+
+	// The entity tag (ETag) to avoid matching.
+	IfNoneMatch *string `mandatory:"false" contributesTo:"header" name:"if-none-match"`
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// Optional byte range to fetch, as described in RFC 7233 (https://tools.ietf.org/html/rfc7233#section-2.1).
+	Range *string `mandatory:"false" contributesTo:"header" name:"range"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedGetObjectRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request PreauthenticatedGetObjectRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedGetObjectRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedHeadObjectRequest defines the input parameters for the HeadObject method of PreauthenticatedRequestClient
+type PreauthenticatedHeadObjectRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The entity tag (ETag) to match.
+	IfMatch *string `mandatory:"false" contributesTo:"header" name:"if-match"`
+
+	// The entity tag (ETag) to avoid matching.
+	IfNoneMatch *string `mandatory:"false" contributesTo:"header" name:"if-none-match"`
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedHeadObjectRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request PreauthenticatedHeadObjectRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedHeadObjectRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedPutObjectRequest defines the input parameters for the PutObject method of PreauthenticatedRequestClient
+type PreauthenticatedPutObjectRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The content length of the body.
+	ContentLength *int64 `mandatory:"true" contributesTo:"header" name:"Content-Length"`
+
+	// The object to upload to the object store.
+	PutObjectBody io.ReadCloser `mandatory:"true" contributesTo:"body" encoding:"binary"`
+
+	// The entity tag (ETag) to match.
+	IfMatch *string `mandatory:"false" contributesTo:"header" name:"if-match"`
+
+	// The entity tag (ETag) to avoid matching. The only valid value is '*', which indicates that the request
+	// should fail if the object already exists.
+	IfNoneMatch *string `mandatory:"false" contributesTo:"header" name:"if-none-match"`
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// The base-64 encoded MD5 hash of the body.
+	ContentMD5 *string `mandatory:"false" contributesTo:"header" name:"Content-MD5"`
+
+	// The content type of the object.
+	ContentType *string `mandatory:"false" contributesTo:"header" name:"Content-Type"`
+
+	// The content language of the object.
+	ContentLanguage *string `mandatory:"false" contributesTo:"header" name:"Content-Language"`
+
+	// The content encoding of the object.
+	ContentEncoding *string `mandatory:"false" contributesTo:"header" name:"Content-Encoding"`
+
+	// Optional user-defined metadata key and value.
+	OpcMeta map[string]string `mandatory:"false" contributesTo:"header-collection" prefix:"opc-meta-"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedPutObjectRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request PreauthenticatedPutObjectRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedPutObjectRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedCreateMultipartUploadRequest defines the input parameters for the CreateMultipartUpload method
+// of PreauthenticatedRequestClient
+type PreauthenticatedCreateMultipartUploadRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The entity tag (ETag) to match.
+	IfMatch *string `mandatory:"false" contributesTo:"header" name:"if-match"`
+
+	// The entity tag (ETag) to avoid matching. The only valid value is '*', which indicates that the request
+	// should fail if the object already exists.
+	IfNoneMatch *string `mandatory:"false" contributesTo:"header" name:"if-none-match"`
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// The content type of the object.
+	ContentType *string `mandatory:"false" contributesTo:"header" name:"Content-Type"`
+
+	// The content language of the object.
+	ContentLanguage *string `mandatory:"false" contributesTo:"header" name:"Content-Language"`
+
+	// The content encoding of the object.
+	ContentEncoding *string `mandatory:"false" contributesTo:"header" name:"Content-Encoding"`
+
+	// Optional user-defined metadata key and value.
+	OpcMeta map[string]string `mandatory:"false" contributesTo:"header-collection" prefix:"opc-meta-"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedCreateMultipartUploadRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface, the request is flagged as the start of a multipart upload
+func (request PreauthenticatedCreateMultipartUploadRequest) HTTPRequest(method, path string) (http.Request, error) {
+	httpRequest, err := common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+	if err == nil {
+		httpRequest.Header.Set(requestHeaderOpcMultipart, "true")
+	}
+	return httpRequest, err
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedCreateMultipartUploadRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedUploadPartRequest defines the input parameters for the UploadPart method of PreauthenticatedRequestClient
+type PreauthenticatedUploadPartRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The upload ID for a multipart upload.
+	UploadId *string
+
+	// The part number that identifies the object part currently being uploaded.
+	UploadPartNum *int
+
+	// The content length of the body.
+	ContentLength *int64 `mandatory:"true" contributesTo:"header" name:"Content-Length"`
+
+	// The part being uploaded to the Object Storage service.
+	UploadPartBody io.ReadCloser `mandatory:"true" contributesTo:"body" encoding:"binary"`
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// The base-64 encoded MD5 hash of the body.
+	ContentMD5 *string `mandatory:"false" contributesTo:"header" name:"Content-MD5"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedUploadPartRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request PreauthenticatedUploadPartRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedUploadPartRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedMultipartUploadRequest defines the input parameters for the CommitMultipartUpload and
+// AbortMultipartUpload methods of PreauthenticatedRequestClient
+type PreauthenticatedMultipartUploadRequest struct {
+
+	// The name of the object. Only required for bucket level pre-authenticated requests.
+	ObjectName *string
+
+	// The upload ID for a multipart upload.
+	UploadId *string
+
+	// The client request ID for tracing.
+	OpcClientRequestId *string `mandatory:"false" contributesTo:"header" name:"opc-client-request-id"`
+
+	// Metadata about the request. This information will not be transmitted to the service, but
+	// represents information that the SDK will consume to drive retry behavior.
+	RequestMetadata common.RequestMetadata
+}
+
+func (request PreauthenticatedMultipartUploadRequest) String() string {
+	return common.PointerString(request)
+}
+
+// HTTPRequest implements the OCIRequest interface
+func (request PreauthenticatedMultipartUploadRequest) HTTPRequest(method, path string) (http.Request, error) {
+	return common.MakeDefaultHTTPRequestWithTaggedStruct(method, path, request)
+}
+
+// RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
+func (request PreauthenticatedMultipartUploadRequest) RetryPolicy() *common.RetryPolicy {
+	return request.RequestMetadata.RetryPolicy
+}
+
+// PreauthenticatedMultipartUpload describes a multipart upload created through a pre-authenticated request.
+type PreauthenticatedMultipartUpload struct {
+
+	// The Object Storage namespace in which the bucket and objects reside.
+	Namespace *string `mandatory:"true" json:"namespace"`
+
+	// The bucket in which the in-progress multipart upload is stored.
+	BucketName *string `mandatory:"true" json:"bucketName"`
+
+	// The object name of the in-progress multipart upload.
+	Object *string `mandatory:"true" json:"object"`
+
+	// The unique identifier for the in-progress multipart upload.
+	UploadId *string `mandatory:"true" json:"uploadId"`
+
+	// The URI, relative to the Object Storage endpoint, used to upload the parts, commit or abort the upload.
+	AccessUri *string `mandatory:"true" json:"accessUri"`
+
+	// The date and time the upload was created, as described in RFC 2616 (https://tools.ietf.org/html/rfc2616#section-14.29).
+	TimeCreated *common.SDKTime `mandatory:"false" json:"timeCreated"`
+}
+
+func (m PreauthenticatedMultipartUpload) String() string {
+	return common.PointerString(m)
+}
+
+// PreauthenticatedCreateMultipartUploadResponse wrapper for the CreateMultipartUpload method of PreauthenticatedRequestClient
+type PreauthenticatedCreateMultipartUploadResponse struct {
+
+	// The underlying http response
+	RawResponse *http.Response
+
+	// The PreauthenticatedMultipartUpload instance
+	PreauthenticatedMultipartUpload `presentIn:"body"`
+
+	// Echoes back the value passed in the opc-client-request-id header, for use by clients when debugging.
+	OpcClientRequestId *string `presentIn:"header" name:"opc-client-request-id"`
+
+	// Unique Oracle-assigned identifier for the request.
+	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+}
+
+func (response PreauthenticatedCreateMultipartUploadResponse) String() string {
+	return common.PointerString(response)
+}
+
+// HTTPResponse implements the OCIResponse interface
+func (response PreauthenticatedCreateMultipartUploadResponse) HTTPResponse() *http.Response {
+	return response.RawResponse
+}
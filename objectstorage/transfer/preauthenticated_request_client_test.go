@@ -0,0 +1,164 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/example/helpers"
+)
+
+// fakePreauthenticatedRequestServer records the requests sent to a bucket level pre-authenticated request, with their
+// escaped paths
+type fakePreauthenticatedRequestServer struct {
+	mutex    sync.Mutex
+	requests []string
+	parts    map[string]int
+}
+
+func (server *fakePreauthenticatedRequestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.requests = append(server.requests, r.Method+" "+r.URL.EscapedPath())
+
+	if len(r.Header.Get("Authorization")) != 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPut && r.Header.Get(requestHeaderOpcMultipart) == "true":
+		fmt.Fprint(w, `{"namespace":"ns","bucketName":"bucket","object":"obj","uploadId":"upload1","accessUri":"/p/token/n/ns/b/bucket/u/obj/id/upload1/"}`)
+	case r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/u/"):
+		body, _ := ioutil.ReadAll(r.Body)
+		server.parts[r.URL.Path] = len(body)
+		w.Header().Set("etag", "etag-"+r.URL.Path)
+	case r.Method == http.MethodPost:
+		w.Header().Set("opc-multipart-md5", "md5")
+	case r.Method == http.MethodGet:
+		w.Header().Set("etag", "etag1")
+		fmt.Fprint(w, "content")
+	}
+}
+
+func TestNewPreauthenticatedRequestClient(t *testing.T) {
+	client, err := NewPreauthenticatedRequestClient("https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/ns/b/bucket/o/")
+	assert.NoError(t, err)
+	assert.True(t, client.IsBucketLevel())
+	assert.Equal(t, "https://objectstorage.us-phoenix-1.oraclecloud.com", client.Host)
+
+	path, err := client.multipartUploadPath(common.String("dir/obj"), common.String("id1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "/p/token/n/ns/b/bucket/u/dir%2Fobj/id/id1/", path)
+
+	_, err = client.objectPath(nil)
+	assert.Equal(t, errorInvalidObjectName, err)
+
+	client, err = NewPreauthenticatedRequestClient("https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/ns/b/bucket/o/obj")
+	assert.NoError(t, err)
+	assert.False(t, client.IsBucketLevel())
+	path, err = client.multipartUploadPath(nil, common.String("id1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "/p/token/n/ns/b/bucket/u/obj/id/id1/", path)
+
+	// the name of an object level request may contain /o/
+	client, err = NewPreauthenticatedRequestClient("https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/ns/b/bucket/o/a%2Fo%2Fb")
+	assert.NoError(t, err)
+	assert.False(t, client.IsBucketLevel())
+	path, err = client.multipartUploadPath(nil, common.String("id1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "/p/token/n/ns/b/bucket/u/a%2Fo%2Fb/id/id1/", path)
+
+	for _, parURL := range []string{
+		"/p/token/n/ns/b/bucket/o/",
+		"https://objectstorage.us-phoenix-1.oraclecloud.com/n/ns/b/bucket/o/",
+		"https://objectstorage.us-phoenix-1.oraclecloud.com/p/token/n/ns/b/bucket/",
+		"https://objectstorage.us-phoenix-1.oraclecloud.com/p//n/ns/b/bucket/o/",
+	} {
+		_, err = NewPreauthenticatedRequestClient(parURL)
+		assert.Equal(t, errorInvalidPreauthenticatedRequestURL, err, parURL)
+	}
+}
+
+func TestPreauthenticatedRequestClient_ObjectNames(t *testing.T) {
+	fake := &fakePreauthenticatedRequestServer{parts: make(map[string]int)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := NewPreauthenticatedRequestClient(server.URL + "/p/token/n/ns/b/bucket/o/")
+	assert.NoError(t, err)
+
+	// the names are sent escaped and untouched, the service does not clean them either
+	for _, name := range []string{"a/o/b", "a//b", "dir/", "a b?c#d"} {
+		_, err = client.GetObject(context.Background(), PreauthenticatedGetObjectRequest{ObjectName: common.String(name)})
+		assert.NoError(t, err)
+		assert.Equal(t, "GET /p/token/n/ns/b/bucket/o/"+url.PathEscape(name), fake.requests[len(fake.requests)-1])
+
+		_, err = client.UploadPart(context.Background(), PreauthenticatedUploadPartRequest{
+			ObjectName:     common.String(name),
+			UploadId:       common.String("upload1"),
+			UploadPartNum:  common.Int(1),
+			UploadPartBody: ioutil.NopCloser(strings.NewReader("part")),
+			ContentLength:  common.Int64(4),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "PUT /p/token/n/ns/b/bucket/u/"+url.PathEscape(name)+"/id/upload1/1", fake.requests[len(fake.requests)-1])
+	}
+}
+
+func TestPreauthenticatedRequestClient_GetObject(t *testing.T) {
+	server := httptest.NewServer(&fakePreauthenticatedRequestServer{})
+	defer server.Close()
+
+	client, err := NewPreauthenticatedRequestClient(server.URL + "/p/token/n/ns/b/bucket/o/")
+	assert.NoError(t, err)
+
+	resp, err := client.GetObject(context.Background(), PreauthenticatedGetObjectRequest{ObjectName: common.String("obj")})
+	assert.NoError(t, err)
+	defer resp.Content.Close()
+	content, _ := ioutil.ReadAll(resp.Content)
+	assert.Equal(t, "content", string(content))
+	assert.Equal(t, "etag1", *resp.ETag)
+}
+
+func TestUploadManager_UploadFileWithPreauthenticatedRequest(t *testing.T) {
+	fake := &fakePreauthenticatedRequestServer{parts: make(map[string]int)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := NewPreauthenticatedRequestClient(server.URL + "/p/token/n/ns/b/bucket/o/")
+	assert.NoError(t, err)
+
+	filePath, _ := helpers.WriteTempFileOfSize(25)
+	req := UploadFileRequest{
+		UploadRequest: UploadRequest{
+			ObjectName:                    common.String("obj"),
+			PartSize:                      common.Int64(10),
+			PreauthenticatedRequestClient: &client,
+		},
+		FilePath: filePath,
+	}
+
+	resp, err := NewUploadManager().UploadFile(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, MultipartUpload, resp.Type)
+	assert.Equal(t, "upload1", *resp.UploadID)
+	assert.Equal(t, map[string]int{
+		"/p/token/n/ns/b/bucket/u/obj/id/upload1/1": 10,
+		"/p/token/n/ns/b/bucket/u/obj/id/upload1/2": 10,
+		"/p/token/n/ns/b/bucket/u/obj/id/upload1/3": 5,
+	}, fake.parts)
+	assert.Equal(t, "POST /p/token/n/ns/b/bucket/u/obj/id/upload1/", fake.requests[len(fake.requests)-1])
+}
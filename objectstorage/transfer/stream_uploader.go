@@ -48,20 +48,21 @@ func (streamUpload *streamUpload) UploadStream(ctx context.Context, request Uplo
 func (streamUpload *streamUpload) startConcurrentUpload(ctx context.Context, done <-chan struct{}, parts <-chan uploadPart, request UploadStreamRequest) (response UploadResponse, err error) {
 	result := make(chan uploadPart)
 	numUploads := *request.NumberOfGoroutines
+	parts, uploaded := adaptConcurrency(done, parts, result, &request.UploadRequest)
 	var wg sync.WaitGroup
 	wg.Add(numUploads)
 
 	// start fixed number of goroutines to upload parts
 	for i := 0; i < numUploads; i++ {
 		go func() {
-			streamUpload.multipartUploader.uploadParts(ctx, done, parts, result, request.UploadRequest, streamUpload.uploadID)
+			streamUpload.multipartUploader.uploadParts(ctx, done, parts, uploaded, request.UploadRequest, streamUpload.uploadID)
 			wg.Done()
 		}()
 	}
 
 	go func() {
 		wg.Wait()
-		close(result)
+		close(uploaded)
 	}()
 
 	streamUpload.manifest.updateManifest(result, streamUpload.uploadID)
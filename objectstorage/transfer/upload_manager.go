@@ -9,13 +9,16 @@
 // able to upload parts in parallel to reduce upload time.
 //
 // To use this package, you must be authorized in an IAM policy. If you're not authorized, talk to an administrator.
+// Alternatively, objects can be uploaded and downloaded through a pre-authenticated request with a
+// PreauthenticatedRequestClient, which does not need any credentials.
 package transfer
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"github.com/oracle/oci-go-sdk/v27/common"
-	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+	"io"
 	"math"
 	"net/http"
 	"os"
@@ -114,33 +117,23 @@ func (uploadManager *UploadManager) UploadStream(ctx context.Context, request Up
 	//check if the stream is empty
 	buffer := make([]byte, *request.PartSize)
 	numberOfBytesRead, err := request.StreamReader.Read(buffer)
+	if numberOfBytesRead == 0 && err != nil && err != io.EOF {
+		return
+	}
 
 	if numberOfBytesRead == 0 {
 		return uploadEmptyStream(ctx, request)
 	}
 
+	// put back the bytes read by the check
+	request.StreamReader = io.MultiReader(bytes.NewReader(buffer[:numberOfBytesRead]), request.StreamReader)
+
 	response, err = uploadManager.StreamUploader.UploadStream(ctx, request)
 	return
 }
 
 func uploadEmptyStream(ctx context.Context, request UploadStreamRequest) (response UploadResponse, err error) {
-	putObjReq := objectstorage.PutObjectRequest{
-		NamespaceName:      request.UploadRequest.NamespaceName,
-		BucketName:         request.UploadRequest.BucketName,
-		ObjectName:         request.UploadRequest.ObjectName,
-		ContentLength:      new(int64),
-		PutObjectBody:      http.NoBody,
-		OpcMeta:            request.UploadRequest.Metadata,
-		IfMatch:            request.UploadRequest.IfMatch,
-		IfNoneMatch:        request.UploadRequest.IfNoneMatch,
-		ContentType:        request.UploadRequest.ContentType,
-		ContentLanguage:    request.UploadRequest.ContentLanguage,
-		ContentEncoding:    request.UploadRequest.ContentEncoding,
-		ContentMD5:         request.UploadRequest.ContentMD5,
-		OpcClientRequestId: request.UploadRequest.OpcClientRequestID,
-		RequestMetadata:    request.UploadRequest.RequestMetadata,
-	}
-	putObjResp, err := request.UploadRequest.ObjectStorageClient.PutObject(ctx, putObjReq)
+	putObjResp, err := request.UploadRequest.putObject(ctx, http.NoBody, 0)
 	spUploadResp := SinglepartUploadResponse{putObjResp}
 	return UploadResponse{SinglepartUpload, &spUploadResp, nil}, err
 }
@@ -4,7 +4,9 @@
 package transfer
 
 import (
+	"context"
 	"errors"
+	"io"
 	"math"
 	"net/http"
 	"time"
@@ -52,6 +54,11 @@ type UploadRequest struct {
 	// A configured object storage client to use for interacting with the Object Storage service.
 	ObjectStorageClient *objectstorage.ObjectStorageClient `mandatory:"false"`
 
+	// [Optional] A client for a pre-authenticated request of the bucket or of the object to upload. When set, the object
+	// is uploaded through the pre-authenticated request without any credentials, ObjectStorageClient, NamespaceName
+	// and BucketName are not used. ObjectName is only required for bucket level pre-authenticated requests.
+	PreauthenticatedRequestClient *PreauthenticatedRequestClient `mandatory:"false"`
+
 	// [Optional] The entity tag of the object to match.
 	IfMatch *string `mandatory:"false"`
 
@@ -87,6 +94,20 @@ type UploadRequest struct {
 
 	// [Optional] Whether or not this UploadManager supports performing multipart uploads md5 checksum verification. Defaults to False.
 	EnableMultipartChecksumVerification *bool `mandatory:"false"`
+
+	// [Optional] The maximum number of bytes per second uploaded by this request, shared across all of its parts.
+	// This setting is ignored if BandwidthLimiter is set. Defaults to no limit.
+	MaxBytesPerSecond *int64 `mandatory:"false"`
+
+	// [Optional] The bandwidth limiter used to throttle this request. Use the same limiter for several requests,
+	// and for the ObjectReaderRequest of the downloads, to cap the combined throughput of the transfers in the process.
+	BandwidthLimiter *BandwidthLimiter `mandatory:"false"`
+
+	// [Optional] Whether or not the number of go routines uploading parts of a multipart upload adapts to the service
+	// responses. Downloads with an ObjectReader are not concerned, they read sequentially. When enabled,
+	// NumberOfGoroutines is the upper bound, the number of parts in flight is halved when the service throttles
+	// the upload (429 or 503) and grows back one by one on sustained success. Defaults to False.
+	EnableAdaptiveConcurrency *bool `mandatory:"false"`
 }
 
 // RetryPolicy implements the OCIRetryableRequest interface. This retrieves the specified retry policy.
@@ -98,11 +119,16 @@ var (
 	errorInvalidNamespace  = errors.New("namespaceName is required")
 	errorInvalidBucketName = errors.New("bucketName is required")
 	errorInvalidObjectName = errors.New("objectName is required")
+	errorInvalidBandwidth  = errors.New("maxBytesPerSecond must be greater than 0")
 )
 
 const defaultNumberOfGoroutines = 5 // increase the value might cause 409 error form service and client timeout
 
 func (request UploadRequest) validate() error {
+	if request.PreauthenticatedRequestClient != nil {
+		return request.validatePreauthenticatedRequest()
+	}
+
 	if request.NamespaceName == nil {
 		return errorInvalidNamespace
 	}
@@ -115,11 +141,27 @@ func (request UploadRequest) validate() error {
 		return errorInvalidObjectName
 	}
 
+	if request.MaxBytesPerSecond != nil && *request.MaxBytesPerSecond <= 0 {
+		return errorInvalidBandwidth
+	}
+
+	return nil
+}
+
+func (request UploadRequest) validatePreauthenticatedRequest() error {
+	if request.PreauthenticatedRequestClient.IsBucketLevel() && request.ObjectName == nil {
+		return errorInvalidObjectName
+	}
+
+	if request.MaxBytesPerSecond != nil && *request.MaxBytesPerSecond <= 0 {
+		return errorInvalidBandwidth
+	}
+
 	return nil
 }
 
 func (request *UploadRequest) initDefaultValues() error {
-	if request.ObjectStorageClient == nil {
+	if request.ObjectStorageClient == nil && request.PreauthenticatedRequestClient == nil {
 		client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(common.DefaultConfigProvider())
 
 		// default timeout is 60s which includes the time for reading the body
@@ -151,6 +193,10 @@ func (request *UploadRequest) initDefaultValues() error {
 		request.NumberOfGoroutines = common.Int(1) // one go routine for upload
 	}
 
+	if request.BandwidthLimiter == nil && request.MaxBytesPerSecond != nil {
+		request.BandwidthLimiter = NewBandwidthLimiter(*request.MaxBytesPerSecond)
+	}
+
 	if request.RetryPolicy() == nil {
 		// default retry policy
 		request.RequestMetadata = common.RequestMetadata{RetryPolicy: getUploadManagerDefaultRetryPolicy()}
@@ -159,6 +205,43 @@ func (request *UploadRequest) initDefaultValues() error {
 	return nil
 }
 
+// putObject uploads the body in a single request, through the pre-authenticated request client if one is set
+func (request UploadRequest) putObject(ctx context.Context, body io.ReadCloser, contentLength int64) (objectstorage.PutObjectResponse, error) {
+	if request.PreauthenticatedRequestClient != nil {
+		return request.PreauthenticatedRequestClient.PutObject(ctx, PreauthenticatedPutObjectRequest{
+			ObjectName:         request.ObjectName,
+			ContentLength:      common.Int64(contentLength),
+			PutObjectBody:      body,
+			OpcMeta:            request.Metadata,
+			IfMatch:            request.IfMatch,
+			IfNoneMatch:        request.IfNoneMatch,
+			ContentType:        request.ContentType,
+			ContentLanguage:    request.ContentLanguage,
+			ContentEncoding:    request.ContentEncoding,
+			ContentMD5:         request.ContentMD5,
+			OpcClientRequestId: request.OpcClientRequestID,
+			RequestMetadata:    request.RequestMetadata,
+		})
+	}
+
+	return request.ObjectStorageClient.PutObject(ctx, objectstorage.PutObjectRequest{
+		NamespaceName:      request.NamespaceName,
+		BucketName:         request.BucketName,
+		ObjectName:         request.ObjectName,
+		ContentLength:      common.Int64(contentLength),
+		PutObjectBody:      body,
+		OpcMeta:            request.Metadata,
+		IfMatch:            request.IfMatch,
+		IfNoneMatch:        request.IfNoneMatch,
+		ContentType:        request.ContentType,
+		ContentLanguage:    request.ContentLanguage,
+		ContentEncoding:    request.ContentEncoding,
+		ContentMD5:         request.ContentMD5,
+		OpcClientRequestId: request.OpcClientRequestID,
+		RequestMetadata:    request.RequestMetadata,
+	})
+}
+
 // UploadResponseType with underlying type: string
 type UploadResponseType string
 
@@ -4,11 +4,15 @@
 package transfer
 
 import (
+	"bytes"
 	"context"
+	"io/ioutil"
 	"testing"
+	"testing/iotest"
 
 	"github.com/oracle/oci-go-sdk/v27/common"
 	"github.com/oracle/oci-go-sdk/v27/example/helpers"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,3 +117,55 @@ func TestUploadManager_UploadStream(t *testing.T) {
 	_, err := uploadManager.UploadStream(context.Background(), req)
 	assert.Equal(t, errorInvalidStreamUploader, err)
 }
+
+// fakeStreamUpload reads the whole stream of the request, as the stream uploader does
+type fakeStreamUpload struct {
+	content []byte
+}
+
+func (fake *fakeStreamUpload) UploadStream(ctx context.Context, request UploadStreamRequest) (response UploadResponse, err error) {
+	fake.content, err = ioutil.ReadAll(request.StreamReader)
+	return UploadResponse{Type: MultipartUpload}, err
+}
+
+func TestUploadManager_UploadStreamUploadsTheProbedBytes(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 5)
+	streamUploader := &fakeStreamUpload{}
+	uploadManager := UploadManager{StreamUploader: streamUploader}
+	_, err := uploadManager.UploadStream(context.Background(), UploadStreamRequest{
+		UploadRequest: UploadRequest{
+			NamespaceName:       common.String("namespace"),
+			BucketName:          common.String("bname"),
+			ObjectName:          common.String("objectName"),
+			ObjectStorageClient: &objectstorage.ObjectStorageClient{},
+			PartSize:            common.Int64(10),
+		},
+		StreamReader: bytes.NewReader(content),
+	})
+	assert.NoError(t, err)
+
+	// the bytes read to check whether the stream is empty are uploaded too
+	assert.Equal(t, content, streamUploader.content)
+}
+
+func TestUploadManager_UploadStreamProbeError(t *testing.T) {
+	uploadManager := UploadManager{StreamUploader: &fakeStreamUpload{}}
+	_, err := uploadManager.UploadStream(context.Background(), UploadStreamRequest{
+		UploadRequest: UploadRequest{
+			NamespaceName:       common.String("namespace"),
+			BucketName:          common.String("bname"),
+			ObjectName:          common.String("objectName"),
+			ObjectStorageClient: &objectstorage.ObjectStorageClient{},
+		},
+		StreamReader: failingReader{},
+	})
+
+	// a stream failing before its first byte is not uploaded as an empty object
+	assert.Equal(t, iotest.ErrTimeout, err)
+}
+
+type failingReader struct{}
+
+func (fr failingReader) Read(p []byte) (n int, err error) {
+	return 0, iotest.ErrTimeout
+}
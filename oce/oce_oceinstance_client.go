@@ -102,10 +102,12 @@ func (client OceInstanceClient) ChangeOceInstanceCompartment(ctx context.Context
 				response = ChangeOceInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeOceInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeOceInstanceCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client OceInstanceClient) CreateOceInstance(ctx context.Context, request C
 				response = CreateOceInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateOceInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateOceInstanceResponse")
 	}
@@ -274,6 +274,65 @@ Sometimes you may need to wait until an attribute of a resource, such as an inst
 An example of this would be launching an instance and then waiting for the instance to become available, or waiting until a subnet in a VCN has been terminated.
 You might also want to retry the same operation again if there's network issue etc...
 This can be accomplished by using the RequestMetadata.RetryPolicy. You can find the examples here: https://github.com/oracle/oci-go-sdk/blob/master/example/example_retry_test.go
+The attempts stop as soon as the context of the request is done, even while waiting to retry it. The OnAttempt and
+OnRetry hooks of the policy are called after each attempt and before each wait, with the errors of the attempts so far.
+The operations which accept an opc-retry-token send the same token with all the attempts of a request, so that the
+service does not perform it twice: the token is generated unless it is set on the request, and returned on the
+OpcRetryToken field of the response.
+
+Mocking Clients
+
+Every service client implements an interface of its operations, named after the client, such as core.ComputeClientAPI
+for core.ComputeClient. Code depending on these interfaces rather than on the clients can be tested with mocks of the
+clients. The interfaces are generated from the clients by running "go generate". An example of a mock can be found
+here: https://github.com/oracle/oci-go-sdk/blob/master/example/example_client_api_test.go
+
+Validating Requests
+
+Every request, and every details struct of a request body, has a Validate method which reports all its missing
+mandatory fields, invalid enum values and malformed OCIDs at once, with the paths of the fields, such as
+CreateVcnDetails.CidrBlock. The clients validate the requests before sending them once validation is enabled:
+	common.EnableRequestValidation()
+
+	// fails without calling the service, the error being a common.ValidationError
+	_, err := client.CreateVcn(ctx, core.CreateVcnRequest{})
+
+The validation can be enabled and disabled at any time, common.DisableRequestValidation disabling it. The Validate
+methods are generated from the models by running "go generate".
+
+Reading and Writing Models
+
+The models of the services, such as core.LaunchInstanceDetails, can be stored as json documents, such as the
+specifications of resources kept in Git, with the package common/modelio. The documents are deterministic, and keep
+the discriminators of the polymorphic models, such as core.InstanceSourceDetails, so that the models read from them
+are the ones written:
+	var details core.LaunchInstanceDetails
+	err := modelio.Load("instance.json", &details)
+
+	err = modelio.Save("instance.json", details)
+
+The yaml documents are read and written by the package common/modelio/modelyaml, a module of its own so that the SDK
+does not depend on a yaml library:
+	err := modelyaml.Load("instance.yaml", &details)
+
+The drift between a resource and its desired model can be detected with the package common/modeldiff, which also
+builds the update details holding only the fields to change:
+	var details core.UpdateInstanceDetails
+	changes, err := modeldiff.Patch(instance, desired, &details)
+
+Calling Any Endpoint
+
+The endpoints which are not supported by the service clients yet, such as preview endpoints, can be called with the
+Invoke method of the clients, which signs, retries and logs the requests, and returns the failures of the service as
+common.ServiceError like the operations of the clients:
+	var vcn core.Vcn
+	_, err := client.Invoke(ctx, common.InvokeRequest{
+		Method:     http.MethodGet,
+		Path:       "/vcns/{vcnId}",
+		PathParams: map[string]string{"vcnId": vcnID},
+	}, &vcn)
+
+An example can be found here: https://github.com/oracle/oci-go-sdk/blob/master/example/example_rawrequest_test.go
 
 Using the SDK with a Proxy Server
 
@@ -360,3 +419,7 @@ Please refer to this link: https://github.com/oracle/oci-go-sdk#help
 package oci
 
 //go:generate go run cmd/genver/main.go cmd/genver/version_template.go --output common/version.go
+//go:generate go run ./cmd/genclientapi
+//go:generate go run ./cmd/genvalidation
+//go:generate go run ./cmd/genpolymorphic
+//go:generate go run ./cmd/genretrytoken
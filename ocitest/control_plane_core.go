@@ -0,0 +1,666 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+	"github.com/oracle/oci-go-sdk/v27/workrequests"
+)
+
+// defaultVolumeSizeInGBs is the size of the volumes if the request does not give it
+const defaultVolumeSizeInGBs = 1024
+
+type fakeVcn struct {
+	core.Vcn
+	lifecycle
+}
+
+type fakeSubnet struct {
+	core.Subnet
+	lifecycle
+	network *net.IPNet
+}
+
+type fakeInstance struct {
+	core.Instance
+	lifecycle
+	subnetID string
+}
+
+type fakeVolume struct {
+	core.Volume
+	lifecycle
+}
+
+func (server *ControlPlaneServer) routeCore(router *router) {
+	router.handle(http.MethodPost, "/vcns", server.locked(server.createVcn))
+	router.handle(http.MethodGet, "/vcns", server.locked(server.listVcns))
+	router.handle(http.MethodGet, "/vcns/{vcnId}", server.locked(server.getVcn))
+	router.handle(http.MethodPut, "/vcns/{vcnId}", server.locked(server.updateVcn))
+	router.handle(http.MethodDelete, "/vcns/{vcnId}", server.locked(server.deleteVcn))
+	router.handle(http.MethodPost, "/subnets", server.locked(server.createSubnet))
+	router.handle(http.MethodGet, "/subnets", server.locked(server.listSubnets))
+	router.handle(http.MethodGet, "/subnets/{subnetId}", server.locked(server.getSubnet))
+	router.handle(http.MethodPut, "/subnets/{subnetId}", server.locked(server.updateSubnet))
+	router.handle(http.MethodDelete, "/subnets/{subnetId}", server.locked(server.deleteSubnet))
+	router.handle(http.MethodPost, "/instances", server.locked(server.launchInstance))
+	router.handle(http.MethodGet, "/instances", server.locked(server.listInstances))
+	router.handle(http.MethodGet, "/instances/{instanceId}", server.locked(server.getInstance))
+	router.handle(http.MethodPut, "/instances/{instanceId}", server.locked(server.updateInstance))
+	router.handle(http.MethodPost, "/instances/{instanceId}", server.locked(server.instanceAction))
+	router.handle(http.MethodDelete, "/instances/{instanceId}", server.locked(server.terminateInstance))
+	router.handle(http.MethodPost, "/volumes", server.locked(server.createVolume))
+	router.handle(http.MethodGet, "/volumes", server.locked(server.listVolumes))
+	router.handle(http.MethodGet, "/volumes/{volumeId}", server.locked(server.getVolume))
+	router.handle(http.MethodPut, "/volumes/{volumeId}", server.locked(server.updateVolume))
+	router.handle(http.MethodDelete, "/volumes/{volumeId}", server.locked(server.deleteVolume))
+}
+
+// read applies the pending change of the state of a resource returned by its Get operation, or writes an error
+// response and returns false if the resource is removed by the change
+func (server *ControlPlaneServer) read(w http.ResponseWriter, resource *lifecycle, resourceType, id string) bool {
+	resource.read(server.TransitionReads)
+	if resource.removed {
+		writeNotFound(w, resourceType, id)
+		return false
+	}
+	return true
+}
+
+// listed returns whether a resource matches the compartmentId, displayName and lifecycleState query parameters of
+// a list request
+func listed(r *http.Request, compartmentID, displayName *string, lifecycleState string) bool {
+	query := r.URL.Query()
+	return *compartmentID == query.Get("compartmentId") &&
+		(query.Get("displayName") == "" || (displayName != nil && *displayName == query.Get("displayName"))) &&
+		(query.Get("lifecycleState") == "" || lifecycleState == query.Get("lifecycleState"))
+}
+
+// defaultDisplayName returns the display name of a resource created without one, as the services name them
+func defaultDisplayName(resourceType string) *string {
+	return common.String(resourceType + time.Now().Format("20060102150405"))
+}
+
+func (server *ControlPlaneServer) vcn(w http.ResponseWriter, vcnID string) *fakeVcn {
+	for _, vcn := range server.vcns {
+		if *vcn.Id == vcnID && !vcn.removed {
+			return vcn
+		}
+	}
+	writeNotFound(w, "vcn", vcnID)
+	return nil
+}
+
+func (server *ControlPlaneServer) createVcn(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := core.CreateVcnDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CidrBlock == nil || details.CompartmentId == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "cidrBlock and compartmentId are required")
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(*details.CidrBlock); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid cidrBlock %s", *details.CidrBlock))
+		return
+	}
+
+	vcn := &fakeVcn{Vcn: core.Vcn{
+		CidrBlock:             details.CidrBlock,
+		CompartmentId:         details.CompartmentId,
+		Id:                    common.String(newOCID("vcn")),
+		LifecycleState:        core.VcnLifecycleStateProvisioning,
+		DefaultDhcpOptionsId:  common.String(newOCID("dhcpoptions")),
+		DefaultRouteTableId:   common.String(newOCID("routetable")),
+		DefaultSecurityListId: common.String(newOCID("securitylist")),
+		DefinedTags:           details.DefinedTags,
+		DisplayName:           details.DisplayName,
+		DnsLabel:              details.DnsLabel,
+		FreeformTags:          details.FreeformTags,
+		TimeCreated:           now(),
+	}}
+	if vcn.DisplayName == nil {
+		vcn.DisplayName = defaultDisplayName("vcn")
+	}
+	if vcn.DnsLabel != nil {
+		vcn.VcnDomainName = common.String(*vcn.DnsLabel + ".oraclevcn.com")
+	}
+
+	vcn.transition(func() {
+		vcn.LifecycleState = core.VcnLifecycleStateAvailable
+	})
+	server.vcns = append(server.vcns, vcn)
+	writeJSON(w, http.StatusOK, vcn.Vcn)
+}
+
+func (server *ControlPlaneServer) listVcns(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	items := []core.Vcn{}
+	for _, vcn := range server.vcns {
+		if !vcn.removed && listed(r, vcn.CompartmentId, vcn.DisplayName, string(vcn.LifecycleState)) {
+			items = append(items, vcn.Vcn)
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getVcn(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vcn := server.vcn(w, params["vcnId"])
+	if vcn != nil && server.read(w, &vcn.lifecycle, "vcn", params["vcnId"]) {
+		writeJSON(w, http.StatusOK, vcn.Vcn)
+	}
+}
+
+func (server *ControlPlaneServer) updateVcn(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vcn := server.vcn(w, params["vcnId"])
+	details := core.UpdateVcnDetails{}
+	if vcn == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.DisplayName != nil {
+		vcn.DisplayName = details.DisplayName
+	}
+	if details.DefinedTags != nil {
+		vcn.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		vcn.FreeformTags = details.FreeformTags
+	}
+	writeJSON(w, http.StatusOK, vcn.Vcn)
+}
+
+func (server *ControlPlaneServer) deleteVcn(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vcn := server.vcn(w, params["vcnId"])
+	if vcn == nil {
+		return
+	}
+
+	for _, subnet := range server.subnets {
+		if *subnet.VcnId == *vcn.Id && !subnet.removed && subnet.LifecycleState != core.SubnetLifecycleStateTerminating {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("vcn %s still has subnet %s", *vcn.Id, *subnet.Id))
+			return
+		}
+	}
+
+	if vcn.LifecycleState != core.VcnLifecycleStateTerminating {
+		vcn.LifecycleState = core.VcnLifecycleStateTerminating
+		vcn.transition(vcn.remove)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *ControlPlaneServer) subnet(w http.ResponseWriter, subnetID string) *fakeSubnet {
+	for _, subnet := range server.subnets {
+		if *subnet.Id == subnetID && !subnet.removed {
+			return subnet
+		}
+	}
+	writeNotFound(w, "subnet", subnetID)
+	return nil
+}
+
+func (server *ControlPlaneServer) createSubnet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := core.CreateSubnetDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CidrBlock == nil || details.CompartmentId == nil || details.VcnId == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "cidrBlock, compartmentId and vcnId are required")
+		return
+	}
+
+	vcn := server.vcn(w, *details.VcnId)
+	if vcn == nil {
+		return
+	}
+
+	_, network, err := net.ParseCIDR(*details.CidrBlock)
+	if err != nil || network.IP.To4() == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid cidrBlock %s", *details.CidrBlock))
+		return
+	}
+
+	_, vcnNetwork, _ := net.ParseCIDR(*vcn.CidrBlock)
+	vcnOnes, _ := vcnNetwork.Mask.Size()
+	if ones, _ := network.Mask.Size(); !vcnNetwork.Contains(network.IP) || ones < vcnOnes {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("cidrBlock %s is not in the cidrBlock %s of the vcn", *details.CidrBlock, *vcn.CidrBlock))
+		return
+	}
+
+	for _, subnet := range server.subnets {
+		if *subnet.VcnId == *vcn.Id && !subnet.removed && (subnet.network.Contains(network.IP) || network.Contains(subnet.network.IP)) {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("cidrBlock %s overlaps the subnet %s", *details.CidrBlock, *subnet.Id))
+			return
+		}
+	}
+
+	routerIP := append(net.IP{}, network.IP.To4()...)
+	routerIP[3]++
+	subnet := &fakeSubnet{
+		Subnet: core.Subnet{
+			CidrBlock:              details.CidrBlock,
+			CompartmentId:          details.CompartmentId,
+			Id:                     common.String(newOCID("subnet")),
+			LifecycleState:         core.SubnetLifecycleStateProvisioning,
+			RouteTableId:           details.RouteTableId,
+			VcnId:                  vcn.Id,
+			VirtualRouterIp:        common.String(routerIP.String()),
+			VirtualRouterMac:       common.String("00:00:17:" + randomHex(2) + ":" + randomHex(2) + ":" + randomHex(2)),
+			AvailabilityDomain:     details.AvailabilityDomain,
+			DefinedTags:            details.DefinedTags,
+			DhcpOptionsId:          details.DhcpOptionsId,
+			DisplayName:            details.DisplayName,
+			DnsLabel:               details.DnsLabel,
+			FreeformTags:           details.FreeformTags,
+			ProhibitPublicIpOnVnic: details.ProhibitPublicIpOnVnic,
+			SecurityListIds:        details.SecurityListIds,
+			TimeCreated:            now(),
+		},
+		network: network,
+	}
+	if subnet.RouteTableId == nil {
+		subnet.RouteTableId = vcn.DefaultRouteTableId
+	}
+	if subnet.DhcpOptionsId == nil {
+		subnet.DhcpOptionsId = vcn.DefaultDhcpOptionsId
+	}
+	if subnet.SecurityListIds == nil {
+		subnet.SecurityListIds = []string{*vcn.DefaultSecurityListId}
+	}
+	if subnet.DisplayName == nil {
+		subnet.DisplayName = defaultDisplayName("subnet")
+	}
+	if subnet.DnsLabel != nil && vcn.VcnDomainName != nil {
+		subnet.SubnetDomainName = common.String(*subnet.DnsLabel + "." + *vcn.VcnDomainName)
+	}
+
+	subnet.transition(func() {
+		subnet.LifecycleState = core.SubnetLifecycleStateAvailable
+	})
+	server.subnets = append(server.subnets, subnet)
+	writeJSON(w, http.StatusOK, subnet.Subnet)
+}
+
+func (server *ControlPlaneServer) listSubnets(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	vcnID := r.URL.Query().Get("vcnId")
+	items := []core.Subnet{}
+	for _, subnet := range server.subnets {
+		if !subnet.removed && (vcnID == "" || *subnet.VcnId == vcnID) &&
+			listed(r, subnet.CompartmentId, subnet.DisplayName, string(subnet.LifecycleState)) {
+			items = append(items, subnet.Subnet)
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getSubnet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	subnet := server.subnet(w, params["subnetId"])
+	if subnet != nil && server.read(w, &subnet.lifecycle, "subnet", params["subnetId"]) {
+		writeJSON(w, http.StatusOK, subnet.Subnet)
+	}
+}
+
+func (server *ControlPlaneServer) updateSubnet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	subnet := server.subnet(w, params["subnetId"])
+	details := core.UpdateSubnetDetails{}
+	if subnet == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.DisplayName != nil {
+		subnet.DisplayName = details.DisplayName
+	}
+	if details.DhcpOptionsId != nil {
+		subnet.DhcpOptionsId = details.DhcpOptionsId
+	}
+	if details.RouteTableId != nil {
+		subnet.RouteTableId = details.RouteTableId
+	}
+	if details.SecurityListIds != nil {
+		subnet.SecurityListIds = details.SecurityListIds
+	}
+	if details.DefinedTags != nil {
+		subnet.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		subnet.FreeformTags = details.FreeformTags
+	}
+	writeJSON(w, http.StatusOK, subnet.Subnet)
+}
+
+func (server *ControlPlaneServer) deleteSubnet(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	subnet := server.subnet(w, params["subnetId"])
+	if subnet == nil {
+		return
+	}
+
+	for _, instance := range server.instances {
+		if instance.subnetID == *subnet.Id && instance.LifecycleState != core.InstanceLifecycleStateTerminated {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("subnet %s is used by instance %s", *subnet.Id, *instance.Id))
+			return
+		}
+	}
+
+	if subnet.LifecycleState != core.SubnetLifecycleStateTerminating {
+		subnet.LifecycleState = core.SubnetLifecycleStateTerminating
+		subnet.transition(subnet.remove)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *ControlPlaneServer) instance(w http.ResponseWriter, instanceID string) *fakeInstance {
+	for _, instance := range server.instances {
+		if *instance.Id == instanceID {
+			return instance
+		}
+	}
+	writeNotFound(w, "instance", instanceID)
+	return nil
+}
+
+func (server *ControlPlaneServer) launchInstance(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := core.LaunchInstanceDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.AvailabilityDomain == nil || details.CompartmentId == nil || details.Shape == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "availabilityDomain, compartmentId and shape are required")
+		return
+	}
+
+	subnetID := details.SubnetId
+	if details.CreateVnicDetails != nil && details.CreateVnicDetails.SubnetId != nil {
+		subnetID = details.CreateVnicDetails.SubnetId
+	}
+	if subnetID == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "createVnicDetails.subnetId is required")
+		return
+	}
+	if server.subnet(w, *subnetID) == nil {
+		return
+	}
+
+	instance := &fakeInstance{
+		Instance: core.Instance{
+			AvailabilityDomain: details.AvailabilityDomain,
+			CompartmentId:      details.CompartmentId,
+			Id:                 common.String(newOCID("instance")),
+			Region:             common.String(server.Region),
+			Shape:              details.Shape,
+			TimeCreated:        now(),
+			DefinedTags:        details.DefinedTags,
+			DisplayName:        details.DisplayName,
+			ExtendedMetadata:   details.ExtendedMetadata,
+			FaultDomain:        details.FaultDomain,
+			FreeformTags:       details.FreeformTags,
+			ImageId:            details.ImageId,
+			Metadata:           details.Metadata,
+			SourceDetails:      details.SourceDetails,
+		},
+		subnetID: *subnetID,
+	}
+	if instance.DisplayName == nil {
+		instance.DisplayName = defaultDisplayName("instance")
+	}
+
+	instance.setState(core.InstanceLifecycleStateProvisioning, core.InstanceLifecycleStateStarting, core.InstanceLifecycleStateRunning)
+	server.instances = append(server.instances, instance)
+
+	workRequest := server.newWorkRequest("LaunchInstance", *instance.CompartmentId, "instance", *instance.Id,
+		workrequests.WorkRequestResourceActionTypeCreated, &instance.lifecycle)
+	w.Header().Set("opc-work-request-id", *workRequest.Id)
+	writeJSON(w, http.StatusOK, instance.Instance)
+}
+
+func (server *ControlPlaneServer) listInstances(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	availabilityDomain := r.URL.Query().Get("availabilityDomain")
+	items := []core.Instance{}
+	for _, instance := range server.instances {
+		if (availabilityDomain == "" || *instance.AvailabilityDomain == availabilityDomain) &&
+			listed(r, instance.CompartmentId, instance.DisplayName, string(instance.LifecycleState)) {
+			items = append(items, instance.Instance)
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getInstance(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	instance := server.instance(w, params["instanceId"])
+	if instance != nil && server.read(w, &instance.lifecycle, "instance", params["instanceId"]) {
+		writeJSON(w, http.StatusOK, instance.Instance)
+	}
+}
+
+func (server *ControlPlaneServer) updateInstance(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	instance := server.instance(w, params["instanceId"])
+	details := core.UpdateInstanceDetails{}
+	if instance == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.DisplayName != nil {
+		instance.DisplayName = details.DisplayName
+	}
+	if details.Metadata != nil {
+		instance.Metadata = details.Metadata
+	}
+	if details.ExtendedMetadata != nil {
+		instance.ExtendedMetadata = details.ExtendedMetadata
+	}
+	if details.Shape != nil {
+		instance.Shape = details.Shape
+	}
+	if details.FaultDomain != nil {
+		instance.FaultDomain = details.FaultDomain
+	}
+	if details.DefinedTags != nil {
+		instance.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		instance.FreeformTags = details.FreeformTags
+	}
+	writeJSON(w, http.StatusOK, instance.Instance)
+}
+
+// instanceActions are the states of the instances through an action, from the state the action applies to. The
+// actions on the instances already in the last state are ignored.
+var instanceActions = map[core.InstanceActionActionEnum][]core.InstanceLifecycleStateEnum{
+	core.InstanceActionActionStop:      {core.InstanceLifecycleStateRunning, core.InstanceLifecycleStateStopping, core.InstanceLifecycleStateStopped},
+	core.InstanceActionActionSoftstop:  {core.InstanceLifecycleStateRunning, core.InstanceLifecycleStateStopping, core.InstanceLifecycleStateStopped},
+	core.InstanceActionActionStart:     {core.InstanceLifecycleStateStopped, core.InstanceLifecycleStateStarting, core.InstanceLifecycleStateRunning},
+	core.InstanceActionActionReset:     {core.InstanceLifecycleStateRunning, core.InstanceLifecycleStateStopping, core.InstanceLifecycleStateStarting, core.InstanceLifecycleStateRunning},
+	core.InstanceActionActionSoftreset: {core.InstanceLifecycleStateRunning, core.InstanceLifecycleStateStopping, core.InstanceLifecycleStateStarting, core.InstanceLifecycleStateRunning},
+}
+
+func (server *ControlPlaneServer) instanceAction(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	instance := server.instance(w, params["instanceId"])
+	if instance == nil {
+		return
+	}
+
+	action := r.URL.Query().Get("action")
+	states, ok := instanceActions[core.InstanceActionActionEnum(action)]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("action %s is not supported", action))
+		return
+	}
+
+	switch instance.LifecycleState {
+	case states[0]:
+		instance.setState(states[1:]...)
+	case states[len(states)-1]:
+	default:
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("instance %s is %s", *instance.Id, instance.LifecycleState))
+		return
+	}
+	writeJSON(w, http.StatusOK, instance.Instance)
+}
+
+// setState changes the state of an instance to the first state, and then to the following states as it is read
+func (instance *fakeInstance) setState(states ...core.InstanceLifecycleStateEnum) {
+	instance.LifecycleState = states[0]
+	steps := []func(){}
+	for _, state := range states[1:] {
+		state := state
+		steps = append(steps, func() {
+			instance.LifecycleState = state
+		})
+	}
+	instance.transition(steps...)
+}
+
+func (server *ControlPlaneServer) terminateInstance(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	instance := server.instance(w, params["instanceId"])
+	if instance == nil {
+		return
+	}
+
+	if instance.LifecycleState != core.InstanceLifecycleStateTerminating && instance.LifecycleState != core.InstanceLifecycleStateTerminated {
+		instance.setState(core.InstanceLifecycleStateTerminating, core.InstanceLifecycleStateTerminated)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *ControlPlaneServer) volume(w http.ResponseWriter, volumeID string) *fakeVolume {
+	for _, volume := range server.volumes {
+		if *volume.Id == volumeID && !volume.removed {
+			return volume
+		}
+	}
+	writeNotFound(w, "volume", volumeID)
+	return nil
+}
+
+func (server *ControlPlaneServer) createVolume(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := core.CreateVolumeDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.AvailabilityDomain == nil || details.CompartmentId == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "availabilityDomain and compartmentId are required")
+		return
+	}
+
+	sizeInGBs := int64(defaultVolumeSizeInGBs)
+	if details.SizeInGBs != nil {
+		sizeInGBs = *details.SizeInGBs
+	} else if details.SizeInMBs != nil {
+		sizeInGBs = *details.SizeInMBs / 1024
+	}
+	if sizeInGBs < 50 || sizeInGBs > 32768 {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid size %d GB, the volumes have 50 GB to 32 TB", sizeInGBs))
+		return
+	}
+
+	volume := &fakeVolume{Volume: core.Volume{
+		AvailabilityDomain: details.AvailabilityDomain,
+		CompartmentId:      details.CompartmentId,
+		DisplayName:        details.DisplayName,
+		Id:                 common.String(newOCID("volume")),
+		LifecycleState:     core.VolumeLifecycleStateProvisioning,
+		SizeInMBs:          common.Int64(sizeInGBs * 1024),
+		TimeCreated:        now(),
+		DefinedTags:        details.DefinedTags,
+		FreeformTags:       details.FreeformTags,
+		IsHydrated:         common.Bool(true),
+		KmsKeyId:           details.KmsKeyId,
+		VpusPerGB:          details.VpusPerGB,
+		SizeInGBs:          common.Int64(sizeInGBs),
+		SourceDetails:      details.SourceDetails,
+	}}
+	if volume.DisplayName == nil {
+		volume.DisplayName = defaultDisplayName("volume")
+	}
+
+	volume.transition(func() {
+		volume.LifecycleState = core.VolumeLifecycleStateAvailable
+	})
+	server.volumes = append(server.volumes, volume)
+	writeJSON(w, http.StatusOK, volume.Volume)
+}
+
+func (server *ControlPlaneServer) listVolumes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	availabilityDomain := r.URL.Query().Get("availabilityDomain")
+	items := []core.Volume{}
+	for _, volume := range server.volumes {
+		if !volume.removed && (availabilityDomain == "" || *volume.AvailabilityDomain == availabilityDomain) &&
+			listed(r, volume.CompartmentId, volume.DisplayName, string(volume.LifecycleState)) {
+			items = append(items, volume.Volume)
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getVolume(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	volume := server.volume(w, params["volumeId"])
+	if volume != nil && server.read(w, &volume.lifecycle, "volume", params["volumeId"]) {
+		writeJSON(w, http.StatusOK, volume.Volume)
+	}
+}
+
+func (server *ControlPlaneServer) updateVolume(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	volume := server.volume(w, params["volumeId"])
+	details := core.UpdateVolumeDetails{}
+	if volume == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.SizeInGBs != nil {
+		if *details.SizeInGBs < *volume.SizeInGBs {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", "the volumes can not be shrunk")
+			return
+		}
+		volume.SizeInGBs = details.SizeInGBs
+		volume.SizeInMBs = common.Int64(*details.SizeInGBs * 1024)
+	}
+	if details.DisplayName != nil {
+		volume.DisplayName = details.DisplayName
+	}
+	if details.VpusPerGB != nil {
+		volume.VpusPerGB = details.VpusPerGB
+	}
+	if details.DefinedTags != nil {
+		volume.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		volume.FreeformTags = details.FreeformTags
+	}
+	writeJSON(w, http.StatusOK, volume.Volume)
+}
+
+func (server *ControlPlaneServer) deleteVolume(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	volume := server.volume(w, params["volumeId"])
+	if volume == nil {
+		return
+	}
+
+	if volume.LifecycleState != core.VolumeLifecycleStateTerminating {
+		volume.LifecycleState = core.VolumeLifecycleStateTerminating
+		volume.transition(volume.remove)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/identity"
+	"github.com/oracle/oci-go-sdk/v27/workrequests"
+)
+
+type fakeCompartment struct {
+	identity.Compartment
+	lifecycle
+}
+
+func (server *ControlPlaneServer) routeIdentity(router *router) {
+	router.handle(http.MethodPost, "/compartments", server.locked(server.createCompartment))
+	router.handle(http.MethodGet, "/compartments", server.locked(server.listCompartments))
+	router.handle(http.MethodGet, "/compartments/{compartmentId}", server.locked(server.getCompartment))
+	router.handle(http.MethodPut, "/compartments/{compartmentId}", server.locked(server.updateCompartment))
+	router.handle(http.MethodDelete, "/compartments/{compartmentId}", server.locked(server.deleteCompartment))
+	router.handle(http.MethodPost, "/policies", server.locked(server.createPolicy))
+	router.handle(http.MethodGet, "/policies", server.locked(server.listPolicies))
+	router.handle(http.MethodGet, "/policies/{policyId}", server.locked(server.getPolicy))
+	router.handle(http.MethodPut, "/policies/{policyId}", server.locked(server.updatePolicy))
+	router.handle(http.MethodDelete, "/policies/{policyId}", server.locked(server.deletePolicy))
+}
+
+func (server *ControlPlaneServer) compartment(w http.ResponseWriter, compartmentID string) *fakeCompartment {
+	for _, compartment := range server.compartments {
+		if *compartment.Id == compartmentID {
+			return compartment
+		}
+	}
+	writeNotFound(w, "compartment", compartmentID)
+	return nil
+}
+
+// parentCompartment checks that the parent of a compartment or policy is the tenancy or a compartment which is not
+// deleted, or writes an error response and returns false
+func (server *ControlPlaneServer) parentCompartment(w http.ResponseWriter, compartmentID string) bool {
+	if compartmentID == server.TenancyId {
+		return true
+	}
+
+	compartment := server.compartment(w, compartmentID)
+	if compartment != nil && compartment.LifecycleState != identity.CompartmentLifecycleStateActive &&
+		compartment.LifecycleState != identity.CompartmentLifecycleStateCreating {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("compartment %s is %s", compartmentID, compartment.LifecycleState))
+		return false
+	}
+	return compartment != nil
+}
+
+// compartmentNameUsed writes an error response and returns true if a compartment which is not deleted has the name in
+// the parent compartment
+func (server *ControlPlaneServer) compartmentNameUsed(w http.ResponseWriter, parentID, name string) bool {
+	for _, compartment := range server.compartments {
+		if *compartment.CompartmentId == parentID && *compartment.Name == name &&
+			compartment.LifecycleState != identity.CompartmentLifecycleStateDeleted {
+			writeError(w, http.StatusConflict, "CompartmentAlreadyExists", fmt.Sprintf("compartment %s already exists", name))
+			return true
+		}
+	}
+	return false
+}
+
+func (server *ControlPlaneServer) createCompartment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := identity.CreateCompartmentDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CompartmentId == nil || details.Name == nil || details.Description == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "compartmentId, description and name are required")
+		return
+	}
+
+	if !server.parentCompartment(w, *details.CompartmentId) || server.compartmentNameUsed(w, *details.CompartmentId, *details.Name) {
+		return
+	}
+
+	compartment := &fakeCompartment{Compartment: identity.Compartment{
+		Id:             common.String(newOCID("compartment")),
+		CompartmentId:  details.CompartmentId,
+		Name:           details.Name,
+		Description:    details.Description,
+		TimeCreated:    now(),
+		LifecycleState: identity.CompartmentLifecycleStateCreating,
+		IsAccessible:   common.Bool(true),
+		FreeformTags:   details.FreeformTags,
+		DefinedTags:    details.DefinedTags,
+	}}
+
+	compartment.transition(func() {
+		compartment.LifecycleState = identity.CompartmentLifecycleStateActive
+	})
+	server.compartments = append(server.compartments, compartment)
+	writeJSON(w, http.StatusOK, compartment.Compartment)
+}
+
+// inSubtree returns whether a compartment is in the subtree of another compartment
+func (server *ControlPlaneServer) inSubtree(compartment *fakeCompartment, rootID string) bool {
+	for parentID := *compartment.CompartmentId; ; {
+		if parentID == rootID {
+			return true
+		}
+
+		var parent *fakeCompartment
+		for _, candidate := range server.compartments {
+			if *candidate.Id == parentID {
+				parent = candidate
+			}
+		}
+		if parent == nil {
+			return false
+		}
+		parentID = *parent.CompartmentId
+	}
+}
+
+func (server *ControlPlaneServer) listCompartments(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	items := []identity.Compartment{}
+	for _, compartment := range server.compartments {
+		if (query.Get("compartmentIdInSubtree") == "true" && !server.inSubtree(compartment, query.Get("compartmentId"))) ||
+			(query.Get("compartmentIdInSubtree") != "true" && *compartment.CompartmentId != query.Get("compartmentId")) ||
+			(query.Get("name") != "" && *compartment.Name != query.Get("name")) ||
+			(query.Get("lifecycleState") != "" && string(compartment.LifecycleState) != query.Get("lifecycleState")) {
+			continue
+		}
+		items = append(items, compartment.Compartment)
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getCompartment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if compartment := server.compartment(w, params["compartmentId"]); compartment != nil {
+		compartment.read(server.TransitionReads)
+		writeJSON(w, http.StatusOK, compartment.Compartment)
+	}
+}
+
+func (server *ControlPlaneServer) updateCompartment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	compartment := server.compartment(w, params["compartmentId"])
+	details := identity.UpdateCompartmentDetails{}
+	if compartment == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.Name != nil && *details.Name != *compartment.Name {
+		if server.compartmentNameUsed(w, *compartment.CompartmentId, *details.Name) {
+			return
+		}
+		compartment.Name = details.Name
+	}
+	if details.Description != nil {
+		compartment.Description = details.Description
+	}
+	if details.FreeformTags != nil {
+		compartment.FreeformTags = details.FreeformTags
+	}
+	if details.DefinedTags != nil {
+		compartment.DefinedTags = details.DefinedTags
+	}
+	writeJSON(w, http.StatusOK, compartment.Compartment)
+}
+
+func (server *ControlPlaneServer) deleteCompartment(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	compartment := server.compartment(w, params["compartmentId"])
+	if compartment == nil {
+		return
+	}
+
+	if compartment.LifecycleState != identity.CompartmentLifecycleStateActive {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("compartment %s is %s", *compartment.Id, compartment.LifecycleState))
+		return
+	}
+
+	for _, child := range server.compartments {
+		if *child.CompartmentId == *compartment.Id && child.LifecycleState != identity.CompartmentLifecycleStateDeleted {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("compartment %s still has compartment %s", *compartment.Id, *child.Id))
+			return
+		}
+	}
+	for _, policy := range server.policies {
+		if *policy.CompartmentId == *compartment.Id {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("compartment %s still has policy %s", *compartment.Id, *policy.Id))
+			return
+		}
+	}
+
+	compartment.LifecycleState = identity.CompartmentLifecycleStateDeleting
+	compartment.transition(func() {
+		compartment.LifecycleState = identity.CompartmentLifecycleStateDeleted
+	})
+
+	workRequest := server.newWorkRequest(string(identity.WorkRequestOperationTypeCompartment), *compartment.CompartmentId, "compartment",
+		*compartment.Id, workrequests.WorkRequestResourceActionTypeDeleted, &compartment.lifecycle)
+	w.Header().Set("opc-work-request-id", *workRequest.Id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *ControlPlaneServer) policy(w http.ResponseWriter, policyID string) *identity.Policy {
+	for _, policy := range server.policies {
+		if *policy.Id == policyID {
+			return policy
+		}
+	}
+	writeNotFound(w, "policy", policyID)
+	return nil
+}
+
+func (server *ControlPlaneServer) createPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := identity.CreatePolicyDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CompartmentId == nil || details.Name == nil || len(details.Statements) == 0 || details.Description == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "compartmentId, description, name and statements are required")
+		return
+	}
+
+	if !server.parentCompartment(w, *details.CompartmentId) {
+		return
+	}
+
+	for _, policy := range server.policies {
+		if *policy.CompartmentId == *details.CompartmentId && *policy.Name == *details.Name {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("policy %s already exists", *details.Name))
+			return
+		}
+	}
+
+	policy := &identity.Policy{
+		Id:             common.String(newOCID("policy")),
+		CompartmentId:  details.CompartmentId,
+		Name:           details.Name,
+		Statements:     details.Statements,
+		Description:    details.Description,
+		TimeCreated:    now(),
+		LifecycleState: identity.PolicyLifecycleStateActive,
+		VersionDate:    details.VersionDate,
+		FreeformTags:   details.FreeformTags,
+		DefinedTags:    details.DefinedTags,
+	}
+	server.policies = append(server.policies, policy)
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (server *ControlPlaneServer) listPolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	items := []identity.Policy{}
+	for _, policy := range server.policies {
+		if *policy.CompartmentId == query.Get("compartmentId") && (query.Get("name") == "" || *policy.Name == query.Get("name")) {
+			items = append(items, *policy)
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if policy := server.policy(w, params["policyId"]); policy != nil {
+		writeJSON(w, http.StatusOK, policy)
+	}
+}
+
+func (server *ControlPlaneServer) updatePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	policy := server.policy(w, params["policyId"])
+	details := identity.UpdatePolicyDetails{}
+	if policy == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.Statements != nil {
+		if len(details.Statements) == 0 {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", "statements can not be empty")
+			return
+		}
+		policy.Statements = details.Statements
+	}
+	if details.Description != nil {
+		policy.Description = details.Description
+	}
+	if details.VersionDate != nil {
+		policy.VersionDate = details.VersionDate
+	}
+	if details.FreeformTags != nil {
+		policy.FreeformTags = details.FreeformTags
+	}
+	if details.DefinedTags != nil {
+		policy.DefinedTags = details.DefinedTags
+	}
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (server *ControlPlaneServer) deletePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	for i, policy := range server.policies {
+		if *policy.Id == params["policyId"] {
+			server.policies = append(server.policies[:i], server.policies[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeNotFound(w, "policy", params["policyId"])
+}
@@ -0,0 +1,674 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+const (
+	// defaultListObjectsLimit is the number of objects listed if the request does not give a limit
+	defaultListObjectsLimit = 1000
+
+	// maxUploadPartNum is the greatest number of a part of a multipart upload
+	maxUploadPartNum = 10000
+
+	// metadataHeaderPrefix starts the headers of the user metadata of the objects
+	metadataHeaderPrefix = "Opc-Meta-"
+)
+
+type fakeBucket struct {
+	objectstorage.Bucket
+	objects map[string]*fakeObject
+	uploads []*fakeUpload
+}
+
+type fakeObject struct {
+	content      []byte
+	md5          string
+	multipartMD5 string
+	etag         string
+	modified     time.Time
+	header       http.Header
+}
+
+type fakeUpload struct {
+	objectstorage.MultipartUpload
+	header http.Header
+	parts  map[int]*fakeUploadPart
+}
+
+type fakeUploadPart struct {
+	content []byte
+	md5     []byte
+	etag    string
+}
+
+// objectHeaders are the headers of the requests putting the objects which are returned with them
+var objectHeaders = []string{"Content-Type", "Content-Language", "Content-Encoding", "Content-Disposition", "Cache-Control"}
+
+func (server *ControlPlaneServer) routeObjectStorage(router *router) {
+	router.handle(http.MethodGet, "/n", server.locked(server.getNamespace))
+	router.handle(http.MethodPost, "/n/{namespaceName}/b", server.locked(server.createBucket))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b", server.locked(server.listBuckets))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b/{bucketName}", server.locked(server.getBucket))
+	router.handle(http.MethodHead, "/n/{namespaceName}/b/{bucketName}", server.locked(server.headBucket))
+	router.handle(http.MethodPost, "/n/{namespaceName}/b/{bucketName}", server.locked(server.updateBucket))
+	router.handle(http.MethodDelete, "/n/{namespaceName}/b/{bucketName}", server.locked(server.deleteBucket))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b/{bucketName}/o", server.locked(server.listObjects))
+	router.handle(http.MethodPut, "/n/{namespaceName}/b/{bucketName}/o/{objectName...}", server.locked(server.putObject))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b/{bucketName}/o/{objectName...}", server.locked(server.getObject))
+	router.handle(http.MethodHead, "/n/{namespaceName}/b/{bucketName}/o/{objectName...}", server.locked(server.getObject))
+	router.handle(http.MethodDelete, "/n/{namespaceName}/b/{bucketName}/o/{objectName...}", server.locked(server.deleteObject))
+	router.handle(http.MethodPost, "/n/{namespaceName}/b/{bucketName}/u", server.locked(server.createMultipartUpload))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b/{bucketName}/u", server.locked(server.listMultipartUploads))
+	router.handle(http.MethodPut, "/n/{namespaceName}/b/{bucketName}/u/{objectName...}", server.locked(server.uploadPart))
+	router.handle(http.MethodPost, "/n/{namespaceName}/b/{bucketName}/u/{objectName...}", server.locked(server.commitMultipartUpload))
+	router.handle(http.MethodGet, "/n/{namespaceName}/b/{bucketName}/u/{objectName...}", server.locked(server.listMultipartUploadParts))
+	router.handle(http.MethodDelete, "/n/{namespaceName}/b/{bucketName}/u/{objectName...}", server.locked(server.abortMultipartUpload))
+}
+
+// namespace writes an error response and returns false if the namespace of a request is not the namespace of the
+// tenancy
+func (server *ControlPlaneServer) namespace(w http.ResponseWriter, params map[string]string) bool {
+	if params["namespaceName"] != server.Namespace {
+		writeError(w, http.StatusNotFound, "NamespaceNotFound", fmt.Sprintf("namespace %s not found", params["namespaceName"]))
+		return false
+	}
+	return true
+}
+
+// bucket returns the bucket of a request, or writes an error response and returns nil if it does not exist
+func (server *ControlPlaneServer) bucket(w http.ResponseWriter, params map[string]string) *fakeBucket {
+	if !server.namespace(w, params) {
+		return nil
+	}
+
+	for _, bucket := range server.buckets {
+		if *bucket.Name == params["bucketName"] {
+			return bucket
+		}
+	}
+	writeError(w, http.StatusNotFound, "BucketNotFound", fmt.Sprintf("bucket %s not found", params["bucketName"]))
+	return nil
+}
+
+func (server *ControlPlaneServer) getNamespace(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	writeJSON(w, http.StatusOK, server.Namespace)
+}
+
+func (server *ControlPlaneServer) createBucket(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := objectstorage.CreateBucketDetails{}
+	if !server.namespace(w, params) || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.Name == nil || details.CompartmentId == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "compartmentId and name are required")
+		return
+	}
+
+	for _, bucket := range server.buckets {
+		if *bucket.Name == *details.Name {
+			writeError(w, http.StatusConflict, "BucketAlreadyExists", fmt.Sprintf("bucket %s already exists", *details.Name))
+			return
+		}
+	}
+
+	bucket := &fakeBucket{
+		Bucket: objectstorage.Bucket{
+			Namespace:           common.String(server.Namespace),
+			Name:                details.Name,
+			CompartmentId:       details.CompartmentId,
+			Metadata:            details.Metadata,
+			CreatedBy:           common.String(server.userID),
+			TimeCreated:         now(),
+			Etag:                common.String(randomHex(32)),
+			PublicAccessType:    objectstorage.BucketPublicAccessTypeEnum(details.PublicAccessType),
+			StorageTier:         objectstorage.BucketStorageTierEnum(details.StorageTier),
+			ObjectEventsEnabled: details.ObjectEventsEnabled,
+			FreeformTags:        details.FreeformTags,
+			DefinedTags:         details.DefinedTags,
+			KmsKeyId:            details.KmsKeyId,
+			Id:                  common.String(newOCID("bucket")),
+			Versioning:          objectstorage.BucketVersioningEnum(details.Versioning),
+		},
+		objects: make(map[string]*fakeObject),
+	}
+	if bucket.Metadata == nil {
+		bucket.Metadata = map[string]string{}
+	}
+	if bucket.PublicAccessType == "" {
+		bucket.PublicAccessType = objectstorage.BucketPublicAccessTypeNopublicaccess
+	}
+	if bucket.StorageTier == "" {
+		bucket.StorageTier = objectstorage.BucketStorageTierStandard
+	}
+	if bucket.Versioning == "" {
+		bucket.Versioning = objectstorage.BucketVersioningDisabled
+	}
+
+	server.buckets = append(server.buckets, bucket)
+	w.Header().Set("etag", *bucket.Etag)
+	writeJSON(w, http.StatusOK, bucket.Bucket)
+}
+
+func (server *ControlPlaneServer) listBuckets(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if !server.namespace(w, params) {
+		return
+	}
+
+	items := []objectstorage.BucketSummary{}
+	for _, bucket := range server.buckets {
+		if *bucket.CompartmentId == r.URL.Query().Get("compartmentId") {
+			items = append(items, objectstorage.BucketSummary{
+				Namespace:     bucket.Namespace,
+				Name:          bucket.Name,
+				CompartmentId: bucket.CompartmentId,
+				CreatedBy:     bucket.CreatedBy,
+				TimeCreated:   bucket.TimeCreated,
+				Etag:          bucket.Etag,
+				FreeformTags:  bucket.FreeformTags,
+				DefinedTags:   bucket.DefinedTags,
+			})
+		}
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *ControlPlaneServer) getBucket(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	size := int64(0)
+	for _, object := range bucket.objects {
+		size += int64(len(object.content))
+	}
+	bucket.ApproximateCount = common.Int64(int64(len(bucket.objects)))
+	bucket.ApproximateSize = common.Int64(size)
+
+	w.Header().Set("etag", *bucket.Etag)
+	writeJSON(w, http.StatusOK, bucket.Bucket)
+}
+
+func (server *ControlPlaneServer) headBucket(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if bucket := server.bucket(w, params); bucket != nil {
+		w.Header().Set("etag", *bucket.Etag)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (server *ControlPlaneServer) updateBucket(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	details := objectstorage.UpdateBucketDetails{}
+	if bucket == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.Name != nil && *details.Name != *bucket.Name {
+		for _, other := range server.buckets {
+			if *other.Name == *details.Name {
+				writeError(w, http.StatusConflict, "BucketAlreadyExists", fmt.Sprintf("bucket %s already exists", *details.Name))
+				return
+			}
+		}
+		bucket.Name = details.Name
+	}
+	if details.CompartmentId != nil {
+		bucket.CompartmentId = details.CompartmentId
+	}
+	if details.Metadata != nil {
+		bucket.Metadata = details.Metadata
+	}
+	if details.PublicAccessType != "" {
+		bucket.PublicAccessType = objectstorage.BucketPublicAccessTypeEnum(details.PublicAccessType)
+	}
+	if details.ObjectEventsEnabled != nil {
+		bucket.ObjectEventsEnabled = details.ObjectEventsEnabled
+	}
+	if details.FreeformTags != nil {
+		bucket.FreeformTags = details.FreeformTags
+	}
+	if details.DefinedTags != nil {
+		bucket.DefinedTags = details.DefinedTags
+	}
+	if details.KmsKeyId != nil {
+		bucket.KmsKeyId = details.KmsKeyId
+	}
+	if details.Versioning != "" {
+		bucket.Versioning = objectstorage.BucketVersioningEnum(details.Versioning)
+	}
+
+	bucket.Etag = common.String(randomHex(32))
+	w.Header().Set("etag", *bucket.Etag)
+	writeJSON(w, http.StatusOK, bucket.Bucket)
+}
+
+func (server *ControlPlaneServer) deleteBucket(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	if len(bucket.objects) > 0 || len(bucket.uploads) > 0 {
+		writeError(w, http.StatusConflict, "BucketNotEmpty", fmt.Sprintf("bucket %s is not empty", *bucket.Name))
+		return
+	}
+
+	for i, candidate := range server.buckets {
+		if candidate == bucket {
+			server.buckets = append(server.buckets[:i], server.buckets[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// objectSummary returns the summary of an object with the fields requested by the "fields" query parameter
+func objectSummary(name string, object *fakeObject, fields string) objectstorage.ObjectSummary {
+	summary := objectstorage.ObjectSummary{Name: common.String(name)}
+	for _, field := range strings.Split(fields, ",") {
+		switch field {
+		case "size":
+			summary.Size = common.Int64(int64(len(object.content)))
+		case "md5":
+			summary.Md5 = common.String(object.md5)
+		case "etag":
+			summary.Etag = common.String(object.etag)
+		case "timeCreated":
+			summary.TimeCreated = &common.SDKTime{Time: object.modified}
+		case "timeModified":
+			summary.TimeModified = &common.SDKTime{Time: object.modified}
+		}
+	}
+	return summary
+}
+
+func (server *ControlPlaneServer) listObjects(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	limit := defaultListObjectsLimit
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid limit %s", value))
+			return
+		}
+	}
+
+	names := make([]string, 0, len(bucket.objects))
+	for name := range bucket.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	response := objectstorage.ListObjects{Objects: []objectstorage.ObjectSummary{}}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) || (query.Get("start") != "" && name < query.Get("start")) ||
+			(query.Get("startAfter") != "" && name <= query.Get("startAfter")) || (query.Get("end") != "" && name >= query.Get("end")) {
+			continue
+		}
+
+		var subPrefix string
+		if index := strings.Index(name[len(prefix):], delimiter); delimiter != "" && index >= 0 {
+			subPrefix = name[:len(prefix)+index+len(delimiter)]
+			if count := len(response.Prefixes); count > 0 && response.Prefixes[count-1] == subPrefix {
+				continue
+			}
+		}
+
+		if len(response.Objects)+len(response.Prefixes) == limit {
+			response.NextStartWith = common.String(name)
+			break
+		}
+		if subPrefix != "" {
+			response.Prefixes = append(response.Prefixes, subPrefix)
+		} else {
+			response.Objects = append(response.Objects, objectSummary(name, bucket.objects[name], "name,"+query.Get("fields")))
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// objectConditions writes an error response and returns false if the if-match or if-none-match headers of a request
+// putting an object do not match the current object
+func objectConditions(w http.ResponseWriter, r *http.Request, object *fakeObject) bool {
+	if ifMatch := r.Header.Get("if-match"); ifMatch != "" && (object == nil || object.etag != ifMatch) {
+		writeError(w, http.StatusPreconditionFailed, "IfMatchFailed", "the object does not match the if-match header")
+		return false
+	}
+	if ifNoneMatch := r.Header.Get("if-none-match"); ifNoneMatch != "" && object != nil && (ifNoneMatch == "*" || object.etag == ifNoneMatch) {
+		writeError(w, http.StatusPreconditionFailed, "IfNoneMatchFailed", "the object matches the if-none-match header")
+		return false
+	}
+	return true
+}
+
+// objectHeader returns the content and user metadata headers of a request which are returned with the object
+func objectHeader(header http.Header) http.Header {
+	kept := http.Header{}
+	for name, values := range header {
+		if strings.HasPrefix(name, metadataHeaderPrefix) {
+			kept[name] = values
+		}
+	}
+	for _, name := range objectHeaders {
+		if value := header.Get(name); value != "" {
+			kept.Set(name, value)
+		}
+	}
+	return kept
+}
+
+func (server *ControlPlaneServer) putObject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil || !objectConditions(w, r, bucket.objects[params["objectName"]]) {
+		return
+	}
+
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("can not read the object: %v", err))
+		return
+	}
+
+	checksum := md5.Sum(content)
+	object := &fakeObject{
+		content:  content,
+		md5:      base64.StdEncoding.EncodeToString(checksum[:]),
+		etag:     randomHex(32),
+		modified: time.Now().UTC().Truncate(time.Second),
+		header:   objectHeader(r.Header),
+	}
+	if expected := r.Header.Get("Content-MD5"); expected != "" && expected != object.md5 {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("the Content-MD5 %s does not match the content", expected))
+		return
+	}
+
+	bucket.objects[params["objectName"]] = object
+	w.Header().Set("opc-content-md5", object.md5)
+	w.Header().Set("etag", object.etag)
+	w.Header().Set("last-modified", object.modified.Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+}
+
+// getObject returns an object, or its headers for a HEAD request, with the ranges of http.ServeContent. The etags
+// of the service are not quoted, so the if-match and if-none-match headers are evaluated here rather than by
+// http.ServeContent, which would never match them.
+func (server *ControlPlaneServer) getObject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	object, ok := bucket.objects[params["objectName"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ObjectNotFound", fmt.Sprintf("object %s not found", params["objectName"]))
+		return
+	}
+
+	if ifMatch := r.Header.Get("if-match"); ifMatch != "" && ifMatch != object.etag {
+		writeError(w, http.StatusPreconditionFailed, "IfMatchFailed", "the object does not match the if-match header")
+		return
+	}
+	if ifNoneMatch := r.Header.Get("if-none-match"); ifNoneMatch == "*" || ifNoneMatch == object.etag {
+		w.Header().Set("etag", object.etag)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	r.Header.Del("if-match")
+	r.Header.Del("if-none-match")
+
+	for name, values := range object.header {
+		w.Header()[name] = values
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+	if object.multipartMD5 != "" {
+		w.Header().Set("opc-multipart-md5", object.multipartMD5)
+	} else {
+		w.Header().Set("Content-MD5", object.md5)
+	}
+	w.Header().Set("etag", object.etag)
+	http.ServeContent(w, r, params["objectName"], object.modified, bytes.NewReader(object.content))
+}
+
+func (server *ControlPlaneServer) deleteObject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	if _, ok := bucket.objects[params["objectName"]]; !ok {
+		writeError(w, http.StatusNotFound, "ObjectNotFound", fmt.Sprintf("object %s not found", params["objectName"]))
+		return
+	}
+	delete(bucket.objects, params["objectName"])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *ControlPlaneServer) createMultipartUpload(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	details := objectstorage.CreateMultipartUploadDetails{}
+	if bucket == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.Object == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "object is required")
+		return
+	}
+
+	header := http.Header{}
+	for name, value := range map[string]*string{
+		"Content-Type":        details.ContentType,
+		"Content-Language":    details.ContentLanguage,
+		"Content-Encoding":    details.ContentEncoding,
+		"Content-Disposition": details.ContentDisposition,
+		"Cache-Control":       details.CacheControl,
+	} {
+		if value != nil {
+			header.Set(name, *value)
+		}
+	}
+	for name, value := range details.Metadata {
+		header.Set(metadataHeaderPrefix+strings.TrimPrefix(name, "opc-meta-"), value)
+	}
+
+	upload := &fakeUpload{
+		MultipartUpload: objectstorage.MultipartUpload{
+			Namespace:   bucket.Namespace,
+			Bucket:      bucket.Name,
+			Object:      details.Object,
+			UploadId:    common.String(randomHex(36)),
+			TimeCreated: now(),
+		},
+		header: header,
+		parts:  make(map[int]*fakeUploadPart),
+	}
+	bucket.uploads = append(bucket.uploads, upload)
+	w.Header().Set("location", r.URL.Path+"/"+*details.Object)
+	writeJSON(w, http.StatusOK, upload.MultipartUpload)
+}
+
+func (server *ControlPlaneServer) listMultipartUploads(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return
+	}
+
+	items := []objectstorage.MultipartUpload{}
+	for _, upload := range bucket.uploads {
+		items = append(items, upload.MultipartUpload)
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+// upload returns the multipart upload of a request, or writes an error response and returns nil if it does not exist
+func (server *ControlPlaneServer) upload(w http.ResponseWriter, r *http.Request, params map[string]string) (*fakeBucket, *fakeUpload) {
+	bucket := server.bucket(w, params)
+	if bucket == nil {
+		return nil, nil
+	}
+
+	uploadID := r.URL.Query().Get("uploadId")
+	for _, upload := range bucket.uploads {
+		if *upload.UploadId == uploadID && *upload.Object == params["objectName"] {
+			return bucket, upload
+		}
+	}
+	writeError(w, http.StatusNotFound, "NoSuchUpload", fmt.Sprintf("upload %s of object %s not found", uploadID, params["objectName"]))
+	return nil, nil
+}
+
+func (server *ControlPlaneServer) uploadPart(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, upload := server.upload(w, r, params)
+	if upload == nil {
+		return
+	}
+
+	partNum, err := strconv.Atoi(r.URL.Query().Get("uploadPartNum"))
+	if err != nil || partNum < 1 || partNum > maxUploadPartNum {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid uploadPartNum %s", r.URL.Query().Get("uploadPartNum")))
+		return
+	}
+
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("can not read the part: %v", err))
+		return
+	}
+
+	checksum := md5.Sum(content)
+	part := &fakeUploadPart{content: content, md5: checksum[:], etag: randomHex(32)}
+	encoded := base64.StdEncoding.EncodeToString(part.md5)
+	if expected := r.Header.Get("Content-MD5"); expected != "" && expected != encoded {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("the Content-MD5 %s does not match the content", expected))
+		return
+	}
+
+	upload.parts[partNum] = part
+	w.Header().Set("opc-content-md5", encoded)
+	w.Header().Set("etag", part.etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *ControlPlaneServer) listMultipartUploadParts(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, upload := server.upload(w, r, params)
+	if upload == nil {
+		return
+	}
+
+	numbers := make([]int, 0, len(upload.parts))
+	for number := range upload.parts {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+
+	items := []objectstorage.MultipartUploadPartSummary{}
+	for _, number := range numbers {
+		part := upload.parts[number]
+		items = append(items, objectstorage.MultipartUploadPartSummary{
+			Etag:       common.String(part.etag),
+			Md5:        common.String(base64.StdEncoding.EncodeToString(part.md5)),
+			Size:       common.Int64(int64(len(part.content))),
+			PartNumber: common.Int(number),
+		})
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+// commitMultipartUpload puts the object of the committed parts, in the order of their numbers. The MD5 of the
+// object is the MD5 of the MD5s of its parts followed by their count, as the service computes it.
+func (server *ControlPlaneServer) commitMultipartUpload(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	bucket, upload := server.upload(w, r, params)
+	details := objectstorage.CommitMultipartUploadDetails{}
+	if upload == nil || !decodeBody(w, r, &details) {
+		return
+	}
+
+	if len(details.PartsToCommit) == 0 {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "partsToCommit is required")
+		return
+	}
+
+	committed := append([]objectstorage.CommitMultipartUploadPartDetails{}, details.PartsToCommit...)
+	sort.Slice(committed, func(i, j int) bool {
+		return *committed[i].PartNum < *committed[j].PartNum
+	})
+
+	var content, checksums []byte
+	for _, commit := range committed {
+		part, ok := upload.parts[*commit.PartNum]
+		if !ok || part.etag != *commit.Etag {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("part %d with etag %s was not uploaded", *commit.PartNum, *commit.Etag))
+			return
+		}
+		content = append(content, part.content...)
+		checksums = append(checksums, part.md5...)
+	}
+
+	checksum := md5.Sum(checksums)
+	contentChecksum := md5.Sum(content)
+	object := &fakeObject{
+		content:      content,
+		md5:          base64.StdEncoding.EncodeToString(contentChecksum[:]),
+		multipartMD5: fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(checksum[:]), len(committed)),
+		etag:         randomHex(32),
+		modified:     time.Now().UTC().Truncate(time.Second),
+		header:       upload.header,
+	}
+	bucket.objects[*upload.Object] = object
+	bucket.removeUpload(upload)
+
+	w.Header().Set("opc-multipart-md5", object.multipartMD5)
+	w.Header().Set("etag", object.etag)
+	w.Header().Set("last-modified", object.modified.Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *ControlPlaneServer) abortMultipartUpload(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if bucket, upload := server.upload(w, r, params); upload != nil {
+		bucket.removeUpload(upload)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (bucket *fakeBucket) removeUpload(upload *fakeUpload) {
+	for i, candidate := range bucket.uploads {
+		if candidate == upload {
+			bucket.uploads = append(bucket.uploads[:i], bucket.uploads[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/identity"
+	"github.com/oracle/oci-go-sdk/v27/workrequests"
+)
+
+// defaultTransitionReads is the number of reads of a resource in a transitional state before its next state
+const defaultTransitionReads = 1
+
+// ControlPlaneServer is a fake of the control planes of the Core Services, Identity and Object Storage services,
+// serving the APIs of the clients core.VirtualNetworkClient, core.ComputeClient, core.BlockstorageClient,
+// identity.IdentityClient, objectstorage.ObjectStorageClient and workrequests.WorkRequestClient.
+//
+// The virtual network implements CreateVcn, GetVcn, ListVcns, UpdateVcn, DeleteVcn, CreateSubnet, GetSubnet,
+// ListSubnets, UpdateSubnet and DeleteSubnet. The CIDR blocks of the subnets must be in the CIDR block of their VCN
+// and must not overlap, and the VCNs with subnets can not be deleted.
+//
+// The compute and block storage implement LaunchInstance, GetInstance, ListInstances, UpdateInstance,
+// InstanceAction with the STOP, SOFTSTOP, START, RESET and SOFTRESET actions, TerminateInstance, CreateVolume,
+// GetVolume, ListVolumes, UpdateVolume and DeleteVolume.
+//
+// The identity implements CreateCompartment, GetCompartment, ListCompartments, UpdateCompartment,
+// DeleteCompartment, CreatePolicy, GetPolicy, ListPolicies, UpdatePolicy and DeletePolicy. The compartments are
+// created in the tenancy or in its compartments.
+//
+// The object storage implements GetNamespace, CreateBucket, GetBucket, HeadBucket, ListBuckets, UpdateBucket,
+// DeleteBucket, PutObject, GetObject, HeadObject, ListObjects, DeleteObject, CreateMultipartUpload, UploadPart,
+// CommitMultipartUpload, AbortMultipartUpload, ListMultipartUploads and ListMultipartUploadParts.
+//
+// The resources go through the lifecycle states of the services, a created VCN is PROVISIONING and then
+// AVAILABLE, a launched instance is PROVISIONING, STARTING and then RUNNING. The state of a resource changes once
+// it was read TransitionReads times in its current state by its Get operation, so that the tests waiting for a
+// state poll the resources as they would poll the services. The deleted resources are TERMINATING before they are
+// not found, except the instances which are TERMINATED and the compartments which are DELETED. The policies,
+// buckets and objects are created and deleted at once.
+//
+// LaunchInstance and DeleteCompartment return the OCID of a work request, which GetWorkRequest of the
+// workrequests and identity clients return. The work requests are ACCEPTED, IN_PROGRESS and then SUCCEEDED, the
+// resource they operate on reaching its final state with them.
+//
+// ControlPlaneServer is safe for concurrent use.
+type ControlPlaneServer struct {
+	*Server
+
+	// TenancyId is the OCID of the tenancy, the root compartment of the compartments
+	TenancyId string
+
+	// Namespace is the Object Storage namespace of the tenancy
+	Namespace string
+
+	// Region is the region of the instances
+	Region string
+
+	// TransitionReads is the number of times a resource in a transitional state, such as PROVISIONING, is read
+	// before its next state. Zero makes the resources reach their final state when they are read. Defaults to 1.
+	TransitionReads int
+
+	userID       string
+	mutex        sync.Mutex
+	vcns         []*fakeVcn
+	subnets      []*fakeSubnet
+	instances    []*fakeInstance
+	volumes      []*fakeVolume
+	compartments []*fakeCompartment
+	policies     []*identity.Policy
+	workRequests []*fakeWorkRequest
+	buckets      []*fakeBucket
+}
+
+// NewControlPlaneServer starts a fake control plane, which must be closed once done
+func NewControlPlaneServer() *ControlPlaneServer {
+	server := &ControlPlaneServer{
+		TenancyId:       newOCID("tenancy"),
+		Namespace:       randomHex(12),
+		Region:          "us-phoenix-1",
+		TransitionReads: defaultTransitionReads,
+		userID:          newOCID("user"),
+	}
+
+	router := &router{prefixes: []string{"/20160918"}}
+	server.routeCore(router)
+	server.routeIdentity(router)
+	server.routeObjectStorage(router)
+	router.handle(http.MethodGet, "/workRequests/{workRequestId}", server.locked(server.getWorkRequest))
+
+	server.Server = NewServer(router)
+	return server
+}
+
+// locked returns the handler called with the lock of the server held
+func (server *ControlPlaneServer) locked(handler handlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		handler(w, r, params)
+	}
+}
+
+// lifecycle is the pending changes of the state of a resource, applied one at a time as the resource is read
+type lifecycle struct {
+	steps   []func()
+	reads   int
+	removed bool
+}
+
+// transition replaces the pending changes of the state of the resource
+func (lifecycle *lifecycle) transition(steps ...func()) {
+	lifecycle.steps = steps
+	lifecycle.reads = 0
+}
+
+// read is called before a resource is returned by its Get operation, it applies the next change of its state if
+// the resource was read enough times in its current state
+func (lifecycle *lifecycle) read(transitionReads int) {
+	for len(lifecycle.steps) > 0 && lifecycle.reads >= transitionReads {
+		step := lifecycle.steps[0]
+		lifecycle.steps = lifecycle.steps[1:]
+		lifecycle.reads = 0
+		step()
+	}
+	lifecycle.reads++
+}
+
+// finish applies all the pending changes of the state of the resource
+func (lifecycle *lifecycle) finish() {
+	for _, step := range lifecycle.steps {
+		step()
+	}
+	lifecycle.steps = nil
+}
+
+// remove is the last change of the state of the deleted resources, which are not found afterwards
+func (lifecycle *lifecycle) remove() {
+	lifecycle.removed = true
+}
+
+type fakeWorkRequest struct {
+	workrequests.WorkRequest
+	lifecycle
+}
+
+// newWorkRequest adds a work request operating on a resource, which completes the pending changes of the state of
+// the resource when it succeeds
+func (server *ControlPlaneServer) newWorkRequest(operationType, compartmentID, entityType, entityID string,
+	actionType workrequests.WorkRequestResourceActionTypeEnum, resource *lifecycle) *fakeWorkRequest {
+	workRequest := &fakeWorkRequest{WorkRequest: workrequests.WorkRequest{
+		OperationType: common.String(operationType),
+		Status:        workrequests.WorkRequestStatusAccepted,
+		Id:            common.String(newOCID("workrequest")),
+		CompartmentId: common.String(compartmentID),
+		Resources: []workrequests.WorkRequestResource{{
+			ActionType: actionType,
+			EntityType: common.String(entityType),
+			Identifier: common.String(entityID),
+		}},
+		PercentComplete: common.Float32(0),
+		TimeAccepted:    now(),
+	}}
+
+	workRequest.transition(func() {
+		workRequest.Status = workrequests.WorkRequestStatusInProgress
+		workRequest.PercentComplete = common.Float32(50)
+		workRequest.TimeStarted = now()
+	}, func() {
+		resource.finish()
+		workRequest.Status = workrequests.WorkRequestStatusSucceeded
+		workRequest.PercentComplete = common.Float32(100)
+		workRequest.TimeFinished = now()
+	})
+	server.workRequests = append(server.workRequests, workRequest)
+	return workRequest
+}
+
+// getWorkRequest returns a work request, with the fields of the work requests of both the workrequests and
+// identity packages
+func (server *ControlPlaneServer) getWorkRequest(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	for _, workRequest := range server.workRequests {
+		if *workRequest.Id == params["workRequestId"] {
+			workRequest.read(server.TransitionReads)
+			writeJSON(w, http.StatusOK, workRequest.WorkRequest)
+			return
+		}
+	}
+	writeNotFound(w, "work request", params["workRequestId"])
+}
@@ -0,0 +1,402 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+	"github.com/oracle/oci-go-sdk/v27/identity"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+	"github.com/oracle/oci-go-sdk/v27/workrequests"
+)
+
+func TestControlPlaneServer_VirtualNetwork(t *testing.T) {
+	server := NewControlPlaneServer()
+	defer server.Close()
+	ctx := context.Background()
+	network := core.VirtualNetworkClient{BaseClient: server.BaseClient()}
+	network.BasePath = "20160918"
+
+	vcn, err := network.CreateVcn(ctx, core.CreateVcnRequest{CreateVcnDetails: core.CreateVcnDetails{
+		CidrBlock:     common.String("10.0.0.0/16"),
+		CompartmentId: common.String("compartment1"),
+		DnsLabel:      common.String("vcn1"),
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, core.VcnLifecycleStateProvisioning, vcn.LifecycleState)
+	assert.Equal(t, "vcn1.oraclevcn.com", *vcn.VcnDomainName)
+	// the retry token generated for the request is surfaced on the response
+	assert.Len(t, *vcn.OpcRetryToken, 32)
+
+	for _, state := range []core.VcnLifecycleStateEnum{core.VcnLifecycleStateProvisioning, core.VcnLifecycleStateAvailable, core.VcnLifecycleStateAvailable} {
+		got, err := network.GetVcn(ctx, core.GetVcnRequest{VcnId: vcn.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, state, got.LifecycleState)
+	}
+
+	subnetIDs := []*string{}
+	for _, cidrBlock := range []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"} {
+		subnet, err := network.CreateSubnet(ctx, core.CreateSubnetRequest{CreateSubnetDetails: core.CreateSubnetDetails{
+			CidrBlock:     common.String(cidrBlock),
+			CompartmentId: common.String("compartment1"),
+			VcnId:         vcn.Id,
+		}, OpcRetryToken: common.String("subnet-" + cidrBlock)})
+		assert.NoError(t, err)
+		assert.Equal(t, "subnet-"+cidrBlock, *subnet.OpcRetryToken)
+		assert.Equal(t, *vcn.DefaultRouteTableId, *subnet.RouteTableId)
+		subnetIDs = append(subnetIDs, subnet.Id)
+	}
+	assert.Equal(t, "10.0.0.1", *getSubnet(t, network, subnetIDs[0]).VirtualRouterIp)
+
+	for _, cidrBlock := range []string{"10.0.1.128/25", "10.1.0.0/24", "10.0.0.0/8"} {
+		_, err = network.CreateSubnet(ctx, core.CreateSubnetRequest{CreateSubnetDetails: core.CreateSubnetDetails{
+			CidrBlock:     common.String(cidrBlock),
+			CompartmentId: common.String("compartment1"),
+			VcnId:         vcn.Id,
+		}})
+		assertServiceError(t, err, http.StatusBadRequest, "InvalidParameter")
+	}
+
+	// the subnets are listed by pages
+	listed := []string{}
+	request := core.ListSubnetsRequest{CompartmentId: common.String("compartment1"), VcnId: vcn.Id, Limit: common.Int(2)}
+	for pages := 0; ; pages++ {
+		response, err := network.ListSubnets(ctx, request)
+		assert.NoError(t, err)
+		for _, subnet := range response.Items {
+			listed = append(listed, *subnet.Id)
+		}
+		if response.OpcNextPage == nil {
+			assert.Equal(t, 1, pages)
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	assert.Equal(t, []string{*subnetIDs[0], *subnetIDs[1], *subnetIDs[2]}, listed)
+
+	// the vcn is not deleted while it has subnets, which are terminating and then not found
+	_, err = network.DeleteVcn(ctx, core.DeleteVcnRequest{VcnId: vcn.Id})
+	assertServiceError(t, err, http.StatusConflict, "Conflict")
+
+	for _, subnetID := range subnetIDs {
+		_, err = network.DeleteSubnet(ctx, core.DeleteSubnetRequest{SubnetId: subnetID})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, core.SubnetLifecycleStateTerminating, getSubnet(t, network, subnetIDs[0]).LifecycleState)
+	_, err = network.GetSubnet(ctx, core.GetSubnetRequest{SubnetId: subnetIDs[0]})
+	assertServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+
+	_, err = network.DeleteVcn(ctx, core.DeleteVcnRequest{VcnId: vcn.Id})
+	assert.NoError(t, err)
+}
+
+func getSubnet(t *testing.T, network core.VirtualNetworkClient, subnetID *string) core.Subnet {
+	response, err := network.GetSubnet(context.Background(), core.GetSubnetRequest{SubnetId: subnetID})
+	assert.NoError(t, err)
+	return response.Subnet
+}
+
+func TestControlPlaneServer_Compute(t *testing.T) {
+	server := NewControlPlaneServer()
+	defer server.Close()
+	ctx := context.Background()
+	network := core.VirtualNetworkClient{BaseClient: server.BaseClient()}
+	compute := core.ComputeClient{BaseClient: server.BaseClient()}
+	blockstorage := core.BlockstorageClient{BaseClient: server.BaseClient()}
+	workRequests := workrequests.WorkRequestClient{BaseClient: server.BaseClient()}
+
+	vcn, err := network.CreateVcn(ctx, core.CreateVcnRequest{CreateVcnDetails: core.CreateVcnDetails{
+		CidrBlock:     common.String("10.0.0.0/16"),
+		CompartmentId: common.String("compartment1"),
+	}})
+	assert.NoError(t, err)
+	subnet, err := network.CreateSubnet(ctx, core.CreateSubnetRequest{CreateSubnetDetails: core.CreateSubnetDetails{
+		CidrBlock:     common.String("10.0.0.0/24"),
+		CompartmentId: common.String("compartment1"),
+		VcnId:         vcn.Id,
+	}})
+	assert.NoError(t, err)
+
+	launched, err := compute.LaunchInstance(ctx, core.LaunchInstanceRequest{LaunchInstanceDetails: core.LaunchInstanceDetails{
+		AvailabilityDomain: common.String("AD-1"),
+		CompartmentId:      common.String("compartment1"),
+		Shape:              common.String("VM.Standard2.1"),
+		CreateVnicDetails:  &core.CreateVnicDetails{SubnetId: subnet.Id},
+		SourceDetails:      core.InstanceSourceViaImageDetails{ImageId: common.String("image1")},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, core.InstanceLifecycleStateProvisioning, launched.LifecycleState)
+	assert.Equal(t, server.Region, *launched.Region)
+	assert.Equal(t, "image1", *launched.SourceDetails.(core.InstanceSourceViaImageDetails).ImageId)
+
+	// the instance is running once its work request succeeded
+	statuses := []workrequests.WorkRequestStatusEnum{}
+	for len(statuses) == 0 || statuses[len(statuses)-1] != workrequests.WorkRequestStatusSucceeded {
+		workRequest, err := workRequests.GetWorkRequest(ctx, workrequests.GetWorkRequestRequest{WorkRequestId: launched.OpcWorkRequestId})
+		assert.NoError(t, err)
+		assert.Equal(t, *launched.Id, *workRequest.Resources[0].Identifier)
+		statuses = append(statuses, workRequest.Status)
+	}
+	assert.Equal(t, []workrequests.WorkRequestStatusEnum{workrequests.WorkRequestStatusAccepted,
+		workrequests.WorkRequestStatusInProgress, workrequests.WorkRequestStatusSucceeded}, statuses)
+	assert.Equal(t, core.InstanceLifecycleStateRunning, getInstance(t, compute, launched.Id).LifecycleState)
+
+	// the subnet of an instance is not deleted
+	_, err = network.DeleteSubnet(ctx, core.DeleteSubnetRequest{SubnetId: subnet.Id})
+	assertServiceError(t, err, http.StatusConflict, "Conflict")
+
+	stopped, err := compute.InstanceAction(ctx, core.InstanceActionRequest{InstanceId: launched.Id, Action: core.InstanceActionActionStop})
+	assert.NoError(t, err)
+	assert.Equal(t, core.InstanceLifecycleStateStopping, stopped.LifecycleState)
+	_, err = compute.InstanceAction(ctx, core.InstanceActionRequest{InstanceId: launched.Id, Action: core.InstanceActionActionStart})
+	assertServiceError(t, err, http.StatusConflict, "IncorrectState")
+
+	// the resources reach their final state when read without transition reads
+	server.TransitionReads = 0
+	assert.Equal(t, core.InstanceLifecycleStateStopped, getInstance(t, compute, launched.Id).LifecycleState)
+
+	_, err = compute.TerminateInstance(ctx, core.TerminateInstanceRequest{InstanceId: launched.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, core.InstanceLifecycleStateTerminated, getInstance(t, compute, launched.Id).LifecycleState)
+	_, err = network.DeleteSubnet(ctx, core.DeleteSubnetRequest{SubnetId: subnet.Id})
+	assert.NoError(t, err)
+
+	volume, err := blockstorage.CreateVolume(ctx, core.CreateVolumeRequest{CreateVolumeDetails: core.CreateVolumeDetails{
+		AvailabilityDomain: common.String("AD-1"),
+		CompartmentId:      common.String("compartment1"),
+		SizeInGBs:          common.Int64(100),
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100*1024), *volume.SizeInMBs)
+
+	_, err = blockstorage.DeleteVolume(ctx, core.DeleteVolumeRequest{VolumeId: volume.Id})
+	assert.NoError(t, err)
+	_, err = blockstorage.GetVolume(ctx, core.GetVolumeRequest{VolumeId: volume.Id})
+	assertServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+
+	volumes, err := blockstorage.ListVolumes(ctx, core.ListVolumesRequest{CompartmentId: common.String("compartment1")})
+	assert.NoError(t, err)
+	assert.Empty(t, volumes.Items)
+}
+
+func getInstance(t *testing.T, compute core.ComputeClient, instanceID *string) core.Instance {
+	response, err := compute.GetInstance(context.Background(), core.GetInstanceRequest{InstanceId: instanceID})
+	assert.NoError(t, err)
+	return response.Instance
+}
+
+func TestControlPlaneServer_Identity(t *testing.T) {
+	server := NewControlPlaneServer()
+	defer server.Close()
+	ctx := context.Background()
+	client := identity.IdentityClient{BaseClient: server.BaseClient()}
+
+	createCompartment := func(parentID *string, name string) (identity.CreateCompartmentResponse, error) {
+		return client.CreateCompartment(ctx, identity.CreateCompartmentRequest{CreateCompartmentDetails: identity.CreateCompartmentDetails{
+			CompartmentId: parentID,
+			Name:          common.String(name),
+			Description:   common.String("compartment " + name),
+		}})
+	}
+
+	parent, err := createCompartment(common.String(server.TenancyId), "parent")
+	assert.NoError(t, err)
+	assert.Equal(t, identity.CompartmentLifecycleStateCreating, parent.LifecycleState)
+	_, err = createCompartment(common.String(server.TenancyId), "parent")
+	assertServiceError(t, err, http.StatusConflict, "CompartmentAlreadyExists")
+	_, err = createCompartment(common.String("ocid1.compartment.oc1..unknown"), "child")
+	assertServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+	child, err := createCompartment(parent.Id, "child")
+	assert.NoError(t, err)
+
+	subtree, err := client.ListCompartments(ctx, identity.ListCompartmentsRequest{
+		CompartmentId:          common.String(server.TenancyId),
+		CompartmentIdInSubtree: common.Bool(true),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, subtree.Items, 2)
+
+	policy, err := client.CreatePolicy(ctx, identity.CreatePolicyRequest{CreatePolicyDetails: identity.CreatePolicyDetails{
+		CompartmentId: child.Id,
+		Name:          common.String("policy1"),
+		Statements:    []string{"Allow group Administrators to manage all-resources in compartment child"},
+		Description:   common.String("policy"),
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, identity.PolicyLifecycleStateActive, policy.LifecycleState)
+
+	// the compartments are not deleted while they have resources
+	server.TransitionReads = 0
+	for _, compartmentID := range []*string{parent.Id, child.Id} {
+		compartment, err := client.GetCompartment(ctx, identity.GetCompartmentRequest{CompartmentId: compartmentID})
+		assert.NoError(t, err)
+		assert.Equal(t, identity.CompartmentLifecycleStateActive, compartment.LifecycleState)
+	}
+	_, err = client.DeleteCompartment(ctx, identity.DeleteCompartmentRequest{CompartmentId: child.Id})
+	assertServiceError(t, err, http.StatusConflict, "Conflict")
+
+	_, err = client.DeletePolicy(ctx, identity.DeletePolicyRequest{PolicyId: policy.Id})
+	assert.NoError(t, err)
+	_, err = client.GetPolicy(ctx, identity.GetPolicyRequest{PolicyId: policy.Id})
+	assertServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+
+	deleted, err := client.DeleteCompartment(ctx, identity.DeleteCompartmentRequest{CompartmentId: child.Id})
+	assert.NoError(t, err)
+	workRequest, err := client.GetWorkRequest(ctx, identity.GetWorkRequestRequest{WorkRequestId: deleted.OpcWorkRequestId})
+	assert.NoError(t, err)
+	assert.Equal(t, identity.WorkRequestOperationTypeCompartment, workRequest.OperationType)
+	assert.Equal(t, identity.WorkRequestStatusSucceeded, workRequest.Status)
+
+	compartment, err := client.GetCompartment(ctx, identity.GetCompartmentRequest{CompartmentId: child.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, identity.CompartmentLifecycleStateDeleted, compartment.LifecycleState)
+
+	// the name of a deleted compartment can be used again
+	_, err = createCompartment(parent.Id, "child")
+	assert.NoError(t, err)
+}
+
+func TestControlPlaneServer_ObjectStorage(t *testing.T) {
+	server := NewControlPlaneServer()
+	defer server.Close()
+	ctx := context.Background()
+	client := objectstorage.ObjectStorageClient{BaseClient: server.BaseClient()}
+
+	namespace, err := client.GetNamespace(ctx, objectstorage.GetNamespaceRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, server.Namespace, *namespace.Value)
+
+	createBucket := objectstorage.CreateBucketRequest{NamespaceName: namespace.Value, CreateBucketDetails: objectstorage.CreateBucketDetails{
+		Name:          common.String("bucket1"),
+		CompartmentId: common.String("compartment1"),
+	}}
+	bucket, err := client.CreateBucket(ctx, createBucket)
+	assert.NoError(t, err)
+	assert.Equal(t, objectstorage.BucketStorageTierStandard, bucket.StorageTier)
+	_, err = client.CreateBucket(ctx, createBucket)
+	assertServiceError(t, err, http.StatusConflict, "BucketAlreadyExists")
+
+	for _, name := range []string{"a/1.txt", "a/2.txt", "b/1.txt", "c.txt"} {
+		_, err = client.PutObject(ctx, objectstorage.PutObjectRequest{
+			NamespaceName: namespace.Value,
+			BucketName:    bucket.Name,
+			ObjectName:    common.String(name),
+			ContentLength: common.Int64(int64(len(name))),
+			PutObjectBody: ioutil.NopCloser(bytes.NewReader([]byte(name))),
+			ContentType:   common.String("text/plain"),
+			OpcMeta:       map[string]string{"source": "test"},
+		})
+		assert.NoError(t, err)
+	}
+
+	object, err := client.GetObject(ctx, objectstorage.GetObjectRequest{NamespaceName: namespace.Value, BucketName: bucket.Name, ObjectName: common.String("a/2.txt")})
+	assert.NoError(t, err)
+	content, _ := ioutil.ReadAll(object.Content)
+	assert.Equal(t, "a/2.txt", string(content))
+	assert.Equal(t, "text/plain", *object.ContentType)
+	assert.Equal(t, map[string]string{"source": "test"}, object.OpcMeta)
+
+	// the ranges and the conditions of the reads
+	ranged, err := client.GetObject(ctx, objectstorage.GetObjectRequest{NamespaceName: namespace.Value, BucketName: bucket.Name,
+		ObjectName: common.String("a/2.txt"), Range: common.String("bytes=2-4"), IfMatch: object.ETag})
+	assert.NoError(t, err)
+	content, _ = ioutil.ReadAll(ranged.Content)
+	assert.Equal(t, "2.t", string(content))
+	_, err = client.GetObject(ctx, objectstorage.GetObjectRequest{NamespaceName: namespace.Value, BucketName: bucket.Name,
+		ObjectName: common.String("a/2.txt"), IfMatch: common.String("other")})
+	assertServiceError(t, err, http.StatusPreconditionFailed, "IfMatchFailed")
+
+	listed, err := client.ListObjects(ctx, objectstorage.ListObjectsRequest{
+		NamespaceName: namespace.Value,
+		BucketName:    bucket.Name,
+		Delimiter:     common.String("/"),
+		Fields:        common.String("size"),
+		Limit:         common.Int(2),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/", "b/"}, listed.Prefixes)
+	assert.Empty(t, listed.Objects)
+	assert.Equal(t, "c.txt", *listed.NextStartWith)
+
+	listed, err = client.ListObjects(ctx, objectstorage.ListObjectsRequest{
+		NamespaceName: namespace.Value,
+		BucketName:    bucket.Name,
+		Prefix:        common.String("a/"),
+		Fields:        common.String("size"),
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, listed.Objects, 2) {
+		assert.Equal(t, "a/1.txt", *listed.Objects[0].Name)
+		assert.Equal(t, int64(7), *listed.Objects[0].Size)
+	}
+	assert.Nil(t, listed.NextStartWith)
+
+	upload, err := client.CreateMultipartUpload(ctx, objectstorage.CreateMultipartUploadRequest{
+		NamespaceName:                namespace.Value,
+		BucketName:                   bucket.Name,
+		CreateMultipartUploadDetails: objectstorage.CreateMultipartUploadDetails{Object: common.String("multipart")},
+	})
+	assert.NoError(t, err)
+
+	parts := []objectstorage.CommitMultipartUploadPartDetails{}
+	for i, part := range []string{"hello ", "world"} {
+		uploaded, err := client.UploadPart(ctx, objectstorage.UploadPartRequest{
+			NamespaceName:  namespace.Value,
+			BucketName:     bucket.Name,
+			ObjectName:     upload.Object,
+			UploadId:       upload.UploadId,
+			UploadPartNum:  common.Int(i + 1),
+			ContentLength:  common.Int64(int64(len(part))),
+			UploadPartBody: ioutil.NopCloser(bytes.NewReader([]byte(part))),
+		})
+		assert.NoError(t, err)
+		parts = append(parts, objectstorage.CommitMultipartUploadPartDetails{PartNum: common.Int(i + 1), Etag: uploaded.ETag})
+	}
+
+	committed, err := client.CommitMultipartUpload(ctx, objectstorage.CommitMultipartUploadRequest{
+		NamespaceName:                namespace.Value,
+		BucketName:                   bucket.Name,
+		ObjectName:                   upload.Object,
+		UploadId:                     upload.UploadId,
+		CommitMultipartUploadDetails: objectstorage.CommitMultipartUploadDetails{PartsToCommit: parts},
+	})
+	assert.NoError(t, err)
+	assert.Regexp(t, "-2$", *committed.OpcMultipartMd5)
+
+	object, err = client.GetObject(ctx, objectstorage.GetObjectRequest{
+		NamespaceName: namespace.Value,
+		BucketName:    bucket.Name,
+		ObjectName:    upload.Object,
+		Range:         common.String("bytes=6-"),
+	})
+	assert.NoError(t, err)
+	content, _ = ioutil.ReadAll(object.Content)
+	assert.Equal(t, "world", string(content))
+
+	// the bucket is not deleted while it has objects
+	_, err = client.DeleteBucket(ctx, objectstorage.DeleteBucketRequest{NamespaceName: namespace.Value, BucketName: bucket.Name})
+	assertServiceError(t, err, http.StatusConflict, "BucketNotEmpty")
+
+	for _, name := range []string{"a/1.txt", "a/2.txt", "b/1.txt", "c.txt", "multipart"} {
+		_, err = client.DeleteObject(ctx, objectstorage.DeleteObjectRequest{NamespaceName: namespace.Value, BucketName: bucket.Name, ObjectName: common.String(name)})
+		assert.NoError(t, err)
+	}
+	_, err = client.HeadObject(ctx, objectstorage.HeadObjectRequest{NamespaceName: namespace.Value, BucketName: bucket.Name, ObjectName: common.String("c.txt")})
+	if serviceError, ok := common.IsServiceError(err); assert.True(t, ok) {
+		assert.Equal(t, http.StatusNotFound, serviceError.GetHTTPStatusCode())
+	}
+
+	_, err = client.DeleteBucket(ctx, objectstorage.DeleteBucketRequest{NamespaceName: namespace.Value, BucketName: bucket.Name})
+	assert.NoError(t, err)
+	_, err = client.GetBucket(ctx, objectstorage.GetBucketRequest{NamespaceName: namespace.Value, BucketName: bucket.Name})
+	assertServiceError(t, err, http.StatusNotFound, "BucketNotFound")
+}
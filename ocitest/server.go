@@ -0,0 +1,211 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package ocitest provides in-process fakes of OCI services implementing their HTTP APIs, to test code using the
+// SDK clients offline. A fake runs an httptest.Server which the clients are bound to with Server.Bind, or which
+// provides their BaseClient with Server.BaseClient:
+//
+//	server := ocitest.NewVaultServer()
+//	defer server.Close()
+//	client := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+//
+// The fakes keep their state in memory, do not check the signatures of the requests and only implement the
+// subset of the operations documented on each of them.
+package ocitest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// Server is the HTTP server of a fake service
+type Server struct {
+	*httptest.Server
+}
+
+// NewServer returns a server handling the requests with handler, such as a fake of a service not provided by this
+// package, or of the failures of a service
+func NewServer(handler http.Handler) *Server {
+	return &Server{Server: httptest.NewServer(handler)}
+}
+
+// noSigner is the signer of the clients bound to a fake, which does not check signatures
+type noSigner struct{}
+
+func (noSigner) Sign(*http.Request) error {
+	return nil
+}
+
+// BaseClient returns a base client sending its requests to the server, without signing them
+func (server *Server) BaseClient() common.BaseClient {
+	client := common.DefaultBaseClientWithSigner(noSigner{})
+	server.Bind(&client)
+	return client
+}
+
+// Bind makes a client send its requests to the server. The signer of the client is kept, a client created with a
+// configuration provider still requires valid credentials to sign its requests.
+func (server *Server) Bind(client *common.BaseClient) {
+	client.Host = server.URL
+	client.HTTPClient = server.Client()
+}
+
+// handlerFunc handles a request whose path matched the pattern of a route, with the values of its parameters
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// router dispatches the requests to the handlers of the operations, by method and path. The patterns are the paths
+// of the operations, such as "/keys/{keyId}". A last parameter such as "{objectName...}" matches the rest of the
+// path, which may contain slashes. The API versions given as prefixes are removed from the paths of the requests
+// before matching, so that the clients match whatever their base path.
+type router struct {
+	prefixes []string
+	routes   []route
+}
+
+func (router *router) handle(method, pattern string, handler handlerFunc) {
+	router.routes = append(router.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (router *router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("opc-request-id", newRequestID())
+
+	path := r.URL.Path
+	for _, prefix := range router.prefixes {
+		if strings.HasPrefix(path, prefix+"/") {
+			path = strings.TrimPrefix(path, prefix)
+			break
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	methodNotAllowed := false
+	for _, route := range router.routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			methodNotAllowed = true
+			continue
+		}
+		route.handler(w, r, params)
+		return
+	}
+
+	if methodNotAllowed {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("method %s is not allowed on %s", r.Method, r.URL.Path))
+		return
+	}
+	writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s is not implemented by the fake", r.URL.Path))
+}
+
+func (route route) match(segments []string) (map[string]string, bool) {
+	last := route.segments[len(route.segments)-1]
+	rest := strings.HasSuffix(last, "...}")
+	if len(segments) != len(route.segments) && (!rest || len(segments) < len(route.segments)) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range route.segments {
+		if rest && i == len(route.segments)-1 {
+			params[segment[1:len(segment)-4]] = strings.Join(segments[i:], "/")
+		} else if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// writeJSON writes a response with a JSON body
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error response as the services do, which the clients return as service errors
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{"code": code, "message": message})
+}
+
+// writeNotFound writes the error of the services for a resource which does not exist
+func writeNotFound(w http.ResponseWriter, resource, id string) {
+	writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s not found", resource, id))
+}
+
+// decodeBody decodes the JSON body of a request, or writes an error response and returns false
+func decodeBody(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// page returns the bounds of the page of a list of count items requested with the "limit" and "page" query
+// parameters, and sets the "opc-next-page" header if there are more items. The page tokens are the offsets of the
+// pages. It writes an error response and returns false if the parameters are invalid.
+func page(w http.ResponseWriter, r *http.Request, count int) (int, int, bool) {
+	start, end := 0, count
+	if token := r.URL.Query().Get("page"); len(token) > 0 {
+		var err error
+		if start, err = strconv.Atoi(token); err != nil || start < 0 || start > count {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid page %s", token))
+			return 0, 0, false
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); len(limit) > 0 {
+		size, err := strconv.Atoi(limit)
+		if err != nil || size <= 0 {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid limit %s", limit))
+			return 0, 0, false
+		}
+		if start+size < count {
+			end = start + size
+			w.Header().Set("opc-next-page", strconv.Itoa(end))
+		}
+	}
+	return start, end, true
+}
+
+// newOCID returns a random OCID of a resource type
+func newOCID(resourceType string) string {
+	return fmt.Sprintf("ocid1.%s.oc1..%s", resourceType, randomHex(30))
+}
+
+func newRequestID() string {
+	return strings.ToUpper(randomHex(32))
+}
+
+func randomHex(length int) string {
+	buffer := make([]byte, (length+1)/2)
+	rand.Read(buffer)
+	return hex.EncodeToString(buffer)[:length]
+}
+
+func now() *common.SDKTime {
+	return &common.SDKTime{Time: time.Now()}
+}
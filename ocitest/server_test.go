@@ -0,0 +1,62 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/core"
+)
+
+func TestServer_RetryTokenOfFailedRequests(t *testing.T) {
+	var mutex sync.Mutex
+	var tokens []string
+	server := NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		tokens = append(tokens, r.Header.Get("opc-retry-token"))
+		mutex.Unlock()
+		writeError(w, http.StatusInternalServerError, "InternalServerError", "failed")
+	}))
+	defer server.Close()
+	network := core.VirtualNetworkClient{BaseClient: server.BaseClient()}
+	policy := common.NewRetryPolicy(3, func(response common.OCIOperationResponse) bool {
+		return response.Error != nil
+	}, func(response common.OCIOperationResponse) time.Duration {
+		return 0
+	})
+	request := core.CreateVcnRequest{
+		CreateVcnDetails: core.CreateVcnDetails{
+			CidrBlock:     common.String("10.0.0.0/16"),
+			CompartmentId: common.String("ocid1.compartment.oc1..aaaaaaaa"),
+		},
+		RequestMetadata: common.RequestMetadata{RetryPolicy: &policy},
+	}
+
+	// all the attempts send the generated token, which is returned although the request failed
+	response, err := network.CreateVcn(context.Background(), request)
+	assert.Error(t, err)
+	assert.Len(t, tokens, 3)
+	assert.Len(t, tokens[0], 32)
+	assert.Equal(t, []string{tokens[0], tokens[0], tokens[0]}, tokens)
+	assert.Equal(t, tokens[0], *response.OpcRetryToken)
+	assert.Equal(t, http.StatusInternalServerError, response.RawResponse.StatusCode)
+
+	// the token is returned when the request is not sent at all
+	common.EnableRequestValidation()
+	defer common.DisableRequestValidation()
+	request.CompartmentId = nil
+	response, err = network.CreateVcn(context.Background(), request)
+	assert.IsType(t, common.ValidationError{}, err)
+	assert.Len(t, tokens, 3)
+	assert.Nil(t, response.RawResponse)
+	assert.Len(t, *response.OpcRetryToken, 32)
+	assert.NotEqual(t, tokens[0], *response.OpcRetryToken)
+}
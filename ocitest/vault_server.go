@@ -0,0 +1,54 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"net/http"
+	"sync"
+)
+
+// VaultServer is a fake of a vault of the Vault service, serving the APIs of the clients
+// keymanagement.KmsManagementClient, keymanagement.KmsCryptoClient, vault.VaultsClient and secrets.SecretsClient.
+//
+// The keys implement CreateKey, GetKey, ListKeys, UpdateKey, EnableKey, DisableKey, ScheduleKeyDeletion,
+// CancelKeyDeletion, CreateKeyVersion, GetKeyVersion and ListKeyVersions. Only the AES keys are supported, they are
+// enabled as soon as created. Encrypt, Decrypt and GenerateDataEncryptionKey encrypt with AES-GCM, the ciphertexts
+// are only decrypted with the key which encrypted them and the same associated data, by any version of the key.
+//
+// The secrets implement CreateSecret, GetSecret, ListSecrets, UpdateSecret, ScheduleSecretDeletion,
+// CancelSecretDeletion, GetSecretVersion, ListSecretVersions, GetSecretBundle and ListSecretBundleVersions, with
+// the CURRENT, PENDING, LATEST, PREVIOUS and DEPRECATED stages of the versions.
+//
+// VaultServer is safe for concurrent use.
+type VaultServer struct {
+	*Server
+
+	// VaultId is the OCID of the vault of the keys and secrets
+	VaultId string
+
+	mutex   sync.Mutex
+	keys    []*fakeKey
+	secrets []*fakeSecret
+}
+
+// NewVaultServer starts a fake vault, which must be closed once done
+func NewVaultServer() *VaultServer {
+	server := &VaultServer{VaultId: newOCID("vault")}
+
+	router := &router{prefixes: []string{"/20180608", "/20190301"}}
+	server.routeKeys(router)
+	server.routeSecrets(router)
+
+	server.Server = NewServer(router)
+	return server
+}
+
+// locked returns the handler called with the lock of the server held
+func (server *VaultServer) locked(handler handlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		handler(w, r, params)
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+)
+
+// maxPlaintextSize is the maximum size of the plaintexts given to Encrypt
+const maxPlaintextSize = 4096
+
+// defaultKeyDeletionDelay is the delay before the deletion of a key if the request does not give the time
+const defaultKeyDeletionDelay = 30 * 24 * time.Hour
+
+var errorInvalidCiphertext = errors.New("the ciphertext was not encrypted by this key or with this associated data")
+
+type fakeKey struct {
+	keymanagement.Key
+	versions []*fakeKeyVersion
+}
+
+type fakeKeyVersion struct {
+	keymanagement.KeyVersion
+	material []byte
+}
+
+func (server *VaultServer) routeKeys(router *router) {
+	router.handle(http.MethodPost, "/keys", server.locked(server.createKey))
+	router.handle(http.MethodGet, "/keys", server.locked(server.listKeys))
+	router.handle(http.MethodGet, "/keys/{keyId}", server.locked(server.getKey))
+	router.handle(http.MethodPut, "/keys/{keyId}", server.locked(server.updateKey))
+	router.handle(http.MethodPost, "/keys/{keyId}/actions/enable", server.locked(server.changeKeyState(keymanagement.KeyLifecycleStateDisabled, keymanagement.KeyLifecycleStateEnabled)))
+	router.handle(http.MethodPost, "/keys/{keyId}/actions/disable", server.locked(server.changeKeyState(keymanagement.KeyLifecycleStateEnabled, keymanagement.KeyLifecycleStateDisabled)))
+	router.handle(http.MethodPost, "/keys/{keyId}/actions/scheduleDeletion", server.locked(server.scheduleKeyDeletion))
+	router.handle(http.MethodPost, "/keys/{keyId}/actions/cancelDeletion", server.locked(server.changeKeyState(keymanagement.KeyLifecycleStatePendingDeletion, keymanagement.KeyLifecycleStateEnabled)))
+	router.handle(http.MethodPost, "/keys/{keyId}/keyVersions", server.locked(server.createKeyVersion))
+	router.handle(http.MethodGet, "/keys/{keyId}/keyVersions", server.locked(server.listKeyVersions))
+	router.handle(http.MethodGet, "/keys/{keyId}/keyVersions/{keyVersionId}", server.locked(server.getKeyVersion))
+	router.handle(http.MethodPost, "/encrypt", server.locked(server.encrypt))
+	router.handle(http.MethodPost, "/decrypt", server.locked(server.decrypt))
+	router.handle(http.MethodPost, "/generateDataEncryptionKey", server.locked(server.generateDataEncryptionKey))
+}
+
+// key returns a key, or writes an error response and returns nil if it does not exist
+func (server *VaultServer) key(w http.ResponseWriter, keyID string) *fakeKey {
+	for _, key := range server.keys {
+		if *key.Id == keyID {
+			return key
+		}
+	}
+	writeNotFound(w, "key", keyID)
+	return nil
+}
+
+// enabledKey returns a key usable for cryptographic operations, or writes an error response and returns nil
+func (server *VaultServer) enabledKey(w http.ResponseWriter, keyID *string) *fakeKey {
+	if keyID == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "keyId is required")
+		return nil
+	}
+
+	key := server.key(w, *keyID)
+	if key != nil && key.LifecycleState != keymanagement.KeyLifecycleStateEnabled {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("key %s is %s", *keyID, key.LifecycleState))
+		return nil
+	}
+	return key
+}
+
+func (server *VaultServer) createKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := keymanagement.CreateKeyDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CompartmentId == nil || details.DisplayName == nil || details.KeyShape == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "compartmentId, displayName and keyShape are required")
+		return
+	}
+
+	if !validAESShape(details.KeyShape) {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "only AES keys of 16, 24 or 32 bytes are supported")
+		return
+	}
+
+	protectionMode := keymanagement.KeyProtectionModeEnum(details.ProtectionMode)
+	if protectionMode == "" {
+		protectionMode = keymanagement.KeyProtectionModeHsm
+	}
+
+	key := &fakeKey{Key: keymanagement.Key{
+		CompartmentId:  details.CompartmentId,
+		DisplayName:    details.DisplayName,
+		Id:             common.String(newOCID("key")),
+		KeyShape:       details.KeyShape,
+		LifecycleState: keymanagement.KeyLifecycleStateEnabled,
+		TimeCreated:    now(),
+		VaultId:        common.String(server.VaultId),
+		DefinedTags:    details.DefinedTags,
+		FreeformTags:   details.FreeformTags,
+		ProtectionMode: protectionMode,
+	}}
+	key.addVersion()
+	server.keys = append(server.keys, key)
+	writeJSON(w, http.StatusOK, key.Key)
+}
+
+func (server *VaultServer) listKeys(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	compartmentID := r.URL.Query().Get("compartmentId")
+	items := []keymanagement.KeySummary{}
+	for _, key := range server.keys {
+		if *key.CompartmentId != compartmentID {
+			continue
+		}
+		items = append(items, keymanagement.KeySummary{
+			CompartmentId:  key.CompartmentId,
+			DisplayName:    key.DisplayName,
+			Id:             key.Id,
+			LifecycleState: keymanagement.KeySummaryLifecycleStateEnum(key.LifecycleState),
+			TimeCreated:    key.TimeCreated,
+			VaultId:        key.VaultId,
+			DefinedTags:    key.DefinedTags,
+			FreeformTags:   key.FreeformTags,
+			ProtectionMode: keymanagement.KeySummaryProtectionModeEnum(key.ProtectionMode),
+		})
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *VaultServer) getKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if key := server.key(w, params["keyId"]); key != nil {
+		writeJSON(w, http.StatusOK, key.Key)
+	}
+}
+
+func (server *VaultServer) updateKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := server.key(w, params["keyId"])
+	if key == nil {
+		return
+	}
+
+	details := keymanagement.UpdateKeyDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.DisplayName != nil {
+		key.DisplayName = details.DisplayName
+	}
+	if details.DefinedTags != nil {
+		key.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		key.FreeformTags = details.FreeformTags
+	}
+	writeJSON(w, http.StatusOK, key.Key)
+}
+
+// changeKeyState returns the handler of an action moving a key from a state to another
+func (server *VaultServer) changeKeyState(from, to keymanagement.KeyLifecycleStateEnum) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		key := server.key(w, params["keyId"])
+		if key == nil {
+			return
+		}
+
+		if key.LifecycleState != from {
+			writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("key %s is %s", *key.Id, key.LifecycleState))
+			return
+		}
+		key.LifecycleState = to
+		key.TimeOfDeletion = nil
+		writeJSON(w, http.StatusOK, key.Key)
+	}
+}
+
+func (server *VaultServer) scheduleKeyDeletion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := server.key(w, params["keyId"])
+	if key == nil {
+		return
+	}
+
+	details := keymanagement.ScheduleKeyDeletionDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if key.LifecycleState != keymanagement.KeyLifecycleStateEnabled && key.LifecycleState != keymanagement.KeyLifecycleStateDisabled {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("key %s is %s", *key.Id, key.LifecycleState))
+		return
+	}
+
+	key.LifecycleState = keymanagement.KeyLifecycleStatePendingDeletion
+	key.TimeOfDeletion = details.TimeOfDeletion
+	if key.TimeOfDeletion == nil {
+		key.TimeOfDeletion = &common.SDKTime{Time: time.Now().Add(defaultKeyDeletionDelay)}
+	}
+	writeJSON(w, http.StatusOK, key.Key)
+}
+
+func (server *VaultServer) createKeyVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := server.enabledKey(w, common.String(params["keyId"]))
+	if key == nil {
+		return
+	}
+	writeJSON(w, http.StatusOK, key.addVersion().KeyVersion)
+}
+
+func (server *VaultServer) listKeyVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := server.key(w, params["keyId"])
+	if key == nil {
+		return
+	}
+
+	items := []keymanagement.KeyVersionSummary{}
+	for _, version := range key.versions {
+		items = append(items, keymanagement.KeyVersionSummary{
+			CompartmentId:  version.CompartmentId,
+			Id:             version.Id,
+			KeyId:          version.KeyId,
+			Origin:         keymanagement.KeyVersionSummaryOriginEnum(version.Origin),
+			TimeCreated:    version.TimeCreated,
+			VaultId:        version.VaultId,
+			LifecycleState: keymanagement.KeyVersionSummaryLifecycleStateEnum(version.LifecycleState),
+		})
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *VaultServer) getKeyVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	key := server.key(w, params["keyId"])
+	if key == nil {
+		return
+	}
+
+	if version := key.version(params["keyVersionId"]); version != nil {
+		writeJSON(w, http.StatusOK, version.KeyVersion)
+		return
+	}
+	writeNotFound(w, "key version", params["keyVersionId"])
+}
+
+func (server *VaultServer) encrypt(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := keymanagement.EncryptDataDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	key := server.enabledKey(w, details.KeyId)
+	if key == nil {
+		return
+	}
+
+	if details.Plaintext == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "plaintext is required")
+		return
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(*details.Plaintext)
+	if err != nil || len(plaintext) > maxPlaintextSize {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("plaintext must be base64 encoded, up to %d bytes", maxPlaintextSize))
+		return
+	}
+
+	ciphertext, err := key.encrypt(plaintext, details.AssociatedData)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, keymanagement.EncryptedData{Ciphertext: common.String(ciphertext)})
+}
+
+func (server *VaultServer) decrypt(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := keymanagement.DecryptDataDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	key := server.enabledKey(w, details.KeyId)
+	if key == nil {
+		return
+	}
+
+	if details.Ciphertext == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "ciphertext is required")
+		return
+	}
+
+	plaintext, err := key.decrypt(*details.Ciphertext, details.AssociatedData)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, keymanagement.DecryptedData{
+		Plaintext:         common.String(base64.StdEncoding.EncodeToString(plaintext)),
+		PlaintextChecksum: common.String(checksum(plaintext)),
+	})
+}
+
+func (server *VaultServer) generateDataEncryptionKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := keymanagement.GenerateKeyDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	key := server.enabledKey(w, details.KeyId)
+	if key == nil {
+		return
+	}
+
+	if details.KeyShape == nil || !validAESShape(details.KeyShape) {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "only AES keys of 16, 24 or 32 bytes are supported")
+		return
+	}
+
+	plaintext := make([]byte, *details.KeyShape.Length)
+	if _, err := rand.Read(plaintext); err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+
+	ciphertext, err := key.encrypt(plaintext, details.AssociatedData)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+
+	generated := keymanagement.GeneratedKey{Ciphertext: common.String(ciphertext)}
+	if details.IncludePlaintextKey != nil && *details.IncludePlaintextKey {
+		generated.Plaintext = common.String(base64.StdEncoding.EncodeToString(plaintext))
+		generated.PlaintextChecksum = common.String(checksum(plaintext))
+	}
+	writeJSON(w, http.StatusOK, generated)
+}
+
+// addVersion generates a new version of the key, which becomes its current version
+func (key *fakeKey) addVersion() *fakeKeyVersion {
+	version := &fakeKeyVersion{
+		KeyVersion: keymanagement.KeyVersion{
+			CompartmentId:  key.CompartmentId,
+			Id:             common.String(newOCID("keyversion")),
+			KeyId:          key.Id,
+			TimeCreated:    now(),
+			VaultId:        key.VaultId,
+			LifecycleState: keymanagement.KeyVersionLifecycleStateEnabled,
+			Origin:         keymanagement.KeyVersionOriginInternal,
+		},
+		material: make([]byte, *key.KeyShape.Length),
+	}
+	rand.Read(version.material)
+
+	key.versions = append(key.versions, version)
+	key.CurrentKeyVersion = version.Id
+	return version
+}
+
+func (key *fakeKey) version(versionID string) *fakeKeyVersion {
+	for _, version := range key.versions {
+		if *version.Id == versionID {
+			return version
+		}
+	}
+	return nil
+}
+
+// encrypt encrypts with the current version of the key. The ciphertext is the base64 encoding of the length of the
+// OCID of the version as a byte, the OCID, the IV and the AES-GCM ciphertext of the plaintext, whose additional
+// data is the associated data as JSON.
+func (key *fakeKey) encrypt(plaintext []byte, associatedData map[string]string) (string, error) {
+	version := key.versions[len(key.versions)-1]
+	aead, err := version.aead()
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext := append([]byte{byte(len(*version.Id))}, *version.Id...)
+	iv := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(iv); err != nil {
+		return "", err
+	}
+	ciphertext = append(ciphertext, iv...)
+	ciphertext = aead.Seal(ciphertext, iv, plaintext, additionalData(associatedData))
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func (key *fakeKey) decrypt(encoded string, associatedData map[string]string) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(ciphertext) == 0 || len(ciphertext) < 1+int(ciphertext[0]) {
+		return nil, errorInvalidCiphertext
+	}
+
+	version := key.version(string(ciphertext[1 : 1+int(ciphertext[0])]))
+	if version == nil {
+		return nil, errorInvalidCiphertext
+	}
+
+	aead, err := version.aead()
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext = ciphertext[1+int(ciphertext[0]):]
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errorInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], additionalData(associatedData))
+	if err != nil {
+		return nil, errorInvalidCiphertext
+	}
+	return plaintext, nil
+}
+
+func (version *fakeKeyVersion) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(version.material)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData returns the associated data of a request as the additional data of AES-GCM, the JSON encoding
+// sorting the keys
+func additionalData(associatedData map[string]string) []byte {
+	if len(associatedData) == 0 {
+		return nil
+	}
+
+	data, _ := json.Marshal(associatedData)
+	return data
+}
+
+func validAESShape(shape *keymanagement.KeyShape) bool {
+	if shape.Algorithm != keymanagement.KeyShapeAlgorithmAes || shape.Length == nil {
+		return false
+	}
+
+	length := *shape.Length
+	return length == 16 || length == 24 || length == 32
+}
+
+func checksum(plaintext []byte) string {
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE(plaintext)), 10)
+}
@@ -0,0 +1,462 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/secrets"
+	"github.com/oracle/oci-go-sdk/v27/vault"
+)
+
+// defaultSecretDeletionDelay is the delay before the deletion of a secret if the request does not give the time
+const defaultSecretDeletionDelay = 30 * 24 * time.Hour
+
+// secretStages are the stages of the secret versions, in the order they are listed
+var secretStages = []string{"CURRENT", "PENDING", "LATEST", "PREVIOUS"}
+
+type fakeSecret struct {
+	vault.Secret
+	versions []*fakeSecretVersion
+}
+
+type fakeSecretVersion struct {
+	number  int64
+	name    *string
+	content string
+	created *common.SDKTime
+	stages  map[string]bool
+}
+
+func (server *VaultServer) routeSecrets(router *router) {
+	router.handle(http.MethodPost, "/secrets", server.locked(server.createSecret))
+	router.handle(http.MethodGet, "/secrets", server.locked(server.listSecrets))
+	router.handle(http.MethodGet, "/secrets/{secretId}", server.locked(server.getSecret))
+	router.handle(http.MethodPut, "/secrets/{secretId}", server.locked(server.updateSecret))
+	router.handle(http.MethodPost, "/secrets/{secretId}/actions/scheduleDeletion", server.locked(server.scheduleSecretDeletion))
+	router.handle(http.MethodPost, "/secrets/{secretId}/actions/cancelDeletion", server.locked(server.cancelSecretDeletion))
+	router.handle(http.MethodGet, "/secrets/{secretId}/versions", server.locked(server.listSecretVersions))
+	router.handle(http.MethodGet, "/secrets/{secretId}/version/{secretVersionNumber}", server.locked(server.getSecretVersion))
+	router.handle(http.MethodGet, "/secretbundles/{secretId}", server.locked(server.getSecretBundle))
+	router.handle(http.MethodGet, "/secretbundles/{secretId}/versions", server.locked(server.listSecretBundleVersions))
+}
+
+// secret returns a secret, or writes an error response and returns nil if it does not exist
+func (server *VaultServer) secret(w http.ResponseWriter, secretID string) *fakeSecret {
+	for _, secret := range server.secrets {
+		if *secret.Id == secretID {
+			return secret
+		}
+	}
+	writeNotFound(w, "secret", secretID)
+	return nil
+}
+
+// activeSecret returns a secret whose content can be read or updated, or writes an error response and returns nil
+func (server *VaultServer) activeSecret(w http.ResponseWriter, secretID string) *fakeSecret {
+	secret := server.secret(w, secretID)
+	if secret != nil && secret.LifecycleState != vault.SecretLifecycleStateActive {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("secret %s is %s", secretID, secret.LifecycleState))
+		return nil
+	}
+	return secret
+}
+
+func (server *VaultServer) createSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	details := vault.CreateSecretDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.CompartmentId == nil || details.SecretName == nil || details.VaultId == nil || details.SecretContent == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "compartmentId, secretContent, secretName and vaultId are required")
+		return
+	}
+
+	if *details.VaultId != server.VaultId {
+		writeNotFound(w, "vault", *details.VaultId)
+		return
+	}
+
+	for _, secret := range server.secrets {
+		if *secret.SecretName == *details.SecretName && secret.LifecycleState != vault.SecretLifecycleStateDeleted {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("secret %s already exists", *details.SecretName))
+			return
+		}
+	}
+
+	content, ok := secretContent(w, details.SecretContent)
+	if !ok {
+		return
+	}
+
+	secret := &fakeSecret{Secret: vault.Secret{
+		CompartmentId:  details.CompartmentId,
+		Id:             common.String(newOCID("vaultsecret")),
+		LifecycleState: vault.SecretLifecycleStateActive,
+		SecretName:     details.SecretName,
+		TimeCreated:    now(),
+		VaultId:        details.VaultId,
+		DefinedTags:    details.DefinedTags,
+		Description:    details.Description,
+		FreeformTags:   details.FreeformTags,
+		KeyId:          details.KeyId,
+		Metadata:       details.Metadata,
+		SecretRules:    details.SecretRules,
+	}}
+	secret.addVersion(content, details.SecretContent.GetName(), vault.SecretContentDetailsStageCurrent)
+	server.secrets = append(server.secrets, secret)
+	writeJSON(w, http.StatusOK, secret.Secret)
+}
+
+func (server *VaultServer) listSecrets(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	items := []vault.SecretSummary{}
+	for _, secret := range server.secrets {
+		if *secret.CompartmentId != query.Get("compartmentId") ||
+			(query.Get("name") != "" && *secret.SecretName != query.Get("name")) ||
+			(query.Get("vaultId") != "" && *secret.VaultId != query.Get("vaultId")) ||
+			(query.Get("lifecycleState") != "" && string(secret.LifecycleState) != query.Get("lifecycleState")) {
+			continue
+		}
+
+		items = append(items, vault.SecretSummary{
+			CompartmentId:  secret.CompartmentId,
+			Id:             secret.Id,
+			LifecycleState: vault.SecretSummaryLifecycleStateEnum(secret.LifecycleState),
+			SecretName:     secret.SecretName,
+			TimeCreated:    secret.TimeCreated,
+			VaultId:        secret.VaultId,
+			DefinedTags:    secret.DefinedTags,
+			Description:    secret.Description,
+			FreeformTags:   secret.FreeformTags,
+			KeyId:          secret.KeyId,
+			TimeOfDeletion: secret.TimeOfDeletion,
+		})
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *VaultServer) getSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if secret := server.secret(w, params["secretId"]); secret != nil {
+		writeJSON(w, http.StatusOK, secret.Secret)
+	}
+}
+
+func (server *VaultServer) updateSecret(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.activeSecret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	details := vault.UpdateSecretDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	if details.SecretContent != nil && details.CurrentVersionNumber != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "secretContent and currentVersionNumber are exclusive")
+		return
+	}
+
+	if details.SecretContent != nil {
+		content, ok := secretContent(w, details.SecretContent)
+		if !ok {
+			return
+		}
+
+		if name := details.SecretContent.GetName(); name != nil && secret.versionNamed(*name) != nil {
+			writeError(w, http.StatusConflict, "Conflict", fmt.Sprintf("secret version %s already exists", *name))
+			return
+		}
+		secret.addVersion(content, details.SecretContent.GetName(), details.SecretContent.GetStage())
+	}
+
+	if details.CurrentVersionNumber != nil {
+		version := secret.version(*details.CurrentVersionNumber)
+		if version == nil {
+			writeNotFound(w, "secret version", strconv.FormatInt(*details.CurrentVersionNumber, 10))
+			return
+		}
+		secret.makeCurrent(version)
+	}
+
+	if details.Description != nil {
+		secret.Description = details.Description
+	}
+	if details.Metadata != nil {
+		secret.Metadata = details.Metadata
+	}
+	if details.DefinedTags != nil {
+		secret.DefinedTags = details.DefinedTags
+	}
+	if details.FreeformTags != nil {
+		secret.FreeformTags = details.FreeformTags
+	}
+	if details.SecretRules != nil {
+		secret.SecretRules = details.SecretRules
+	}
+	writeJSON(w, http.StatusOK, secret.Secret)
+}
+
+func (server *VaultServer) scheduleSecretDeletion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.activeSecret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	details := vault.ScheduleSecretDeletionDetails{}
+	if !decodeBody(w, r, &details) {
+		return
+	}
+
+	secret.LifecycleState = vault.SecretLifecycleStatePendingDeletion
+	secret.TimeOfDeletion = details.TimeOfDeletion
+	if secret.TimeOfDeletion == nil {
+		secret.TimeOfDeletion = &common.SDKTime{Time: time.Now().Add(defaultSecretDeletionDelay)}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *VaultServer) cancelSecretDeletion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.secret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	if secret.LifecycleState != vault.SecretLifecycleStatePendingDeletion {
+		writeError(w, http.StatusConflict, "IncorrectState", fmt.Sprintf("secret %s is %s", *secret.Id, secret.LifecycleState))
+		return
+	}
+	secret.LifecycleState = vault.SecretLifecycleStateActive
+	secret.TimeOfDeletion = nil
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *VaultServer) listSecretVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.secret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	items := []vault.SecretVersionSummary{}
+	for _, version := range secret.versions {
+		summary := vault.SecretVersionSummary{
+			SecretId:      secret.Id,
+			TimeCreated:   version.created,
+			VersionNumber: common.Int64(version.number),
+			ContentType:   vault.SecretVersionSummaryContentTypeBase64,
+			Name:          version.name,
+		}
+		for _, stage := range version.stageNames() {
+			summary.Stages = append(summary.Stages, vault.SecretVersionSummaryStagesEnum(stage))
+		}
+		items = append(items, summary)
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+func (server *VaultServer) getSecretVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.secret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	number, _ := strconv.ParseInt(params["secretVersionNumber"], 10, 64)
+	version := secret.version(number)
+	if version == nil {
+		writeNotFound(w, "secret version", params["secretVersionNumber"])
+		return
+	}
+
+	result := vault.SecretVersion{
+		ContentType:   vault.SecretVersionContentTypeBase64,
+		Name:          version.name,
+		SecretId:      secret.Id,
+		TimeCreated:   version.created,
+		VersionNumber: common.Int64(version.number),
+	}
+	for _, stage := range version.stageNames() {
+		result.Stages = append(result.Stages, vault.SecretVersionStagesEnum(stage))
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (server *VaultServer) getSecretBundle(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.activeSecret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	var version *fakeSecretVersion
+	switch {
+	case query.Get("versionNumber") != "":
+		number, _ := strconv.ParseInt(query.Get("versionNumber"), 10, 64)
+		version = secret.version(number)
+	case query.Get("secretVersionName") != "":
+		version = secret.versionNamed(query.Get("secretVersionName"))
+	case query.Get("stage") != "":
+		version = secret.versionInStage(query.Get("stage"))
+	default:
+		version = secret.versionInStage("CURRENT")
+	}
+
+	if version == nil {
+		writeNotFound(w, "secret version", r.URL.RawQuery)
+		return
+	}
+
+	bundle := secrets.SecretBundle{
+		SecretId:            secret.Id,
+		VersionNumber:       common.Int64(version.number),
+		TimeCreated:         version.created,
+		VersionName:         version.name,
+		SecretBundleContent: secrets.Base64SecretBundleContentDetails{Content: common.String(version.content)},
+		Metadata:            secret.Metadata,
+	}
+	for _, stage := range version.stageNames() {
+		bundle.Stages = append(bundle.Stages, secrets.SecretBundleStagesEnum(stage))
+	}
+	writeJSON(w, http.StatusOK, bundle)
+}
+
+func (server *VaultServer) listSecretBundleVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	secret := server.activeSecret(w, params["secretId"])
+	if secret == nil {
+		return
+	}
+
+	items := []secrets.SecretBundleVersionSummary{}
+	for _, version := range secret.versions {
+		summary := secrets.SecretBundleVersionSummary{
+			SecretId:      secret.Id,
+			VersionNumber: common.Int64(version.number),
+			TimeCreated:   version.created,
+			VersionName:   version.name,
+		}
+		for _, stage := range version.stageNames() {
+			summary.Stages = append(summary.Stages, secrets.SecretBundleVersionSummaryStagesEnum(stage))
+		}
+		items = append(items, summary)
+	}
+
+	if start, end, ok := page(w, r, len(items)); ok {
+		writeJSON(w, http.StatusOK, items[start:end])
+	}
+}
+
+// secretContent returns the base64 content of a secret version, or writes an error response and returns false
+func secretContent(w http.ResponseWriter, details vault.SecretContentDetails) (string, bool) {
+	content, ok := details.(vault.Base64SecretContentDetails)
+	if !ok || content.Content == nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "secretContent must be BASE64 content")
+		return "", false
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(*content.Content); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidParameter", "secretContent is not base64 encoded")
+		return "", false
+	}
+	return *content.Content, true
+}
+
+// addVersion adds the latest version of the secret, which becomes its current version unless it is pending. A
+// new pending version replaces the pending version.
+func (secret *fakeSecret) addVersion(content string, name *string, stage vault.SecretContentDetailsStageEnum) {
+	version := &fakeSecretVersion{
+		number:  int64(len(secret.versions) + 1),
+		name:    name,
+		content: content,
+		created: now(),
+		stages:  map[string]bool{"LATEST": true},
+	}
+
+	for _, other := range secret.versions {
+		delete(other.stages, "LATEST")
+		if stage == vault.SecretContentDetailsStagePending {
+			delete(other.stages, "PENDING")
+		}
+	}
+
+	secret.versions = append(secret.versions, version)
+	if stage == vault.SecretContentDetailsStagePending {
+		version.stages["PENDING"] = true
+	} else {
+		secret.makeCurrent(version)
+	}
+}
+
+// makeCurrent makes a version the current version of the secret, the current version becoming the previous one
+func (secret *fakeSecret) makeCurrent(version *fakeSecretVersion) {
+	if version.stages["CURRENT"] {
+		return
+	}
+
+	for _, other := range secret.versions {
+		delete(other.stages, "PREVIOUS")
+		if other.stages["CURRENT"] {
+			delete(other.stages, "CURRENT")
+			other.stages["PREVIOUS"] = true
+		}
+	}
+
+	delete(version.stages, "PENDING")
+	version.stages["CURRENT"] = true
+	secret.CurrentVersionNumber = common.Int64(version.number)
+}
+
+func (secret *fakeSecret) version(number int64) *fakeSecretVersion {
+	if number < 1 || number > int64(len(secret.versions)) {
+		return nil
+	}
+	return secret.versions[number-1]
+}
+
+func (secret *fakeSecret) versionNamed(name string) *fakeSecretVersion {
+	for _, version := range secret.versions {
+		if version.name != nil && *version.name == name {
+			return version
+		}
+	}
+	return nil
+}
+
+// versionInStage returns the latest version in a stage
+func (secret *fakeSecret) versionInStage(stage string) *fakeSecretVersion {
+	for i := len(secret.versions) - 1; i >= 0; i-- {
+		for _, name := range secret.versions[i].stageNames() {
+			if name == stage {
+				return secret.versions[i]
+			}
+		}
+	}
+	return nil
+}
+
+// stageNames returns the stages of the version, DEPRECATED if it has none
+func (version *fakeSecretVersion) stageNames() []string {
+	var names []string
+	for _, stage := range secretStages {
+		if version.stages[stage] {
+			names = append(names, stage)
+		}
+	}
+
+	if len(names) == 0 {
+		return []string{"DEPRECATED"}
+	}
+	return names
+}
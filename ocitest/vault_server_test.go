@@ -0,0 +1,195 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package ocitest
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/keymanagement"
+	"github.com/oracle/oci-go-sdk/v27/secrets"
+	"github.com/oracle/oci-go-sdk/v27/vault"
+)
+
+func encode(data string) *string {
+	return common.String(base64.StdEncoding.EncodeToString([]byte(data)))
+}
+
+func decode(t *testing.T, data *string) string {
+	decoded, err := base64.StdEncoding.DecodeString(*data)
+	assert.NoError(t, err)
+	return string(decoded)
+}
+
+func assertServiceError(t *testing.T, err error, status int, code string) {
+	serviceError, ok := common.IsServiceError(err)
+	if assert.True(t, ok, "%v is not a service error", err) {
+		assert.Equal(t, status, serviceError.GetHTTPStatusCode())
+		assert.Equal(t, code, serviceError.GetCode())
+	}
+}
+
+func TestVaultServer_Keys(t *testing.T) {
+	server := NewVaultServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	management := keymanagement.KmsManagementClient{BaseClient: server.BaseClient()}
+	crypto := keymanagement.KmsCryptoClient{BaseClient: server.BaseClient()}
+
+	created, err := management.CreateKey(ctx, keymanagement.CreateKeyRequest{CreateKeyDetails: keymanagement.CreateKeyDetails{
+		CompartmentId: common.String("compartment1"),
+		DisplayName:   common.String("key1"),
+		KeyShape:      &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(32)},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanagement.KeyLifecycleStateEnabled, created.LifecycleState)
+	assert.Equal(t, server.VaultId, *created.VaultId)
+	keyID := created.Id
+
+	encrypted, err := crypto.Encrypt(ctx, keymanagement.EncryptRequest{EncryptDataDetails: keymanagement.EncryptDataDetails{
+		KeyId:          keyID,
+		Plaintext:      encode("hello world"),
+		AssociatedData: map[string]string{"purpose": "test"},
+	}})
+	assert.NoError(t, err)
+
+	// a new version is current, the ciphertexts of the previous ones still decrypt
+	version, err := management.CreateKeyVersion(ctx, keymanagement.CreateKeyVersionRequest{KeyId: keyID})
+	assert.NoError(t, err)
+	key, err := management.GetKey(ctx, keymanagement.GetKeyRequest{KeyId: keyID})
+	assert.NoError(t, err)
+	assert.Equal(t, *version.Id, *key.CurrentKeyVersion)
+
+	decrypted, err := crypto.Decrypt(ctx, keymanagement.DecryptRequest{DecryptDataDetails: keymanagement.DecryptDataDetails{
+		KeyId:          keyID,
+		Ciphertext:     encrypted.Ciphertext,
+		AssociatedData: map[string]string{"purpose": "test"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", decode(t, decrypted.Plaintext))
+
+	_, err = crypto.Decrypt(ctx, keymanagement.DecryptRequest{DecryptDataDetails: keymanagement.DecryptDataDetails{
+		KeyId:      keyID,
+		Ciphertext: encrypted.Ciphertext,
+	}})
+	assertServiceError(t, err, http.StatusBadRequest, "InvalidParameter")
+
+	generated, err := crypto.GenerateDataEncryptionKey(ctx, keymanagement.GenerateDataEncryptionKeyRequest{GenerateKeyDetails: keymanagement.GenerateKeyDetails{
+		IncludePlaintextKey: common.Bool(true),
+		KeyId:               keyID,
+		KeyShape:            &keymanagement.KeyShape{Algorithm: keymanagement.KeyShapeAlgorithmAes, Length: common.Int(16)},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, decode(t, generated.Plaintext), 16)
+	unwrapped, err := crypto.Decrypt(ctx, keymanagement.DecryptRequest{DecryptDataDetails: keymanagement.DecryptDataDetails{
+		KeyId:      keyID,
+		Ciphertext: generated.Ciphertext,
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, *generated.Plaintext, *unwrapped.Plaintext)
+
+	versions, err := management.ListKeyVersions(ctx, keymanagement.ListKeyVersionsRequest{KeyId: keyID, Limit: common.Int(1)})
+	assert.NoError(t, err)
+	assert.Len(t, versions.Items, 1)
+	assert.NotNil(t, versions.OpcNextPage)
+
+	// a disabled key can not be used
+	_, err = management.DisableKey(ctx, keymanagement.DisableKeyRequest{KeyId: keyID})
+	assert.NoError(t, err)
+	_, err = crypto.Encrypt(ctx, keymanagement.EncryptRequest{EncryptDataDetails: keymanagement.EncryptDataDetails{KeyId: keyID, Plaintext: encode("data")}})
+	assertServiceError(t, err, http.StatusConflict, "IncorrectState")
+
+	keys, err := management.ListKeys(ctx, keymanagement.ListKeysRequest{CompartmentId: common.String("compartment1")})
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 1)
+	assert.Equal(t, keymanagement.KeySummaryLifecycleStateDisabled, keys.Items[0].LifecycleState)
+
+	_, err = management.GetKey(ctx, keymanagement.GetKeyRequest{KeyId: common.String("unknown")})
+	assertServiceError(t, err, http.StatusNotFound, "NotAuthorizedOrNotFound")
+}
+
+func TestVaultServer_Secrets(t *testing.T) {
+	server := NewVaultServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	vaults := vault.VaultsClient{BaseClient: server.BaseClient()}
+	vaults.BasePath = "20180608"
+	bundles := secrets.SecretsClient{BaseClient: server.BaseClient()}
+	bundles.BasePath = "20190301"
+
+	created, err := vaults.CreateSecret(ctx, vault.CreateSecretRequest{CreateSecretDetails: vault.CreateSecretDetails{
+		CompartmentId: common.String("compartment1"),
+		SecretName:    common.String("db-password"),
+		VaultId:       common.String(server.VaultId),
+		SecretContent: vault.Base64SecretContentDetails{Content: encode("password1"), Name: common.String("v1")},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), *created.CurrentVersionNumber)
+	secretID := created.Id
+
+	_, err = vaults.CreateSecret(ctx, vault.CreateSecretRequest{CreateSecretDetails: vault.CreateSecretDetails{
+		CompartmentId: common.String("compartment1"),
+		SecretName:    common.String("db-password"),
+		VaultId:       common.String(server.VaultId),
+		SecretContent: vault.Base64SecretContentDetails{Content: encode("password1")},
+	}})
+	assertServiceError(t, err, http.StatusConflict, "Conflict")
+
+	// a pending version is not current until promoted
+	_, err = vaults.UpdateSecret(ctx, vault.UpdateSecretRequest{SecretId: secretID, UpdateSecretDetails: vault.UpdateSecretDetails{
+		SecretContent: vault.Base64SecretContentDetails{Content: encode("password2"), Stage: vault.SecretContentDetailsStagePending},
+	}})
+	assert.NoError(t, err)
+
+	current, err := bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: secretID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), *current.VersionNumber)
+	assert.Equal(t, "password1", decode(t, current.SecretBundleContent.(secrets.Base64SecretBundleContentDetails).Content))
+
+	pending, err := bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: secretID, Stage: secrets.GetSecretBundleStagePending})
+	assert.NoError(t, err)
+	assert.Equal(t, []secrets.SecretBundleStagesEnum{secrets.SecretBundleStagesPending, secrets.SecretBundleStagesLatest}, pending.Stages)
+
+	_, err = vaults.UpdateSecret(ctx, vault.UpdateSecretRequest{SecretId: secretID, UpdateSecretDetails: vault.UpdateSecretDetails{
+		CurrentVersionNumber: common.Int64(2),
+	}})
+	assert.NoError(t, err)
+
+	current, err = bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: secretID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), *current.VersionNumber)
+	assert.Equal(t, []secrets.SecretBundleStagesEnum{secrets.SecretBundleStagesCurrent, secrets.SecretBundleStagesLatest}, current.Stages)
+
+	previous, err := bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: secretID, SecretVersionName: common.String("v1")})
+	assert.NoError(t, err)
+	assert.Equal(t, []secrets.SecretBundleStagesEnum{secrets.SecretBundleStagesPrevious}, previous.Stages)
+
+	// a third version deprecates the first
+	_, err = vaults.UpdateSecret(ctx, vault.UpdateSecretRequest{SecretId: secretID, UpdateSecretDetails: vault.UpdateSecretDetails{
+		SecretContent: vault.Base64SecretContentDetails{Content: encode("password3")},
+	}})
+	assert.NoError(t, err)
+	versions, err := vaults.ListSecretVersions(ctx, vault.ListSecretVersionsRequest{SecretId: secretID})
+	assert.NoError(t, err)
+	assert.Len(t, versions.Items, 3)
+	assert.Equal(t, []vault.SecretVersionSummaryStagesEnum{vault.SecretVersionSummaryStagesDeprecated}, versions.Items[0].Stages)
+	assert.Equal(t, []vault.SecretVersionSummaryStagesEnum{vault.SecretVersionSummaryStagesPrevious}, versions.Items[1].Stages)
+
+	list, err := vaults.ListSecrets(ctx, vault.ListSecretsRequest{CompartmentId: common.String("compartment1"), Name: common.String("db-password")})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, *secretID, *list.Items[0].Id)
+
+	_, err = vaults.ScheduleSecretDeletion(ctx, vault.ScheduleSecretDeletionRequest{SecretId: secretID})
+	assert.NoError(t, err)
+	_, err = bundles.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{SecretId: secretID})
+	assertServiceError(t, err, http.StatusConflict, "IncorrectState")
+}
@@ -106,10 +106,12 @@ func (client EsxiHostClient) CreateEsxiHost(ctx context.Context, request CreateE
 				response = CreateEsxiHostResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateEsxiHostResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateEsxiHostResponse")
 	}
@@ -0,0 +1,51 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package ocvp
+
+import (
+	"context"
+)
+
+// EsxiHostClientAPI is the interface of the operations of EsxiHostClient, to inject the client and mock it in tests
+type EsxiHostClientAPI interface {
+	// CreateEsxiHost Adds another ESXi host to an existing SDDC. The attributes of the specified
+	// `Sddc` determine the VMware software and other configuration settings used
+	// by the ESXi host.
+	// Use the WorkRequest operations to track the
+	// creation of the ESXi host.
+	CreateEsxiHost(ctx context.Context, request CreateEsxiHostRequest) (response CreateEsxiHostResponse, err error)
+
+	// DeleteEsxiHost Deletes the specified ESXi host. Before deleting the host, back up or
+	// migrate any VMware workloads running on it.
+	// When you delete an ESXi host, Oracle does not remove the node
+	// configuration within the VMware environment itself. That is
+	// your responsibility.
+	// **Note:** If you delete EXSi hosts from the SDDC to total less than 3,
+	// you are still billed for the 3 minimum recommended EXSi hosts. Also,
+	// you cannot add more VMware workloads to the SDDC until it again has at
+	// least 3 ESXi hosts.
+	// Use the WorkRequest operations to track the
+	// deletion of the ESXi host.
+	DeleteEsxiHost(ctx context.Context, request DeleteEsxiHostRequest) (response DeleteEsxiHostResponse, err error)
+
+	// GetEsxiHost Gets the specified ESXi host's information.
+	GetEsxiHost(ctx context.Context, request GetEsxiHostRequest) (response GetEsxiHostResponse, err error)
+
+	// ListEsxiHosts Lists the ESXi hosts in the specified SDDC. The list can be filtered
+	// by Compute instance OCID or ESXi display name.
+	// Remember that in terms of implementation, an ESXi host is a Compute instance that
+	// is configured with the chosen bundle of VMware software. Each `EsxiHost`
+	// object has its own OCID (`id`), and a separate attribute for the OCID of
+	// the Compute instance (`computeInstanceId`). When filtering the list of
+	// ESXi hosts, you can specify the OCID of the Compute instance, not the
+	// ESXi host OCID.
+	ListEsxiHosts(ctx context.Context, request ListEsxiHostsRequest) (response ListEsxiHostsResponse, err error)
+
+	// UpdateEsxiHost Updates the specified ESXi host.
+	UpdateEsxiHost(ctx context.Context, request UpdateEsxiHostRequest) (response UpdateEsxiHostResponse, err error)
+}
+
+// EsxiHostClient implements EsxiHostClientAPI
+var _ EsxiHostClientAPI = EsxiHostClient{}
@@ -104,10 +104,12 @@ func (client SddcClient) ChangeSddcCompartment(ctx context.Context, request Chan
 				response = ChangeSddcCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSddcCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSddcCompartmentResponse")
 	}
@@ -161,10 +163,12 @@ func (client SddcClient) CreateSddc(ctx context.Context, request CreateSddcReque
 				response = CreateSddcResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSddcResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSddcResponse")
 	}
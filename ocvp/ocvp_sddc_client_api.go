@@ -0,0 +1,50 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package ocvp
+
+import (
+	"context"
+)
+
+// SddcClientAPI is the interface of the operations of SddcClient, to inject the client and mock it in tests
+type SddcClientAPI interface {
+	// ChangeSddcCompartment Moves an SDDC into a different compartment within the same tenancy. For information
+	// about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeSddcCompartment(ctx context.Context, request ChangeSddcCompartmentRequest) (response ChangeSddcCompartmentResponse, err error)
+
+	// CreateSddc Creates a software-defined data center (SDDC).
+	// Use the WorkRequest operations to track the
+	// creation of the SDDC.
+	CreateSddc(ctx context.Context, request CreateSddcRequest) (response CreateSddcResponse, err error)
+
+	// DeleteSddc Deletes the specified SDDC, along with the other resources that were
+	// created with the SDDC. For example: the Compute instances, DNS records,
+	// and so on.
+	// Use the WorkRequest operations to track the
+	// deletion of the SDDC.
+	DeleteSddc(ctx context.Context, request DeleteSddcRequest) (response DeleteSddcResponse, err error)
+
+	// GetSddc Gets the specified SDDC's information.
+	GetSddc(ctx context.Context, request GetSddcRequest) (response GetSddcResponse, err error)
+
+	// ListSddcs Lists the SDDCs in the specified compartment. The list can be
+	// filtered by display name or availability domain.
+	ListSddcs(ctx context.Context, request ListSddcsRequest) (response ListSddcsResponse, err error)
+
+	// ListSupportedVmwareSoftwareVersions Lists the versions of bundled VMware software supported by the Oracle Cloud
+	// VMware Solution.
+	ListSupportedVmwareSoftwareVersions(ctx context.Context, request ListSupportedVmwareSoftwareVersionsRequest) (response ListSupportedVmwareSoftwareVersionsResponse, err error)
+
+	// UpdateSddc Updates the specified SDDC.
+	// **Important:** Updating an SDDC affects only certain attributes in the `Sddc`
+	// object and does not affect the VMware environment currently running in
+	// the SDDC. For more information, see
+	// UpdateSddcDetails.
+	UpdateSddc(ctx context.Context, request UpdateSddcRequest) (response UpdateSddcResponse, err error)
+}
+
+// SddcClient implements SddcClientAPI
+var _ SddcClientAPI = SddcClient{}
@@ -66,6 +66,9 @@ type CreateOdaInstanceResponse struct {
 	// Unique Oracle-assigned identifier for the request. If you contact Oracle
 	// about this request, provide this request ID.
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateOdaInstanceResponse) String() string {
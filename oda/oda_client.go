@@ -103,10 +103,12 @@ func (client OdaClient) ChangeOdaInstanceCompartment(ctx context.Context, reques
 				response = ChangeOdaInstanceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeOdaInstanceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeOdaInstanceCompartmentResponse")
 	}
@@ -160,10 +162,12 @@ func (client OdaClient) CreateOdaInstance(ctx context.Context, request CreateOda
 				response = CreateOdaInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateOdaInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateOdaInstanceResponse")
 	}
@@ -582,10 +586,12 @@ func (client OdaClient) StartOdaInstance(ctx context.Context, request StartOdaIn
 				response = StartOdaInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StartOdaInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StartOdaInstanceResponse")
 	}
@@ -639,10 +645,12 @@ func (client OdaClient) StopOdaInstance(ctx context.Context, request StopOdaInst
 				response = StopOdaInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(StopOdaInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into StopOdaInstanceResponse")
 	}
@@ -0,0 +1,73 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package oda
+
+import (
+	"context"
+)
+
+// OdaClientAPI is the interface of the operations of OdaClient, to inject the client and mock it in tests
+type OdaClientAPI interface {
+	// ChangeOdaInstanceCompartment Moves an Digital Assistant instance into a different compartment. When provided, If-Match is checked against
+	// ETag values of the resource.
+	ChangeOdaInstanceCompartment(ctx context.Context, request ChangeOdaInstanceCompartmentRequest) (response ChangeOdaInstanceCompartmentResponse, err error)
+
+	// CreateOdaInstance Starts an asynchronous job to create a Digital Assistant instance.
+	// To monitor the status of the job, take the `opc-work-request-id` response
+	// header value and use it to call `GET /workRequests/{workRequestID}`.
+	CreateOdaInstance(ctx context.Context, request CreateOdaInstanceRequest) (response CreateOdaInstanceResponse, err error)
+
+	// DeleteOdaInstance Starts an asynchronous job to delete the specified Digital Assistant instance.
+	// To monitor the status of the job, take the `opc-work-request-id` response header value and use it to call `GET /workRequests/{workRequestID}`.
+	DeleteOdaInstance(ctx context.Context, request DeleteOdaInstanceRequest) (response DeleteOdaInstanceResponse, err error)
+
+	// GetOdaInstance Gets the specified Digital Assistant instance.
+	GetOdaInstance(ctx context.Context, request GetOdaInstanceRequest) (response GetOdaInstanceResponse, err error)
+
+	// GetWorkRequest Gets information about the work request with the specified ID, including its status.
+	// You can use this operation to monitor the status of jobs that you
+	// requested to create, delete, and update instances.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListOdaInstances Returns a page of Digital Assistant instances that belong to the specified
+	// compartment.
+	// If the `opc-next-page` header appears in the response, then
+	// there are more items to retrieve. To get the next page in the subsequent
+	// GET request, include the header's value as the `page` query parameter.
+	ListOdaInstances(ctx context.Context, request ListOdaInstancesRequest) (response ListOdaInstancesResponse, err error)
+
+	// ListWorkRequestErrors Returns a page of errors for the specified work request.
+	// If the `opc-next-page` header appears in the response, then
+	// there are more items to retrieve. To get the next page in the subsequent
+	// GET request, include the header's value as the `page` query parameter.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Returns a page of of log messages for a given work request.
+	// If the `opc-next-page` header appears in the response, then
+	// there are more items to retrieve. To get the next page in the subsequent
+	// GET request, include the header's value as the `page` query parameter.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Returns a page of work requests for the specified compartment.
+	// If the `opc-next-page` header appears in the response, then
+	// there are more items to retrieve. To get the next page in the subsequent
+	// GET request, include the header's value as the `page` query parameter.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// StartOdaInstance Starts an inactive Digital Assistant instance. Once active, the instance will be accessible and metering
+	// of requests will be started again.
+	StartOdaInstance(ctx context.Context, request StartOdaInstanceRequest) (response StartOdaInstanceResponse, err error)
+
+	// StopOdaInstance Stops an active Digital Assistant instance. Once inactive, the instance will not be accessible and metering
+	// of requests will be stopped until the instance is started again. Data associated with the instance
+	// is not affected.
+	StopOdaInstance(ctx context.Context, request StopOdaInstanceRequest) (response StopOdaInstanceResponse, err error)
+
+	// UpdateOdaInstance Updates the specified Digital Assistant instance with the information in the request body.
+	UpdateOdaInstance(ctx context.Context, request UpdateOdaInstanceRequest) (response UpdateOdaInstanceResponse, err error)
+}
+
+// OdaClient implements OdaClientAPI
+var _ OdaClientAPI = OdaClient{}
@@ -106,10 +106,12 @@ func (client NotificationControlPlaneClient) ChangeTopicCompartment(ctx context.
 				response = ChangeTopicCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeTopicCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeTopicCompartmentResponse")
 	}
@@ -170,10 +172,12 @@ func (client NotificationControlPlaneClient) CreateTopic(ctx context.Context, re
 				response = CreateTopicResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateTopicResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateTopicResponse")
 	}
@@ -0,0 +1,48 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package ons
+
+import (
+	"context"
+)
+
+// NotificationControlPlaneClientAPI is the interface of the operations of NotificationControlPlaneClient, to inject the client and mock it in tests
+type NotificationControlPlaneClientAPI interface {
+	// ChangeTopicCompartment Moves a topic into a different compartment within the same tenancy. For information about moving resources
+	// between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	ChangeTopicCompartment(ctx context.Context, request ChangeTopicCompartmentRequest) (response ChangeTopicCompartmentResponse, err error)
+
+	// CreateTopic Creates a topic in the specified compartment. For general information about topics, see
+	// Managing Topics and Subscriptions (https://docs.cloud.oracle.com/iaas/Content/Notification/Tasks/managingtopicsandsubscriptions.htm).
+	// For the purposes of access control, you must provide the OCID of the compartment where you want the topic to reside.
+	// For information about access control and compartments, see Overview of the IAM Service (https://docs.cloud.oracle.com/Content/Identity/Concepts/overview.htm).
+	// You must specify a display name for the topic.
+	// All Oracle Cloud Infrastructure resources, including topics, get an Oracle-assigned, unique ID called an
+	// Oracle Cloud Identifier (OCID). When you create a resource, you can find its OCID in the response. You can also
+	// retrieve a resource's OCID by using a List API operation on that resource type, or by viewing the resource in the
+	// Console. For more information, see Resource Identifiers (https://docs.cloud.oracle.com/Content/General/Concepts/identifiers.htm).
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	CreateTopic(ctx context.Context, request CreateTopicRequest) (response CreateTopicResponse, err error)
+
+	// DeleteTopic Deletes the specified topic.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	DeleteTopic(ctx context.Context, request DeleteTopicRequest) (response DeleteTopicResponse, err error)
+
+	// GetTopic Gets the specified topic's configuration information.
+	GetTopic(ctx context.Context, request GetTopicRequest) (response GetTopicResponse, err error)
+
+	// ListTopics Lists topics in the specified compartment.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 120.
+	ListTopics(ctx context.Context, request ListTopicsRequest) (response ListTopicsResponse, err error)
+
+	// UpdateTopic Updates the specified topic's configuration.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	UpdateTopic(ctx context.Context, request UpdateTopicRequest) (response UpdateTopicResponse, err error)
+}
+
+// NotificationControlPlaneClient implements NotificationControlPlaneClientAPI
+var _ NotificationControlPlaneClientAPI = NotificationControlPlaneClient{}
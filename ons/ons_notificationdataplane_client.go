@@ -106,10 +106,12 @@ func (client NotificationDataPlaneClient) ChangeSubscriptionCompartment(ctx cont
 				response = ChangeSubscriptionCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSubscriptionCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSubscriptionCompartmentResponse")
 	}
@@ -164,10 +166,12 @@ func (client NotificationDataPlaneClient) CreateSubscription(ctx context.Context
 				response = CreateSubscriptionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSubscriptionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSubscriptionResponse")
 	}
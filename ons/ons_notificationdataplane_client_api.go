@@ -0,0 +1,68 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package ons
+
+import (
+	"context"
+)
+
+// NotificationDataPlaneClientAPI is the interface of the operations of NotificationDataPlaneClient, to inject the client and mock it in tests
+type NotificationDataPlaneClientAPI interface {
+	// ChangeSubscriptionCompartment Moves a subscription into a different compartment within the same tenancy. For information about moving
+	// resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	ChangeSubscriptionCompartment(ctx context.Context, request ChangeSubscriptionCompartmentRequest) (response ChangeSubscriptionCompartmentResponse, err error)
+
+	// CreateSubscription Creates a subscription for the specified topic and sends a subscription confirmation URL to the endpoint. The subscription remains in "Pending" status until it has been confirmed.
+	// For information about confirming subscriptions, see
+	// To confirm a subscription (https://docs.cloud.oracle.com/iaas/Content/Notification/Tasks/managingtopicsandsubscriptions.htm#confirmSub).
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequest) (response CreateSubscriptionResponse, err error)
+
+	// DeleteSubscription Deletes the specified subscription.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequest) (response DeleteSubscriptionResponse, err error)
+
+	// GetConfirmSubscription Gets the confirmation details for the specified subscription.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	GetConfirmSubscription(ctx context.Context, request GetConfirmSubscriptionRequest) (response GetConfirmSubscriptionResponse, err error)
+
+	// GetSubscription Gets the specified subscription's configuration information.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	GetSubscription(ctx context.Context, request GetSubscriptionRequest) (response GetSubscriptionResponse, err error)
+
+	// GetUnsubscription Gets the unsubscription details for the specified subscription.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	GetUnsubscription(ctx context.Context, request GetUnsubscriptionRequest) (response GetUnsubscriptionResponse, err error)
+
+	// ListSubscriptions Lists the subscriptions in the specified compartment or topic.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	ListSubscriptions(ctx context.Context, request ListSubscriptionsRequest) (response ListSubscriptionsResponse, err error)
+
+	// PublishMessage Publishes a message to the specified topic.
+	// The topic endpoint is required for this operation.
+	// To get the topic endpoint, use GetTopic
+	// and review the `apiEndpoint` value in the response (NotificationTopic).
+	// Limits information follows.
+	// Message size limit per request: 64KB.
+	// Message delivery rate limit per endpoint: 60 messages per minute for HTTP-based protocols, 10 messages per minute for the `EMAIL` protocol.
+	// HTTP-based protocols use URL endpoints that begin with "http:" or "https:".
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60 per topic.
+	// For more information about publishing messages, see Publishing Messages (https://docs.cloud.oracle.com/iaas/Content/Notification/Tasks/publishingmessages.htm).
+	// For steps to request a limit increase, see Requesting a Service Limit Increase (https://docs.cloud.oracle.com/iaas/Content/General/Concepts/servicelimits.htm#three).
+	PublishMessage(ctx context.Context, request PublishMessageRequest) (response PublishMessageResponse, err error)
+
+	// ResendSubscriptionConfirmation Resends the confirmation details for the specified subscription.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	ResendSubscriptionConfirmation(ctx context.Context, request ResendSubscriptionConfirmationRequest) (response ResendSubscriptionConfirmationResponse, err error)
+
+	// UpdateSubscription Updates the specified subscription's configuration.
+	// Transactions Per Minute (TPM) per-tenancy limit for this operation: 60.
+	UpdateSubscription(ctx context.Context, request UpdateSubscriptionRequest) (response UpdateSubscriptionResponse, err error)
+}
+
+// NotificationDataPlaneClient implements NotificationDataPlaneClientAPI
+var _ NotificationDataPlaneClientAPI = NotificationDataPlaneClient{}
@@ -104,10 +104,12 @@ func (client OperationsInsightsClient) IngestSqlBucket(ctx context.Context, requ
 				response = IngestSqlBucketResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(IngestSqlBucketResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into IngestSqlBucketResponse")
 	}
@@ -159,10 +161,12 @@ func (client OperationsInsightsClient) IngestSqlPlanLines(ctx context.Context, r
 				response = IngestSqlPlanLinesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(IngestSqlPlanLinesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into IngestSqlPlanLinesResponse")
 	}
@@ -215,10 +219,12 @@ func (client OperationsInsightsClient) IngestSqlText(ctx context.Context, reques
 				response = IngestSqlTextResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(IngestSqlTextResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into IngestSqlTextResponse")
 	}
@@ -0,0 +1,183 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package opsi
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetListDatabaseInsightsDatabaseTypeEnumValues(),
+		GetListDatabaseInsightsFieldsEnumValues(),
+		GetListDatabaseInsightsSortByEnumValues(),
+		GetListDatabaseInsightsSortOrderEnumValues(),
+		GetSortOrderEnumValues(),
+		GetSummarizeDatabaseInsightResourceCapacityTrendAggregationCollectionResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceCapacityTrendDatabaseTypeEnumValues(),
+		GetSummarizeDatabaseInsightResourceCapacityTrendSortByEnumValues(),
+		GetSummarizeDatabaseInsightResourceCapacityTrendSortOrderEnumValues(),
+		GetSummarizeDatabaseInsightResourceCapacityTrendUtilizationLevelEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendAggregationPatternEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendAggregationResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendDatabaseTypeEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendForecastModelEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendStatisticEnumValues(),
+		GetSummarizeDatabaseInsightResourceForecastTrendUtilizationLevelEnumValues(),
+		GetSummarizeDatabaseInsightResourceStatisticsAggregationCollectionResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceStatisticsDatabaseTypeEnumValues(),
+		GetSummarizeDatabaseInsightResourceStatisticsSortByEnumValues(),
+		GetSummarizeDatabaseInsightResourceStatisticsSortOrderEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageAggregationResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageDatabaseTypeEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageTrendAggregationCollectionResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageTrendDatabaseTypeEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageTrendSortByEnumValues(),
+		GetSummarizeDatabaseInsightResourceUsageTrendSortOrderEnumValues(),
+		GetSummarizeDatabaseInsightResourceUtilizationInsightAggregationResourceMetricEnumValues(),
+		GetSummarizeDatabaseInsightResourceUtilizationInsightDatabaseTypeEnumValues(),
+		GetSummarizeSqlInsightsDatabaseTypeEnumValues(),
+		GetSummarizeSqlStatisticsCategoryEnumValues(),
+		GetSummarizeSqlStatisticsDatabaseTypeEnumValues(),
+		GetSummarizeSqlStatisticsSortByEnumValues(),
+		GetSummarizeSqlStatisticsSortOrderEnumValues(),
+		GetUsageUnitEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request IngestSqlBucketRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request IngestSqlPlanLinesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request IngestSqlTextRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListDatabaseInsightsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSqlPlansRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSqlSearchesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSqlTextsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceCapacityTrendRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceForecastTrendRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceStatisticsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceUsageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceUsageTrendRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeDatabaseInsightResourceUtilizationInsightRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlInsightsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlPlanInsightsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlResponseTimeDistributionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlStatisticsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlStatisticsTimeSeriesByPlanRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SummarizeSqlStatisticsTimeSeriesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DatabaseDetails, see common.ValidateStruct
+func (m DatabaseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlBucketDetails, see common.ValidateStruct
+func (m IngestSqlBucketDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlBucketResponseDetails, see common.ValidateStruct
+func (m IngestSqlBucketResponseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlPlanLinesDetails, see common.ValidateStruct
+func (m IngestSqlPlanLinesDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlPlanLinesResponseDetails, see common.ValidateStruct
+func (m IngestSqlPlanLinesResponseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlTextDetails, see common.ValidateStruct
+func (m IngestSqlTextDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of IngestSqlTextResponseDetails, see common.ValidateStruct
+func (m IngestSqlTextResponseDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
@@ -63,6 +63,9 @@ type CreateScheduledJobResponse struct {
 
 	// For optimistic concurrency control. See if-match.
 	Etag *string `presentIn:"header" name:"etag"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response CreateScheduledJobResponse) String() string {
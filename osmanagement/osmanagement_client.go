@@ -155,10 +155,12 @@ func (client OsManagementClient) AttachChildSoftwareSourceToManagedInstance(ctx
 				response = AttachChildSoftwareSourceToManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachChildSoftwareSourceToManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachChildSoftwareSourceToManagedInstanceResponse")
 	}
@@ -213,10 +215,12 @@ func (client OsManagementClient) AttachManagedInstanceToManagedInstanceGroup(ctx
 				response = AttachManagedInstanceToManagedInstanceGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachManagedInstanceToManagedInstanceGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachManagedInstanceToManagedInstanceGroupResponse")
 	}
@@ -271,10 +275,12 @@ func (client OsManagementClient) AttachParentSoftwareSourceToManagedInstance(ctx
 				response = AttachParentSoftwareSourceToManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(AttachParentSoftwareSourceToManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into AttachParentSoftwareSourceToManagedInstanceResponse")
 	}
@@ -327,10 +333,12 @@ func (client OsManagementClient) ChangeManagedInstanceGroupCompartment(ctx conte
 				response = ChangeManagedInstanceGroupCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeManagedInstanceGroupCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeManagedInstanceGroupCompartmentResponse")
 	}
@@ -383,10 +391,12 @@ func (client OsManagementClient) ChangeScheduledJobCompartment(ctx context.Conte
 				response = ChangeScheduledJobCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeScheduledJobCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeScheduledJobCompartmentResponse")
 	}
@@ -439,10 +449,12 @@ func (client OsManagementClient) ChangeSoftwareSourceCompartment(ctx context.Con
 				response = ChangeSoftwareSourceCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSoftwareSourceCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSoftwareSourceCompartmentResponse")
 	}
@@ -496,10 +508,12 @@ func (client OsManagementClient) CreateManagedInstanceGroup(ctx context.Context,
 				response = CreateManagedInstanceGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateManagedInstanceGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateManagedInstanceGroupResponse")
 	}
@@ -554,10 +568,12 @@ func (client OsManagementClient) CreateScheduledJob(ctx context.Context, request
 				response = CreateScheduledJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateScheduledJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateScheduledJobResponse")
 	}
@@ -611,10 +627,12 @@ func (client OsManagementClient) CreateSoftwareSource(ctx context.Context, reque
 				response = CreateSoftwareSourceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSoftwareSourceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSoftwareSourceResponse")
 	}
@@ -817,10 +835,12 @@ func (client OsManagementClient) DetachChildSoftwareSourceFromManagedInstance(ct
 				response = DetachChildSoftwareSourceFromManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DetachChildSoftwareSourceFromManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DetachChildSoftwareSourceFromManagedInstanceResponse")
 	}
@@ -872,10 +892,12 @@ func (client OsManagementClient) DetachManagedInstanceFromManagedInstanceGroup(c
 				response = DetachManagedInstanceFromManagedInstanceGroupResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DetachManagedInstanceFromManagedInstanceGroupResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DetachManagedInstanceFromManagedInstanceGroupResponse")
 	}
@@ -928,10 +950,12 @@ func (client OsManagementClient) DetachParentSoftwareSourceFromManagedInstance(c
 				response = DetachParentSoftwareSourceFromManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DetachParentSoftwareSourceFromManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DetachParentSoftwareSourceFromManagedInstanceResponse")
 	}
@@ -1383,10 +1407,12 @@ func (client OsManagementClient) InstallAllPackageUpdatesOnManagedInstance(ctx c
 				response = InstallAllPackageUpdatesOnManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstallAllPackageUpdatesOnManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstallAllPackageUpdatesOnManagedInstanceResponse")
 	}
@@ -1438,10 +1464,12 @@ func (client OsManagementClient) InstallAllWindowsUpdatesOnManagedInstance(ctx c
 				response = InstallAllWindowsUpdatesOnManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstallAllWindowsUpdatesOnManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstallAllWindowsUpdatesOnManagedInstanceResponse")
 	}
@@ -1493,10 +1521,12 @@ func (client OsManagementClient) InstallPackageOnManagedInstance(ctx context.Con
 				response = InstallPackageOnManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstallPackageOnManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstallPackageOnManagedInstanceResponse")
 	}
@@ -1548,10 +1578,12 @@ func (client OsManagementClient) InstallPackageUpdateOnManagedInstance(ctx conte
 				response = InstallPackageUpdateOnManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstallPackageUpdateOnManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstallPackageUpdateOnManagedInstanceResponse")
 	}
@@ -1603,10 +1635,12 @@ func (client OsManagementClient) InstallWindowsUpdateOnManagedInstance(ctx conte
 				response = InstallWindowsUpdateOnManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(InstallWindowsUpdateOnManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into InstallWindowsUpdateOnManagedInstanceResponse")
 	}
@@ -2458,10 +2492,12 @@ func (client OsManagementClient) RemovePackageFromManagedInstance(ctx context.Co
 				response = RemovePackageFromManagedInstanceResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RemovePackageFromManagedInstanceResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RemovePackageFromManagedInstanceResponse")
 	}
@@ -2564,10 +2600,12 @@ func (client OsManagementClient) RunScheduledJobNow(ctx context.Context, request
 				response = RunScheduledJobNowResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(RunScheduledJobNowResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into RunScheduledJobNowResponse")
 	}
@@ -2671,10 +2709,12 @@ func (client OsManagementClient) SkipNextScheduledJobExecution(ctx context.Conte
 				response = SkipNextScheduledJobExecutionResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(SkipNextScheduledJobExecutionResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into SkipNextScheduledJobExecutionResponse")
 	}
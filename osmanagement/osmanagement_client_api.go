@@ -0,0 +1,197 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package osmanagement
+
+import (
+	"context"
+)
+
+// OsManagementClientAPI is the interface of the operations of OsManagementClient, to inject the client and mock it in tests
+type OsManagementClientAPI interface {
+	// AddPackagesToSoftwareSource Adds a given list of Software Packages to a specific Software Source.
+	AddPackagesToSoftwareSource(ctx context.Context, request AddPackagesToSoftwareSourceRequest) (response AddPackagesToSoftwareSourceResponse, err error)
+
+	// AttachChildSoftwareSourceToManagedInstance Adds a child software source to a managed instance. After the software
+	// source has been added, then packages from that software source can be
+	// installed on the managed instance.
+	AttachChildSoftwareSourceToManagedInstance(ctx context.Context, request AttachChildSoftwareSourceToManagedInstanceRequest) (response AttachChildSoftwareSourceToManagedInstanceResponse, err error)
+
+	// AttachManagedInstanceToManagedInstanceGroup Adds a Managed Instance to a Managed Instance Group. After the Managed
+	// Instance has been added, then operations can be performed on the Managed
+	// Instance Group which will then apply to all Managed Instances in the
+	// group.
+	AttachManagedInstanceToManagedInstanceGroup(ctx context.Context, request AttachManagedInstanceToManagedInstanceGroupRequest) (response AttachManagedInstanceToManagedInstanceGroupResponse, err error)
+
+	// AttachParentSoftwareSourceToManagedInstance Adds a parent software source to a managed instance. After the software
+	// source has been added, then packages from that software source can be
+	// installed on the managed instance. Software sources that have this
+	// software source as a parent will be able to be added to this managed instance.
+	AttachParentSoftwareSourceToManagedInstance(ctx context.Context, request AttachParentSoftwareSourceToManagedInstanceRequest) (response AttachParentSoftwareSourceToManagedInstanceResponse, err error)
+
+	// ChangeManagedInstanceGroupCompartment Moves a resource into a different compartment. When provided, If-Match
+	// is checked against ETag values of the resource.
+	ChangeManagedInstanceGroupCompartment(ctx context.Context, request ChangeManagedInstanceGroupCompartmentRequest) (response ChangeManagedInstanceGroupCompartmentResponse, err error)
+
+	// ChangeScheduledJobCompartment Moves a resource into a different compartment. When provided, If-Match
+	// is checked against ETag values of the resource.
+	ChangeScheduledJobCompartment(ctx context.Context, request ChangeScheduledJobCompartmentRequest) (response ChangeScheduledJobCompartmentResponse, err error)
+
+	// ChangeSoftwareSourceCompartment Moves a resource into a different compartment. When provided, If-Match
+	// is checked against ETag values of the resource.
+	ChangeSoftwareSourceCompartment(ctx context.Context, request ChangeSoftwareSourceCompartmentRequest) (response ChangeSoftwareSourceCompartmentResponse, err error)
+
+	// CreateManagedInstanceGroup Creates a new Managed Instance Group on the management system.
+	// This will not contain any managed instances after it is first created,
+	// and they must be added later.
+	CreateManagedInstanceGroup(ctx context.Context, request CreateManagedInstanceGroupRequest) (response CreateManagedInstanceGroupResponse, err error)
+
+	// CreateScheduledJob Creates a new Scheduled Job to perform a specific package operation on
+	// a set of managed instances or managed instance groups.  Can be created
+	// as a one-time execution in the future, or as a recurring execution
+	// that repeats on a defined interval.
+	CreateScheduledJob(ctx context.Context, request CreateScheduledJobRequest) (response CreateScheduledJobResponse, err error)
+
+	// CreateSoftwareSource Creates a new custom Software Source on the management system.
+	// This will not contain any packages after it is first created,
+	// and they must be added later.
+	CreateSoftwareSource(ctx context.Context, request CreateSoftwareSourceRequest) (response CreateSoftwareSourceResponse, err error)
+
+	// DeleteManagedInstanceGroup Deletes a Managed Instance Group from the management system
+	DeleteManagedInstanceGroup(ctx context.Context, request DeleteManagedInstanceGroupRequest) (response DeleteManagedInstanceGroupResponse, err error)
+
+	// DeleteScheduledJob Cancels an existing Scheduled Job on the management system
+	DeleteScheduledJob(ctx context.Context, request DeleteScheduledJobRequest) (response DeleteScheduledJobResponse, err error)
+
+	// DeleteSoftwareSource Deletes a custom Software Source on the management system
+	DeleteSoftwareSource(ctx context.Context, request DeleteSoftwareSourceRequest) (response DeleteSoftwareSourceResponse, err error)
+
+	// DetachChildSoftwareSourceFromManagedInstance Removes a child software source from a managed instance. Packages will no longer be able to be
+	// installed from these software sources.
+	DetachChildSoftwareSourceFromManagedInstance(ctx context.Context, request DetachChildSoftwareSourceFromManagedInstanceRequest) (response DetachChildSoftwareSourceFromManagedInstanceResponse, err error)
+
+	// DetachManagedInstanceFromManagedInstanceGroup Removes a Managed Instance from a Managed Instance Group.
+	DetachManagedInstanceFromManagedInstanceGroup(ctx context.Context, request DetachManagedInstanceFromManagedInstanceGroupRequest) (response DetachManagedInstanceFromManagedInstanceGroupResponse, err error)
+
+	// DetachParentSoftwareSourceFromManagedInstance Removes a software source from a managed instance. All child software sources will also be removed
+	// from the managed instance. Packages will no longer be able to be installed from these software sources.
+	DetachParentSoftwareSourceFromManagedInstance(ctx context.Context, request DetachParentSoftwareSourceFromManagedInstanceRequest) (response DetachParentSoftwareSourceFromManagedInstanceResponse, err error)
+
+	// GetErratum Returns a specific erratum.
+	GetErratum(ctx context.Context, request GetErratumRequest) (response GetErratumResponse, err error)
+
+	// GetManagedInstance Returns a specific Managed Instance.
+	GetManagedInstance(ctx context.Context, request GetManagedInstanceRequest) (response GetManagedInstanceResponse, err error)
+
+	// GetManagedInstanceGroup Returns a specific Managed Instance Group.
+	GetManagedInstanceGroup(ctx context.Context, request GetManagedInstanceGroupRequest) (response GetManagedInstanceGroupResponse, err error)
+
+	// GetScheduledJob Gets the detailed information for the Scheduled Job with the given ID.
+	GetScheduledJob(ctx context.Context, request GetScheduledJobRequest) (response GetScheduledJobResponse, err error)
+
+	// GetSoftwarePackage Returns a specific Software Package.
+	GetSoftwarePackage(ctx context.Context, request GetSoftwarePackageRequest) (response GetSoftwarePackageResponse, err error)
+
+	// GetSoftwareSource Returns a specific Software Source.
+	GetSoftwareSource(ctx context.Context, request GetSoftwareSourceRequest) (response GetSoftwareSourceResponse, err error)
+
+	// GetWindowsUpdate Returns a Windows Update object.
+	GetWindowsUpdate(ctx context.Context, request GetWindowsUpdateRequest) (response GetWindowsUpdateResponse, err error)
+
+	// GetWorkRequest Gets the detailed information for the work request with the given ID.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// InstallAllPackageUpdatesOnManagedInstance Install all of the available package updates for the managed instance.
+	InstallAllPackageUpdatesOnManagedInstance(ctx context.Context, request InstallAllPackageUpdatesOnManagedInstanceRequest) (response InstallAllPackageUpdatesOnManagedInstanceResponse, err error)
+
+	// InstallAllWindowsUpdatesOnManagedInstance Install all of the available Windows updates for the managed instance.
+	InstallAllWindowsUpdatesOnManagedInstance(ctx context.Context, request InstallAllWindowsUpdatesOnManagedInstanceRequest) (response InstallAllWindowsUpdatesOnManagedInstanceResponse, err error)
+
+	// InstallPackageOnManagedInstance Installs a package on a managed instance.
+	InstallPackageOnManagedInstance(ctx context.Context, request InstallPackageOnManagedInstanceRequest) (response InstallPackageOnManagedInstanceResponse, err error)
+
+	// InstallPackageUpdateOnManagedInstance Updates a package on a managed instance.
+	InstallPackageUpdateOnManagedInstance(ctx context.Context, request InstallPackageUpdateOnManagedInstanceRequest) (response InstallPackageUpdateOnManagedInstanceResponse, err error)
+
+	// InstallWindowsUpdateOnManagedInstance Installs a Windows update on a managed instance.
+	InstallWindowsUpdateOnManagedInstance(ctx context.Context, request InstallWindowsUpdateOnManagedInstanceRequest) (response InstallWindowsUpdateOnManagedInstanceResponse, err error)
+
+	// ListAvailablePackagesForManagedInstance Returns a list of packages available for install on the Managed Instance.
+	ListAvailablePackagesForManagedInstance(ctx context.Context, request ListAvailablePackagesForManagedInstanceRequest) (response ListAvailablePackagesForManagedInstanceResponse, err error)
+
+	// ListAvailableSoftwareSourcesForManagedInstance Returns a list of available software sources for a Managed Instance.
+	ListAvailableSoftwareSourcesForManagedInstance(ctx context.Context, request ListAvailableSoftwareSourcesForManagedInstanceRequest) (response ListAvailableSoftwareSourcesForManagedInstanceResponse, err error)
+
+	// ListAvailableUpdatesForManagedInstance Returns a list of available updates for a Managed Instance.
+	ListAvailableUpdatesForManagedInstance(ctx context.Context, request ListAvailableUpdatesForManagedInstanceRequest) (response ListAvailableUpdatesForManagedInstanceResponse, err error)
+
+	// ListAvailableWindowsUpdatesForManagedInstance Returns a list of available Windows updates for a Managed Instance. This is only applicable to Windows instances.
+	ListAvailableWindowsUpdatesForManagedInstance(ctx context.Context, request ListAvailableWindowsUpdatesForManagedInstanceRequest) (response ListAvailableWindowsUpdatesForManagedInstanceResponse, err error)
+
+	// ListManagedInstanceGroups Returns a list of all Managed Instance Groups.
+	ListManagedInstanceGroups(ctx context.Context, request ListManagedInstanceGroupsRequest) (response ListManagedInstanceGroupsResponse, err error)
+
+	// ListManagedInstances Returns a list of all Managed Instances.
+	ListManagedInstances(ctx context.Context, request ListManagedInstancesRequest) (response ListManagedInstancesResponse, err error)
+
+	// ListPackagesInstalledOnManagedInstance Returns a list of installed packages on the Managed Instance.
+	ListPackagesInstalledOnManagedInstance(ctx context.Context, request ListPackagesInstalledOnManagedInstanceRequest) (response ListPackagesInstalledOnManagedInstanceResponse, err error)
+
+	// ListScheduledJobs Returns a list of all of the currently active Scheduled Jobs in the system
+	ListScheduledJobs(ctx context.Context, request ListScheduledJobsRequest) (response ListScheduledJobsResponse, err error)
+
+	// ListSoftwareSourcePackages Lists Software Packages in a Software Source
+	ListSoftwareSourcePackages(ctx context.Context, request ListSoftwareSourcePackagesRequest) (response ListSoftwareSourcePackagesResponse, err error)
+
+	// ListSoftwareSources Returns a list of all Software Sources.
+	ListSoftwareSources(ctx context.Context, request ListSoftwareSourcesRequest) (response ListSoftwareSourcesResponse, err error)
+
+	// ListUpcomingScheduledJobs Returns a list of all of the Scheduled Jobs whose next execution time is at or before the specified time.
+	ListUpcomingScheduledJobs(ctx context.Context, request ListUpcomingScheduledJobsRequest) (response ListUpcomingScheduledJobsResponse, err error)
+
+	// ListWindowsUpdates Returns a list of Windows Updates.
+	ListWindowsUpdates(ctx context.Context, request ListWindowsUpdatesRequest) (response ListWindowsUpdatesResponse, err error)
+
+	// ListWindowsUpdatesInstalledOnManagedInstance Returns a list of installed Windows updates for a Managed Instance. This is only applicable to Windows instances.
+	ListWindowsUpdatesInstalledOnManagedInstance(ctx context.Context, request ListWindowsUpdatesInstalledOnManagedInstanceRequest) (response ListWindowsUpdatesInstalledOnManagedInstanceResponse, err error)
+
+	// ListWorkRequestErrors Gets the errors for the work request with the given ID.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Lists the log entries for the work request with the given ID.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// RemovePackageFromManagedInstance Removes an installed package from a managed instance.
+	RemovePackageFromManagedInstance(ctx context.Context, request RemovePackageFromManagedInstanceRequest) (response RemovePackageFromManagedInstanceResponse, err error)
+
+	// RemovePackagesFromSoftwareSource Removes a given list of Software Packages from a specific Software Source.
+	RemovePackagesFromSoftwareSource(ctx context.Context, request RemovePackagesFromSoftwareSourceRequest) (response RemovePackagesFromSoftwareSourceResponse, err error)
+
+	// RunScheduledJobNow This will trigger an already created Scheduled Job to being executing
+	// immediately instead of waiting for its next regularly scheduled time.
+	RunScheduledJobNow(ctx context.Context, request RunScheduledJobNowRequest) (response RunScheduledJobNowResponse, err error)
+
+	// SearchSoftwarePackages Searches all of the available Software Sources and returns any/all Software Packages matching
+	// the search criteria.
+	SearchSoftwarePackages(ctx context.Context, request SearchSoftwarePackagesRequest) (response SearchSoftwarePackagesResponse, err error)
+
+	// SkipNextScheduledJobExecution This will force an already created Scheduled Job to skip its
+	// next regularly scheduled execution
+	SkipNextScheduledJobExecution(ctx context.Context, request SkipNextScheduledJobExecutionRequest) (response SkipNextScheduledJobExecutionResponse, err error)
+
+	// UpdateManagedInstanceGroup Updates a specific Managed Instance Group.
+	UpdateManagedInstanceGroup(ctx context.Context, request UpdateManagedInstanceGroupRequest) (response UpdateManagedInstanceGroupResponse, err error)
+
+	// UpdateScheduledJob Updates an existing Scheduled Job on the management system.
+	UpdateScheduledJob(ctx context.Context, request UpdateScheduledJobRequest) (response UpdateScheduledJobResponse, err error)
+
+	// UpdateSoftwareSource Updates an existing custom Software Source on the management system.
+	UpdateSoftwareSource(ctx context.Context, request UpdateSoftwareSourceRequest) (response UpdateSoftwareSourceResponse, err error)
+}
+
+// OsManagementClient implements OsManagementClientAPI
+var _ OsManagementClientAPI = OsManagementClient{}
@@ -0,0 +1,423 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package osmanagement
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetArchTypesEnumValues(),
+		GetChecksumTypesEnumValues(),
+		GetInstallationRequirementsEnumValues(),
+		GetIntervalTypesEnumValues(),
+		GetIsEligibleForInstallationEnumValues(),
+		GetLifecycleStatesEnumValues(),
+		GetListAvailablePackagesForManagedInstanceSortByEnumValues(),
+		GetListAvailablePackagesForManagedInstanceSortOrderEnumValues(),
+		GetListAvailableSoftwareSourcesForManagedInstanceSortByEnumValues(),
+		GetListAvailableSoftwareSourcesForManagedInstanceSortOrderEnumValues(),
+		GetListAvailableUpdatesForManagedInstanceSortByEnumValues(),
+		GetListAvailableUpdatesForManagedInstanceSortOrderEnumValues(),
+		GetListAvailableWindowsUpdatesForManagedInstanceIsEligibleForInstallationEnumValues(),
+		GetListAvailableWindowsUpdatesForManagedInstanceSortByEnumValues(),
+		GetListAvailableWindowsUpdatesForManagedInstanceSortOrderEnumValues(),
+		GetListManagedInstanceGroupsLifecycleStateEnumValues(),
+		GetListManagedInstanceGroupsOsFamilyEnumValues(),
+		GetListManagedInstanceGroupsSortByEnumValues(),
+		GetListManagedInstanceGroupsSortOrderEnumValues(),
+		GetListManagedInstancesOsFamilyEnumValues(),
+		GetListManagedInstancesSortByEnumValues(),
+		GetListManagedInstancesSortOrderEnumValues(),
+		GetListPackagesInstalledOnManagedInstanceSortByEnumValues(),
+		GetListPackagesInstalledOnManagedInstanceSortOrderEnumValues(),
+		GetListScheduledJobsLifecycleStateEnumValues(),
+		GetListScheduledJobsOperationTypeEnumValues(),
+		GetListScheduledJobsOsFamilyEnumValues(),
+		GetListScheduledJobsSortByEnumValues(),
+		GetListScheduledJobsSortOrderEnumValues(),
+		GetListSoftwareSourcePackagesSortByEnumValues(),
+		GetListSoftwareSourcePackagesSortOrderEnumValues(),
+		GetListSoftwareSourcesLifecycleStateEnumValues(),
+		GetListSoftwareSourcesSortByEnumValues(),
+		GetListSoftwareSourcesSortOrderEnumValues(),
+		GetListUpcomingScheduledJobsLifecycleStateEnumValues(),
+		GetListUpcomingScheduledJobsOsFamilyEnumValues(),
+		GetListUpcomingScheduledJobsSortByEnumValues(),
+		GetListUpcomingScheduledJobsSortOrderEnumValues(),
+		GetListWindowsUpdatesInstalledOnManagedInstanceSortByEnumValues(),
+		GetListWindowsUpdatesInstalledOnManagedInstanceSortOrderEnumValues(),
+		GetListWindowsUpdatesSortByEnumValues(),
+		GetListWindowsUpdatesSortOrderEnumValues(),
+		GetListWorkRequestErrorsSortByEnumValues(),
+		GetListWorkRequestErrorsSortOrderEnumValues(),
+		GetListWorkRequestLogsSortByEnumValues(),
+		GetListWorkRequestLogsSortOrderEnumValues(),
+		GetListWorkRequestsOsFamilyEnumValues(),
+		GetListWorkRequestsSortByEnumValues(),
+		GetListWorkRequestsSortOrderEnumValues(),
+		GetManagedInstanceStatusEnumValues(),
+		GetManagedInstanceSummaryStatusEnumValues(),
+		GetOperationStatusEnumValues(),
+		GetOperationTypesEnumValues(),
+		GetOsFamiliesEnumValues(),
+		GetPackageUpdateTypesEnumValues(),
+		GetRecurrenceIntervalTypeEnumValues(),
+		GetScheduleTypesEnumValues(),
+		GetSearchSoftwarePackagesSortByEnumValues(),
+		GetSearchSoftwarePackagesSortOrderEnumValues(),
+		GetSoftwareSourceStatusEnumValues(),
+		GetSoftwareSourceSummaryStatusEnumValues(),
+		GetUpdateTypesEnumValues(),
+		GetWindowsUpdateInstallationRequirementsEnumValues(),
+		GetWorkRequestResourceActionTypeEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AddPackagesToSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AttachChildSoftwareSourceToManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AttachManagedInstanceToManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request AttachParentSoftwareSourceToManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeManagedInstanceGroupCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeScheduledJobCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ChangeSoftwareSourceCompartmentRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateScheduledJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request CreateSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteScheduledJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DeleteSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DetachChildSoftwareSourceFromManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DetachManagedInstanceFromManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request DetachParentSoftwareSourceFromManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetErratumRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetScheduledJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSoftwarePackageRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWindowsUpdateRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetWorkRequestRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request InstallAllPackageUpdatesOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request InstallAllWindowsUpdatesOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request InstallPackageOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request InstallPackageUpdateOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request InstallWindowsUpdateOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAvailablePackagesForManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAvailableSoftwareSourcesForManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAvailableUpdatesForManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListAvailableWindowsUpdatesForManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListManagedInstanceGroupsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListManagedInstancesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListPackagesInstalledOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListScheduledJobsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSoftwareSourcePackagesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSoftwareSourcesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListUpcomingScheduledJobsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWindowsUpdatesInstalledOnManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWindowsUpdatesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestErrorsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestLogsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListWorkRequestsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RemovePackageFromManagedInstanceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RemovePackagesFromSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request RunScheduledJobNowRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SearchSoftwarePackagesRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request SkipNextScheduledJobExecutionRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateManagedInstanceGroupRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateScheduledJobRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request UpdateSoftwareSourceRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AddPackagesToSoftwareSourceDetails, see common.ValidateStruct
+func (m AddPackagesToSoftwareSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AttachChildSoftwareSourceToManagedInstanceDetails, see common.ValidateStruct
+func (m AttachChildSoftwareSourceToManagedInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of AttachParentSoftwareSourceToManagedInstanceDetails, see common.ValidateStruct
+func (m AttachParentSoftwareSourceToManagedInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeManagedInstanceGroupCompartmentDetails, see common.ValidateStruct
+func (m ChangeManagedInstanceGroupCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeScheduledJobCompartmentDetails, see common.ValidateStruct
+func (m ChangeScheduledJobCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of ChangeSoftwareSourceCompartmentDetails, see common.ValidateStruct
+func (m ChangeSoftwareSourceCompartmentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateManagedInstanceGroupDetails, see common.ValidateStruct
+func (m CreateManagedInstanceGroupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateScheduledJobDetails, see common.ValidateStruct
+func (m CreateScheduledJobDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of CreateSoftwareSourceDetails, see common.ValidateStruct
+func (m CreateSoftwareSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DetachChildSoftwareSourceFromManagedInstanceDetails, see common.ValidateStruct
+func (m DetachChildSoftwareSourceFromManagedInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of DetachParentSoftwareSourceFromManagedInstanceDetails, see common.ValidateStruct
+func (m DetachParentSoftwareSourceFromManagedInstanceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of RemovePackagesFromSoftwareSourceDetails, see common.ValidateStruct
+func (m RemovePackagesFromSoftwareSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateManagedInstanceGroupDetails, see common.ValidateStruct
+func (m UpdateManagedInstanceGroupDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateScheduledJobDetails, see common.ValidateStruct
+func (m UpdateScheduledJobDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of UpdateSoftwareSourceDetails, see common.ValidateStruct
+func (m UpdateSoftwareSourceDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
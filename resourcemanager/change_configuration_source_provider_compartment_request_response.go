@@ -61,6 +61,9 @@ type ChangeConfigurationSourceProviderCompartmentResponse struct {
 
 	// Unique identifier for the request.
 	OpcRequestId *string `presentIn:"header" name:"opc-request-id"`
+
+	// The retry token sent with all the attempts of the request, generated unless it was set. It is not sent by the service.
+	OpcRetryToken *string
 }
 
 func (response ChangeConfigurationSourceProviderCompartmentResponse) String() string {
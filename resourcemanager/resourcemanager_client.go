@@ -159,10 +159,12 @@ func (client ResourceManagerClient) ChangeConfigurationSourceProviderCompartment
 				response = ChangeConfigurationSourceProviderCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeConfigurationSourceProviderCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeConfigurationSourceProviderCompartmentResponse")
 	}
@@ -214,10 +216,12 @@ func (client ResourceManagerClient) ChangeStackCompartment(ctx context.Context,
 				response = ChangeStackCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeStackCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeStackCompartmentResponse")
 	}
@@ -271,10 +275,12 @@ func (client ResourceManagerClient) CreateConfigurationSourceProvider(ctx contex
 				response = CreateConfigurationSourceProviderResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConfigurationSourceProviderResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConfigurationSourceProviderResponse")
 	}
@@ -326,10 +332,12 @@ func (client ResourceManagerClient) CreateJob(ctx context.Context, request Creat
 				response = CreateJobResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateJobResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateJobResponse")
 	}
@@ -386,10 +394,12 @@ func (client ResourceManagerClient) CreateStack(ctx context.Context, request Cre
 				response = CreateStackResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateStackResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateStackResponse")
 	}
@@ -541,10 +551,12 @@ func (client ResourceManagerClient) DetectStackDrift(ctx context.Context, reques
 				response = DetectStackDriftResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DetectStackDriftResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DetectStackDriftResponse")
 	}
@@ -0,0 +1,138 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package resourcemanager
+
+import (
+	"context"
+)
+
+// ResourceManagerClientAPI is the interface of the operations of ResourceManagerClient, to inject the client and mock it in tests
+type ResourceManagerClientAPI interface {
+	// CancelJob Indicates the intention to cancel the specified job.
+	// Cancellation of the job is not immediate, and may be delayed,
+	// or may not happen at all.
+	CancelJob(ctx context.Context, request CancelJobRequest) (response CancelJobResponse, err error)
+
+	// ChangeConfigurationSourceProviderCompartment Moves a configuration source provider into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	ChangeConfigurationSourceProviderCompartment(ctx context.Context, request ChangeConfigurationSourceProviderCompartmentRequest) (response ChangeConfigurationSourceProviderCompartmentResponse, err error)
+
+	// ChangeStackCompartment Moves a Stack and it's associated Jobs into a different compartment.
+	ChangeStackCompartment(ctx context.Context, request ChangeStackCompartmentRequest) (response ChangeStackCompartmentResponse, err error)
+
+	// CreateConfigurationSourceProvider Creates a configuration source provider in the specified compartment.
+	// For more information, see
+	// To create a configuration source provider (https://docs.cloud.oracle.com/iaas/Content/ResourceManager/Tasks/managingstacksandjobs.htm#CreateConfigurationSourceProvider).
+	CreateConfigurationSourceProvider(ctx context.Context, request CreateConfigurationSourceProviderRequest) (response CreateConfigurationSourceProviderResponse, err error)
+
+	// CreateJob Creates a job.
+	CreateJob(ctx context.Context, request CreateJobRequest) (response CreateJobResponse, err error)
+
+	// CreateStack Creates a stack in the specified compartment.
+	// You can create a stack from a Terraform configuration file.
+	// The Terraform configuration file can be directly uploaded or referenced from a source code control system.
+	// You can also create a stack from an existing compartment.
+	// For more information, see
+	// To create a stack (https://docs.cloud.oracle.com/iaas/Content/ResourceManager/Tasks/managingstacksandjobs.htm#CreateStack).
+	CreateStack(ctx context.Context, request CreateStackRequest) (response CreateStackResponse, err error)
+
+	// DeleteConfigurationSourceProvider Deletes the specified configuration source provider.
+	DeleteConfigurationSourceProvider(ctx context.Context, request DeleteConfigurationSourceProviderRequest) (response DeleteConfigurationSourceProviderResponse, err error)
+
+	// DeleteStack Deletes the specified stack object.
+	DeleteStack(ctx context.Context, request DeleteStackRequest) (response DeleteStackResponse, err error)
+
+	// DetectStackDrift Checks drift status for the specified stack.
+	DetectStackDrift(ctx context.Context, request DetectStackDriftRequest) (response DetectStackDriftResponse, err error)
+
+	// GetConfigurationSourceProvider Gets the properties of the specified configuration source provider.
+	GetConfigurationSourceProvider(ctx context.Context, request GetConfigurationSourceProviderRequest) (response GetConfigurationSourceProviderResponse, err error)
+
+	// GetJob Returns the specified job along with the job details.
+	GetJob(ctx context.Context, request GetJobRequest) (response GetJobResponse, err error)
+
+	// GetJobLogs Returns log entries for the specified job in JSON format.
+	GetJobLogs(ctx context.Context, request GetJobLogsRequest) (response GetJobLogsResponse, err error)
+
+	// GetJobLogsContent Returns raw log file for the specified job in text format.
+	// Returns a maximum of 100,000 log entries.
+	GetJobLogsContent(ctx context.Context, request GetJobLogsContentRequest) (response GetJobLogsContentResponse, err error)
+
+	// GetJobTfConfig Returns the Terraform configuration file for the specified job in .zip format.
+	// Returns an error if no zip file is found.
+	GetJobTfConfig(ctx context.Context, request GetJobTfConfigRequest) (response GetJobTfConfigResponse, err error)
+
+	// GetJobTfState Returns the Terraform state for the specified job.
+	GetJobTfState(ctx context.Context, request GetJobTfStateRequest) (response GetJobTfStateResponse, err error)
+
+	// GetStack Gets a stack using the stack ID.
+	GetStack(ctx context.Context, request GetStackRequest) (response GetStackResponse, err error)
+
+	// GetStackTfConfig Returns the Terraform configuration file in .zip format for the specified stack.
+	// Returns an error if no zip file is found.
+	GetStackTfConfig(ctx context.Context, request GetStackTfConfigRequest) (response GetStackTfConfigResponse, err error)
+
+	// GetStackTfState Returns the Terraform state for the specified stack.
+	GetStackTfState(ctx context.Context, request GetStackTfStateRequest) (response GetStackTfStateResponse, err error)
+
+	// GetWorkRequest Return the given work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListConfigurationSourceProviders Lists configuration source providers according to the specified filter.
+	// - For `compartmentId`, lists all configuration source providers in the matching compartment.
+	// - For `configurationSourceProviderId`, lists the matching configuration source provider.
+	ListConfigurationSourceProviders(ctx context.Context, request ListConfigurationSourceProvidersRequest) (response ListConfigurationSourceProvidersResponse, err error)
+
+	// ListJobs Returns a list of jobs in a stack or compartment, ordered by time created.
+	// - To list all jobs in a stack, provide the stack OCID (https://docs.cloud.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
+	// - To list all jobs in a compartment, provide the compartment OCID (https://docs.cloud.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
+	// - To return a specific job, provide the job OCID (https://docs.cloud.oracle.com/iaas/Content/General/Concepts/identifiers.htm).
+	ListJobs(ctx context.Context, request ListJobsRequest) (response ListJobsResponse, err error)
+
+	// ListResourceDiscoveryServices Returns a list of supported services for Resource Discovery. For reference on service names, see the Terraform provider documentation (https://www.terraform.io/docs/providers/oci/guides/resource_discovery.html#services).
+	ListResourceDiscoveryServices(ctx context.Context, request ListResourceDiscoveryServicesRequest) (response ListResourceDiscoveryServicesResponse, err error)
+
+	// ListStackResourceDriftDetails Lists drift status details for each resource defined in the specified stack.
+	// The drift status details for a given resource indicate differences, if any, between the actual state
+	// and the expected (defined) state for that resource.
+	ListStackResourceDriftDetails(ctx context.Context, request ListStackResourceDriftDetailsRequest) (response ListStackResourceDriftDetailsResponse, err error)
+
+	// ListStacks Returns a list of stacks.
+	// - If called using the compartment ID, returns all stacks in the specified compartment.
+	// - If called using the stack ID, returns the specified stack.
+	ListStacks(ctx context.Context, request ListStacksRequest) (response ListStacksResponse, err error)
+
+	// ListTerraformVersions Returns a list of supported Terraform versions for use with stacks.
+	ListTerraformVersions(ctx context.Context, request ListTerraformVersionsRequest) (response ListTerraformVersionsResponse, err error)
+
+	// ListWorkRequestErrors Return a (paginated) list of errors for a given work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Return a (paginated) list of logs for a given work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a given compartment or for a given resource.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateConfigurationSourceProvider Updates the properties of the specified configuration source provider.
+	// For more information, see
+	// To update a configuration source provider (https://docs.cloud.oracle.com/iaas/Content/ResourceManager/Tasks/managingstacksandjobs.htm#UpdateConfigurationSourceProvider).
+	UpdateConfigurationSourceProvider(ctx context.Context, request UpdateConfigurationSourceProviderRequest) (response UpdateConfigurationSourceProviderResponse, err error)
+
+	// UpdateJob Updates the specified job.
+	UpdateJob(ctx context.Context, request UpdateJobRequest) (response UpdateJobResponse, err error)
+
+	// UpdateStack Updates the specified stack object.
+	// Use `UpdateStack` when you update your Terraform configuration
+	// and want your changes to be reflected in the execution plan.
+	// For more information, see
+	// To update a stack (https://docs.cloud.oracle.com/iaas/Content/ResourceManager/Tasks/managingstacksandjobs.htm#UpdateStack) and
+	// To edit a stack (https://docs.cloud.oracle.com/iaas/Content/ResourceManager/Tasks/managingstacksandjobs.htm#EditStack).
+	UpdateStack(ctx context.Context, request UpdateStackRequest) (response UpdateStackResponse, err error)
+}
+
+// ResourceManagerClient implements ResourceManagerClientAPI
+var _ ResourceManagerClientAPI = ResourceManagerClient{}
@@ -0,0 +1,211 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package resourcemanager
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*ConfigSource)(nil), func() common.PolymorphicJSONUnmarshaler { return &configsource{} })
+	common.RegisterPolymorphicModel((*ConfigSourceRecord)(nil), func() common.PolymorphicJSONUnmarshaler { return &configsourcerecord{} })
+	common.RegisterPolymorphicModel((*ConfigurationSourceProvider)(nil), func() common.PolymorphicJSONUnmarshaler { return &configurationsourceprovider{} })
+	common.RegisterPolymorphicModel((*ConfigurationSourceProviderSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &configurationsourceprovidersummary{} })
+	common.RegisterPolymorphicModel((*CreateConfigSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createconfigsourcedetails{} })
+	common.RegisterPolymorphicModel((*CreateConfigurationSourceProviderDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createconfigurationsourceproviderdetails{} })
+	common.RegisterPolymorphicModel((*CreateJobOperationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &createjoboperationdetails{} })
+	common.RegisterPolymorphicModel((*JobOperationDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &joboperationdetails{} })
+	common.RegisterPolymorphicModel((*JobOperationDetailsSummary)(nil), func() common.PolymorphicJSONUnmarshaler { return &joboperationdetailssummary{} })
+	common.RegisterPolymorphicModel((*UpdateConfigSourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateconfigsourcedetails{} })
+	common.RegisterPolymorphicModel((*UpdateConfigurationSourceProviderDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &updateconfigurationsourceproviderdetails{} })
+}
+
+// MarshalJSON marshals a ConfigSource whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m configsource) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconfigsource configsource
+	s := struct {
+		MarshalTypeconfigsource
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconfigsource: (MarshalTypeconfigsource)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ConfigSourceRecord whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m configsourcerecord) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconfigsourcerecord configsourcerecord
+	s := struct {
+		MarshalTypeconfigsourcerecord
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconfigsourcerecord: (MarshalTypeconfigsourcerecord)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ConfigurationSourceProvider whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m configurationsourceprovider) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconfigurationsourceprovider configurationsourceprovider
+	s := struct {
+		MarshalTypeconfigurationsourceprovider
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconfigurationsourceprovider: (MarshalTypeconfigurationsourceprovider)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a ConfigurationSourceProviderSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m configurationsourceprovidersummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeconfigurationsourceprovidersummary configurationsourceprovidersummary
+	s := struct {
+		MarshalTypeconfigurationsourceprovidersummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeconfigurationsourceprovidersummary: (MarshalTypeconfigurationsourceprovidersummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateConfigSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createconfigsourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateconfigsourcedetails createconfigsourcedetails
+	s := struct {
+		MarshalTypecreateconfigsourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateconfigsourcedetails: (MarshalTypecreateconfigsourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateConfigurationSourceProviderDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createconfigurationsourceproviderdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreateconfigurationsourceproviderdetails createconfigurationsourceproviderdetails
+	s := struct {
+		MarshalTypecreateconfigurationsourceproviderdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreateconfigurationsourceproviderdetails: (MarshalTypecreateconfigurationsourceproviderdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a CreateJobOperationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m createjoboperationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypecreatejoboperationdetails createjoboperationdetails
+	s := struct {
+		MarshalTypecreatejoboperationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypecreatejoboperationdetails: (MarshalTypecreatejoboperationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a JobOperationDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m joboperationdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypejoboperationdetails joboperationdetails
+	s := struct {
+		MarshalTypejoboperationdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypejoboperationdetails: (MarshalTypejoboperationdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a JobOperationDetailsSummary whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m joboperationdetailssummary) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypejoboperationdetailssummary joboperationdetailssummary
+	s := struct {
+		MarshalTypejoboperationdetailssummary
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypejoboperationdetailssummary: (MarshalTypejoboperationdetailssummary)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateConfigSourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateconfigsourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateconfigsourcedetails updateconfigsourcedetails
+	s := struct {
+		MarshalTypeupdateconfigsourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateconfigsourcedetails: (MarshalTypeupdateconfigsourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a UpdateConfigurationSourceProviderDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m updateconfigurationsourceproviderdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeupdateconfigurationsourceproviderdetails updateconfigurationsourceproviderdetails
+	s := struct {
+		MarshalTypeupdateconfigurationsourceproviderdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeupdateconfigurationsourceproviderdetails: (MarshalTypeupdateconfigurationsourceproviderdetails)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package resourcesearch
+
+import (
+	"context"
+)
+
+// ResourceSearchClientAPI is the interface of the operations of ResourceSearchClient, to inject the client and mock it in tests
+type ResourceSearchClientAPI interface {
+	// GetResourceType Gets detailed information about a resource type by using the resource type name.
+	GetResourceType(ctx context.Context, request GetResourceTypeRequest) (response GetResourceTypeResponse, err error)
+
+	// ListResourceTypes Lists all resource types that you can search or query for.
+	ListResourceTypes(ctx context.Context, request ListResourceTypesRequest) (response ListResourceTypesResponse, err error)
+
+	// SearchResources Queries any and all compartments in the specified tenancy to find resources that match the specified criteria.
+	// Results include resources that you have permission to view and can span different resource types.
+	// You can also sort results based on a specified resource attribute.
+	SearchResources(ctx context.Context, request SearchResourcesRequest) (response SearchResourcesResponse, err error)
+}
+
+// ResourceSearchClient implements ResourceSearchClientAPI
+var _ ResourceSearchClientAPI = ResourceSearchClient{}
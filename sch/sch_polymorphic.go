@@ -0,0 +1,67 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package sch
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*SourceDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &sourcedetails{} })
+	common.RegisterPolymorphicModel((*TargetDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &targetdetails{} })
+	common.RegisterPolymorphicModel((*TaskDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &taskdetails{} })
+}
+
+// MarshalJSON marshals a SourceDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m sourcedetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesourcedetails sourcedetails
+	s := struct {
+		MarshalTypesourcedetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesourcedetails: (MarshalTypesourcedetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a TargetDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m targetdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypetargetdetails targetdetails
+	s := struct {
+		MarshalTypetargetdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypetargetdetails: (MarshalTypetargetdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a TaskDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m taskdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypetaskdetails taskdetails
+	s := struct {
+		MarshalTypetaskdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypetaskdetails: (MarshalTypetaskdetails)(m),
+	}
+	return json.Marshal(&s)
+}
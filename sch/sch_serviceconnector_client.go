@@ -109,10 +109,12 @@ func (client ServiceConnectorClient) ActivateServiceConnector(ctx context.Contex
 				response = ActivateServiceConnectorResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ActivateServiceConnectorResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ActivateServiceConnectorResponse")
 	}
@@ -232,10 +234,12 @@ func (client ServiceConnectorClient) CreateServiceConnector(ctx context.Context,
 				response = CreateServiceConnectorResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateServiceConnectorResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateServiceConnectorResponse")
 	}
@@ -291,10 +295,12 @@ func (client ServiceConnectorClient) DeactivateServiceConnector(ctx context.Cont
 				response = DeactivateServiceConnectorResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeactivateServiceConnectorResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeactivateServiceConnectorResponse")
 	}
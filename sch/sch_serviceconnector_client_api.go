@@ -0,0 +1,83 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package sch
+
+import (
+	"context"
+)
+
+// ServiceConnectorClientAPI is the interface of the operations of ServiceConnectorClient, to inject the client and mock it in tests
+type ServiceConnectorClientAPI interface {
+	// ActivateServiceConnector Activates the specified service connector.
+	// After you send your request, the service connector's state is temporarily
+	// UPDATING. When the state changes to ACTIVE, data begins transferring from the
+	// source service to the target service. For instructions on activating service
+	// connectors, see
+	// To activate a service connector (https://docs.cloud.oracle.com/iaas/Content/service-connector-hub/managingconnectors.htm#activate).
+	ActivateServiceConnector(ctx context.Context, request ActivateServiceConnectorRequest) (response ActivateServiceConnectorResponse, err error)
+
+	// ChangeServiceConnectorCompartment Moves a service connector into a different compartment within the same tenancy.
+	// For information about moving resources between compartments, see
+	// Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When provided, If-Match is checked against ETag values of the resource.
+	ChangeServiceConnectorCompartment(ctx context.Context, request ChangeServiceConnectorCompartmentRequest) (response ChangeServiceConnectorCompartmentResponse, err error)
+
+	// CreateServiceConnector Creates a new service connector in the specified compartment.
+	// A service connector is a logically defined flow for moving data from
+	// a source service to a destination service in Oracle Cloud Infrastructure.
+	// For general information about service connectors, see
+	// Service Connector Hub Overview (https://docs.cloud.oracle.com/iaas/Content/service-connector-hub/overview.htm).
+	// For purposes of access control, you must provide the
+	// OCID (https://docs.cloud.oracle.com/iaas/Content/General/Concepts/identifiers.htm) of the compartment where
+	// you want the service connector to reside. Notice that the service connector
+	// doesn't have to be in the same compartment as the source or target services.
+	// For information about access control and compartments, see
+	// Overview of the IAM Service (https://docs.cloud.oracle.com/iaas/Content/Identity/Concepts/overview.htm).
+	// After you send your request, the new service connector's state is temporarily
+	// CREATING. When the state changes to ACTIVE, data begins transferring from the
+	// source service to the target service. For instructions on deactivating and
+	// activating service connectors, see
+	// To activate or deactivate a service connector (https://docs.cloud.oracle.com/iaas/Content/service-connector-hub/overview.htm).
+	CreateServiceConnector(ctx context.Context, request CreateServiceConnectorRequest) (response CreateServiceConnectorResponse, err error)
+
+	// DeactivateServiceConnector Deactivates the specified service connector.
+	// After you send your request, the service connector's state is temporarily
+	// UPDATING and any data transfer stops. The state then changes to INACTIVE.
+	// For instructions on deactivating service connectors, see
+	// To deactivate a service connector (https://docs.cloud.oracle.com/iaas/Content/service-connector-hub/managingconnectors.htm#deactivate).
+	DeactivateServiceConnector(ctx context.Context, request DeactivateServiceConnectorRequest) (response DeactivateServiceConnectorResponse, err error)
+
+	// DeleteServiceConnector Deletes the specified service connector.
+	// After you send your request, the service connector's state is temporarily
+	// DELETING and any data transfer stops. The state then changes to DELETED.
+	DeleteServiceConnector(ctx context.Context, request DeleteServiceConnectorRequest) (response DeleteServiceConnectorResponse, err error)
+
+	// GetServiceConnector Gets the specified service connector's configuration information.
+	GetServiceConnector(ctx context.Context, request GetServiceConnectorRequest) (response GetServiceConnectorResponse, err error)
+
+	// GetWorkRequest Gets the details of the specified work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListServiceConnectors Lists service connectors in the specified compartment.
+	ListServiceConnectors(ctx context.Context, request ListServiceConnectorsRequest) (response ListServiceConnectorsResponse, err error)
+
+	// ListWorkRequestErrors Lists work request errors for the specified work request. Results are paginated.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Lists logs for the specified work request. Results are paginated.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in the specified compartment.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+
+	// UpdateServiceConnector Updates the configuration information for the specified service connector.
+	// After you send your request, the service connector's state is temporarily
+	// UPDATING and any data transfer pauses. The state then changes back to its
+	// original value: if ACTIVE, then data transfer resumes.
+	UpdateServiceConnector(ctx context.Context, request UpdateServiceConnectorRequest) (response UpdateServiceConnectorResponse, err error)
+}
+
+// ServiceConnectorClient implements ServiceConnectorClientAPI
+var _ ServiceConnectorClientAPI = ServiceConnectorClient{}
@@ -0,0 +1,420 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package cache caches the secrets of the Vault service. The Cache fetches the secrets by OCID, or by name within a
+// vault, decodes their content, serves them from memory until their TTL, and keeps serving them while they are
+// fetched again in the background. The subscribers of a secret are notified when a rotation produces a new version.
+package cache
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/secrets"
+	"github.com/oracle/oci-go-sdk/v27/vault"
+)
+
+// Reference identifies a secret version: a secret given by OCID or by name, and a rotation stage, a version
+// number or a version name
+type Reference struct {
+	SecretId *string
+
+	// the name, vault and compartment of a secret not given by OCID
+	SecretName    *string
+	VaultId       *string
+	CompartmentId *string
+
+	// the version of the secret, the CURRENT stage by default
+	Stage         secrets.GetSecretBundleStageEnum
+	VersionNumber *int64
+	VersionName   *string
+}
+
+// ByID returns a reference to the current version of the secret of the OCID
+func ByID(secretID string) Reference {
+	return Reference{SecretId: common.String(secretID)}
+}
+
+// ByName returns a reference to the current version of the secret of the name in the vault
+func ByName(compartmentID, vaultID, secretName string) Reference {
+	return Reference{SecretName: common.String(secretName), VaultId: common.String(vaultID), CompartmentId: common.String(compartmentID)}
+}
+
+// WithStage returns the reference to the version of the secret in the rotation stage, such as PENDING
+func (reference Reference) WithStage(stage secrets.GetSecretBundleStageEnum) Reference {
+	reference.Stage, reference.VersionNumber, reference.VersionName = stage, nil, nil
+	return reference
+}
+
+// WithVersion returns the reference to the version number of the secret
+func (reference Reference) WithVersion(versionNumber int64) Reference {
+	reference.Stage, reference.VersionNumber, reference.VersionName = "", common.Int64(versionNumber), nil
+	return reference
+}
+
+// WithVersionName returns the reference to the version name of the secret
+func (reference Reference) WithVersionName(versionName string) Reference {
+	reference.Stage, reference.VersionNumber, reference.VersionName = "", nil, common.String(versionName)
+	return reference
+}
+
+// isPinned returns true for the references to a version, whose content never changes
+func (reference Reference) isPinned() bool {
+	return reference.VersionNumber != nil || reference.VersionName != nil
+}
+
+func (reference Reference) validate() error {
+	if reference.SecretId == nil && (reference.SecretName == nil || reference.VaultId == nil || reference.CompartmentId == nil) {
+		return fmt.Errorf("a secret reference requires a secret OCID, or a secret name, vault OCID and compartment OCID")
+	}
+	return nil
+}
+
+func (reference Reference) secretKey() string {
+	if reference.SecretId != nil {
+		return *reference.SecretId
+	}
+	return *reference.CompartmentId + "/" + *reference.VaultId + "/" + *reference.SecretName
+}
+
+func (reference Reference) key() string {
+	key := reference.secretKey() + "|" + string(reference.Stage)
+	if reference.VersionNumber != nil {
+		key += fmt.Sprintf("|%d", *reference.VersionNumber)
+	}
+	if reference.VersionName != nil {
+		key += "|name:" + *reference.VersionName
+	}
+	return key
+}
+
+// Secret is a decoded secret version
+type Secret struct {
+	SecretId      string
+	VersionNumber int64
+	VersionName   *string
+	Stages        []secrets.SecretBundleStagesEnum
+	Content       []byte
+	Metadata      map[string]interface{}
+	TimeOfExpiry  *common.SDKTime
+
+	// The time the secret was fetched.
+	FetchedAt time.Time
+}
+
+// Subscriber is notified when a new version of a secret is fetched, previous is the version cached before
+type Subscriber func(previous, current *Secret)
+
+type entry struct {
+	reference Reference
+	secret    *Secret
+}
+
+// call is a fetch in progress, shared by the concurrent readers of a secret
+type call struct {
+	done   chan struct{}
+	secret *Secret
+	err    error
+}
+
+// Cache caches the secrets, Cache is safe for concurrent use
+type Cache struct {
+	client      secrets.SecretsClient
+	vaultClient *vault.VaultsClient
+	config      CacheConfiguration
+
+	mutex       sync.Mutex
+	entries     map[string]*entry
+	calls       map[string]*call
+	secretIDs   map[string]string
+	subscribers map[string]map[int]Subscriber
+	nextID      int
+
+	stop      chan struct{}
+	closeOnce sync.Once
+
+	// now returns the current time, replaced in tests
+	now func() time.Time
+}
+
+// NewCache returns a cache of the secrets of the client. vaultClient is only required to find the secrets by name,
+// it may be nil otherwise. The cache must be closed if the configuration sets a refresh interval.
+func NewCache(client secrets.SecretsClient, vaultClient *vault.VaultsClient, config CacheConfiguration) (*Cache, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+
+	cache := &Cache{
+		client:      client,
+		vaultClient: vaultClient,
+		config:      config,
+		entries:     make(map[string]*entry),
+		calls:       make(map[string]*call),
+		secretIDs:   make(map[string]string),
+		subscribers: make(map[string]map[int]Subscriber),
+		stop:        make(chan struct{}),
+		now:         time.Now,
+	}
+
+	if config.RefreshInterval != nil {
+		go cache.refreshPeriodically()
+	}
+	return cache, nil
+}
+
+// Get returns the secret version of the reference, from the cache if it has not expired
+func (cache *Cache) Get(ctx context.Context, reference Reference) (*Secret, error) {
+	if err := reference.validate(); err != nil {
+		return nil, err
+	}
+
+	key := reference.key()
+	cache.mutex.Lock()
+	if entry, ok := cache.entries[key]; ok {
+		age := cache.now().Sub(entry.secret.FetchedAt)
+		if reference.isPinned() || age < *cache.config.TTL {
+			cache.mutex.Unlock()
+			return entry.secret, nil
+		}
+
+		if age < *cache.config.TTL+*cache.config.StaleTTL {
+			cache.fetch(reference)
+			cache.mutex.Unlock()
+			return entry.secret, nil
+		}
+	}
+	call := cache.fetch(reference)
+	cache.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.secret, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// GetString returns the content of the secret version of the reference as a string
+func (cache *Cache) GetString(ctx context.Context, reference Reference) (string, error) {
+	secret, err := cache.Get(ctx, reference)
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Content), nil
+}
+
+// Subscribe registers a subscriber notified when a new version of the secret of the reference is fetched, and
+// returns the function unregistering it. The subscribers are called in the goroutine fetching the secret.
+func (cache *Cache) Subscribe(reference Reference, subscriber Subscriber) (unsubscribe func(), err error) {
+	if err := reference.validate(); err != nil {
+		return nil, err
+	}
+
+	key := reference.key()
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	id := cache.nextID
+	cache.nextID++
+	if cache.subscribers[key] == nil {
+		cache.subscribers[key] = make(map[int]Subscriber)
+	}
+	cache.subscribers[key][id] = subscriber
+
+	return func() {
+		cache.mutex.Lock()
+		delete(cache.subscribers[key], id)
+		cache.mutex.Unlock()
+	}, nil
+}
+
+// Invalidate removes the secret version of the reference from the cache
+func (cache *Cache) Invalidate(reference Reference) error {
+	if err := reference.validate(); err != nil {
+		return err
+	}
+
+	cache.mutex.Lock()
+	delete(cache.entries, reference.key())
+	cache.mutex.Unlock()
+	return nil
+}
+
+// Close stops the periodic refresh
+func (cache *Cache) Close() {
+	cache.closeOnce.Do(func() {
+		close(cache.stop)
+	})
+}
+
+// fetch starts fetching the secret version, unless it is already being fetched. The mutex must be held.
+func (cache *Cache) fetch(reference Reference) *call {
+	key := reference.key()
+	if existing, ok := cache.calls[key]; ok {
+		return existing
+	}
+
+	call := &call{done: make(chan struct{})}
+	cache.calls[key] = call
+	go func() {
+		// the fetch is shared by the readers, it is not canceled by any of them
+		secret, err := cache.load(context.Background(), reference)
+
+		cache.mutex.Lock()
+		delete(cache.calls, key)
+		var previous *Secret
+		var subscribers []Subscriber
+		if err == nil {
+			if entry, ok := cache.entries[key]; ok {
+				previous = entry.secret
+			}
+			cache.entries[key] = &entry{reference: reference, secret: secret}
+
+			if previous != nil && previous.VersionNumber != secret.VersionNumber {
+				for _, subscriber := range cache.subscribers[key] {
+					subscribers = append(subscribers, subscriber)
+				}
+			}
+		}
+		call.secret, call.err = secret, err
+		close(call.done)
+		cache.mutex.Unlock()
+
+		if err != nil {
+			common.Debugf("fetching secret %s failed with error: %v\n", reference.secretKey(), err)
+		}
+		for _, subscriber := range subscribers {
+			subscriber(previous, secret)
+		}
+	}()
+	return call
+}
+
+// load fetches and decodes the secret version
+func (cache *Cache) load(ctx context.Context, reference Reference) (*Secret, error) {
+	secretID, err := cache.secretID(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cache.client.GetSecretBundle(ctx, secrets.GetSecretBundleRequest{
+		SecretId:          common.String(secretID),
+		Stage:             reference.Stage,
+		VersionNumber:     reference.VersionNumber,
+		SecretVersionName: reference.VersionName,
+		RequestMetadata:   cache.config.RequestMetadata,
+	})
+	if err != nil {
+		if serviceError, ok := common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound && reference.SecretId == nil {
+			// the secret of the name may have been deleted and created again
+			cache.mutex.Lock()
+			delete(cache.secretIDs, reference.secretKey())
+			cache.mutex.Unlock()
+		}
+		return nil, err
+	}
+
+	content, err := decodeContent(resp.SecretBundleContent)
+	if err != nil {
+		return nil, fmt.Errorf("decoding secret %s failed: %v", secretID, err)
+	}
+
+	secret := &Secret{
+		SecretId:     secretID,
+		VersionName:  resp.VersionName,
+		Stages:       resp.Stages,
+		Content:      content,
+		Metadata:     resp.Metadata,
+		TimeOfExpiry: resp.TimeOfExpiry,
+		FetchedAt:    cache.now(),
+	}
+	if resp.VersionNumber != nil {
+		secret.VersionNumber = *resp.VersionNumber
+	}
+	return secret, nil
+}
+
+// secretID returns the OCID of the secret of the reference, looking up the active secret of the name in the vault
+func (cache *Cache) secretID(ctx context.Context, reference Reference) (string, error) {
+	if reference.SecretId != nil {
+		return *reference.SecretId, nil
+	}
+
+	key := reference.secretKey()
+	cache.mutex.Lock()
+	secretID, ok := cache.secretIDs[key]
+	cache.mutex.Unlock()
+	if ok {
+		return secretID, nil
+	}
+
+	if cache.vaultClient == nil {
+		return "", fmt.Errorf("a vault client is required to find secret %s by name", *reference.SecretName)
+	}
+
+	resp, err := cache.vaultClient.ListSecrets(ctx, vault.ListSecretsRequest{
+		CompartmentId:   reference.CompartmentId,
+		VaultId:         reference.VaultId,
+		Name:            reference.SecretName,
+		LifecycleState:  vault.SecretSummaryLifecycleStateActive,
+		RequestMetadata: cache.config.RequestMetadata,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, summary := range resp.Items {
+		if summary.SecretName != nil && *summary.SecretName == *reference.SecretName && summary.Id != nil {
+			cache.mutex.Lock()
+			cache.secretIDs[key] = *summary.Id
+			cache.mutex.Unlock()
+			return *summary.Id, nil
+		}
+	}
+	return "", fmt.Errorf("no active secret %s in vault %s", *reference.SecretName, *reference.VaultId)
+}
+
+// refreshPeriodically fetches the cached secrets again at every refresh interval, until the cache is closed
+func (cache *Cache) refreshPeriodically() {
+	ticker := time.NewTicker(*cache.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			cache.mutex.Lock()
+			for _, entry := range cache.entries {
+				if !entry.reference.isPinned() {
+					cache.fetch(entry.reference)
+				}
+			}
+			cache.mutex.Unlock()
+		case <-cache.stop:
+			return
+		}
+	}
+}
+
+func decodeContent(content secrets.SecretBundleContentDetails) ([]byte, error) {
+	switch details := content.(type) {
+	case secrets.Base64SecretBundleContentDetails:
+		return decodeBase64(details.Content)
+	case *secrets.Base64SecretBundleContentDetails:
+		return decodeBase64(details.Content)
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported content type %T", content)
+}
+
+func decodeBase64(content *string) ([]byte, error) {
+	if content == nil {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(*content)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package cache
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// CacheConfiguration defines the input parameters of NewCache
+type CacheConfiguration struct {
+	// [Optional] How long a secret is served from the cache before it is fetched again. Defaults to 5 minutes. The
+	// secrets pinned to a version number or name never expire.
+	TTL *time.Duration `mandatory:"false"`
+
+	// [Optional] How long after its TTL a secret is still served from the cache while it is fetched again in the
+	// background. Past this window, Get waits for the secret to be fetched. Defaults to 1 minute.
+	StaleTTL *time.Duration `mandatory:"false"`
+
+	// [Optional] How often the cached secrets are fetched again in the background, so that the rotations are
+	// detected even if the secrets are not read. Defaults to no periodic refresh.
+	RefreshInterval *time.Duration `mandatory:"false"`
+
+	// [Optional] The retry policy of the GetSecretBundle requests, and of the ListSecrets requests finding the secrets
+	// referenced by name, whether Get waits for them or they refresh the secrets in the background.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidTTL             = errors.New("ttl must be greater than 0")
+	errorInvalidStaleTTL        = errors.New("staleTTL must not be negative")
+	errorInvalidRefreshInterval = errors.New("refreshInterval must be greater than 0")
+)
+
+const (
+	defaultTTL      = 5 * time.Minute
+	defaultStaleTTL = time.Minute
+)
+
+func (config CacheConfiguration) validate() error {
+	if config.TTL != nil && *config.TTL <= 0 {
+		return errorInvalidTTL
+	}
+
+	if config.StaleTTL != nil && *config.StaleTTL < 0 {
+		return errorInvalidStaleTTL
+	}
+
+	if config.RefreshInterval != nil && *config.RefreshInterval <= 0 {
+		return errorInvalidRefreshInterval
+	}
+
+	return nil
+}
+
+func (config *CacheConfiguration) initDefaultValues() {
+	if config.TTL == nil {
+		ttl := defaultTTL
+		config.TTL = &ttl
+	}
+
+	if config.StaleTTL == nil {
+		staleTTL := defaultStaleTTL
+		config.StaleTTL = &staleTTL
+	}
+}
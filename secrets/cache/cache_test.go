@@ -0,0 +1,208 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package cache
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+	"github.com/oracle/oci-go-sdk/v27/secrets"
+	"github.com/oracle/oci-go-sdk/v27/vault"
+)
+
+// createTestSecret creates the secret "db-password" of the compartment "compartment1" in a fake vault, whose content
+// is "password1", and returns its OCID
+func createTestSecret(t *testing.T, server *ocitest.VaultServer, client vault.VaultsClient) string {
+	resp, err := client.CreateSecret(context.Background(), vault.CreateSecretRequest{CreateSecretDetails: vault.CreateSecretDetails{
+		CompartmentId: common.String("compartment1"),
+		VaultId:       common.String(server.VaultId),
+		SecretName:    common.String("db-password"),
+		KeyId:         common.String("key1"),
+		SecretContent: vault.Base64SecretContentDetails{Content: common.String(base64.StdEncoding.EncodeToString([]byte("password1")))},
+	}})
+	assert.NoError(t, err)
+	return *resp.Id
+}
+
+// rotateTestSecret adds the next version of a secret of a fake vault, in the given stage
+func rotateTestSecret(t *testing.T, client vault.VaultsClient, secretID, content string, stage vault.SecretContentDetailsStageEnum) {
+	_, err := client.UpdateSecret(context.Background(), vault.UpdateSecretRequest{
+		SecretId: common.String(secretID),
+		UpdateSecretDetails: vault.UpdateSecretDetails{SecretContent: vault.Base64SecretContentDetails{
+			Content: common.String(base64.StdEncoding.EncodeToString([]byte(content))),
+			Stage:   stage,
+		}},
+	})
+	assert.NoError(t, err)
+}
+
+// countFetches serves the requests with the handler of a fake vault, counting the GetSecretBundle requests
+func countFetches(handler http.Handler, fetches *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/secretbundles/") {
+			atomic.AddInt32(fetches, 1)
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// testClock is a manual clock
+type testClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (clock *testClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *testClock) Advance(duration time.Duration) {
+	clock.mutex.Lock()
+	clock.now = clock.now.Add(duration)
+	clock.mutex.Unlock()
+}
+
+func TestCache_ServesUntilExpiry(t *testing.T) {
+	server := ocitest.NewVaultServer()
+	defer server.Close()
+	vaultClient := vault.VaultsClient{BaseClient: server.BaseClient()}
+	secretID := createTestSecret(t, server, vaultClient)
+
+	var fetches int32
+	counting := ocitest.NewServer(countFetches(server.Config.Handler, &fetches))
+	defer counting.Close()
+	ttl, staleTTL := time.Minute, time.Minute
+	cache, err := NewCache(secrets.SecretsClient{BaseClient: counting.BaseClient()}, &vaultClient, CacheConfiguration{TTL: &ttl, StaleTTL: &staleTTL})
+	assert.NoError(t, err)
+	defer cache.Close()
+	clock := &testClock{now: time.Now()}
+	cache.now = clock.Now
+	ctx := context.Background()
+
+	value, err := cache.GetString(ctx, ByID(secretID))
+	assert.NoError(t, err)
+	assert.Equal(t, "password1", value)
+
+	// fresh, from the cache
+	rotateTestSecret(t, vaultClient, secretID, "password2", vault.SecretContentDetailsStageCurrent)
+	value, _ = cache.GetString(ctx, ByID(secretID))
+	assert.Equal(t, "password1", value)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// stale, served while the secret is fetched in the background
+	clock.Advance(90 * time.Second)
+	value, _ = cache.GetString(ctx, ByID(secretID))
+	assert.Equal(t, "password1", value)
+	assert.Eventually(t, func() bool {
+		value, _ := cache.GetString(ctx, ByID(secretID))
+		return value == "password2"
+	}, time.Second, time.Millisecond)
+
+	// expired, fetched before being served
+	rotateTestSecret(t, vaultClient, secretID, "password3", vault.SecretContentDetailsStageCurrent)
+	clock.Advance(3 * time.Minute)
+	value, _ = cache.GetString(ctx, ByID(secretID))
+	assert.Equal(t, "password3", value)
+}
+
+func TestCache_StagesAndVersions(t *testing.T) {
+	server := ocitest.NewVaultServer()
+	defer server.Close()
+	vaultClient := vault.VaultsClient{BaseClient: server.BaseClient()}
+	secretID := createTestSecret(t, server, vaultClient)
+	rotateTestSecret(t, vaultClient, secretID, "password2", vault.SecretContentDetailsStagePending)
+
+	var fetches int32
+	counting := ocitest.NewServer(countFetches(server.Config.Handler, &fetches))
+	defer counting.Close()
+	ttl, staleTTL := time.Minute, time.Minute
+	cache, err := NewCache(secrets.SecretsClient{BaseClient: counting.BaseClient()}, &vaultClient, CacheConfiguration{TTL: &ttl, StaleTTL: &staleTTL})
+	assert.NoError(t, err)
+	defer cache.Close()
+	clock := &testClock{now: time.Now()}
+	cache.now = clock.Now
+	ctx := context.Background()
+
+	pending, err := cache.Get(ctx, ByName("compartment1", server.VaultId, "db-password").WithStage(secrets.GetSecretBundleStagePending))
+	assert.NoError(t, err)
+	assert.Equal(t, "password2", string(pending.Content))
+	assert.Equal(t, int64(2), pending.VersionNumber)
+	assert.Contains(t, pending.Stages, secrets.SecretBundleStagesPending)
+
+	// the pinned versions never expire
+	pinned, err := cache.GetString(ctx, ByID(secretID).WithVersion(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "password1", pinned)
+	fetched := atomic.LoadInt32(&fetches)
+	clock.Advance(time.Hour)
+	pinned, _ = cache.GetString(ctx, ByID(secretID).WithVersion(1))
+	assert.Equal(t, "password1", pinned)
+	assert.Equal(t, fetched, atomic.LoadInt32(&fetches))
+
+	_, err = cache.Get(ctx, ByName("compartment1", server.VaultId, "unknown"))
+	assert.EqualError(t, err, fmt.Sprintf("no active secret unknown in vault %s", server.VaultId))
+
+	_, err = cache.Get(ctx, ByID("secret2"))
+	serviceError, ok := common.IsServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, serviceError.GetHTTPStatusCode())
+
+	_, err = cache.Get(ctx, Reference{SecretName: common.String("db-password")})
+	assert.Error(t, err)
+
+	// the partial references are rejected rather than dereferenced
+	_, err = cache.Subscribe(Reference{SecretName: common.String("db-password")}, func(previous, current *Secret) {})
+	assert.Error(t, err)
+	assert.Error(t, cache.Invalidate(Reference{SecretName: common.String("db-password")}))
+	assert.NoError(t, cache.Invalidate(ByID(secretID)))
+}
+
+func TestCache_NotifiesRotations(t *testing.T) {
+	server := ocitest.NewVaultServer()
+	defer server.Close()
+	vaultClient := vault.VaultsClient{BaseClient: server.BaseClient()}
+	secretID := createTestSecret(t, server, vaultClient)
+
+	refreshInterval := 5 * time.Millisecond
+	cache, err := NewCache(secrets.SecretsClient{BaseClient: server.BaseClient()}, &vaultClient, CacheConfiguration{RefreshInterval: &refreshInterval})
+	assert.NoError(t, err)
+	defer cache.Close()
+
+	rotations := make(chan string, 10)
+	unsubscribe, err := cache.Subscribe(ByID(secretID), func(previous, current *Secret) {
+		rotations <- fmt.Sprintf("%d->%d", previous.VersionNumber, current.VersionNumber)
+	})
+	assert.NoError(t, err)
+	defer unsubscribe()
+
+	_, err = cache.Get(context.Background(), ByID(secretID))
+	assert.NoError(t, err)
+
+	// the periodic refresh detects the rotation without any read
+	rotateTestSecret(t, vaultClient, secretID, "password2", vault.SecretContentDetailsStageCurrent)
+	select {
+	case rotation := <-rotations:
+		assert.Equal(t, "1->2", rotation)
+	case <-time.After(time.Second):
+		t.Fatal("the rotation was not notified")
+	}
+
+	unsubscribe()
+	rotateTestSecret(t, vaultClient, secretID, "password3", vault.SecretContentDetailsStageCurrent)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, rotations, 0)
+}
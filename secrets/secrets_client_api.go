@@ -0,0 +1,22 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package secrets
+
+import (
+	"context"
+)
+
+// SecretsClientAPI is the interface of the operations of SecretsClient, to inject the client and mock it in tests
+type SecretsClientAPI interface {
+	// GetSecretBundle Gets a secret bundle that matches either the specified `stage`, `label`, or `versionNumber` parameter.
+	// If none of these parameters are provided, the bundle for the secret version marked as `CURRENT` will be returned.
+	GetSecretBundle(ctx context.Context, request GetSecretBundleRequest) (response GetSecretBundleResponse, err error)
+
+	// ListSecretBundleVersions Lists all secret bundle versions for the specified secret.
+	ListSecretBundleVersions(ctx context.Context, request ListSecretBundleVersionsRequest) (response ListSecretBundleVersionsResponse, err error)
+}
+
+// SecretsClient implements SecretsClientAPI
+var _ SecretsClientAPI = SecretsClient{}
@@ -0,0 +1,40 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package secrets
+
+import (
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterEnumValues(enumValues)
+}
+
+// enumValues returns the values of the enum types of the package, checked by the validation
+func enumValues() []interface{} {
+	return []interface{}{
+		GetGetSecretBundleStageEnumValues(),
+		GetListSecretBundleVersionsSortByEnumValues(),
+		GetListSecretBundleVersionsSortOrderEnumValues(),
+		GetSecretBundleContentDetailsContentTypeEnumValues(),
+		GetSecretBundleStagesEnumValues(),
+		GetSecretBundleVersionSummaryStagesEnumValues(),
+	}
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request GetSecretBundleRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of the request and of its body, see common.ValidateStruct
+func (request ListSecretBundleVersionsRequest) Validate() error {
+	return common.ValidateStruct(request)
+}
+
+// Validate checks the mandatory fields, the enum values and the OCIDs of Base64SecretBundleContentDetails, see common.ValidateStruct
+func (m Base64SecretBundleContentDetails) Validate() error {
+	return common.ValidateStruct(m)
+}
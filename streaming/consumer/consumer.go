@@ -0,0 +1,237 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package consumer consumes a stream of the Streaming service as a member of a consumer group. The Consumer manages
+// the group cursor, keeps the partitions of the instance reserved with heartbeats while the messages are handled,
+// and commits the offsets once they are handled, so that every message is delivered at least once.
+package consumer
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// Handler processes a batch of messages. The offsets of the messages are committed when it returns nil, the
+// messages are delivered again when it returns an error. ctx is done when the consumer is shutting down.
+type Handler func(ctx context.Context, messages []streaming.Message) error
+
+// Consumer delivers the messages of the partitions assigned to its instance of the consumer group to a Handler
+type Consumer struct {
+	client streaming.StreamClient
+	config ConsumerConfiguration
+
+	// the cursor to use for the next request, nil until the group is joined
+	mutex  sync.Mutex
+	cursor *string
+}
+
+// NewConsumer returns a consumer of the stream described by config. client must be created for the messages
+// endpoint of the stream.
+func NewConsumer(client streaming.StreamClient, config ConsumerConfiguration) (*Consumer, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	config.initDefaultValues()
+	return &Consumer{client: client, config: config}, nil
+}
+
+// Run consumes the stream until ctx is done, one batch of messages at a time. It backs off after empty polls and
+// transient errors, and joins the group again after the handler fails to get the messages delivered again. Run
+// returns nil once ctx is done, or the first error the consumer can not recover from.
+func (consumer *Consumer) Run(ctx context.Context, handler Handler) error {
+	backoff := consumer.newBackoff()
+	for ctx.Err() == nil {
+		messages, err := consumer.poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			if !worker.IsTransientError(err) {
+				return err
+			}
+
+			common.Debugf("polling stream %s failed with error: %v, backing off\n", *consumer.config.StreamId, err)
+			backoff.wait(ctx)
+			continue
+		}
+
+		if len(messages) == 0 {
+			backoff.wait(ctx)
+			continue
+		}
+		backoff.reset()
+
+		if err = consumer.handle(ctx, handler, messages); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+
+			// the group resumes from the last committed offsets, which delivers the messages again
+			common.Debugf("handling %d messages of stream %s failed with error: %v\n", len(messages), *consumer.config.StreamId, err)
+			consumer.setCursor(nil)
+			backoff.wait(ctx)
+			continue
+		}
+
+		consumer.commit(ctx)
+	}
+	return nil
+}
+
+// poll gets the next batch of messages, joining the group first if needed
+func (consumer *Consumer) poll(ctx context.Context) ([]streaming.Message, error) {
+	cursor := consumer.getCursor()
+	if cursor == nil {
+		resp, err := consumer.client.CreateGroupCursor(ctx, streaming.CreateGroupCursorRequest{
+			StreamId: consumer.config.StreamId,
+			CreateGroupCursorDetails: streaming.CreateGroupCursorDetails{
+				Type:         consumer.config.Type,
+				GroupName:    consumer.config.GroupName,
+				InstanceName: consumer.config.InstanceName,
+				Time:         consumer.config.Time,
+				TimeoutInMs:  consumer.config.TimeoutInMs,
+				CommitOnGet:  common.Bool(false),
+			},
+			RequestMetadata: consumer.config.RequestMetadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cursor = resp.Value
+	}
+
+	resp, err := consumer.client.GetMessages(ctx, streaming.GetMessagesRequest{
+		StreamId:        consumer.config.StreamId,
+		Cursor:          cursor,
+		Limit:           consumer.config.Limit,
+		RequestMetadata: consumer.config.RequestMetadata,
+	})
+	if err != nil {
+		if isInvalidCursorError(err) {
+			// the cursor expired, join the group again on the next poll
+			consumer.setCursor(nil)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	consumer.setCursor(resp.OpcNextCursor)
+	return resp.Items, nil
+}
+
+// handle runs the handler, sending heartbeats in the background until it returns
+func (consumer *Consumer) handle(ctx context.Context, handler Handler, messages []streaming.Message) error {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		consumer.heartbeat(ctx, done)
+	}()
+
+	err := handler(ctx, messages)
+	close(done)
+	wg.Wait()
+	return err
+}
+
+// heartbeat keeps the partitions of the instance reserved until done is closed
+func (consumer *Consumer) heartbeat(ctx context.Context, done <-chan struct{}) {
+	ticker := time.NewTicker(*consumer.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resp, err := consumer.client.ConsumerHeartbeat(ctx, streaming.ConsumerHeartbeatRequest{
+			StreamId:        consumer.config.StreamId,
+			Cursor:          consumer.getCursor(),
+			RequestMetadata: consumer.config.RequestMetadata,
+		})
+		if err != nil {
+			common.Debugf("heartbeat of stream %s failed with error: %v\n", *consumer.config.StreamId, err)
+			continue
+		}
+		consumer.setCursor(resp.Value)
+	}
+}
+
+// commit commits the offsets of the messages handled so far. The commit is not canceled with ctx, so the messages
+// handled before a shutdown are not delivered again.
+func (consumer *Consumer) commit(ctx context.Context) {
+	commitCtx, cancel := context.WithTimeout(context.Background(), time.Duration(*consumer.config.TimeoutInMs)*time.Millisecond)
+	defer cancel()
+
+	resp, err := consumer.client.ConsumerCommit(commitCtx, streaming.ConsumerCommitRequest{
+		StreamId:        consumer.config.StreamId,
+		Cursor:          consumer.getCursor(),
+		RequestMetadata: consumer.config.RequestMetadata,
+	})
+	if err != nil {
+		// the next commit covers these messages as well
+		common.Debugf("commit of stream %s failed with error: %v\n", *consumer.config.StreamId, err)
+		return
+	}
+	consumer.setCursor(resp.Value)
+}
+
+func (consumer *Consumer) getCursor() *string {
+	consumer.mutex.Lock()
+	defer consumer.mutex.Unlock()
+	return consumer.cursor
+}
+
+func (consumer *Consumer) setCursor(cursor *string) {
+	consumer.mutex.Lock()
+	defer consumer.mutex.Unlock()
+	consumer.cursor = cursor
+}
+
+// backoff is the exponential wait after empty polls and transient errors
+type backoff struct {
+	min, max time.Duration
+	next     time.Duration
+}
+
+func (consumer *Consumer) newBackoff() *backoff {
+	return &backoff{min: *consumer.config.MinBackoff, max: *consumer.config.MaxBackoff, next: *consumer.config.MinBackoff}
+}
+
+func (b *backoff) reset() {
+	b.next = b.min
+}
+
+// wait blocks for the current backoff, or until ctx is done, and doubles the next one
+func (b *backoff) wait(ctx context.Context) {
+	timer := time.NewTimer(b.next)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	b.next *= 2
+	if b.next > b.max {
+		b.next = b.max
+	}
+}
+
+// isInvalidCursorError returns true if the cursor expired or was invalidated by a rebalancing of the group
+func isInvalidCursorError(err error) bool {
+	serviceError, ok := common.IsServiceError(err)
+	return ok && serviceError.GetHTTPStatusCode() == http.StatusBadRequest
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package consumer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// ConsumerConfiguration defines the input parameters of NewConsumer
+type ConsumerConfiguration struct {
+	// The OCID of the stream to consume.
+	StreamId *string `mandatory:"true"`
+
+	// The name of the consumer group.
+	GroupName *string `mandatory:"true"`
+
+	// [Optional] The name of this instance in the group, the partitions are balanced across the instances of a group.
+	// The service generates a name if not set, set it to get the same partitions back after a restart.
+	InstanceName *string `mandatory:"false"`
+
+	// [Optional] Where a group without committed offsets starts consuming. Defaults to TRIM_HORIZON.
+	Type streaming.CreateGroupCursorDetailsTypeEnum `mandatory:"false"`
+
+	// [Optional] The time to start consuming from, only used with the AT_TIME type.
+	Time *common.SDKTime `mandatory:"false"`
+
+	// [Optional] The time in milliseconds after which the service reassigns the partitions of an instance that neither
+	// polled nor sent a heartbeat. Defaults to 30 seconds.
+	TimeoutInMs *int `mandatory:"false"`
+
+	// [Optional] The maximum number of messages delivered to the handler at once. Defaults to 100, the max is 10,000.
+	Limit *int `mandatory:"false"`
+
+	// [Optional] The interval of the heartbeats sent while the handler runs. Defaults to a third of TimeoutInMs.
+	HeartbeatInterval *time.Duration `mandatory:"false"`
+
+	// [Optional] The first wait after an empty poll or a transient error, doubled after each consecutive one.
+	// Defaults to 500 milliseconds.
+	MinBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum wait after empty polls or transient errors. Defaults to 10 seconds.
+	MaxBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The retry policy of the CreateGroupCursor, GetMessages, ConsumerHeartbeat and ConsumerCommit
+	// requests. The transient errors left once the policy gave up are waited out with MinBackoff and MaxBackoff.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidStreamID    = errors.New("streamId is required")
+	errorInvalidGroupName   = errors.New("groupName is required")
+	errorInvalidLimit       = errors.New("limit must be between 1 and 10000")
+	errorInvalidTimeoutInMs = errors.New("timeoutInMs must be greater than 0")
+	errorInvalidBackoff     = errors.New("minBackoff must be greater than 0 and not greater than maxBackoff")
+)
+
+const (
+	defaultTimeoutInMs = 30000
+	defaultLimit       = 100
+	maxLimit           = 10000
+	defaultMinBackoff  = 500 * time.Millisecond
+	defaultMaxBackoff  = 10 * time.Second
+)
+
+func (config ConsumerConfiguration) validate() error {
+	if config.StreamId == nil {
+		return errorInvalidStreamID
+	}
+
+	if config.GroupName == nil {
+		return errorInvalidGroupName
+	}
+
+	if config.Limit != nil && (*config.Limit <= 0 || *config.Limit > maxLimit) {
+		return errorInvalidLimit
+	}
+
+	if config.TimeoutInMs != nil && *config.TimeoutInMs <= 0 {
+		return errorInvalidTimeoutInMs
+	}
+
+	if config.MinBackoff != nil && *config.MinBackoff <= 0 {
+		return errorInvalidBackoff
+	}
+
+	if config.MinBackoff != nil && config.MaxBackoff != nil && *config.MinBackoff > *config.MaxBackoff {
+		return errorInvalidBackoff
+	}
+
+	return nil
+}
+
+func (config *ConsumerConfiguration) initDefaultValues() {
+	if len(config.Type) == 0 {
+		config.Type = streaming.CreateGroupCursorDetailsTypeTrimHorizon
+	}
+
+	if config.TimeoutInMs == nil {
+		config.TimeoutInMs = common.Int(defaultTimeoutInMs)
+	}
+
+	if config.Limit == nil {
+		config.Limit = common.Int(defaultLimit)
+	}
+
+	if config.HeartbeatInterval == nil || *config.HeartbeatInterval <= 0 {
+		interval := time.Duration(*config.TimeoutInMs) * time.Millisecond / 3
+		config.HeartbeatInterval = &interval
+	}
+
+	if config.MinBackoff == nil {
+		minBackoff := defaultMinBackoff
+		config.MinBackoff = &minBackoff
+	}
+
+	if config.MaxBackoff == nil {
+		maxBackoff := defaultMaxBackoff
+		if maxBackoff < *config.MinBackoff {
+			maxBackoff = *config.MinBackoff
+		}
+		config.MaxBackoff = &maxBackoff
+	}
+}
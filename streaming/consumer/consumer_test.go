@@ -0,0 +1,153 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+func TestConsumer_Run(t *testing.T) {
+	// a single partition of messages, the cursors are the offsets of the next messages
+	const messages = 5
+	var mutex sync.Mutex
+	committed, heartbeats, throttled := 0, 0, false
+	server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/groupCursors"):
+			fmt.Fprintf(w, `{"value":"%d"}`, committed)
+		case strings.HasSuffix(r.URL.Path, "/messages"):
+			if !throttled {
+				throttled = true
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"code":"TooManyRequests","message":"throttled"}`)
+				return
+			}
+
+			var items []map[string]interface{}
+			for i := offset; i < messages && len(items) < 2; i++ {
+				items = append(items, map[string]interface{}{"stream": "stream1", "partition": "0", "offset": i, "value": []byte(strconv.Itoa(i))})
+			}
+			w.Header().Set("opc-next-cursor", strconv.Itoa(offset+len(items)))
+			json.NewEncoder(w).Encode(items)
+		case strings.HasSuffix(r.URL.Path, "/heartbeat"):
+			heartbeats++
+			fmt.Fprintf(w, `{"value":"%d"}`, offset)
+		case strings.HasSuffix(r.URL.Path, "/commit"):
+			committed = offset
+			fmt.Fprintf(w, `{"value":"%d"}`, offset)
+		}
+	}))
+	defer server.Close()
+
+	minBackoff, heartbeatInterval := time.Millisecond, 5*time.Millisecond
+	consumer, err := NewConsumer(streaming.StreamClient{BaseClient: server.BaseClient()}, ConsumerConfiguration{
+		StreamId:          common.String("stream1"),
+		GroupName:         common.String("group1"),
+		MinBackoff:        &minBackoff,
+		HeartbeatInterval: &heartbeatInterval,
+	})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var delivered []int64
+	failed := false
+	err = consumer.Run(ctx, func(ctx context.Context, batch []streaming.Message) error {
+		if *batch[0].Offset == 2 && !failed {
+			// the batch is delivered again after a failure
+			failed = true
+			time.Sleep(20 * time.Millisecond)
+			return errors.New("handler failed")
+		}
+
+		for _, message := range batch {
+			delivered = append(delivered, *message.Offset)
+		}
+		if len(delivered) == messages {
+			cancel()
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, delivered)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, 5, committed)
+	assert.True(t, heartbeats > 0)
+}
+
+func TestConsumer_RunFailsOnPermanentError(t *testing.T) {
+	server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":"NotAuthorizedOrNotFound","message":"stream not found"}`)
+	}))
+	defer server.Close()
+
+	client := streaming.StreamClient{BaseClient: server.BaseClient()}
+	consumer, err := NewConsumer(client, ConsumerConfiguration{StreamId: common.String("stream1"), GroupName: common.String("group1")})
+	assert.NoError(t, err)
+
+	err = consumer.Run(context.Background(), func(ctx context.Context, messages []streaming.Message) error {
+		return nil
+	})
+	serviceError, ok := common.IsServiceError(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, serviceError.GetHTTPStatusCode())
+
+	// the requests which can not be signed are not retried
+	client.Signer = failingSigner{}
+	consumer, err = NewConsumer(client, ConsumerConfiguration{StreamId: common.String("stream1"), GroupName: common.String("group1")})
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = consumer.Run(ctx, func(ctx context.Context, messages []streaming.Message) error {
+		return nil
+	})
+	assert.Equal(t, errorSigning, err)
+}
+
+var errorSigning = errors.New("can not read the private key")
+
+// failingSigner fails to sign the requests, as a signer whose key can not be read
+type failingSigner struct{}
+
+func (failingSigner) Sign(*http.Request) error {
+	return errorSigning
+}
+
+func TestConsumerConfiguration_Validate(t *testing.T) {
+	config := ConsumerConfiguration{StreamId: common.String("stream1")}
+	assert.Equal(t, errorInvalidGroupName, config.validate())
+
+	config.GroupName = common.String("group1")
+	config.Limit = common.Int(maxLimit + 1)
+	assert.Equal(t, errorInvalidLimit, config.validate())
+
+	config.Limit = nil
+	config.initDefaultValues()
+	assert.NoError(t, config.validate())
+	assert.Equal(t, streaming.CreateGroupCursorDetailsTypeTrimHorizon, config.Type)
+	assert.Equal(t, 10*time.Second, *config.HeartbeatInterval)
+}
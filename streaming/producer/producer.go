@@ -0,0 +1,318 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package producer sends messages to a stream of the Streaming service in batches. The Producer buffers the
+// messages given to Send, groups them in PutMessages requests by size, count or linger time, and retries the
+// messages the service failed to append, until each message is delivered or runs out of attempts.
+package producer
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+var (
+	errorProducerClosed  = errors.New("the producer is closed")
+	errorMessageTooLarge = errors.New("the message is larger than 1 MiB once base64 encoded")
+)
+
+// messageOverhead accounts for the JSON syntax around the key and value of a message in a request
+const messageOverhead = 32
+
+// DeliveryReport is the outcome of the delivery of a message
+type DeliveryReport struct {
+	Key   []byte
+	Value []byte
+
+	// The partition and offset of the message in the stream, set if the message was delivered.
+	Partition *string
+	Offset    *int64
+	Timestamp *common.SDKTime
+
+	// The number of times the message was sent.
+	Attempts int
+
+	// The error of the last attempt, nil if the message was delivered.
+	Err error
+}
+
+// DeliveryFuture is the pending delivery of a message
+type DeliveryFuture struct {
+	done   chan struct{}
+	report DeliveryReport
+}
+
+// Done returns a channel closed once the message is delivered or failed
+func (future *DeliveryFuture) Done() <-chan struct{} {
+	return future.done
+}
+
+// Wait blocks until the message is delivered or failed, or ctx is done, and returns its delivery report
+func (future *DeliveryFuture) Wait(ctx context.Context) (DeliveryReport, error) {
+	select {
+	case <-future.done:
+		return future.report, future.report.Err
+	case <-ctx.Done():
+		return DeliveryReport{}, ctx.Err()
+	}
+}
+
+// pendingMessage is a message buffered or in flight
+type pendingMessage struct {
+	future *DeliveryFuture
+	size   int
+}
+
+// Producer sends messages to a stream in batches. Messages are sent in the order of the calls to Send and a single
+// request is in flight at a time, the messages the service failed to append are retried before any newer message,
+// which keeps the messages of a key in order. When the service appends a message of a key after failing an earlier
+// message of the same key in the same request, the appended message is sent again after the retried one, so a
+// consumer may read it twice but always reads its last copy after the earlier message. Producer is safe for
+// concurrent use.
+type Producer struct {
+	client streaming.StreamClient
+	config ProducerConfiguration
+
+	messages *worker.Queue
+	done     chan struct{}
+}
+
+// NewProducer returns a producer to the stream described by config, and starts sending the messages in the
+// background. client must be created for the messages endpoint of the stream. The producer must be closed.
+func NewProducer(client streaming.StreamClient, config ProducerConfiguration) (*Producer, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	config.initDefaultValues()
+
+	producer := &Producer{
+		client:   client,
+		config:   config,
+		messages: worker.NewQueue(*config.MaxBufferedMessages, errorProducerClosed),
+		done:     make(chan struct{}),
+	}
+	go producer.run()
+	return producer, nil
+}
+
+// Send buffers a message to send, it blocks while the buffer is full until ctx is done. The returned future
+// completes once the message is delivered or failed.
+func (producer *Producer) Send(ctx context.Context, key, value []byte) (*DeliveryFuture, error) {
+	size := base64.StdEncoding.EncodedLen(len(key)) + base64.StdEncoding.EncodedLen(len(value)) + messageOverhead
+	if size > *producer.config.MaxBatchBytes {
+		return nil, errorMessageTooLarge
+	}
+
+	message := &pendingMessage{
+		future: &DeliveryFuture{done: make(chan struct{}), report: DeliveryReport{Key: key, Value: value}},
+		size:   size,
+	}
+	if err := producer.messages.Put(ctx, message); err != nil {
+		return nil, err
+	}
+	return message.future, nil
+}
+
+// Close stops accepting messages and waits until the buffered messages are delivered or failed, or ctx is done.
+// Messages still buffered when ctx is done keep being sent in the background.
+func (producer *Producer) Close(ctx context.Context) error {
+	producer.messages.Close()
+
+	select {
+	case <-producer.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run batches the buffered messages and sends them until the producer is closed and the buffer is empty
+func (producer *Producer) run() {
+	defer close(producer.done)
+
+	var queue []*pendingMessage
+	messages := producer.messages.Items()
+	linger, backoff := newStoppedTimer(), newStoppedTimer()
+	defer linger.Stop()
+	defer backoff.Stop()
+	lingerExpired, backingOff, closing := false, false, false
+
+	for len(queue) > 0 || !closing {
+		if len(queue) > 0 && !backingOff && (closing || lingerExpired || producer.isBatchFull(queue)) {
+			var wait time.Duration
+			queue, wait = producer.sendBatch(queue)
+			lingerExpired = false
+			if wait > 0 {
+				backingOff = true
+				resetTimer(backoff, wait)
+			} else if len(queue) > 0 {
+				resetTimer(linger, *producer.config.Linger)
+			}
+			continue
+		}
+
+		select {
+		case item, ok := <-messages:
+			if !ok {
+				// a nil channel is never ready, which keeps the loop waiting on the timers
+				messages = nil
+				closing = true
+				continue
+			}
+
+			queue = append(queue, item.(*pendingMessage))
+			if len(queue) == 1 {
+				resetTimer(linger, *producer.config.Linger)
+			}
+		case <-linger.C:
+			lingerExpired = true
+		case <-backoff.C:
+			// the retried messages already waited for their batch to fill
+			backingOff, lingerExpired = false, true
+		}
+	}
+}
+
+// newStoppedTimer returns a timer which does not fire until it is reset
+func newStoppedTimer() *time.Timer {
+	timer := time.NewTimer(time.Hour)
+	stopTimer(timer)
+	return timer
+}
+
+// stopTimer stops a timer and drains its channel, so that a later reset does not see an earlier expiry
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+}
+
+// resetTimer restarts a timer, whether it fired or not
+func resetTimer(timer *time.Timer, d time.Duration) {
+	stopTimer(timer)
+	timer.Reset(d)
+}
+
+// isBatchFull returns true if the queue holds at least a full batch
+func (producer *Producer) isBatchFull(queue []*pendingMessage) bool {
+	if len(queue) >= *producer.config.MaxBatchMessages {
+		return true
+	}
+
+	size := 0
+	for _, message := range queue {
+		size += message.size
+		if size >= *producer.config.MaxBatchBytes {
+			return true
+		}
+	}
+	return false
+}
+
+// sendBatch sends the first messages of the queue in a single request and returns the queue without the messages
+// delivered or failed, the messages to retry first, and the wait before the retry
+func (producer *Producer) sendBatch(queue []*pendingMessage) ([]*pendingMessage, time.Duration) {
+	count, size := 0, 0
+	for count < len(queue) && count < *producer.config.MaxBatchMessages && size+queue[count].size <= *producer.config.MaxBatchBytes {
+		size += queue[count].size
+		count++
+	}
+	batch := queue[:count]
+
+	entries := make([]streaming.PutMessagesDetailsEntry, len(batch))
+	for i, message := range batch {
+		message.future.report.Attempts++
+		entries[i] = streaming.PutMessagesDetailsEntry{Key: message.future.report.Key, Value: message.future.report.Value}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *producer.config.RequestTimeout)
+	resp, err := producer.client.PutMessages(ctx, streaming.PutMessagesRequest{
+		StreamId:           producer.config.StreamId,
+		PutMessagesDetails: streaming.PutMessagesDetails{Messages: entries},
+		RequestMetadata:    producer.config.RequestMetadata,
+	})
+	cancel()
+	if err == nil && len(resp.Entries) != len(batch) {
+		err = fmt.Errorf("the service returned %d results for %d messages", len(resp.Entries), len(batch))
+	}
+
+	// the keys with a message retried, whose later messages in the batch are sent again after it
+	retriedKeys := make(map[string]bool)
+	var retries []*pendingMessage
+	for i, message := range batch {
+		messageErr := err
+		if err == nil && resp.Entries[i].Error != nil {
+			messageErr = entryError(resp.Entries[i])
+		}
+		key := message.future.report.Key
+		canRetry := message.future.report.Attempts < *producer.config.MaxAttempts
+
+		if messageErr == nil {
+			message.future.report.Partition = resp.Entries[i].Partition
+			message.future.report.Offset = resp.Entries[i].Offset
+			message.future.report.Timestamp = resp.Entries[i].Timestamp
+			if len(key) > 0 && retriedKeys[string(key)] && canRetry {
+				retries = append(retries, message)
+				continue
+			}
+			producer.complete(message, nil)
+			continue
+		}
+
+		if canRetry && (err == nil || worker.IsTransientError(err)) {
+			if len(key) > 0 {
+				retriedKeys[string(key)] = true
+			}
+			retries = append(retries, message)
+			continue
+		}
+		producer.complete(message, messageErr)
+	}
+
+	if len(retries) == 0 {
+		return queue[count:], 0
+	}
+	common.Debugf("%d messages failed to be appended to stream %s, retrying\n", len(retries), *producer.config.StreamId)
+	return append(retries, queue[count:]...), producer.retryBackoff(retries[0].future.report.Attempts)
+}
+
+// retryBackoff returns the wait before sending again a message sent attempts times, RetryBackoff doubled after
+// each retry until it reaches maxRetryBackoff
+func (producer *Producer) retryBackoff(attempts int) time.Duration {
+	backoff := *producer.config.RetryBackoff
+	for i := 1; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff && backoff > *producer.config.RetryBackoff {
+		return maxRetryBackoff
+	}
+	return backoff
+}
+
+// complete reports the outcome of the delivery of a message. The report is sent to Reports from the goroutine sending
+// the batches, which does not send any request until the report is received.
+func (producer *Producer) complete(message *pendingMessage, err error) {
+	message.future.report.Err = err
+	close(message.future.done)
+	if producer.config.Reports != nil {
+		producer.config.Reports <- message.future.report
+	}
+}
+
+// entryError returns the error of a message the service failed to append
+func entryError(entry streaming.PutMessagesResultEntry) error {
+	if entry.ErrorMessage != nil {
+		return fmt.Errorf("%s: %s", *entry.Error, *entry.ErrorMessage)
+	}
+	return errors.New(*entry.Error)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package producer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+// ProducerConfiguration defines the input parameters of NewProducer
+type ProducerConfiguration struct {
+	// The OCID of the stream to produce to.
+	StreamId *string `mandatory:"true"`
+
+	// [Optional] The maximum number of messages sent in a single PutMessages request. Defaults to 100.
+	MaxBatchMessages *int `mandatory:"false"`
+
+	// [Optional] The maximum size in bytes of a PutMessages request, keys and values are counted base64 encoded.
+	// Defaults to 1 MiB, which is the limit of the service.
+	MaxBatchBytes *int `mandatory:"false"`
+
+	// [Optional] How long a message waits for more messages to fill its batch before the batch is sent.
+	// Defaults to 100 milliseconds.
+	Linger *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum number of times a message is sent before its delivery fails. Defaults to 5.
+	MaxAttempts *int `mandatory:"false"`
+
+	// [Optional] The wait before the first retry of failed messages, doubled after each retry up to 30 seconds.
+	// Defaults to 100 milliseconds.
+	RetryBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The timeout of a PutMessages request, a request timing out is retried like a broken connection.
+	// Defaults to 60 seconds.
+	RequestTimeout *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum number of messages buffered before Send blocks. Defaults to 10,000.
+	MaxBufferedMessages *int `mandatory:"false"`
+
+	// [Optional] A channel receiving the delivery report of every message, in addition to its future. The producer
+	// stops sending messages while a report is not received, so the channel should be buffered and must be drained
+	// until Close returns.
+	Reports chan<- DeliveryReport `mandatory:"false"`
+
+	// [Optional] The retry policy of each PutMessages request. The messages the service failed to append are retried
+	// by the producer itself, up to MaxAttempts.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidStreamID      = errors.New("streamId is required")
+	errorInvalidBatchMessages = errors.New("maxBatchMessages must be greater than 0")
+	errorInvalidBatchBytes    = errors.New("maxBatchBytes must be between 1 and 1 MiB")
+	errorInvalidMaxAttempts   = errors.New("maxAttempts must be greater than 0")
+)
+
+const (
+	// maxRequestBytes is the limit of the service for a request, and for a single message
+	maxRequestBytes = 1024 * 1024
+
+	defaultMaxBatchMessages    = 100
+	defaultLinger              = 100 * time.Millisecond
+	defaultMaxAttempts         = 5
+	defaultRetryBackoff        = 100 * time.Millisecond
+	maxRetryBackoff            = 30 * time.Second
+	defaultRequestTimeout      = 60 * time.Second
+	defaultMaxBufferedMessages = 10000
+)
+
+func (config ProducerConfiguration) validate() error {
+	if config.StreamId == nil {
+		return errorInvalidStreamID
+	}
+
+	if config.MaxBatchMessages != nil && *config.MaxBatchMessages <= 0 {
+		return errorInvalidBatchMessages
+	}
+
+	if config.MaxBatchBytes != nil && (*config.MaxBatchBytes <= 0 || *config.MaxBatchBytes > maxRequestBytes) {
+		return errorInvalidBatchBytes
+	}
+
+	if config.MaxAttempts != nil && *config.MaxAttempts <= 0 {
+		return errorInvalidMaxAttempts
+	}
+
+	return nil
+}
+
+func (config *ProducerConfiguration) initDefaultValues() {
+	if config.MaxBatchMessages == nil {
+		config.MaxBatchMessages = common.Int(defaultMaxBatchMessages)
+	}
+
+	if config.MaxBatchBytes == nil {
+		config.MaxBatchBytes = common.Int(maxRequestBytes)
+	}
+
+	if config.Linger == nil || *config.Linger < 0 {
+		linger := defaultLinger
+		config.Linger = &linger
+	}
+
+	if config.MaxAttempts == nil {
+		config.MaxAttempts = common.Int(defaultMaxAttempts)
+	}
+
+	if config.RetryBackoff == nil || *config.RetryBackoff < 0 {
+		retryBackoff := defaultRetryBackoff
+		config.RetryBackoff = &retryBackoff
+	}
+
+	if config.RequestTimeout == nil || *config.RequestTimeout <= 0 {
+		requestTimeout := defaultRequestTimeout
+		config.RequestTimeout = &requestTimeout
+	}
+
+	if config.MaxBufferedMessages == nil || *config.MaxBufferedMessages < 0 {
+		config.MaxBufferedMessages = common.Int(defaultMaxBufferedMessages)
+	}
+}
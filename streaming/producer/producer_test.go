@@ -0,0 +1,223 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// fakeStreamServer appends the messages to a single partition, the messages with a value starting with "retry"
+// fail on their first attempt and the ones starting with "fail" always fail
+type fakeStreamServer struct {
+	mutex    sync.Mutex
+	appended []string
+	batches  []int
+	attempts map[string]int
+}
+
+func (server *fakeStreamServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	var details streaming.PutMessagesDetails
+	json.NewDecoder(r.Body).Decode(&details)
+	server.batches = append(server.batches, len(details.Messages))
+
+	result := map[string]interface{}{}
+	var entries []map[string]interface{}
+	failures := 0
+	if server.attempts == nil {
+		server.attempts = make(map[string]int)
+	}
+	for _, message := range details.Messages {
+		value := string(message.Value)
+		server.attempts[value]++
+		if strings.HasPrefix(value, "fail") || (strings.HasPrefix(value, "retry") && server.attempts[value] == 1) {
+			failures++
+			entries = append(entries, map[string]interface{}{"error": "429", "errorMessage": "throttled"})
+			continue
+		}
+
+		entries = append(entries, map[string]interface{}{"partition": "0", "offset": len(server.appended)})
+		server.appended = append(server.appended, value)
+	}
+	result["failures"] = failures
+	result["entries"] = entries
+	json.NewEncoder(w).Encode(result)
+}
+
+func TestProducer_RetriesFailedMessagesInOrder(t *testing.T) {
+	fake := &fakeStreamServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	retryBackoff := time.Millisecond
+	producer, err := NewProducer(streaming.StreamClient{BaseClient: server.BaseClient()}, ProducerConfiguration{
+		StreamId:         common.String("stream1"),
+		RetryBackoff:     &retryBackoff,
+		MaxBatchMessages: common.Int(3),
+		MaxAttempts:      common.Int(2),
+	})
+	assert.NoError(t, err)
+
+	var futures []*DeliveryFuture
+	for _, value := range []string{"a", "retry-b", "fail-c", "d", "e"} {
+		future, err := producer.Send(context.Background(), []byte("key"), []byte(value))
+		assert.NoError(t, err)
+		futures = append(futures, future)
+	}
+	assert.NoError(t, producer.Close(context.Background()))
+
+	report, err := futures[1].Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Attempts)
+	assert.Equal(t, "0", *report.Partition)
+
+	report, err = futures[2].Wait(context.Background())
+	assert.EqualError(t, err, "429: throttled")
+	assert.Equal(t, 2, report.Attempts)
+
+	// the retried messages are sent before the newer ones
+	assert.Equal(t, []string{"a", "retry-b", "d", "e"}, fake.appended)
+	assert.Equal(t, []int{3, 3, 1}, fake.batches)
+}
+
+func TestProducer_RetriesTheLaterMessagesOfAFailedKey(t *testing.T) {
+	fake := &fakeStreamServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	retryBackoff := time.Millisecond
+	producer, err := NewProducer(streaming.StreamClient{BaseClient: server.BaseClient()}, ProducerConfiguration{
+		StreamId:     common.String("stream1"),
+		RetryBackoff: &retryBackoff,
+	})
+	assert.NoError(t, err)
+
+	var futures []*DeliveryFuture
+	for _, message := range [][2]string{{"key1", "retry-a"}, {"key1", "b"}, {"key2", "c"}} {
+		future, err := producer.Send(context.Background(), []byte(message[0]), []byte(message[1]))
+		assert.NoError(t, err)
+		futures = append(futures, future)
+	}
+	assert.NoError(t, producer.Close(context.Background()))
+
+	// b is appended before the retried message of its key, and appended again after it
+	assert.Equal(t, []string{"b", "c", "retry-a", "b"}, fake.appended)
+	report, err := futures[1].Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Attempts)
+	assert.Equal(t, int64(3), *report.Offset)
+
+	report, err = futures[2].Wait(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Attempts)
+}
+
+func TestProducer_RequestTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+	requestTimeout, retryBackoff := 10*time.Millisecond, time.Millisecond
+	producer, err := NewProducer(streaming.StreamClient{BaseClient: server.BaseClient()}, ProducerConfiguration{
+		StreamId:       common.String("stream1"),
+		RetryBackoff:   &retryBackoff,
+		RequestTimeout: &requestTimeout,
+		MaxAttempts:    common.Int(2),
+	})
+	assert.NoError(t, err)
+
+	future, err := producer.Send(context.Background(), nil, []byte("a"))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, producer.Close(ctx))
+	report, err := future.Wait(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 2, report.Attempts)
+}
+
+func TestProducer_LingerAndReports(t *testing.T) {
+	fake := &fakeStreamServer{}
+	reports := make(chan DeliveryReport, 10)
+	linger := 10 * time.Millisecond
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	retryBackoff := time.Millisecond
+	producer, err := NewProducer(streaming.StreamClient{BaseClient: server.BaseClient()}, ProducerConfiguration{
+		StreamId:     common.String("stream1"),
+		RetryBackoff: &retryBackoff,
+		Linger:       &linger,
+		Reports:      reports,
+	})
+	assert.NoError(t, err)
+
+	future, err := producer.Send(context.Background(), nil, []byte("a"))
+	assert.NoError(t, err)
+
+	// sent once the linger time is over, without closing the producer
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	report, err := future.Wait(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), *report.Offset)
+	assert.Equal(t, "a", string((<-reports).Value))
+
+	assert.NoError(t, producer.Close(context.Background()))
+	_, err = producer.Send(context.Background(), nil, []byte("b"))
+	assert.Equal(t, errorProducerClosed, err)
+}
+
+func TestProducer_BatchesBySize(t *testing.T) {
+	fake := &fakeStreamServer{}
+	server := ocitest.NewServer(fake)
+	defer server.Close()
+	retryBackoff := time.Millisecond
+	producer, err := NewProducer(streaming.StreamClient{BaseClient: server.BaseClient()}, ProducerConfiguration{
+		StreamId:      common.String("stream1"),
+		RetryBackoff:  &retryBackoff,
+		MaxBatchBytes: common.Int(100),
+	})
+	assert.NoError(t, err)
+
+	_, err = producer.Send(context.Background(), nil, make([]byte, 100))
+	assert.Equal(t, errorMessageTooLarge, err)
+
+	// each message takes 32 bytes of overhead and 8 bytes of base64 encoded value
+	for i := 0; i < 5; i++ {
+		_, err = producer.Send(context.Background(), nil, []byte("12345"))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, producer.Close(context.Background()))
+	assert.Equal(t, []int{2, 2, 1}, fake.batches)
+}
+
+func TestProducer_RetryBackoff(t *testing.T) {
+	config := ProducerConfiguration{StreamId: common.String("stream1"), MaxAttempts: common.Int(100)}
+	config.initDefaultValues()
+	producer := &Producer{config: config}
+	assert.Equal(t, defaultRetryBackoff, producer.retryBackoff(1))
+	assert.Equal(t, 4*defaultRetryBackoff, producer.retryBackoff(3))
+	assert.Equal(t, maxRetryBackoff, producer.retryBackoff(64))
+	assert.Equal(t, maxRetryBackoff, producer.retryBackoff(100))
+
+	// a backoff above the maximum is not shortened
+	retryBackoff := time.Minute
+	producer.config.RetryBackoff = &retryBackoff
+	assert.Equal(t, time.Minute, producer.retryBackoff(10))
+}
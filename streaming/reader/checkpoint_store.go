@@ -0,0 +1,110 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CheckpointStore stores the offset of the last message handled in each partition of a stream
+type CheckpointStore interface {
+	// Load returns the offset stored for the partition, nil if there is none
+	Load(ctx context.Context, streamID, partition string) (*int64, error)
+
+	// Save stores the offset of the partition
+	Save(ctx context.Context, streamID, partition string, offset int64) error
+}
+
+// FileCheckpointStore stores the offsets of all the partitions in a local JSON file. The file is replaced
+// atomically on every save, it is never left half written.
+type FileCheckpointStore struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewFileCheckpointStore returns a checkpoint store in the file at path, the file is created on the first save
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load returns the offset stored for the partition, nil if there is none
+func (store *FileCheckpointStore) Load(ctx context.Context, streamID, partition string) (*int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	checkpoints, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+
+	offset, ok := checkpoints[streamID][partition]
+	if !ok {
+		return nil, nil
+	}
+	return &offset, nil
+}
+
+// Save stores the offset of the partition
+func (store *FileCheckpointStore) Save(ctx context.Context, streamID, partition string, offset int64) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	checkpoints, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	if checkpoints[streamID] == nil {
+		checkpoints[streamID] = make(map[string]int64)
+	}
+	checkpoints[streamID][partition] = offset
+
+	content, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	// write a temporary file next to the store and rename it over the store
+	file, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err = file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), store.path)
+}
+
+// read returns the offsets of the file by stream and partition
+func (store *FileCheckpointStore) read() (map[string]map[string]int64, error) {
+	checkpoints := make(map[string]map[string]int64)
+	content, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &checkpoints)
+	return checkpoints, err
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/nosql"
+)
+
+// The columns of the rows of a NoSqlCheckpointStore
+const (
+	nosqlColumnStreamID  = "streamId"
+	nosqlColumnPartition = "partition"
+	nosqlColumnOffset    = "offset"
+)
+
+// NoSqlCheckpointStore stores the offsets in a NoSQL table, one row per partition. The table must have the schema
+//
+//	CREATE TABLE <name> (streamId STRING, partition STRING, offset LONG, PRIMARY KEY(streamId, partition))
+type NoSqlCheckpointStore struct {
+	client        nosql.NosqlClient
+	tableNameOrID string
+	compartmentID *string
+}
+
+// NewNoSqlCheckpointStore returns a checkpoint store in the table. compartmentID is only required if the table is
+// given by name.
+func NewNoSqlCheckpointStore(client nosql.NosqlClient, tableNameOrID string, compartmentID *string) *NoSqlCheckpointStore {
+	return &NoSqlCheckpointStore{client: client, tableNameOrID: tableNameOrID, compartmentID: compartmentID}
+}
+
+// Load returns the offset stored for the partition, nil if there is none
+func (store *NoSqlCheckpointStore) Load(ctx context.Context, streamID, partition string) (*int64, error) {
+	request := nosql.GetRowRequest{
+		TableNameOrId: common.String(store.tableNameOrID),
+		Key:           []string{nosqlColumnStreamID + ":" + streamID, nosqlColumnPartition + ":" + partition},
+		CompartmentId: store.compartmentID,
+		Consistency:   nosql.GetRowConsistencyAbsolute,
+	}
+	httpRequest, err := request.HTTPRequest(http.MethodGet, "/tables/{tableNameOrId}/rows")
+	if err != nil {
+		return nil, err
+	}
+
+	// the row is decoded here rather than by GetRow, whose float64 numbers can not hold every offset
+	httpResponse, err := store.client.Call(ctx, &httpRequest)
+	defer common.CloseBodyIfValid(httpResponse)
+	if serviceError, ok := common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var row struct {
+		Value map[string]interface{} `json:"value"`
+	}
+	decoder := json.NewDecoder(httpResponse.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&row); err != nil {
+		return nil, err
+	}
+
+	value, ok := row.Value[nosqlColumnOffset]
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("invalid offset %v in table %s", value, store.tableNameOrID)
+	}
+
+	offset, err := strconv.ParseInt(number.String(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %v in table %s", value, store.tableNameOrID)
+	}
+	return &offset, nil
+}
+
+// Save stores the offset of the partition
+func (store *NoSqlCheckpointStore) Save(ctx context.Context, streamID, partition string, offset int64) error {
+	_, err := store.client.UpdateRow(ctx, nosql.UpdateRowRequest{
+		TableNameOrId: common.String(store.tableNameOrID),
+		UpdateRowDetails: nosql.UpdateRowDetails{
+			Value: map[string]interface{}{
+				nosqlColumnStreamID:  streamID,
+				nosqlColumnPartition: partition,
+				nosqlColumnOffset:    offset,
+			},
+			CompartmentId: store.compartmentID,
+		},
+	})
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package reader
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/objectstorage"
+)
+
+// ObjectStorageCheckpointStore stores the offset of each partition in its own object, named
+// <prefix><stream OCID>/<partition>, so that the partitions are saved independently.
+type ObjectStorageCheckpointStore struct {
+	client        objectstorage.ObjectStorageClient
+	namespaceName string
+	bucketName    string
+	prefix        string
+}
+
+// NewObjectStorageCheckpointStore returns a checkpoint store in the bucket, under the given object name prefix
+func NewObjectStorageCheckpointStore(client objectstorage.ObjectStorageClient, namespaceName, bucketName, prefix string) *ObjectStorageCheckpointStore {
+	return &ObjectStorageCheckpointStore{client: client, namespaceName: namespaceName, bucketName: bucketName, prefix: prefix}
+}
+
+// Load returns the offset stored for the partition, nil if there is none
+func (store *ObjectStorageCheckpointStore) Load(ctx context.Context, streamID, partition string) (*int64, error) {
+	resp, err := store.client.GetObject(ctx, objectstorage.GetObjectRequest{
+		NamespaceName: common.String(store.namespaceName),
+		BucketName:    common.String(store.bucketName),
+		ObjectName:    common.String(store.objectName(streamID, partition)),
+	})
+	if serviceError, ok := common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Content.Close()
+
+	content, err := ioutil.ReadAll(resp.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &offset, nil
+}
+
+// Save stores the offset of the partition
+func (store *ObjectStorageCheckpointStore) Save(ctx context.Context, streamID, partition string, offset int64) error {
+	content := strconv.FormatInt(offset, 10)
+	_, err := store.client.PutObject(ctx, objectstorage.PutObjectRequest{
+		NamespaceName: common.String(store.namespaceName),
+		BucketName:    common.String(store.bucketName),
+		ObjectName:    common.String(store.objectName(streamID, partition)),
+		ContentLength: common.Int64(int64(len(content))),
+		PutObjectBody: ioutil.NopCloser(strings.NewReader(content)),
+	})
+	return err
+}
+
+func (store *ObjectStorageCheckpointStore) objectName(streamID, partition string) string {
+	return store.prefix + streamID + "/" + partition
+}
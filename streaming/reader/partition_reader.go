@@ -0,0 +1,247 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+// Package reader reads the partitions of a stream of the Streaming service without consumer group. The
+// PartitionReader reads every partition from a given position, and saves the offset of the messages handled in a
+// CheckpointStore, so that a job can be stopped and resumed exactly where it left off, or replayed from any offset.
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/internal/worker"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// Handler processes a batch of messages of a partition. The offset of the last message is saved when it returns
+// nil, the reader stops when it returns an error.
+type Handler func(ctx context.Context, partition string, messages []streaming.Message) error
+
+// PartitionReader reads the partitions of a stream concurrently, each of them in order
+type PartitionReader struct {
+	adminClient streaming.StreamAdminClient
+	client      streaming.StreamClient
+	config      PartitionReaderConfiguration
+}
+
+// NewPartitionReader returns a reader of the stream described by config. client must be created for the messages
+// endpoint of the stream, adminClient is only used to get the number of partitions when config does not list them.
+func NewPartitionReader(adminClient streaming.StreamAdminClient, client streaming.StreamClient, config PartitionReaderConfiguration) (*PartitionReader, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	config.initDefaultValues()
+	return &PartitionReader{adminClient: adminClient, client: client, config: config}, nil
+}
+
+// Run reads all the partitions until ctx is done, or until they are caught up if StopWhenCaughtUp is set. It returns
+// the first error the reader can not recover from, which stops the reading of all the partitions.
+func (reader *PartitionReader) Run(ctx context.Context, handler Handler) error {
+	partitions, err := reader.partitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, partition := range partitions {
+		wg.Add(1)
+		go func(partition string) {
+			defer wg.Done()
+			if err := reader.readPartition(ctx, partition, handler); err != nil {
+				once.Do(func() {
+					common.Debugf("reading partition %s of stream %s failed with error: %v\n", partition, *reader.config.StreamId, err)
+					firstErr = err
+					cancel()
+				})
+			}
+		}(partition)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// partitions returns the partitions of the configuration, or all the partitions of the stream
+func (reader *PartitionReader) partitions(ctx context.Context) ([]string, error) {
+	if len(reader.config.Partitions) > 0 {
+		return reader.config.Partitions, nil
+	}
+
+	resp, err := reader.adminClient.GetStream(ctx, streaming.GetStreamRequest{
+		StreamId:        reader.config.StreamId,
+		RequestMetadata: reader.config.RequestMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Partitions == nil {
+		return nil, fmt.Errorf("the number of partitions of stream %s is unknown", *reader.config.StreamId)
+	}
+
+	partitions := make([]string, *resp.Partitions)
+	for i := range partitions {
+		partitions[i] = strconv.Itoa(i)
+	}
+	return partitions, nil
+}
+
+// readPartition reads a partition from its checkpoint, or from the configured position
+func (reader *PartitionReader) readPartition(ctx context.Context, partition string, handler Handler) error {
+	var lastOffset *int64
+	if reader.config.CheckpointStore != nil {
+		offset, err := reader.config.CheckpointStore.Load(ctx, *reader.config.StreamId, partition)
+		if err != nil {
+			return err
+		}
+		lastOffset = offset
+	}
+
+	backoff := &backoff{min: *reader.config.MinBackoff, max: *reader.config.MaxBackoff, next: *reader.config.MinBackoff}
+	var cursor *string
+	for ctx.Err() == nil {
+		isNewCursor := cursor == nil
+		if isNewCursor {
+			var err error
+			if cursor, err = reader.createCursor(ctx, partition, lastOffset); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				if !worker.IsTransientError(err) {
+					return err
+				}
+				backoff.wait(ctx)
+				continue
+			}
+		}
+
+		resp, err := reader.client.GetMessages(ctx, streaming.GetMessagesRequest{
+			StreamId:        reader.config.StreamId,
+			Cursor:          cursor,
+			Limit:           reader.config.Limit,
+			RequestMetadata: reader.config.RequestMetadata,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if isInvalidCursorError(err) && !isNewCursor {
+				// the cursor expired, start again after the last message handled
+				cursor = nil
+				continue
+			}
+
+			if !worker.IsTransientError(err) {
+				return err
+			}
+
+			common.Debugf("reading partition %s of stream %s failed with error: %v, backing off\n", partition, *reader.config.StreamId, err)
+			backoff.wait(ctx)
+			continue
+		}
+		cursor = resp.OpcNextCursor
+
+		if len(resp.Items) == 0 {
+			if *reader.config.StopWhenCaughtUp {
+				return nil
+			}
+			backoff.wait(ctx)
+			continue
+		}
+		backoff.reset()
+
+		if err = handler(ctx, partition, resp.Items); err != nil {
+			return err
+		}
+
+		lastOffset = resp.Items[len(resp.Items)-1].Offset
+		if reader.config.CheckpointStore != nil {
+			if err = reader.config.CheckpointStore.Save(ctx, *reader.config.StreamId, partition, *lastOffset); err != nil {
+				if ctx.Err() != nil {
+					// stopped after the batch was handled, it is handled again when the reader resumes
+					return nil
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createCursor returns a cursor after the last offset handled, or at the configured position
+func (reader *PartitionReader) createCursor(ctx context.Context, partition string, lastOffset *int64) (*string, error) {
+	details := streaming.CreateCursorDetails{
+		Partition: common.String(partition),
+		Type:      reader.config.Type,
+		Time:      reader.config.Time,
+	}
+
+	switch {
+	case lastOffset != nil:
+		details.Type = streaming.CreateCursorDetailsTypeAfterOffset
+		details.Offset = lastOffset
+		details.Time = nil
+	case reader.config.Type == streaming.CreateCursorDetailsTypeAtOffset || reader.config.Type == streaming.CreateCursorDetailsTypeAfterOffset:
+		offset, ok := reader.config.Offsets[partition]
+		if !ok {
+			return nil, fmt.Errorf("no offset to start partition %s from", partition)
+		}
+		details.Offset = common.Int64(offset)
+	}
+
+	resp, err := reader.client.CreateCursor(ctx, streaming.CreateCursorRequest{
+		StreamId:            reader.config.StreamId,
+		CreateCursorDetails: details,
+		RequestMetadata:     reader.config.RequestMetadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// backoff is the exponential wait after empty reads and transient errors
+type backoff struct {
+	min, max time.Duration
+	next     time.Duration
+}
+
+func (b *backoff) reset() {
+	b.next = b.min
+}
+
+// wait blocks for the current backoff, or until ctx is done, and doubles the next one
+func (b *backoff) wait(ctx context.Context) {
+	timer := time.NewTimer(b.next)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	b.next *= 2
+	if b.next > b.max {
+		b.next = b.max
+	}
+}
+
+// isInvalidCursorError returns true if the cursor expired
+func isInvalidCursorError(err error) bool {
+	serviceError, ok := common.IsServiceError(err)
+	return ok && serviceError.GetHTTPStatusCode() == http.StatusBadRequest
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package reader
+
+import (
+	"errors"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// PartitionReaderConfiguration defines the input parameters of NewPartitionReader
+type PartitionReaderConfiguration struct {
+	// The OCID of the stream to read.
+	StreamId *string `mandatory:"true"`
+
+	// [Optional] The partitions to read. Defaults to all the partitions of the stream.
+	Partitions []string `mandatory:"false"`
+
+	// [Optional] Where the partitions without checkpoint start: TRIM_HORIZON, LATEST, AT_TIME, AT_OFFSET or
+	// AFTER_OFFSET. Defaults to TRIM_HORIZON.
+	Type streaming.CreateCursorDetailsTypeEnum `mandatory:"false"`
+
+	// [Optional] The offset of each partition to start from, required for the AT_OFFSET and AFTER_OFFSET types.
+	Offsets map[string]int64 `mandatory:"false"`
+
+	// [Optional] The time to start from, required for the AT_TIME type.
+	Time *common.SDKTime `mandatory:"false"`
+
+	// [Optional] The store of the offsets of the messages handled. The partitions with a checkpoint resume after
+	// their offset, whatever the type. The offsets are not saved if not set.
+	CheckpointStore CheckpointStore `mandatory:"false"`
+
+	// [Optional] Whether or not a partition stops being read once it is caught up, that is when a read returns no
+	// message. Run returns once all the partitions are caught up. Defaults to False.
+	StopWhenCaughtUp *bool `mandatory:"false"`
+
+	// [Optional] The maximum number of messages delivered to the handler at once. Defaults to 100, the max is 10,000.
+	Limit *int `mandatory:"false"`
+
+	// [Optional] The first wait after an empty read or a transient error, doubled after each consecutive one.
+	// Defaults to 500 milliseconds.
+	MinBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The maximum wait after empty reads or transient errors. Defaults to 10 seconds.
+	MaxBackoff *time.Duration `mandatory:"false"`
+
+	// [Optional] The retry policy of the GetStream, CreateCursor and GetMessages requests. The transient errors left
+	// once the policy gave up are waited out with MinBackoff and MaxBackoff.
+	RequestMetadata common.RequestMetadata
+}
+
+var (
+	errorInvalidStreamID = errors.New("streamId is required")
+	errorInvalidLimit    = errors.New("limit must be between 1 and 10000")
+	errorInvalidTime     = errors.New("time is required for the AT_TIME type")
+	errorInvalidBackoff  = errors.New("minBackoff must be greater than 0 and not greater than maxBackoff")
+)
+
+const (
+	defaultLimit      = 100
+	maxLimit          = 10000
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+func (config PartitionReaderConfiguration) validate() error {
+	if config.StreamId == nil {
+		return errorInvalidStreamID
+	}
+
+	if config.Limit != nil && (*config.Limit <= 0 || *config.Limit > maxLimit) {
+		return errorInvalidLimit
+	}
+
+	if config.Type == streaming.CreateCursorDetailsTypeAtTime && config.Time == nil {
+		return errorInvalidTime
+	}
+
+	if config.MinBackoff != nil && *config.MinBackoff <= 0 {
+		return errorInvalidBackoff
+	}
+
+	if config.MinBackoff != nil && config.MaxBackoff != nil && *config.MinBackoff > *config.MaxBackoff {
+		return errorInvalidBackoff
+	}
+
+	return nil
+}
+
+func (config *PartitionReaderConfiguration) initDefaultValues() {
+	if len(config.Type) == 0 {
+		config.Type = streaming.CreateCursorDetailsTypeTrimHorizon
+	}
+
+	if config.StopWhenCaughtUp == nil {
+		config.StopWhenCaughtUp = common.Bool(false)
+	}
+
+	if config.Limit == nil {
+		config.Limit = common.Int(defaultLimit)
+	}
+
+	if config.MinBackoff == nil {
+		minBackoff := defaultMinBackoff
+		config.MinBackoff = &minBackoff
+	}
+
+	if config.MaxBackoff == nil {
+		maxBackoff := defaultMaxBackoff
+		if maxBackoff < *config.MinBackoff {
+			maxBackoff = *config.MinBackoff
+		}
+		config.MaxBackoff = &maxBackoff
+	}
+}
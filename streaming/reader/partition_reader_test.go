@@ -0,0 +1,210 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+
+package reader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/oci-go-sdk/v27/common"
+	"github.com/oracle/oci-go-sdk/v27/nosql"
+	"github.com/oracle/oci-go-sdk/v27/ocitest"
+	"github.com/oracle/oci-go-sdk/v27/streaming"
+)
+
+// servePartitions serves a stream of two partitions of 5 messages each, the cursors are <partition>:<next offset>
+func servePartitions(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/streams/stream1":
+		fmt.Fprint(w, `{"id":"stream1","partitions":2}`)
+	case strings.HasSuffix(r.URL.Path, "/cursors"):
+		var details streaming.CreateCursorDetails
+		json.NewDecoder(r.Body).Decode(&details)
+		offset := int64(0)
+		switch details.Type {
+		case streaming.CreateCursorDetailsTypeAfterOffset:
+			offset = *details.Offset + 1
+		case streaming.CreateCursorDetailsTypeAtOffset:
+			offset = *details.Offset
+		}
+		fmt.Fprintf(w, `{"value":"%s:%d"}`, *details.Partition, offset)
+	case strings.HasSuffix(r.URL.Path, "/messages"):
+		cursor := strings.Split(r.URL.Query().Get("cursor"), ":")
+		offset, _ := strconv.Atoi(cursor[1])
+		items := []map[string]interface{}{}
+		for i := offset; i < 5 && len(items) < 2; i++ {
+			items = append(items, map[string]interface{}{"stream": "stream1", "partition": cursor[0], "offset": i, "value": []byte(cursor[0] + strconv.Itoa(i))})
+		}
+		w.Header().Set("opc-next-cursor", fmt.Sprintf("%s:%d", cursor[0], offset+len(items)))
+		json.NewEncoder(w).Encode(items)
+	}
+}
+
+// recorder records the values of the messages handled
+type recorder struct {
+	mutex  sync.Mutex
+	values map[string][]string
+}
+
+func (recorder *recorder) handle(failAt string) Handler {
+	return func(ctx context.Context, partition string, messages []streaming.Message) error {
+		recorder.mutex.Lock()
+		defer recorder.mutex.Unlock()
+		for _, message := range messages {
+			if string(message.Value) == failAt {
+				return errors.New("handler failed")
+			}
+		}
+
+		for _, message := range messages {
+			recorder.values[partition] = append(recorder.values[partition], string(message.Value))
+		}
+		return nil
+	}
+}
+
+func TestPartitionReader_ResumesFromCheckpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := NewFileCheckpointStore(filepath.Join(dir, "checkpoints.json"))
+
+	server := ocitest.NewServer(http.HandlerFunc(servePartitions))
+	defer server.Close()
+	adminClient := streaming.StreamAdminClient{BaseClient: server.BaseClient()}
+	client := streaming.StreamClient{BaseClient: server.BaseClient()}
+	reader, err := NewPartitionReader(adminClient, client, PartitionReaderConfiguration{
+		StreamId:         common.String("stream1"),
+		StopWhenCaughtUp: common.Bool(true),
+		CheckpointStore:  store,
+	})
+	assert.NoError(t, err)
+
+	// the first run stops on the failure of the second batch of partition 1
+	first := &recorder{values: make(map[string][]string)}
+	err = reader.Run(context.Background(), first.handle("12"))
+	assert.EqualError(t, err, "handler failed")
+	assert.Equal(t, []string{"10", "11"}, first.values["1"])
+
+	offset, err := store.Load(context.Background(), "stream1", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), *offset)
+
+	// the second run resumes after the last message handled in each partition
+	second := &recorder{values: make(map[string][]string)}
+	assert.NoError(t, reader.Run(context.Background(), second.handle("")))
+	assert.Equal(t, []string{"12", "13", "14"}, second.values["1"])
+	assert.Equal(t, append(first.values["0"], second.values["0"]...), []string{"00", "01", "02", "03", "04"})
+
+	offset, err = store.Load(context.Background(), "stream1", "0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), *offset)
+}
+
+func TestPartitionReader_StartsAtOffsets(t *testing.T) {
+	server := ocitest.NewServer(http.HandlerFunc(servePartitions))
+	defer server.Close()
+	adminClient := streaming.StreamAdminClient{BaseClient: server.BaseClient()}
+	client := streaming.StreamClient{BaseClient: server.BaseClient()}
+	reader, err := NewPartitionReader(adminClient, client, PartitionReaderConfiguration{
+		StreamId:         common.String("stream1"),
+		StopWhenCaughtUp: common.Bool(true),
+		Partitions:       []string{"1"},
+		Type:             streaming.CreateCursorDetailsTypeAtOffset,
+		Offsets:          map[string]int64{"1": 3},
+	})
+	assert.NoError(t, err)
+
+	recorder := &recorder{values: make(map[string][]string)}
+	assert.NoError(t, reader.Run(context.Background(), recorder.handle("")))
+	assert.Equal(t, map[string][]string{"1": {"13", "14"}}, recorder.values)
+}
+
+// cancellingStore cancels the run when it saves an offset, before failing the save like a canceled request
+type cancellingStore struct {
+	cancel context.CancelFunc
+}
+
+func (store cancellingStore) Load(ctx context.Context, streamID, partition string) (*int64, error) {
+	return nil, nil
+}
+
+func (store cancellingStore) Save(ctx context.Context, streamID, partition string, offset int64) error {
+	store.cancel()
+	return ctx.Err()
+}
+
+func TestPartitionReader_StopsCleanlyWhenCanceledAfterTheHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := ocitest.NewServer(http.HandlerFunc(servePartitions))
+	defer server.Close()
+	adminClient := streaming.StreamAdminClient{BaseClient: server.BaseClient()}
+	client := streaming.StreamClient{BaseClient: server.BaseClient()}
+	reader, err := NewPartitionReader(adminClient, client, PartitionReaderConfiguration{
+		StreamId:         common.String("stream1"),
+		StopWhenCaughtUp: common.Bool(true),
+		Partitions:       []string{"0"},
+		CheckpointStore:  cancellingStore{cancel: cancel},
+	})
+	assert.NoError(t, err)
+
+	recorder := &recorder{values: make(map[string][]string)}
+	assert.NoError(t, reader.Run(ctx, recorder.handle("")))
+	assert.Equal(t, []string{"00", "01"}, recorder.values["0"])
+}
+
+func TestNoSqlCheckpointStore_Load(t *testing.T) {
+	server := ocitest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(strings.Join(r.URL.Query()["key"], ","), "partition:1") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"NotAuthorizedOrNotFound","message":"row not found"}`)
+			return
+		}
+		// an offset above 2^53, which a float64 rounds
+		fmt.Fprint(w, `{"value":{"streamId":"stream1","partition":"0","offset":9007199254740993}}`)
+	}))
+	defer server.Close()
+
+	store := NewNoSqlCheckpointStore(nosql.NosqlClient{BaseClient: server.BaseClient()}, "checkpoints", common.String("compartment1"))
+	offset, err := store.Load(context.Background(), "stream1", "0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), *offset)
+
+	offset, err = store.Load(context.Background(), "stream1", "1")
+	assert.NoError(t, err)
+	assert.Nil(t, offset)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoints")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFileCheckpointStore(filepath.Join(dir, "checkpoints.json"))
+	offset, err := store.Load(context.Background(), "stream1", "0")
+	assert.NoError(t, err)
+	assert.Nil(t, offset)
+
+	assert.NoError(t, store.Save(context.Background(), "stream1", "0", 42))
+	assert.NoError(t, store.Save(context.Background(), "stream2", "0", 7))
+	offset, err = NewFileCheckpointStore(filepath.Join(dir, "checkpoints.json")).Load(context.Background(), "stream1", "0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), *offset)
+
+	files, _ := ioutil.ReadDir(dir)
+	assert.Len(t, files, 1)
+}
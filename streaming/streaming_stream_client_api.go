@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package streaming
+
+import (
+	"context"
+)
+
+// StreamClientAPI is the interface of the operations of StreamClient, to inject the client and mock it in tests
+type StreamClientAPI interface {
+	// ConsumerCommit Provides a mechanism to manually commit offsets, if not using commit-on-get consumer semantics.
+	// This commits offsets assicated with the provided cursor, extends the timeout on each of the affected partitions, and returns an updated cursor.
+	ConsumerCommit(ctx context.Context, request ConsumerCommitRequest) (response ConsumerCommitResponse, err error)
+
+	// ConsumerHeartbeat Allows long-running processes to extend the timeout on partitions reserved by a consumer instance.
+	ConsumerHeartbeat(ctx context.Context, request ConsumerHeartbeatRequest) (response ConsumerHeartbeatResponse, err error)
+
+	// CreateCursor Creates a cursor. Cursors are used to consume a stream, starting from a specific point in the partition and going forward from there.
+	// You can create a cursor based on an offset, a time, the trim horizon, or the most recent message in the stream. As the oldest message
+	// inside the retention period boundary, using the trim horizon effectively lets you consume all messages in the stream. A cursor based
+	// on the most recent message allows consumption of only messages that are added to the stream after you create the cursor. Cursors expire
+	// five minutes after you receive them from the service.
+	CreateCursor(ctx context.Context, request CreateCursorRequest) (response CreateCursorResponse, err error)
+
+	// CreateGroupCursor Creates a group-cursor.
+	CreateGroupCursor(ctx context.Context, request CreateGroupCursorRequest) (response CreateGroupCursorResponse, err error)
+
+	// GetGroup Returns the current state of a consumer group.
+	GetGroup(ctx context.Context, request GetGroupRequest) (response GetGroupResponse, err error)
+
+	// GetMessages Returns messages from the specified stream using the specified cursor as the starting point for consumption. By default, the number of messages returned is undefined, but the service returns as many as possible.
+	// To get messages, you must first obtain a cursor using the CreateCursor operation.
+	// In the response, retrieve the value of the 'opc-next-cursor' header to pass as a parameter to get the next batch of messages in the stream.
+	GetMessages(ctx context.Context, request GetMessagesRequest) (response GetMessagesResponse, err error)
+
+	// PutMessages Emits messages to a stream. There's no limit to the number of messages in a request, but the total size of a message or request must be 1 MiB or less.
+	// The service calculates the partition ID from the message key and stores messages that share a key on the same partition.
+	// If a message does not contain a key or if the key is null, the service generates a message key for you.
+	// The partition ID cannot be passed as a parameter.
+	PutMessages(ctx context.Context, request PutMessagesRequest) (response PutMessagesResponse, err error)
+
+	// UpdateGroup Forcefully changes the current location of a group as a whole; reseting processing location of all consumers to a particular location in the stream.
+	UpdateGroup(ctx context.Context, request UpdateGroupRequest) (response UpdateGroupResponse, err error)
+}
+
+// StreamClient implements StreamClientAPI
+var _ StreamClientAPI = StreamClient{}
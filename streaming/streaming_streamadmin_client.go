@@ -255,10 +255,12 @@ func (client StreamAdminClient) CreateConnectHarness(ctx context.Context, reques
 				response = CreateConnectHarnessResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateConnectHarnessResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateConnectHarnessResponse")
 	}
@@ -366,10 +368,12 @@ func (client StreamAdminClient) CreateStreamPool(ctx context.Context, request Cr
 				response = CreateStreamPoolResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateStreamPoolResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateStreamPoolResponse")
 	}
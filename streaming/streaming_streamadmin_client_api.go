@@ -0,0 +1,90 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package streaming
+
+import (
+	"context"
+)
+
+// StreamAdminClientAPI is the interface of the operations of StreamAdminClient, to inject the client and mock it in tests
+type StreamAdminClientAPI interface {
+	// ChangeConnectHarnessCompartment Moves a resource into a different compartment. When provided, If-Match is checked against ETag values of the resource.
+	ChangeConnectHarnessCompartment(ctx context.Context, request ChangeConnectHarnessCompartmentRequest) (response ChangeConnectHarnessCompartmentResponse, err error)
+
+	// ChangeStreamCompartment Moves a resource into a different compartment.
+	// When provided, If-Match is checked against ETag values of the resource.
+	// The stream will also be moved into the default stream pool in the destination compartment.
+	ChangeStreamCompartment(ctx context.Context, request ChangeStreamCompartmentRequest) (response ChangeStreamCompartmentResponse, err error)
+
+	// ChangeStreamPoolCompartment Moves a resource into a different compartment. When provided, If-Match is checked against ETag values of the resource.
+	ChangeStreamPoolCompartment(ctx context.Context, request ChangeStreamPoolCompartmentRequest) (response ChangeStreamPoolCompartmentResponse, err error)
+
+	// CreateConnectHarness Starts the provisioning of a new connect harness.
+	// To track the progress of the provisioning, you can periodically call ConnectHarness object tells you its current state.
+	CreateConnectHarness(ctx context.Context, request CreateConnectHarnessRequest) (response CreateConnectHarnessResponse, err error)
+
+	// CreateStream Starts the provisioning of a new stream.
+	// The stream will be created in the given compartment id or stream pool id, depending on which parameter is specified.
+	// Compartment id and stream pool id cannot be specified at the same time.
+	// To track the progress of the provisioning, you can periodically call GetStream.
+	// In the response, the `lifecycleState` parameter of the Stream object tells you its current state.
+	CreateStream(ctx context.Context, request CreateStreamRequest) (response CreateStreamResponse, err error)
+
+	// CreateStreamPool Starts the provisioning of a new stream pool.
+	// To track the progress of the provisioning, you can periodically call GetStreamPool.
+	// In the response, the `lifecycleState` parameter of the object tells you its current state.
+	CreateStreamPool(ctx context.Context, request CreateStreamPoolRequest) (response CreateStreamPoolResponse, err error)
+
+	// DeleteConnectHarness Deletes a connect harness and its content. Connect harness contents are deleted immediately. The service retains records of the connect harness itself for 90 days after deletion.
+	// The `lifecycleState` parameter of the `ConnectHarness` object changes to `DELETING` and the connect harness becomes inaccessible for read or write operations.
+	// To verify that a connect harness has been deleted, make a GetConnectHarness request. If the call returns the connect harness's
+	// lifecycle state as `DELETED`, then the connect harness has been deleted. If the call returns a "404 Not Found" error, that means all records of the
+	// connect harness have been deleted.
+	DeleteConnectHarness(ctx context.Context, request DeleteConnectHarnessRequest) (response DeleteConnectHarnessResponse, err error)
+
+	// DeleteStream Deletes a stream and its content. Stream contents are deleted immediately. The service retains records of the stream itself for 90 days after deletion.
+	// The `lifecycleState` parameter of the `Stream` object changes to `DELETING` and the stream becomes inaccessible for read or write operations.
+	// To verify that a stream has been deleted, make a GetStream request. If the call returns the stream's
+	// lifecycle state as `DELETED`, then the stream has been deleted. If the call returns a "404 Not Found" error, that means all records of the
+	// stream have been deleted.
+	DeleteStream(ctx context.Context, request DeleteStreamRequest) (response DeleteStreamResponse, err error)
+
+	// DeleteStreamPool Deletes a stream pool. All containing streams will also be deleted.
+	// The default stream pool of a compartment cannot be deleted.
+	DeleteStreamPool(ctx context.Context, request DeleteStreamPoolRequest) (response DeleteStreamPoolResponse, err error)
+
+	// GetConnectHarness Gets detailed information about a connect harness.
+	GetConnectHarness(ctx context.Context, request GetConnectHarnessRequest) (response GetConnectHarnessResponse, err error)
+
+	// GetStream Gets detailed information about a stream, including the number of partitions.
+	GetStream(ctx context.Context, request GetStreamRequest) (response GetStreamResponse, err error)
+
+	// GetStreamPool Gets detailed information about the stream pool, such as Kafka settings.
+	GetStreamPool(ctx context.Context, request GetStreamPoolRequest) (response GetStreamPoolResponse, err error)
+
+	// ListConnectHarnesses Lists the connectharness.
+	ListConnectHarnesses(ctx context.Context, request ListConnectHarnessesRequest) (response ListConnectHarnessesResponse, err error)
+
+	// ListStreamPools List the stream pools for a given compartment ID.
+	ListStreamPools(ctx context.Context, request ListStreamPoolsRequest) (response ListStreamPoolsResponse, err error)
+
+	// ListStreams Lists the streams in the given compartment id.
+	// If the compartment id is specified, it will list streams in the compartment, regardless of their stream pool.
+	// If the stream pool id is specified, the action will be scoped to that stream pool.
+	// The compartment id and stream pool id cannot be specified at the same time.
+	ListStreams(ctx context.Context, request ListStreamsRequest) (response ListStreamsResponse, err error)
+
+	// UpdateConnectHarness Updates the tags applied to the connect harness.
+	UpdateConnectHarness(ctx context.Context, request UpdateConnectHarnessRequest) (response UpdateConnectHarnessResponse, err error)
+
+	// UpdateStream Updates the stream. Only specified values will be updated.
+	UpdateStream(ctx context.Context, request UpdateStreamRequest) (response UpdateStreamResponse, err error)
+
+	// UpdateStreamPool Updates the specified stream pool.
+	UpdateStreamPool(ctx context.Context, request UpdateStreamPoolRequest) (response UpdateStreamPoolResponse, err error)
+}
+
+// StreamAdminClient implements StreamAdminClientAPI
+var _ StreamAdminClientAPI = StreamAdminClient{}
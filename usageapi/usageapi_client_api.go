@@ -0,0 +1,21 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package usageapi
+
+import (
+	"context"
+)
+
+// UsageapiClientAPI is the interface of the operations of UsageapiClient, to inject the client and mock it in tests
+type UsageapiClientAPI interface {
+	// RequestSummarizedConfigurations Returns the list of config for UI dropdown list
+	RequestSummarizedConfigurations(ctx context.Context, request RequestSummarizedConfigurationsRequest) (response RequestSummarizedConfigurationsResponse, err error)
+
+	// RequestSummarizedUsages Returns the usage for the given account
+	RequestSummarizedUsages(ctx context.Context, request RequestSummarizedUsagesRequest) (response RequestSummarizedUsagesResponse, err error)
+}
+
+// UsageapiClient implements UsageapiClientAPI
+var _ UsageapiClientAPI = UsageapiClient{}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package vault
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*SecretContentDetails)(nil), func() common.PolymorphicJSONUnmarshaler { return &secretcontentdetails{} })
+	common.RegisterPolymorphicModel((*SecretRule)(nil), func() common.PolymorphicJSONUnmarshaler { return &secretrule{} })
+}
+
+// MarshalJSON marshals a SecretContentDetails whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m secretcontentdetails) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesecretcontentdetails secretcontentdetails
+	s := struct {
+		MarshalTypesecretcontentdetails
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesecretcontentdetails: (MarshalTypesecretcontentdetails)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a SecretRule whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m secretrule) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypesecretrule secretrule
+	s := struct {
+		MarshalTypesecretrule
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypesecretrule: (MarshalTypesecretrule)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -206,10 +206,12 @@ func (client VaultsClient) ChangeSecretCompartment(ctx context.Context, request
 				response = ChangeSecretCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeSecretCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeSecretCompartmentResponse")
 	}
@@ -262,10 +264,12 @@ func (client VaultsClient) CreateSecret(ctx context.Context, request CreateSecre
 				response = CreateSecretResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateSecretResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateSecretResponse")
 	}
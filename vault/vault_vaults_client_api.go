@@ -0,0 +1,59 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package vault
+
+import (
+	"context"
+)
+
+// VaultsClientAPI is the interface of the operations of VaultsClient, to inject the client and mock it in tests
+type VaultsClientAPI interface {
+	// CancelSecretDeletion Cancels the pending deletion of the specified secret. Canceling
+	// a scheduled deletion restores the secret's lifecycle state to what
+	// it was before you scheduled the secret for deletion.
+	CancelSecretDeletion(ctx context.Context, request CancelSecretDeletionRequest) (response CancelSecretDeletionResponse, err error)
+
+	// CancelSecretVersionDeletion Cancels the scheduled deletion of a secret version.
+	CancelSecretVersionDeletion(ctx context.Context, request CancelSecretVersionDeletionRequest) (response CancelSecretVersionDeletionResponse, err error)
+
+	// ChangeSecretCompartment Moves a secret into a different compartment within the same tenancy. For information about
+	// moving resources between compartments, see Moving Resources to a Different Compartment (https://docs.cloud.oracle.com/iaas/Content/Identity/Tasks/managingcompartments.htm#moveRes).
+	// When provided, if-match is checked against the ETag values of the secret.
+	ChangeSecretCompartment(ctx context.Context, request ChangeSecretCompartmentRequest) (response ChangeSecretCompartmentResponse, err error)
+
+	// CreateSecret Creates a new secret according to the details of the request.
+	// This operation is not supported by the Oracle Cloud Infrastructure Terraform Provider.
+	CreateSecret(ctx context.Context, request CreateSecretRequest) (response CreateSecretResponse, err error)
+
+	// GetSecret Gets information about the specified secret.
+	GetSecret(ctx context.Context, request GetSecretRequest) (response GetSecretResponse, err error)
+
+	// GetSecretVersion Gets information about the specified version of a secret.
+	GetSecretVersion(ctx context.Context, request GetSecretVersionRequest) (response GetSecretVersionResponse, err error)
+
+	// ListSecretVersions Lists all secret versions for the specified secret.
+	ListSecretVersions(ctx context.Context, request ListSecretVersionsRequest) (response ListSecretVersionsResponse, err error)
+
+	// ListSecrets Lists all secrets in the specified vault and compartment.
+	ListSecrets(ctx context.Context, request ListSecretsRequest) (response ListSecretsResponse, err error)
+
+	// ScheduleSecretDeletion Schedules the deletion of the specified secret. This sets the lifecycle state of the secret
+	// to `PENDING_DELETION` and then deletes it after the specified retention period ends.
+	ScheduleSecretDeletion(ctx context.Context, request ScheduleSecretDeletionRequest) (response ScheduleSecretDeletionResponse, err error)
+
+	// ScheduleSecretVersionDeletion Schedules the deletion of the specified secret version. This deletes it after the specified retention period ends. You can only
+	// delete a secret version if the secret version rotation state is marked as `DEPRECATED`.
+	ScheduleSecretVersionDeletion(ctx context.Context, request ScheduleSecretVersionDeletionRequest) (response ScheduleSecretVersionDeletionResponse, err error)
+
+	// UpdateSecret Updates the properties of a secret. Specifically, you can update the version number of the secret to make
+	// that version number the current version. You can also update a secret's description, its free-form or defined tags, rules
+	// and the secret contents. Updating the secret content automatically creates a new secret version. You cannot, however, update the current secret version number and the secret contents and the rules at the
+	// same time. Furthermore, the secret must in an `ACTIVE` lifecycle state to be updated.
+	// This operation is not supported by the Oracle Cloud Infrastructure Terraform Provider.
+	UpdateSecret(ctx context.Context, request UpdateSecretRequest) (response UpdateSecretResponse, err error)
+}
+
+// VaultsClient implements VaultsClientAPI
+var _ VaultsClientAPI = VaultsClient{}
@@ -153,10 +153,12 @@ func (client WaasClient) CancelWorkRequest(ctx context.Context, request CancelWo
 				response = CancelWorkRequestResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CancelWorkRequestResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CancelWorkRequestResponse")
 	}
@@ -210,10 +212,12 @@ func (client WaasClient) ChangeAddressListCompartment(ctx context.Context, reque
 				response = ChangeAddressListCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeAddressListCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeAddressListCompartmentResponse")
 	}
@@ -266,10 +270,12 @@ func (client WaasClient) ChangeCertificateCompartment(ctx context.Context, reque
 				response = ChangeCertificateCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCertificateCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCertificateCompartmentResponse")
 	}
@@ -321,10 +327,12 @@ func (client WaasClient) ChangeCustomProtectionRuleCompartment(ctx context.Conte
 				response = ChangeCustomProtectionRuleCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeCustomProtectionRuleCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeCustomProtectionRuleCompartmentResponse")
 	}
@@ -377,10 +385,12 @@ func (client WaasClient) ChangeWaasPolicyCompartment(ctx context.Context, reques
 				response = ChangeWaasPolicyCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeWaasPolicyCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeWaasPolicyCompartmentResponse")
 	}
@@ -432,10 +442,12 @@ func (client WaasClient) CreateAddressList(ctx context.Context, request CreateAd
 				response = CreateAddressListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateAddressListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateAddressListResponse")
 	}
@@ -488,10 +500,12 @@ func (client WaasClient) CreateCertificate(ctx context.Context, request CreateCe
 				response = CreateCertificateResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCertificateResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCertificateResponse")
 	}
@@ -544,10 +558,12 @@ func (client WaasClient) CreateCustomProtectionRule(ctx context.Context, request
 				response = CreateCustomProtectionRuleResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateCustomProtectionRuleResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateCustomProtectionRuleResponse")
 	}
@@ -605,10 +621,12 @@ func (client WaasClient) CreateWaasPolicy(ctx context.Context, request CreateWaa
 				response = CreateWaasPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateWaasPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateWaasPolicyResponse")
 	}
@@ -660,10 +678,12 @@ func (client WaasClient) DeleteAddressList(ctx context.Context, request DeleteAd
 				response = DeleteAddressListResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteAddressListResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteAddressListResponse")
 	}
@@ -715,10 +735,12 @@ func (client WaasClient) DeleteCertificate(ctx context.Context, request DeleteCe
 				response = DeleteCertificateResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteCertificateResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteCertificateResponse")
 	}
@@ -770,10 +792,12 @@ func (client WaasClient) DeleteCustomProtectionRule(ctx context.Context, request
 				response = DeleteCustomProtectionRuleResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteCustomProtectionRuleResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteCustomProtectionRuleResponse")
 	}
@@ -825,10 +849,12 @@ func (client WaasClient) DeleteWaasPolicy(ctx context.Context, request DeleteWaa
 				response = DeleteWaasPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteWaasPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteWaasPolicyResponse")
 	}
@@ -2551,10 +2577,12 @@ func (client WaasClient) UpdateAccessRules(ctx context.Context, request UpdateAc
 				response = UpdateAccessRulesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateAccessRulesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateAccessRulesResponse")
 	}
@@ -2659,10 +2687,12 @@ func (client WaasClient) UpdateCachingRules(ctx context.Context, request UpdateC
 				response = UpdateCachingRulesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateCachingRulesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateCachingRulesResponse")
 	}
@@ -2719,10 +2749,12 @@ func (client WaasClient) UpdateCaptchas(ctx context.Context, request UpdateCaptc
 				response = UpdateCaptchasResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateCaptchasResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateCaptchasResponse")
 	}
@@ -2824,10 +2856,12 @@ func (client WaasClient) UpdateCustomProtectionRule(ctx context.Context, request
 				response = UpdateCustomProtectionRuleResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateCustomProtectionRuleResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateCustomProtectionRuleResponse")
 	}
@@ -2881,10 +2915,12 @@ func (client WaasClient) UpdateDeviceFingerprintChallenge(ctx context.Context, r
 				response = UpdateDeviceFingerprintChallengeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateDeviceFingerprintChallengeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateDeviceFingerprintChallengeResponse")
 	}
@@ -2937,10 +2973,12 @@ func (client WaasClient) UpdateGoodBots(ctx context.Context, request UpdateGoodB
 				response = UpdateGoodBotsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateGoodBotsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateGoodBotsResponse")
 	}
@@ -2992,10 +3030,12 @@ func (client WaasClient) UpdateHumanInteractionChallenge(ctx context.Context, re
 				response = UpdateHumanInteractionChallengeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateHumanInteractionChallengeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateHumanInteractionChallengeResponse")
 	}
@@ -3047,10 +3087,12 @@ func (client WaasClient) UpdateJsChallenge(ctx context.Context, request UpdateJs
 				response = UpdateJsChallengeResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateJsChallengeResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateJsChallengeResponse")
 	}
@@ -3102,10 +3144,12 @@ func (client WaasClient) UpdatePolicyConfig(ctx context.Context, request UpdateP
 				response = UpdatePolicyConfigResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdatePolicyConfigResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdatePolicyConfigResponse")
 	}
@@ -3209,10 +3253,12 @@ func (client WaasClient) UpdateProtectionSettings(ctx context.Context, request U
 				response = UpdateProtectionSettingsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateProtectionSettingsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateProtectionSettingsResponse")
 	}
@@ -3316,10 +3362,12 @@ func (client WaasClient) UpdateWaasPolicy(ctx context.Context, request UpdateWaa
 				response = UpdateWaasPolicyResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateWaasPolicyResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateWaasPolicyResponse")
 	}
@@ -3371,10 +3419,12 @@ func (client WaasClient) UpdateWaasPolicyCustomProtectionRules(ctx context.Conte
 				response = UpdateWaasPolicyCustomProtectionRulesResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateWaasPolicyCustomProtectionRulesResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateWaasPolicyCustomProtectionRulesResponse")
 	}
@@ -3426,10 +3476,12 @@ func (client WaasClient) UpdateWafAddressRateLimiting(ctx context.Context, reque
 				response = UpdateWafAddressRateLimitingResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateWafAddressRateLimitingResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateWafAddressRateLimitingResponse")
 	}
@@ -3486,10 +3538,12 @@ func (client WaasClient) UpdateWafConfig(ctx context.Context, request UpdateWafC
 				response = UpdateWafConfigResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateWafConfigResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateWafConfigResponse")
 	}
@@ -3545,10 +3599,12 @@ func (client WaasClient) UpdateWhitelists(ctx context.Context, request UpdateWhi
 				response = UpdateWhitelistsResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateWhitelistsResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateWhitelistsResponse")
 	}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package waas
+
+import (
+	"encoding/json"
+	"github.com/oracle/oci-go-sdk/v27/common"
+)
+
+func init() {
+	common.RegisterPolymorphicModel((*HeaderManipulationAction)(nil), func() common.PolymorphicJSONUnmarshaler { return &headermanipulationaction{} })
+	common.RegisterPolymorphicModel((*LoadBalancingMethod)(nil), func() common.PolymorphicJSONUnmarshaler { return &loadbalancingmethod{} })
+}
+
+// MarshalJSON marshals a HeaderManipulationAction whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m headermanipulationaction) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeheadermanipulationaction headermanipulationaction
+	s := struct {
+		MarshalTypeheadermanipulationaction
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeheadermanipulationaction: (MarshalTypeheadermanipulationaction)(m),
+	}
+	return json.Marshal(&s)
+}
+
+// MarshalJSON marshals a LoadBalancingMethod whose discriminator has no type in the package as the json it was
+// unmarshaled from, keeping the properties of the types added to the service since
+func (m loadbalancingmethod) MarshalJSON() ([]byte, error) {
+	if len(m.JsonData) > 0 {
+		return m.JsonData, nil
+	}
+
+	type MarshalTypeloadbalancingmethod loadbalancingmethod
+	s := struct {
+		MarshalTypeloadbalancingmethod
+		JsonData []byte `json:"-"`
+	}{
+		MarshalTypeloadbalancingmethod: (MarshalTypeloadbalancingmethod)(m),
+	}
+	return json.Marshal(&s)
+}
@@ -102,10 +102,12 @@ func (client RedirectClient) ChangeHttpRedirectCompartment(ctx context.Context,
 				response = ChangeHttpRedirectCompartmentResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(ChangeHttpRedirectCompartmentResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into ChangeHttpRedirectCompartmentResponse")
 	}
@@ -157,10 +159,12 @@ func (client RedirectClient) CreateHttpRedirect(ctx context.Context, request Cre
 				response = CreateHttpRedirectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(CreateHttpRedirectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into CreateHttpRedirectResponse")
 	}
@@ -212,10 +216,12 @@ func (client RedirectClient) DeleteHttpRedirect(ctx context.Context, request Del
 				response = DeleteHttpRedirectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(DeleteHttpRedirectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into DeleteHttpRedirectResponse")
 	}
@@ -367,10 +373,12 @@ func (client RedirectClient) UpdateHttpRedirect(ctx context.Context, request Upd
 				response = UpdateHttpRedirectResponse{}
 			}
 		}
+		response.OpcRetryToken = request.OpcRetryToken
 		return
 	}
 	if convertedResponse, ok := ociResponse.(UpdateHttpRedirectResponse); ok {
 		response = convertedResponse
+		response.OpcRetryToken = request.OpcRetryToken
 	} else {
 		err = fmt.Errorf("failed to convert OCIResponse into UpdateHttpRedirectResponse")
 	}
@@ -0,0 +1,33 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package waas
+
+import (
+	"context"
+)
+
+// RedirectClientAPI is the interface of the operations of RedirectClient, to inject the client and mock it in tests
+type RedirectClientAPI interface {
+	// ChangeHttpRedirectCompartment Moves HTTP Redirect into a different compartment. When provided, If-Match is checked against ETag values of the WAAS policy.
+	ChangeHttpRedirectCompartment(ctx context.Context, request ChangeHttpRedirectCompartmentRequest) (response ChangeHttpRedirectCompartmentResponse, err error)
+
+	// CreateHttpRedirect Creates a new HTTP Redirect on the WAF edge.
+	CreateHttpRedirect(ctx context.Context, request CreateHttpRedirectRequest) (response CreateHttpRedirectResponse, err error)
+
+	// DeleteHttpRedirect Deletes a redirect.
+	DeleteHttpRedirect(ctx context.Context, request DeleteHttpRedirectRequest) (response DeleteHttpRedirectResponse, err error)
+
+	// GetHttpRedirect Gets the details of a HTTP Redirect.
+	GetHttpRedirect(ctx context.Context, request GetHttpRedirectRequest) (response GetHttpRedirectResponse, err error)
+
+	// ListHttpRedirects Gets a list of HTTP Redirects.
+	ListHttpRedirects(ctx context.Context, request ListHttpRedirectsRequest) (response ListHttpRedirectsResponse, err error)
+
+	// UpdateHttpRedirect Updates the details of a HTTP Redirect, including target and tags. Only the fields specified in the request body will be updated; all other properties will remain unchanged.
+	UpdateHttpRedirect(ctx context.Context, request UpdateHttpRedirectRequest) (response UpdateHttpRedirectResponse, err error)
+}
+
+// RedirectClient implements RedirectClientAPI
+var _ RedirectClientAPI = RedirectClient{}
@@ -0,0 +1,27 @@
+// Copyright (c) 2016, 2018, 2020, Oracle and/or its affiliates.  All rights reserved.
+// This software is dual-licensed to you under the Universal Permissive License (UPL) 1.0 as shown at https://oss.oracle.com/licenses/upl or Apache License 2.0 as shown at http://www.apache.org/licenses/LICENSE-2.0. You may choose either license.
+// Code generated. DO NOT EDIT.
+
+package workrequests
+
+import (
+	"context"
+)
+
+// WorkRequestClientAPI is the interface of the operations of WorkRequestClient, to inject the client and mock it in tests
+type WorkRequestClientAPI interface {
+	// GetWorkRequest Gets the details of a work request.
+	GetWorkRequest(ctx context.Context, request GetWorkRequestRequest) (response GetWorkRequestResponse, err error)
+
+	// ListWorkRequestErrors Gets the errors for a work request.
+	ListWorkRequestErrors(ctx context.Context, request ListWorkRequestErrorsRequest) (response ListWorkRequestErrorsResponse, err error)
+
+	// ListWorkRequestLogs Gets the logs for a work request.
+	ListWorkRequestLogs(ctx context.Context, request ListWorkRequestLogsRequest) (response ListWorkRequestLogsResponse, err error)
+
+	// ListWorkRequests Lists the work requests in a compartment or for a specified resource.
+	ListWorkRequests(ctx context.Context, request ListWorkRequestsRequest) (response ListWorkRequestsResponse, err error)
+}
+
+// WorkRequestClient implements WorkRequestClientAPI
+var _ WorkRequestClientAPI = WorkRequestClient{}